@@ -473,6 +473,80 @@ func (this *GetShardResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type DescribeMutableStateCacheRequest to the protobuf v3 wire format
+func (val *DescribeMutableStateCacheRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeMutableStateCacheRequest from the protobuf v3 wire format
+func (val *DescribeMutableStateCacheRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeMutableStateCacheRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeMutableStateCacheRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeMutableStateCacheRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeMutableStateCacheRequest
+	switch t := that.(type) {
+	case *DescribeMutableStateCacheRequest:
+		that1 = t
+	case DescribeMutableStateCacheRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeMutableStateCacheResponse to the protobuf v3 wire format
+func (val *DescribeMutableStateCacheResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeMutableStateCacheResponse from the protobuf v3 wire format
+func (val *DescribeMutableStateCacheResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeMutableStateCacheResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeMutableStateCacheResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeMutableStateCacheResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeMutableStateCacheResponse
+	switch t := that.(type) {
+	case *DescribeMutableStateCacheResponse:
+		that1 = t
+	case DescribeMutableStateCacheResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type ListHistoryTasksRequest to the protobuf v3 wire format
 func (val *ListHistoryTasksRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -3321,3 +3395,1150 @@ func (this *ForceUnloadTaskQueuePartitionResponse) Equal(that interface{}) bool
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type GetMatchingDLQTasksRequest to the protobuf v3 wire format
+func (val *GetMatchingDLQTasksRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetMatchingDLQTasksRequest from the protobuf v3 wire format
+func (val *GetMatchingDLQTasksRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetMatchingDLQTasksRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetMatchingDLQTasksRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetMatchingDLQTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetMatchingDLQTasksRequest
+	switch t := that.(type) {
+	case *GetMatchingDLQTasksRequest:
+		that1 = t
+	case GetMatchingDLQTasksRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetMatchingDLQTasksResponse to the protobuf v3 wire format
+func (val *GetMatchingDLQTasksResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetMatchingDLQTasksResponse from the protobuf v3 wire format
+func (val *GetMatchingDLQTasksResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetMatchingDLQTasksResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetMatchingDLQTasksResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetMatchingDLQTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetMatchingDLQTasksResponse
+	switch t := that.(type) {
+	case *GetMatchingDLQTasksResponse:
+		that1 = t
+	case GetMatchingDLQTasksResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ReenqueueMatchingDLQTasksRequest to the protobuf v3 wire format
+func (val *ReenqueueMatchingDLQTasksRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ReenqueueMatchingDLQTasksRequest from the protobuf v3 wire format
+func (val *ReenqueueMatchingDLQTasksRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ReenqueueMatchingDLQTasksRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ReenqueueMatchingDLQTasksRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ReenqueueMatchingDLQTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ReenqueueMatchingDLQTasksRequest
+	switch t := that.(type) {
+	case *ReenqueueMatchingDLQTasksRequest:
+		that1 = t
+	case ReenqueueMatchingDLQTasksRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ReenqueueMatchingDLQTasksResponse to the protobuf v3 wire format
+func (val *ReenqueueMatchingDLQTasksResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ReenqueueMatchingDLQTasksResponse from the protobuf v3 wire format
+func (val *ReenqueueMatchingDLQTasksResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ReenqueueMatchingDLQTasksResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ReenqueueMatchingDLQTasksResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ReenqueueMatchingDLQTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ReenqueueMatchingDLQTasksResponse
+	switch t := that.(type) {
+	case *ReenqueueMatchingDLQTasksResponse:
+		that1 = t
+	case ReenqueueMatchingDLQTasksResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PauseWorkflowExecutionRequest to the protobuf v3 wire format
+func (val *PauseWorkflowExecutionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PauseWorkflowExecutionRequest from the protobuf v3 wire format
+func (val *PauseWorkflowExecutionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PauseWorkflowExecutionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PauseWorkflowExecutionRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PauseWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PauseWorkflowExecutionRequest
+	switch t := that.(type) {
+	case *PauseWorkflowExecutionRequest:
+		that1 = t
+	case PauseWorkflowExecutionRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PauseWorkflowExecutionResponse to the protobuf v3 wire format
+func (val *PauseWorkflowExecutionResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PauseWorkflowExecutionResponse from the protobuf v3 wire format
+func (val *PauseWorkflowExecutionResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PauseWorkflowExecutionResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PauseWorkflowExecutionResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PauseWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PauseWorkflowExecutionResponse
+	switch t := that.(type) {
+	case *PauseWorkflowExecutionResponse:
+		that1 = t
+	case PauseWorkflowExecutionResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UnpauseWorkflowExecutionRequest to the protobuf v3 wire format
+func (val *UnpauseWorkflowExecutionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UnpauseWorkflowExecutionRequest from the protobuf v3 wire format
+func (val *UnpauseWorkflowExecutionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UnpauseWorkflowExecutionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UnpauseWorkflowExecutionRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UnpauseWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UnpauseWorkflowExecutionRequest
+	switch t := that.(type) {
+	case *UnpauseWorkflowExecutionRequest:
+		that1 = t
+	case UnpauseWorkflowExecutionRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UnpauseWorkflowExecutionResponse to the protobuf v3 wire format
+func (val *UnpauseWorkflowExecutionResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UnpauseWorkflowExecutionResponse from the protobuf v3 wire format
+func (val *UnpauseWorkflowExecutionResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UnpauseWorkflowExecutionResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UnpauseWorkflowExecutionResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UnpauseWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UnpauseWorkflowExecutionResponse
+	switch t := that.(type) {
+	case *UnpauseWorkflowExecutionResponse:
+		that1 = t
+	case UnpauseWorkflowExecutionResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ResetWorkflowExecutionRequest to the protobuf v3 wire format
+func (val *ResetWorkflowExecutionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ResetWorkflowExecutionRequest from the protobuf v3 wire format
+func (val *ResetWorkflowExecutionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ResetWorkflowExecutionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ResetWorkflowExecutionRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ResetWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ResetWorkflowExecutionRequest
+	switch t := that.(type) {
+	case *ResetWorkflowExecutionRequest:
+		that1 = t
+	case ResetWorkflowExecutionRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ResetWorkflowExecutionResponse to the protobuf v3 wire format
+func (val *ResetWorkflowExecutionResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ResetWorkflowExecutionResponse from the protobuf v3 wire format
+func (val *ResetWorkflowExecutionResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ResetWorkflowExecutionResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ResetWorkflowExecutionResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ResetWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ResetWorkflowExecutionResponse
+	switch t := that.(type) {
+	case *ResetWorkflowExecutionResponse:
+		that1 = t
+	case ResetWorkflowExecutionResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DiffWorkflowExecutionHistoriesRequest to the protobuf v3 wire format
+func (val *DiffWorkflowExecutionHistoriesRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DiffWorkflowExecutionHistoriesRequest from the protobuf v3 wire format
+func (val *DiffWorkflowExecutionHistoriesRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DiffWorkflowExecutionHistoriesRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DiffWorkflowExecutionHistoriesRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DiffWorkflowExecutionHistoriesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DiffWorkflowExecutionHistoriesRequest
+	switch t := that.(type) {
+	case *DiffWorkflowExecutionHistoriesRequest:
+		that1 = t
+	case DiffWorkflowExecutionHistoriesRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DiffWorkflowExecutionHistoriesResponse to the protobuf v3 wire format
+func (val *DiffWorkflowExecutionHistoriesResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DiffWorkflowExecutionHistoriesResponse from the protobuf v3 wire format
+func (val *DiffWorkflowExecutionHistoriesResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DiffWorkflowExecutionHistoriesResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DiffWorkflowExecutionHistoriesResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DiffWorkflowExecutionHistoriesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DiffWorkflowExecutionHistoriesResponse
+	switch t := that.(type) {
+	case *DiffWorkflowExecutionHistoriesResponse:
+		that1 = t
+	case DiffWorkflowExecutionHistoriesResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type HistoryEventDiff to the protobuf v3 wire format
+func (val *HistoryEventDiff) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type HistoryEventDiff from the protobuf v3 wire format
+func (val *HistoryEventDiff) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *HistoryEventDiff) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two HistoryEventDiff values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *HistoryEventDiff) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *HistoryEventDiff
+	switch t := that.(type) {
+	case *HistoryEventDiff:
+		that1 = t
+	case HistoryEventDiff:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeWorkflowCallbacksRequest to the protobuf v3 wire format
+func (val *DescribeWorkflowCallbacksRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeWorkflowCallbacksRequest from the protobuf v3 wire format
+func (val *DescribeWorkflowCallbacksRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeWorkflowCallbacksRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeWorkflowCallbacksRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeWorkflowCallbacksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeWorkflowCallbacksRequest
+	switch t := that.(type) {
+	case *DescribeWorkflowCallbacksRequest:
+		that1 = t
+	case DescribeWorkflowCallbacksRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeWorkflowCallbacksResponse to the protobuf v3 wire format
+func (val *DescribeWorkflowCallbacksResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeWorkflowCallbacksResponse from the protobuf v3 wire format
+func (val *DescribeWorkflowCallbacksResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeWorkflowCallbacksResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeWorkflowCallbacksResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeWorkflowCallbacksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeWorkflowCallbacksResponse
+	switch t := that.(type) {
+	case *DescribeWorkflowCallbacksResponse:
+		that1 = t
+	case DescribeWorkflowCallbacksResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateWorkflowCallbackRequest to the protobuf v3 wire format
+func (val *UpdateWorkflowCallbackRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateWorkflowCallbackRequest from the protobuf v3 wire format
+func (val *UpdateWorkflowCallbackRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateWorkflowCallbackRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateWorkflowCallbackRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateWorkflowCallbackRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateWorkflowCallbackRequest
+	switch t := that.(type) {
+	case *UpdateWorkflowCallbackRequest:
+		that1 = t
+	case UpdateWorkflowCallbackRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateWorkflowCallbackResponse to the protobuf v3 wire format
+func (val *UpdateWorkflowCallbackResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateWorkflowCallbackResponse from the protobuf v3 wire format
+func (val *UpdateWorkflowCallbackResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateWorkflowCallbackResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateWorkflowCallbackResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateWorkflowCallbackResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateWorkflowCallbackResponse
+	switch t := that.(type) {
+	case *UpdateWorkflowCallbackResponse:
+		that1 = t
+	case UpdateWorkflowCallbackResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetNexusEndpointPolicyRequest to the protobuf v3 wire format
+func (val *GetNexusEndpointPolicyRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetNexusEndpointPolicyRequest from the protobuf v3 wire format
+func (val *GetNexusEndpointPolicyRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetNexusEndpointPolicyRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetNexusEndpointPolicyRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetNexusEndpointPolicyRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetNexusEndpointPolicyRequest
+	switch t := that.(type) {
+	case *GetNexusEndpointPolicyRequest:
+		that1 = t
+	case GetNexusEndpointPolicyRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetNexusEndpointPolicyResponse to the protobuf v3 wire format
+func (val *GetNexusEndpointPolicyResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetNexusEndpointPolicyResponse from the protobuf v3 wire format
+func (val *GetNexusEndpointPolicyResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetNexusEndpointPolicyResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetNexusEndpointPolicyResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetNexusEndpointPolicyResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetNexusEndpointPolicyResponse
+	switch t := that.(type) {
+	case *GetNexusEndpointPolicyResponse:
+		that1 = t
+	case GetNexusEndpointPolicyResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateNexusEndpointPolicyRequest to the protobuf v3 wire format
+func (val *UpdateNexusEndpointPolicyRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateNexusEndpointPolicyRequest from the protobuf v3 wire format
+func (val *UpdateNexusEndpointPolicyRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateNexusEndpointPolicyRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateNexusEndpointPolicyRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateNexusEndpointPolicyRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateNexusEndpointPolicyRequest
+	switch t := that.(type) {
+	case *UpdateNexusEndpointPolicyRequest:
+		that1 = t
+	case UpdateNexusEndpointPolicyRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateNexusEndpointPolicyResponse to the protobuf v3 wire format
+func (val *UpdateNexusEndpointPolicyResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateNexusEndpointPolicyResponse from the protobuf v3 wire format
+func (val *UpdateNexusEndpointPolicyResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateNexusEndpointPolicyResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateNexusEndpointPolicyResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateNexusEndpointPolicyResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateNexusEndpointPolicyResponse
+	switch t := that.(type) {
+	case *UpdateNexusEndpointPolicyResponse:
+		that1 = t
+	case UpdateNexusEndpointPolicyResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeScheduleRequest to the protobuf v3 wire format
+func (val *DescribeScheduleRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeScheduleRequest from the protobuf v3 wire format
+func (val *DescribeScheduleRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeScheduleRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeScheduleRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeScheduleRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeScheduleRequest
+	switch t := that.(type) {
+	case *DescribeScheduleRequest:
+		that1 = t
+	case DescribeScheduleRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeScheduleResponse to the protobuf v3 wire format
+func (val *DescribeScheduleResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeScheduleResponse from the protobuf v3 wire format
+func (val *DescribeScheduleResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeScheduleResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeScheduleResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeScheduleResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeScheduleResponse
+	switch t := that.(type) {
+	case *DescribeScheduleResponse:
+		that1 = t
+	case DescribeScheduleResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SetScheduleDependenciesRequest to the protobuf v3 wire format
+func (val *SetScheduleDependenciesRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SetScheduleDependenciesRequest from the protobuf v3 wire format
+func (val *SetScheduleDependenciesRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SetScheduleDependenciesRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SetScheduleDependenciesRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SetScheduleDependenciesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SetScheduleDependenciesRequest
+	switch t := that.(type) {
+	case *SetScheduleDependenciesRequest:
+		that1 = t
+	case SetScheduleDependenciesRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SetScheduleDependenciesResponse to the protobuf v3 wire format
+func (val *SetScheduleDependenciesResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SetScheduleDependenciesResponse from the protobuf v3 wire format
+func (val *SetScheduleDependenciesResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SetScheduleDependenciesResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SetScheduleDependenciesResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SetScheduleDependenciesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SetScheduleDependenciesResponse
+	switch t := that.(type) {
+	case *SetScheduleDependenciesResponse:
+		that1 = t
+	case SetScheduleDependenciesResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SetScheduleSharedCalendarsRequest to the protobuf v3 wire format
+func (val *SetScheduleSharedCalendarsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SetScheduleSharedCalendarsRequest from the protobuf v3 wire format
+func (val *SetScheduleSharedCalendarsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SetScheduleSharedCalendarsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SetScheduleSharedCalendarsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SetScheduleSharedCalendarsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SetScheduleSharedCalendarsRequest
+	switch t := that.(type) {
+	case *SetScheduleSharedCalendarsRequest:
+		that1 = t
+	case SetScheduleSharedCalendarsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SetScheduleSharedCalendarsResponse to the protobuf v3 wire format
+func (val *SetScheduleSharedCalendarsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SetScheduleSharedCalendarsResponse from the protobuf v3 wire format
+func (val *SetScheduleSharedCalendarsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SetScheduleSharedCalendarsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SetScheduleSharedCalendarsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SetScheduleSharedCalendarsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SetScheduleSharedCalendarsResponse
+	switch t := that.(type) {
+	case *SetScheduleSharedCalendarsResponse:
+		that1 = t
+	case SetScheduleSharedCalendarsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SetScheduleSlaRequest to the protobuf v3 wire format
+func (val *SetScheduleSlaRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SetScheduleSlaRequest from the protobuf v3 wire format
+func (val *SetScheduleSlaRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SetScheduleSlaRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SetScheduleSlaRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SetScheduleSlaRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SetScheduleSlaRequest
+	switch t := that.(type) {
+	case *SetScheduleSlaRequest:
+		that1 = t
+	case SetScheduleSlaRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SetScheduleSlaResponse to the protobuf v3 wire format
+func (val *SetScheduleSlaResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SetScheduleSlaResponse from the protobuf v3 wire format
+func (val *SetScheduleSlaResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SetScheduleSlaResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SetScheduleSlaResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SetScheduleSlaResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SetScheduleSlaResponse
+	switch t := that.(type) {
+	case *SetScheduleSlaResponse:
+		that1 = t
+	case SetScheduleSlaResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SetScheduleConcurrencyGroupRequest to the protobuf v3 wire format
+func (val *SetScheduleConcurrencyGroupRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SetScheduleConcurrencyGroupRequest from the protobuf v3 wire format
+func (val *SetScheduleConcurrencyGroupRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SetScheduleConcurrencyGroupRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SetScheduleConcurrencyGroupRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SetScheduleConcurrencyGroupRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SetScheduleConcurrencyGroupRequest
+	switch t := that.(type) {
+	case *SetScheduleConcurrencyGroupRequest:
+		that1 = t
+	case SetScheduleConcurrencyGroupRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SetScheduleConcurrencyGroupResponse to the protobuf v3 wire format
+func (val *SetScheduleConcurrencyGroupResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SetScheduleConcurrencyGroupResponse from the protobuf v3 wire format
+func (val *SetScheduleConcurrencyGroupResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SetScheduleConcurrencyGroupResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SetScheduleConcurrencyGroupResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SetScheduleConcurrencyGroupResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SetScheduleConcurrencyGroupResponse
+	switch t := that.(type) {
+	case *SetScheduleConcurrencyGroupResponse:
+		that1 = t
+	case SetScheduleConcurrencyGroupResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
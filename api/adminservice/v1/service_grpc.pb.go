@@ -48,6 +48,7 @@ const (
 	AdminService_DescribeHistoryHost_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/DescribeHistoryHost"
 	AdminService_GetShard_FullMethodName                            = "/temporal.server.api.adminservice.v1.AdminService/GetShard"
 	AdminService_CloseShard_FullMethodName                          = "/temporal.server.api.adminservice.v1.AdminService/CloseShard"
+	AdminService_DescribeMutableStateCache_FullMethodName           = "/temporal.server.api.adminservice.v1.AdminService/DescribeMutableStateCache"
 	AdminService_ListHistoryTasks_FullMethodName                    = "/temporal.server.api.adminservice.v1.AdminService/ListHistoryTasks"
 	AdminService_RemoveTask_FullMethodName                          = "/temporal.server.api.adminservice.v1.AdminService/RemoveTask"
 	AdminService_GetWorkflowExecutionRawHistoryV2_FullMethodName    = "/temporal.server.api.adminservice.v1.AdminService/GetWorkflowExecutionRawHistoryV2"
@@ -85,6 +86,21 @@ const (
 	AdminService_GenerateLastHistoryReplicationTasks_FullMethodName = "/temporal.server.api.adminservice.v1.AdminService/GenerateLastHistoryReplicationTasks"
 	AdminService_DescribeTaskQueuePartition_FullMethodName          = "/temporal.server.api.adminservice.v1.AdminService/DescribeTaskQueuePartition"
 	AdminService_ForceUnloadTaskQueuePartition_FullMethodName       = "/temporal.server.api.adminservice.v1.AdminService/ForceUnloadTaskQueuePartition"
+	AdminService_GetMatchingDLQTasks_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/GetMatchingDLQTasks"
+	AdminService_ReenqueueMatchingDLQTasks_FullMethodName           = "/temporal.server.api.adminservice.v1.AdminService/ReenqueueMatchingDLQTasks"
+	AdminService_PauseWorkflowExecution_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/PauseWorkflowExecution"
+	AdminService_UnpauseWorkflowExecution_FullMethodName            = "/temporal.server.api.adminservice.v1.AdminService/UnpauseWorkflowExecution"
+	AdminService_ResetWorkflowExecution_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/ResetWorkflowExecution"
+	AdminService_DiffWorkflowExecutionHistories_FullMethodName      = "/temporal.server.api.adminservice.v1.AdminService/DiffWorkflowExecutionHistories"
+	AdminService_DescribeSchedule_FullMethodName                    = "/temporal.server.api.adminservice.v1.AdminService/DescribeSchedule"
+	AdminService_SetScheduleDependencies_FullMethodName             = "/temporal.server.api.adminservice.v1.AdminService/SetScheduleDependencies"
+	AdminService_SetScheduleSharedCalendars_FullMethodName          = "/temporal.server.api.adminservice.v1.AdminService/SetScheduleSharedCalendars"
+	AdminService_SetScheduleSla_FullMethodName                      = "/temporal.server.api.adminservice.v1.AdminService/SetScheduleSla"
+	AdminService_SetScheduleConcurrencyGroup_FullMethodName         = "/temporal.server.api.adminservice.v1.AdminService/SetScheduleConcurrencyGroup"
+	AdminService_DescribeWorkflowCallbacks_FullMethodName           = "/temporal.server.api.adminservice.v1.AdminService/DescribeWorkflowCallbacks"
+	AdminService_UpdateWorkflowCallback_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/UpdateWorkflowCallback"
+	AdminService_GetNexusEndpointPolicy_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/GetNexusEndpointPolicy"
+	AdminService_UpdateNexusEndpointPolicy_FullMethodName           = "/temporal.server.api.adminservice.v1.AdminService/UpdateNexusEndpointPolicy"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -103,6 +119,9 @@ type AdminServiceClient interface {
 	DescribeHistoryHost(ctx context.Context, in *DescribeHistoryHostRequest, opts ...grpc.CallOption) (*DescribeHistoryHostResponse, error)
 	GetShard(ctx context.Context, in *GetShardRequest, opts ...grpc.CallOption) (*GetShardResponse, error)
 	CloseShard(ctx context.Context, in *CloseShardRequest, opts ...grpc.CallOption) (*CloseShardResponse, error)
+	// DescribeMutableStateCache returns the largest entries of a shard in the mutable state cache of the history host
+	// that owns the shard.
+	DescribeMutableStateCache(ctx context.Context, in *DescribeMutableStateCacheRequest, opts ...grpc.CallOption) (*DescribeMutableStateCacheResponse, error)
 	ListHistoryTasks(ctx context.Context, in *ListHistoryTasksRequest, opts ...grpc.CallOption) (*ListHistoryTasksResponse, error)
 	RemoveTask(ctx context.Context, in *RemoveTaskRequest, opts ...grpc.CallOption) (*RemoveTaskResponse, error)
 	// Returns the raw history of specified workflow execution.  It fails with 'NotFound' if specified workflow
@@ -175,6 +194,50 @@ type AdminServiceClient interface {
 	GenerateLastHistoryReplicationTasks(ctx context.Context, in *GenerateLastHistoryReplicationTasksRequest, opts ...grpc.CallOption) (*GenerateLastHistoryReplicationTasksResponse, error)
 	DescribeTaskQueuePartition(ctx context.Context, in *DescribeTaskQueuePartitionRequest, opts ...grpc.CallOption) (*DescribeTaskQueuePartitionResponse, error)
 	ForceUnloadTaskQueuePartition(ctx context.Context, in *ForceUnloadTaskQueuePartitionRequest, opts ...grpc.CallOption) (*ForceUnloadTaskQueuePartitionResponse, error)
+	// GetMatchingDLQTasks returns a page of the tasks that matching dropped into the dead-letter queue of a namespace
+	// without delivering them to a worker.
+	GetMatchingDLQTasks(ctx context.Context, in *GetMatchingDLQTasksRequest, opts ...grpc.CallOption) (*GetMatchingDLQTasksResponse, error)
+	// ReenqueueMatchingDLQTasks adds tasks from the matching dead-letter queue of a namespace back to their task queues.
+	ReenqueueMatchingDLQTasks(ctx context.Context, in *ReenqueueMatchingDLQTasksRequest, opts ...grpc.CallOption) (*ReenqueueMatchingDLQTasksResponse, error)
+	// PauseWorkflowExecution freezes a running workflow execution: no workflow tasks, activity tasks, user timers
+	// or child workflow starts are dispatched until it is unpaused. Signals and updates are still accepted and are
+	// delivered to the workflow once it is unpaused.
+	PauseWorkflowExecution(ctx context.Context, in *PauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*PauseWorkflowExecutionResponse, error)
+	// UnpauseWorkflowExecution resumes a paused workflow execution.
+	UnpauseWorkflowExecution(ctx context.Context, in *UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*UnpauseWorkflowExecutionResponse, error)
+	// ResetWorkflowExecution resets a workflow execution like the frontend API of the same name, but lets the caller
+	// choose exactly which signals and updates recorded after the reset point are reapplied, optionally with
+	// replacement signal payloads.
+	ResetWorkflowExecution(ctx context.Context, in *ResetWorkflowExecutionRequest, opts ...grpc.CallOption) (*ResetWorkflowExecutionResponse, error)
+	// DiffWorkflowExecutionHistories compares the histories of two workflow executions, or two branches of one
+	// execution, and reports where they diverge and which events differ.
+	DiffWorkflowExecutionHistories(ctx context.Context, in *DiffWorkflowExecutionHistoriesRequest, opts ...grpc.CallOption) (*DiffWorkflowExecutionHistoriesResponse, error)
+	// DescribeSchedule returns the internal state of a schedule, including its dependencies, SLA and concurrency
+	// group, which the public DescribeSchedule API can't return.
+	DescribeSchedule(ctx context.Context, in *DescribeScheduleRequest, opts ...grpc.CallOption) (*DescribeScheduleResponse, error)
+	// SetScheduleDependencies replaces the upstream schedules that a schedule waits for before taking an action.
+	SetScheduleDependencies(ctx context.Context, in *SetScheduleDependenciesRequest, opts ...grpc.CallOption) (*SetScheduleDependenciesResponse, error)
+	// SetScheduleSharedCalendars replaces the shared calendars whose times a schedule excludes. Shared calendars are
+	// namespace data entries, managed with UpdateNamespace, see temporal.server.api.schedule.v1.SharedCalendar.
+	SetScheduleSharedCalendars(ctx context.Context, in *SetScheduleSharedCalendarsRequest, opts ...grpc.CallOption) (*SetScheduleSharedCalendarsResponse, error)
+	// SetScheduleSla replaces the SLA of a schedule: the conditions that count as violations and where they are reported.
+	SetScheduleSla(ctx context.Context, in *SetScheduleSlaRequest, opts ...grpc.CallOption) (*SetScheduleSlaResponse, error)
+	// SetScheduleConcurrencyGroup moves a schedule to a namespace-wide concurrency group, which limits how many
+	// workflows started by the schedules in the group may run at once.
+	SetScheduleConcurrencyGroup(ctx context.Context, in *SetScheduleConcurrencyGroupRequest, opts ...grpc.CallOption) (*SetScheduleConcurrencyGroupResponse, error)
+	// DescribeWorkflowCallbacks returns the completion callbacks of a workflow execution with their delivery state
+	// and recent attempts (status code, latency and failure of each).
+	DescribeWorkflowCallbacks(ctx context.Context, in *DescribeWorkflowCallbacksRequest, opts ...grpc.CallOption) (*DescribeWorkflowCallbacksResponse, error)
+	// UpdateWorkflowCallback retries a failed or backing off completion callback immediately, skips a pending one,
+	// or replaces the URL of one that hasn't succeeded yet.
+	UpdateWorkflowCallback(ctx context.Context, in *UpdateWorkflowCallbackRequest, opts ...grpc.CallOption) (*UpdateWorkflowCallbackResponse, error)
+	// GetNexusEndpointPolicy returns a Nexus endpoint registry entry including its policy, which the operator API
+	// does not expose.
+	GetNexusEndpointPolicy(ctx context.Context, in *GetNexusEndpointPolicyRequest, opts ...grpc.CallOption) (*GetNexusEndpointPolicyResponse, error)
+	// UpdateNexusEndpointPolicy replaces the policy of a Nexus endpoint: the max operation timeout, the behavior on
+	// caller close, the per caller workflow concurrency limit and result caching. The rest of the endpoint spec is
+	// unchanged.
+	UpdateNexusEndpointPolicy(ctx context.Context, in *UpdateNexusEndpointPolicyRequest, opts ...grpc.CallOption) (*UpdateNexusEndpointPolicyResponse, error)
 }
 
 type adminServiceClient struct {
@@ -239,6 +302,15 @@ func (c *adminServiceClient) CloseShard(ctx context.Context, in *CloseShardReque
 	return out, nil
 }
 
+func (c *adminServiceClient) DescribeMutableStateCache(ctx context.Context, in *DescribeMutableStateCacheRequest, opts ...grpc.CallOption) (*DescribeMutableStateCacheResponse, error) {
+	out := new(DescribeMutableStateCacheResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeMutableStateCache_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) ListHistoryTasks(ctx context.Context, in *ListHistoryTasksRequest, opts ...grpc.CallOption) (*ListHistoryTasksResponse, error) {
 	out := new(ListHistoryTasksResponse)
 	err := c.cc.Invoke(ctx, AdminService_ListHistoryTasks_FullMethodName, in, out, opts...)
@@ -594,6 +666,141 @@ func (c *adminServiceClient) ForceUnloadTaskQueuePartition(ctx context.Context,
 	return out, nil
 }
 
+func (c *adminServiceClient) GetMatchingDLQTasks(ctx context.Context, in *GetMatchingDLQTasksRequest, opts ...grpc.CallOption) (*GetMatchingDLQTasksResponse, error) {
+	out := new(GetMatchingDLQTasksResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetMatchingDLQTasks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ReenqueueMatchingDLQTasks(ctx context.Context, in *ReenqueueMatchingDLQTasksRequest, opts ...grpc.CallOption) (*ReenqueueMatchingDLQTasksResponse, error) {
+	out := new(ReenqueueMatchingDLQTasksResponse)
+	err := c.cc.Invoke(ctx, AdminService_ReenqueueMatchingDLQTasks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PauseWorkflowExecution(ctx context.Context, in *PauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*PauseWorkflowExecutionResponse, error) {
+	out := new(PauseWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, AdminService_PauseWorkflowExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UnpauseWorkflowExecution(ctx context.Context, in *UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*UnpauseWorkflowExecutionResponse, error) {
+	out := new(UnpauseWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, AdminService_UnpauseWorkflowExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ResetWorkflowExecution(ctx context.Context, in *ResetWorkflowExecutionRequest, opts ...grpc.CallOption) (*ResetWorkflowExecutionResponse, error) {
+	out := new(ResetWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, AdminService_ResetWorkflowExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DiffWorkflowExecutionHistories(ctx context.Context, in *DiffWorkflowExecutionHistoriesRequest, opts ...grpc.CallOption) (*DiffWorkflowExecutionHistoriesResponse, error) {
+	out := new(DiffWorkflowExecutionHistoriesResponse)
+	err := c.cc.Invoke(ctx, AdminService_DiffWorkflowExecutionHistories_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeSchedule(ctx context.Context, in *DescribeScheduleRequest, opts ...grpc.CallOption) (*DescribeScheduleResponse, error) {
+	out := new(DescribeScheduleResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetScheduleDependencies(ctx context.Context, in *SetScheduleDependenciesRequest, opts ...grpc.CallOption) (*SetScheduleDependenciesResponse, error) {
+	out := new(SetScheduleDependenciesResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetScheduleDependencies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetScheduleSharedCalendars(ctx context.Context, in *SetScheduleSharedCalendarsRequest, opts ...grpc.CallOption) (*SetScheduleSharedCalendarsResponse, error) {
+	out := new(SetScheduleSharedCalendarsResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetScheduleSharedCalendars_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetScheduleSla(ctx context.Context, in *SetScheduleSlaRequest, opts ...grpc.CallOption) (*SetScheduleSlaResponse, error) {
+	out := new(SetScheduleSlaResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetScheduleSla_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetScheduleConcurrencyGroup(ctx context.Context, in *SetScheduleConcurrencyGroupRequest, opts ...grpc.CallOption) (*SetScheduleConcurrencyGroupResponse, error) {
+	out := new(SetScheduleConcurrencyGroupResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetScheduleConcurrencyGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeWorkflowCallbacks(ctx context.Context, in *DescribeWorkflowCallbacksRequest, opts ...grpc.CallOption) (*DescribeWorkflowCallbacksResponse, error) {
+	out := new(DescribeWorkflowCallbacksResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeWorkflowCallbacks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateWorkflowCallback(ctx context.Context, in *UpdateWorkflowCallbackRequest, opts ...grpc.CallOption) (*UpdateWorkflowCallbackResponse, error) {
+	out := new(UpdateWorkflowCallbackResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateWorkflowCallback_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetNexusEndpointPolicy(ctx context.Context, in *GetNexusEndpointPolicyRequest, opts ...grpc.CallOption) (*GetNexusEndpointPolicyResponse, error) {
+	out := new(GetNexusEndpointPolicyResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetNexusEndpointPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateNexusEndpointPolicy(ctx context.Context, in *UpdateNexusEndpointPolicyRequest, opts ...grpc.CallOption) (*UpdateNexusEndpointPolicyResponse, error) {
+	out := new(UpdateNexusEndpointPolicyResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateNexusEndpointPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility
@@ -610,6 +817,9 @@ type AdminServiceServer interface {
 	DescribeHistoryHost(context.Context, *DescribeHistoryHostRequest) (*DescribeHistoryHostResponse, error)
 	GetShard(context.Context, *GetShardRequest) (*GetShardResponse, error)
 	CloseShard(context.Context, *CloseShardRequest) (*CloseShardResponse, error)
+	// DescribeMutableStateCache returns the largest entries of a shard in the mutable state cache of the history host
+	// that owns the shard.
+	DescribeMutableStateCache(context.Context, *DescribeMutableStateCacheRequest) (*DescribeMutableStateCacheResponse, error)
 	ListHistoryTasks(context.Context, *ListHistoryTasksRequest) (*ListHistoryTasksResponse, error)
 	RemoveTask(context.Context, *RemoveTaskRequest) (*RemoveTaskResponse, error)
 	// Returns the raw history of specified workflow execution.  It fails with 'NotFound' if specified workflow
@@ -682,6 +892,50 @@ type AdminServiceServer interface {
 	GenerateLastHistoryReplicationTasks(context.Context, *GenerateLastHistoryReplicationTasksRequest) (*GenerateLastHistoryReplicationTasksResponse, error)
 	DescribeTaskQueuePartition(context.Context, *DescribeTaskQueuePartitionRequest) (*DescribeTaskQueuePartitionResponse, error)
 	ForceUnloadTaskQueuePartition(context.Context, *ForceUnloadTaskQueuePartitionRequest) (*ForceUnloadTaskQueuePartitionResponse, error)
+	// GetMatchingDLQTasks returns a page of the tasks that matching dropped into the dead-letter queue of a namespace
+	// without delivering them to a worker.
+	GetMatchingDLQTasks(context.Context, *GetMatchingDLQTasksRequest) (*GetMatchingDLQTasksResponse, error)
+	// ReenqueueMatchingDLQTasks adds tasks from the matching dead-letter queue of a namespace back to their task queues.
+	ReenqueueMatchingDLQTasks(context.Context, *ReenqueueMatchingDLQTasksRequest) (*ReenqueueMatchingDLQTasksResponse, error)
+	// PauseWorkflowExecution freezes a running workflow execution: no workflow tasks, activity tasks, user timers
+	// or child workflow starts are dispatched until it is unpaused. Signals and updates are still accepted and are
+	// delivered to the workflow once it is unpaused.
+	PauseWorkflowExecution(context.Context, *PauseWorkflowExecutionRequest) (*PauseWorkflowExecutionResponse, error)
+	// UnpauseWorkflowExecution resumes a paused workflow execution.
+	UnpauseWorkflowExecution(context.Context, *UnpauseWorkflowExecutionRequest) (*UnpauseWorkflowExecutionResponse, error)
+	// ResetWorkflowExecution resets a workflow execution like the frontend API of the same name, but lets the caller
+	// choose exactly which signals and updates recorded after the reset point are reapplied, optionally with
+	// replacement signal payloads.
+	ResetWorkflowExecution(context.Context, *ResetWorkflowExecutionRequest) (*ResetWorkflowExecutionResponse, error)
+	// DiffWorkflowExecutionHistories compares the histories of two workflow executions, or two branches of one
+	// execution, and reports where they diverge and which events differ.
+	DiffWorkflowExecutionHistories(context.Context, *DiffWorkflowExecutionHistoriesRequest) (*DiffWorkflowExecutionHistoriesResponse, error)
+	// DescribeSchedule returns the internal state of a schedule, including its dependencies, SLA and concurrency
+	// group, which the public DescribeSchedule API can't return.
+	DescribeSchedule(context.Context, *DescribeScheduleRequest) (*DescribeScheduleResponse, error)
+	// SetScheduleDependencies replaces the upstream schedules that a schedule waits for before taking an action.
+	SetScheduleDependencies(context.Context, *SetScheduleDependenciesRequest) (*SetScheduleDependenciesResponse, error)
+	// SetScheduleSharedCalendars replaces the shared calendars whose times a schedule excludes. Shared calendars are
+	// namespace data entries, managed with UpdateNamespace, see temporal.server.api.schedule.v1.SharedCalendar.
+	SetScheduleSharedCalendars(context.Context, *SetScheduleSharedCalendarsRequest) (*SetScheduleSharedCalendarsResponse, error)
+	// SetScheduleSla replaces the SLA of a schedule: the conditions that count as violations and where they are reported.
+	SetScheduleSla(context.Context, *SetScheduleSlaRequest) (*SetScheduleSlaResponse, error)
+	// SetScheduleConcurrencyGroup moves a schedule to a namespace-wide concurrency group, which limits how many
+	// workflows started by the schedules in the group may run at once.
+	SetScheduleConcurrencyGroup(context.Context, *SetScheduleConcurrencyGroupRequest) (*SetScheduleConcurrencyGroupResponse, error)
+	// DescribeWorkflowCallbacks returns the completion callbacks of a workflow execution with their delivery state
+	// and recent attempts (status code, latency and failure of each).
+	DescribeWorkflowCallbacks(context.Context, *DescribeWorkflowCallbacksRequest) (*DescribeWorkflowCallbacksResponse, error)
+	// UpdateWorkflowCallback retries a failed or backing off completion callback immediately, skips a pending one,
+	// or replaces the URL of one that hasn't succeeded yet.
+	UpdateWorkflowCallback(context.Context, *UpdateWorkflowCallbackRequest) (*UpdateWorkflowCallbackResponse, error)
+	// GetNexusEndpointPolicy returns a Nexus endpoint registry entry including its policy, which the operator API
+	// does not expose.
+	GetNexusEndpointPolicy(context.Context, *GetNexusEndpointPolicyRequest) (*GetNexusEndpointPolicyResponse, error)
+	// UpdateNexusEndpointPolicy replaces the policy of a Nexus endpoint: the max operation timeout, the behavior on
+	// caller close, the per caller workflow concurrency limit and result caching. The rest of the endpoint spec is
+	// unchanged.
+	UpdateNexusEndpointPolicy(context.Context, *UpdateNexusEndpointPolicyRequest) (*UpdateNexusEndpointPolicyResponse, error)
 	mustEmbedUnimplementedAdminServiceServer()
 }
 
@@ -707,6 +961,9 @@ func (UnimplementedAdminServiceServer) GetShard(context.Context, *GetShardReques
 func (UnimplementedAdminServiceServer) CloseShard(context.Context, *CloseShardRequest) (*CloseShardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CloseShard not implemented")
 }
+func (UnimplementedAdminServiceServer) DescribeMutableStateCache(context.Context, *DescribeMutableStateCacheRequest) (*DescribeMutableStateCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeMutableStateCache not implemented")
+}
 func (UnimplementedAdminServiceServer) ListHistoryTasks(context.Context, *ListHistoryTasksRequest) (*ListHistoryTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListHistoryTasks not implemented")
 }
@@ -818,6 +1075,51 @@ func (UnimplementedAdminServiceServer) DescribeTaskQueuePartition(context.Contex
 func (UnimplementedAdminServiceServer) ForceUnloadTaskQueuePartition(context.Context, *ForceUnloadTaskQueuePartitionRequest) (*ForceUnloadTaskQueuePartitionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForceUnloadTaskQueuePartition not implemented")
 }
+func (UnimplementedAdminServiceServer) GetMatchingDLQTasks(context.Context, *GetMatchingDLQTasksRequest) (*GetMatchingDLQTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMatchingDLQTasks not implemented")
+}
+func (UnimplementedAdminServiceServer) ReenqueueMatchingDLQTasks(context.Context, *ReenqueueMatchingDLQTasksRequest) (*ReenqueueMatchingDLQTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReenqueueMatchingDLQTasks not implemented")
+}
+func (UnimplementedAdminServiceServer) PauseWorkflowExecution(context.Context, *PauseWorkflowExecutionRequest) (*PauseWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseWorkflowExecution not implemented")
+}
+func (UnimplementedAdminServiceServer) UnpauseWorkflowExecution(context.Context, *UnpauseWorkflowExecutionRequest) (*UnpauseWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseWorkflowExecution not implemented")
+}
+func (UnimplementedAdminServiceServer) ResetWorkflowExecution(context.Context, *ResetWorkflowExecutionRequest) (*ResetWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetWorkflowExecution not implemented")
+}
+func (UnimplementedAdminServiceServer) DiffWorkflowExecutionHistories(context.Context, *DiffWorkflowExecutionHistoriesRequest) (*DiffWorkflowExecutionHistoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffWorkflowExecutionHistories not implemented")
+}
+func (UnimplementedAdminServiceServer) DescribeSchedule(context.Context, *DescribeScheduleRequest) (*DescribeScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeSchedule not implemented")
+}
+func (UnimplementedAdminServiceServer) SetScheduleDependencies(context.Context, *SetScheduleDependenciesRequest) (*SetScheduleDependenciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetScheduleDependencies not implemented")
+}
+func (UnimplementedAdminServiceServer) SetScheduleSharedCalendars(context.Context, *SetScheduleSharedCalendarsRequest) (*SetScheduleSharedCalendarsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetScheduleSharedCalendars not implemented")
+}
+func (UnimplementedAdminServiceServer) SetScheduleSla(context.Context, *SetScheduleSlaRequest) (*SetScheduleSlaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetScheduleSla not implemented")
+}
+func (UnimplementedAdminServiceServer) SetScheduleConcurrencyGroup(context.Context, *SetScheduleConcurrencyGroupRequest) (*SetScheduleConcurrencyGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetScheduleConcurrencyGroup not implemented")
+}
+func (UnimplementedAdminServiceServer) DescribeWorkflowCallbacks(context.Context, *DescribeWorkflowCallbacksRequest) (*DescribeWorkflowCallbacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeWorkflowCallbacks not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateWorkflowCallback(context.Context, *UpdateWorkflowCallbackRequest) (*UpdateWorkflowCallbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkflowCallback not implemented")
+}
+func (UnimplementedAdminServiceServer) GetNexusEndpointPolicy(context.Context, *GetNexusEndpointPolicyRequest) (*GetNexusEndpointPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNexusEndpointPolicy not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateNexusEndpointPolicy(context.Context, *UpdateNexusEndpointPolicyRequest) (*UpdateNexusEndpointPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNexusEndpointPolicy not implemented")
+}
 func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
 
 // UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -939,6 +1241,24 @@ func _AdminService_CloseShard_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_DescribeMutableStateCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeMutableStateCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeMutableStateCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeMutableStateCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeMutableStateCache(ctx, req.(*DescribeMutableStateCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_ListHistoryTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListHistoryTasksRequest)
 	if err := dec(in); err != nil {
@@ -1613,6 +1933,276 @@ func _AdminService_ForceUnloadTaskQueuePartition_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_GetMatchingDLQTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMatchingDLQTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetMatchingDLQTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetMatchingDLQTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetMatchingDLQTasks(ctx, req.(*GetMatchingDLQTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ReenqueueMatchingDLQTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReenqueueMatchingDLQTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ReenqueueMatchingDLQTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ReenqueueMatchingDLQTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ReenqueueMatchingDLQTasks(ctx, req.(*ReenqueueMatchingDLQTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PauseWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PauseWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_PauseWorkflowExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PauseWorkflowExecution(ctx, req.(*PauseWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UnpauseWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpauseWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UnpauseWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UnpauseWorkflowExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UnpauseWorkflowExecution(ctx, req.(*UnpauseWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ResetWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ResetWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ResetWorkflowExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ResetWorkflowExecution(ctx, req.(*ResetWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DiffWorkflowExecutionHistories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffWorkflowExecutionHistoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DiffWorkflowExecutionHistories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DiffWorkflowExecutionHistories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DiffWorkflowExecutionHistories(ctx, req.(*DiffWorkflowExecutionHistoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeSchedule(ctx, req.(*DescribeScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetScheduleDependencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetScheduleDependenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetScheduleDependencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetScheduleDependencies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetScheduleDependencies(ctx, req.(*SetScheduleDependenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetScheduleSharedCalendars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetScheduleSharedCalendarsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetScheduleSharedCalendars(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetScheduleSharedCalendars_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetScheduleSharedCalendars(ctx, req.(*SetScheduleSharedCalendarsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetScheduleSla_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetScheduleSlaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetScheduleSla(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetScheduleSla_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetScheduleSla(ctx, req.(*SetScheduleSlaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetScheduleConcurrencyGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetScheduleConcurrencyGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetScheduleConcurrencyGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetScheduleConcurrencyGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetScheduleConcurrencyGroup(ctx, req.(*SetScheduleConcurrencyGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeWorkflowCallbacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeWorkflowCallbacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeWorkflowCallbacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeWorkflowCallbacks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeWorkflowCallbacks(ctx, req.(*DescribeWorkflowCallbacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateWorkflowCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkflowCallbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateWorkflowCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateWorkflowCallback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateWorkflowCallback(ctx, req.(*UpdateWorkflowCallbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetNexusEndpointPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNexusEndpointPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetNexusEndpointPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetNexusEndpointPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetNexusEndpointPolicy(ctx, req.(*GetNexusEndpointPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateNexusEndpointPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNexusEndpointPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateNexusEndpointPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateNexusEndpointPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateNexusEndpointPolicy(ctx, req.(*UpdateNexusEndpointPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1644,6 +2234,10 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CloseShard",
 			Handler:    _AdminService_CloseShard_Handler,
 		},
+		{
+			MethodName: "DescribeMutableStateCache",
+			Handler:    _AdminService_DescribeMutableStateCache_Handler,
+		},
 		{
 			MethodName: "ListHistoryTasks",
 			Handler:    _AdminService_ListHistoryTasks_Handler,
@@ -1788,6 +2382,66 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ForceUnloadTaskQueuePartition",
 			Handler:    _AdminService_ForceUnloadTaskQueuePartition_Handler,
 		},
+		{
+			MethodName: "GetMatchingDLQTasks",
+			Handler:    _AdminService_GetMatchingDLQTasks_Handler,
+		},
+		{
+			MethodName: "ReenqueueMatchingDLQTasks",
+			Handler:    _AdminService_ReenqueueMatchingDLQTasks_Handler,
+		},
+		{
+			MethodName: "PauseWorkflowExecution",
+			Handler:    _AdminService_PauseWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "UnpauseWorkflowExecution",
+			Handler:    _AdminService_UnpauseWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "ResetWorkflowExecution",
+			Handler:    _AdminService_ResetWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "DiffWorkflowExecutionHistories",
+			Handler:    _AdminService_DiffWorkflowExecutionHistories_Handler,
+		},
+		{
+			MethodName: "DescribeSchedule",
+			Handler:    _AdminService_DescribeSchedule_Handler,
+		},
+		{
+			MethodName: "SetScheduleDependencies",
+			Handler:    _AdminService_SetScheduleDependencies_Handler,
+		},
+		{
+			MethodName: "SetScheduleSharedCalendars",
+			Handler:    _AdminService_SetScheduleSharedCalendars_Handler,
+		},
+		{
+			MethodName: "SetScheduleSla",
+			Handler:    _AdminService_SetScheduleSla_Handler,
+		},
+		{
+			MethodName: "SetScheduleConcurrencyGroup",
+			Handler:    _AdminService_SetScheduleConcurrencyGroup_Handler,
+		},
+		{
+			MethodName: "DescribeWorkflowCallbacks",
+			Handler:    _AdminService_DescribeWorkflowCallbacks_Handler,
+		},
+		{
+			MethodName: "UpdateWorkflowCallback",
+			Handler:    _AdminService_UpdateWorkflowCallback_Handler,
+		},
+		{
+			MethodName: "GetNexusEndpointPolicy",
+			Handler:    _AdminService_GetNexusEndpointPolicy_Handler,
+		},
+		{
+			MethodName: "UpdateNexusEndpointPolicy",
+			Handler:    _AdminService_UpdateNexusEndpointPolicy_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
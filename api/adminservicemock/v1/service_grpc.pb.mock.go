@@ -286,6 +286,46 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeMutableState(ctx, in any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeMutableState), varargs...)
 }
 
+// DescribeMutableStateCache mocks base method.
+func (m *MockAdminServiceClient) DescribeMutableStateCache(ctx context.Context, in *adminservice.DescribeMutableStateCacheRequest, opts ...grpc.CallOption) (*adminservice.DescribeMutableStateCacheResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeMutableStateCache", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeMutableStateCacheResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeMutableStateCache indicates an expected call of DescribeMutableStateCache.
+func (mr *MockAdminServiceClientMockRecorder) DescribeMutableStateCache(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableStateCache", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeMutableStateCache), varargs...)
+}
+
+// DescribeSchedule mocks base method.
+func (m *MockAdminServiceClient) DescribeSchedule(ctx context.Context, in *adminservice.DescribeScheduleRequest, opts ...grpc.CallOption) (*adminservice.DescribeScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeSchedule", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeSchedule indicates an expected call of DescribeSchedule.
+func (mr *MockAdminServiceClientMockRecorder) DescribeSchedule(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSchedule", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeSchedule), varargs...)
+}
+
 // DescribeTaskQueuePartition mocks base method.
 func (m *MockAdminServiceClient) DescribeTaskQueuePartition(ctx context.Context, in *adminservice.DescribeTaskQueuePartitionRequest, opts ...grpc.CallOption) (*adminservice.DescribeTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -306,6 +346,46 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeTaskQueuePartition(ctx, in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueuePartition", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeTaskQueuePartition), varargs...)
 }
 
+// DescribeWorkflowCallbacks mocks base method.
+func (m *MockAdminServiceClient) DescribeWorkflowCallbacks(ctx context.Context, in *adminservice.DescribeWorkflowCallbacksRequest, opts ...grpc.CallOption) (*adminservice.DescribeWorkflowCallbacksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeWorkflowCallbacks", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeWorkflowCallbacksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeWorkflowCallbacks indicates an expected call of DescribeWorkflowCallbacks.
+func (mr *MockAdminServiceClientMockRecorder) DescribeWorkflowCallbacks(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowCallbacks", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeWorkflowCallbacks), varargs...)
+}
+
+// DiffWorkflowExecutionHistories mocks base method.
+func (m *MockAdminServiceClient) DiffWorkflowExecutionHistories(ctx context.Context, in *adminservice.DiffWorkflowExecutionHistoriesRequest, opts ...grpc.CallOption) (*adminservice.DiffWorkflowExecutionHistoriesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DiffWorkflowExecutionHistories", varargs...)
+	ret0, _ := ret[0].(*adminservice.DiffWorkflowExecutionHistoriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffWorkflowExecutionHistories indicates an expected call of DiffWorkflowExecutionHistories.
+func (mr *MockAdminServiceClientMockRecorder) DiffWorkflowExecutionHistories(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffWorkflowExecutionHistories", reflect.TypeOf((*MockAdminServiceClient)(nil).DiffWorkflowExecutionHistories), varargs...)
+}
+
 // ForceUnloadTaskQueuePartition mocks base method.
 func (m *MockAdminServiceClient) ForceUnloadTaskQueuePartition(ctx context.Context, in *adminservice.ForceUnloadTaskQueuePartitionRequest, opts ...grpc.CallOption) (*adminservice.ForceUnloadTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -406,6 +486,26 @@ func (mr *MockAdminServiceClientMockRecorder) GetDLQTasks(ctx, in any, opts ...a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).GetDLQTasks), varargs...)
 }
 
+// GetMatchingDLQTasks mocks base method.
+func (m *MockAdminServiceClient) GetMatchingDLQTasks(ctx context.Context, in *adminservice.GetMatchingDLQTasksRequest, opts ...grpc.CallOption) (*adminservice.GetMatchingDLQTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetMatchingDLQTasks", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetMatchingDLQTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMatchingDLQTasks indicates an expected call of GetMatchingDLQTasks.
+func (mr *MockAdminServiceClientMockRecorder) GetMatchingDLQTasks(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMatchingDLQTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).GetMatchingDLQTasks), varargs...)
+}
+
 // GetNamespace mocks base method.
 func (m *MockAdminServiceClient) GetNamespace(ctx context.Context, in *adminservice.GetNamespaceRequest, opts ...grpc.CallOption) (*adminservice.GetNamespaceResponse, error) {
 	m.ctrl.T.Helper()
@@ -446,6 +546,26 @@ func (mr *MockAdminServiceClientMockRecorder) GetNamespaceReplicationMessages(ct
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceReplicationMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).GetNamespaceReplicationMessages), varargs...)
 }
 
+// GetNexusEndpointPolicy mocks base method.
+func (m *MockAdminServiceClient) GetNexusEndpointPolicy(ctx context.Context, in *adminservice.GetNexusEndpointPolicyRequest, opts ...grpc.CallOption) (*adminservice.GetNexusEndpointPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetNexusEndpointPolicy", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetNexusEndpointPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNexusEndpointPolicy indicates an expected call of GetNexusEndpointPolicy.
+func (mr *MockAdminServiceClientMockRecorder) GetNexusEndpointPolicy(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNexusEndpointPolicy", reflect.TypeOf((*MockAdminServiceClient)(nil).GetNexusEndpointPolicy), varargs...)
+}
+
 // GetReplicationMessages mocks base method.
 func (m *MockAdminServiceClient) GetReplicationMessages(ctx context.Context, in *adminservice.GetReplicationMessagesRequest, opts ...grpc.CallOption) (*adminservice.GetReplicationMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -706,6 +826,26 @@ func (mr *MockAdminServiceClientMockRecorder) MergeDLQTasks(ctx, in any, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).MergeDLQTasks), varargs...)
 }
 
+// PauseWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) PauseWorkflowExecution(ctx context.Context, in *adminservice.PauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.PauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.PauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) PauseWorkflowExecution(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).PauseWorkflowExecution), varargs...)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockAdminServiceClient) PurgeDLQMessages(ctx context.Context, in *adminservice.PurgeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -786,6 +926,26 @@ func (mr *MockAdminServiceClientMockRecorder) RebuildMutableState(ctx, in any, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildMutableState", reflect.TypeOf((*MockAdminServiceClient)(nil).RebuildMutableState), varargs...)
 }
 
+// ReenqueueMatchingDLQTasks mocks base method.
+func (m *MockAdminServiceClient) ReenqueueMatchingDLQTasks(ctx context.Context, in *adminservice.ReenqueueMatchingDLQTasksRequest, opts ...grpc.CallOption) (*adminservice.ReenqueueMatchingDLQTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReenqueueMatchingDLQTasks", varargs...)
+	ret0, _ := ret[0].(*adminservice.ReenqueueMatchingDLQTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReenqueueMatchingDLQTasks indicates an expected call of ReenqueueMatchingDLQTasks.
+func (mr *MockAdminServiceClientMockRecorder) ReenqueueMatchingDLQTasks(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReenqueueMatchingDLQTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ReenqueueMatchingDLQTasks), varargs...)
+}
+
 // RefreshWorkflowTasks mocks base method.
 func (m *MockAdminServiceClient) RefreshWorkflowTasks(ctx context.Context, in *adminservice.RefreshWorkflowTasksRequest, opts ...grpc.CallOption) (*adminservice.RefreshWorkflowTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -886,6 +1046,106 @@ func (mr *MockAdminServiceClientMockRecorder) ResendReplicationTasks(ctx, in any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ResendReplicationTasks), varargs...)
 }
 
+// ResetWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) ResetWorkflowExecution(ctx context.Context, in *adminservice.ResetWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.ResetWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.ResetWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetWorkflowExecution indicates an expected call of ResetWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) ResetWorkflowExecution(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).ResetWorkflowExecution), varargs...)
+}
+
+// SetScheduleConcurrencyGroup mocks base method.
+func (m *MockAdminServiceClient) SetScheduleConcurrencyGroup(ctx context.Context, in *adminservice.SetScheduleConcurrencyGroupRequest, opts ...grpc.CallOption) (*adminservice.SetScheduleConcurrencyGroupResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetScheduleConcurrencyGroup", varargs...)
+	ret0, _ := ret[0].(*adminservice.SetScheduleConcurrencyGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetScheduleConcurrencyGroup indicates an expected call of SetScheduleConcurrencyGroup.
+func (mr *MockAdminServiceClientMockRecorder) SetScheduleConcurrencyGroup(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetScheduleConcurrencyGroup", reflect.TypeOf((*MockAdminServiceClient)(nil).SetScheduleConcurrencyGroup), varargs...)
+}
+
+// SetScheduleDependencies mocks base method.
+func (m *MockAdminServiceClient) SetScheduleDependencies(ctx context.Context, in *adminservice.SetScheduleDependenciesRequest, opts ...grpc.CallOption) (*adminservice.SetScheduleDependenciesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetScheduleDependencies", varargs...)
+	ret0, _ := ret[0].(*adminservice.SetScheduleDependenciesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetScheduleDependencies indicates an expected call of SetScheduleDependencies.
+func (mr *MockAdminServiceClientMockRecorder) SetScheduleDependencies(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetScheduleDependencies", reflect.TypeOf((*MockAdminServiceClient)(nil).SetScheduleDependencies), varargs...)
+}
+
+// SetScheduleSharedCalendars mocks base method.
+func (m *MockAdminServiceClient) SetScheduleSharedCalendars(ctx context.Context, in *adminservice.SetScheduleSharedCalendarsRequest, opts ...grpc.CallOption) (*adminservice.SetScheduleSharedCalendarsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetScheduleSharedCalendars", varargs...)
+	ret0, _ := ret[0].(*adminservice.SetScheduleSharedCalendarsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetScheduleSharedCalendars indicates an expected call of SetScheduleSharedCalendars.
+func (mr *MockAdminServiceClientMockRecorder) SetScheduleSharedCalendars(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetScheduleSharedCalendars", reflect.TypeOf((*MockAdminServiceClient)(nil).SetScheduleSharedCalendars), varargs...)
+}
+
+// SetScheduleSla mocks base method.
+func (m *MockAdminServiceClient) SetScheduleSla(ctx context.Context, in *adminservice.SetScheduleSlaRequest, opts ...grpc.CallOption) (*adminservice.SetScheduleSlaResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetScheduleSla", varargs...)
+	ret0, _ := ret[0].(*adminservice.SetScheduleSlaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetScheduleSla indicates an expected call of SetScheduleSla.
+func (mr *MockAdminServiceClientMockRecorder) SetScheduleSla(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetScheduleSla", reflect.TypeOf((*MockAdminServiceClient)(nil).SetScheduleSla), varargs...)
+}
+
 // StreamWorkflowReplicationMessages mocks base method.
 func (m *MockAdminServiceClient) StreamWorkflowReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (adminservice.AdminService_StreamWorkflowReplicationMessagesClient, error) {
 	m.ctrl.T.Helper()
@@ -926,6 +1186,66 @@ func (mr *MockAdminServiceClientMockRecorder) SyncWorkflowState(ctx, in any, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncWorkflowState", reflect.TypeOf((*MockAdminServiceClient)(nil).SyncWorkflowState), varargs...)
 }
 
+// UnpauseWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) UnpauseWorkflowExecution(ctx context.Context, in *adminservice.UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.UnpauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) UnpauseWorkflowExecution(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).UnpauseWorkflowExecution), varargs...)
+}
+
+// UpdateNexusEndpointPolicy mocks base method.
+func (m *MockAdminServiceClient) UpdateNexusEndpointPolicy(ctx context.Context, in *adminservice.UpdateNexusEndpointPolicyRequest, opts ...grpc.CallOption) (*adminservice.UpdateNexusEndpointPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateNexusEndpointPolicy", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateNexusEndpointPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNexusEndpointPolicy indicates an expected call of UpdateNexusEndpointPolicy.
+func (mr *MockAdminServiceClientMockRecorder) UpdateNexusEndpointPolicy(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNexusEndpointPolicy", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateNexusEndpointPolicy), varargs...)
+}
+
+// UpdateWorkflowCallback mocks base method.
+func (m *MockAdminServiceClient) UpdateWorkflowCallback(ctx context.Context, in *adminservice.UpdateWorkflowCallbackRequest, opts ...grpc.CallOption) (*adminservice.UpdateWorkflowCallbackResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateWorkflowCallback", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateWorkflowCallbackResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkflowCallback indicates an expected call of UpdateWorkflowCallback.
+func (mr *MockAdminServiceClientMockRecorder) UpdateWorkflowCallback(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowCallback", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateWorkflowCallback), varargs...)
+}
+
 // MockAdminService_StreamWorkflowReplicationMessagesClient is a mock of AdminService_StreamWorkflowReplicationMessagesClient interface.
 type MockAdminService_StreamWorkflowReplicationMessagesClient struct {
 	ctrl     *gomock.Controller
@@ -1251,6 +1571,36 @@ func (mr *MockAdminServiceServerMockRecorder) DescribeMutableState(arg0, arg1 an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeMutableState), arg0, arg1)
 }
 
+// DescribeMutableStateCache mocks base method.
+func (m *MockAdminServiceServer) DescribeMutableStateCache(arg0 context.Context, arg1 *adminservice.DescribeMutableStateCacheRequest) (*adminservice.DescribeMutableStateCacheResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeMutableStateCache", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeMutableStateCacheResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeMutableStateCache indicates an expected call of DescribeMutableStateCache.
+func (mr *MockAdminServiceServerMockRecorder) DescribeMutableStateCache(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableStateCache", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeMutableStateCache), arg0, arg1)
+}
+
+// DescribeSchedule mocks base method.
+func (m *MockAdminServiceServer) DescribeSchedule(arg0 context.Context, arg1 *adminservice.DescribeScheduleRequest) (*adminservice.DescribeScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeSchedule indicates an expected call of DescribeSchedule.
+func (mr *MockAdminServiceServerMockRecorder) DescribeSchedule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSchedule", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeSchedule), arg0, arg1)
+}
+
 // DescribeTaskQueuePartition mocks base method.
 func (m *MockAdminServiceServer) DescribeTaskQueuePartition(arg0 context.Context, arg1 *adminservice.DescribeTaskQueuePartitionRequest) (*adminservice.DescribeTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1266,6 +1616,36 @@ func (mr *MockAdminServiceServerMockRecorder) DescribeTaskQueuePartition(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueuePartition", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeTaskQueuePartition), arg0, arg1)
 }
 
+// DescribeWorkflowCallbacks mocks base method.
+func (m *MockAdminServiceServer) DescribeWorkflowCallbacks(arg0 context.Context, arg1 *adminservice.DescribeWorkflowCallbacksRequest) (*adminservice.DescribeWorkflowCallbacksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeWorkflowCallbacks", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeWorkflowCallbacksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeWorkflowCallbacks indicates an expected call of DescribeWorkflowCallbacks.
+func (mr *MockAdminServiceServerMockRecorder) DescribeWorkflowCallbacks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowCallbacks", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeWorkflowCallbacks), arg0, arg1)
+}
+
+// DiffWorkflowExecutionHistories mocks base method.
+func (m *MockAdminServiceServer) DiffWorkflowExecutionHistories(arg0 context.Context, arg1 *adminservice.DiffWorkflowExecutionHistoriesRequest) (*adminservice.DiffWorkflowExecutionHistoriesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffWorkflowExecutionHistories", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DiffWorkflowExecutionHistoriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffWorkflowExecutionHistories indicates an expected call of DiffWorkflowExecutionHistories.
+func (mr *MockAdminServiceServerMockRecorder) DiffWorkflowExecutionHistories(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffWorkflowExecutionHistories", reflect.TypeOf((*MockAdminServiceServer)(nil).DiffWorkflowExecutionHistories), arg0, arg1)
+}
+
 // ForceUnloadTaskQueuePartition mocks base method.
 func (m *MockAdminServiceServer) ForceUnloadTaskQueuePartition(arg0 context.Context, arg1 *adminservice.ForceUnloadTaskQueuePartitionRequest) (*adminservice.ForceUnloadTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1341,6 +1721,21 @@ func (mr *MockAdminServiceServerMockRecorder) GetDLQTasks(arg0, arg1 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).GetDLQTasks), arg0, arg1)
 }
 
+// GetMatchingDLQTasks mocks base method.
+func (m *MockAdminServiceServer) GetMatchingDLQTasks(arg0 context.Context, arg1 *adminservice.GetMatchingDLQTasksRequest) (*adminservice.GetMatchingDLQTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMatchingDLQTasks", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetMatchingDLQTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMatchingDLQTasks indicates an expected call of GetMatchingDLQTasks.
+func (mr *MockAdminServiceServerMockRecorder) GetMatchingDLQTasks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMatchingDLQTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).GetMatchingDLQTasks), arg0, arg1)
+}
+
 // GetNamespace mocks base method.
 func (m *MockAdminServiceServer) GetNamespace(arg0 context.Context, arg1 *adminservice.GetNamespaceRequest) (*adminservice.GetNamespaceResponse, error) {
 	m.ctrl.T.Helper()
@@ -1371,6 +1766,21 @@ func (mr *MockAdminServiceServerMockRecorder) GetNamespaceReplicationMessages(ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceReplicationMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).GetNamespaceReplicationMessages), arg0, arg1)
 }
 
+// GetNexusEndpointPolicy mocks base method.
+func (m *MockAdminServiceServer) GetNexusEndpointPolicy(arg0 context.Context, arg1 *adminservice.GetNexusEndpointPolicyRequest) (*adminservice.GetNexusEndpointPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNexusEndpointPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetNexusEndpointPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNexusEndpointPolicy indicates an expected call of GetNexusEndpointPolicy.
+func (mr *MockAdminServiceServerMockRecorder) GetNexusEndpointPolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNexusEndpointPolicy", reflect.TypeOf((*MockAdminServiceServer)(nil).GetNexusEndpointPolicy), arg0, arg1)
+}
+
 // GetReplicationMessages mocks base method.
 func (m *MockAdminServiceServer) GetReplicationMessages(arg0 context.Context, arg1 *adminservice.GetReplicationMessagesRequest) (*adminservice.GetReplicationMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1566,6 +1976,21 @@ func (mr *MockAdminServiceServerMockRecorder) MergeDLQTasks(arg0, arg1 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).MergeDLQTasks), arg0, arg1)
 }
 
+// PauseWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) PauseWorkflowExecution(arg0 context.Context, arg1 *adminservice.PauseWorkflowExecutionRequest) (*adminservice.PauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.PauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) PauseWorkflowExecution(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).PauseWorkflowExecution), arg0, arg1)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockAdminServiceServer) PurgeDLQMessages(arg0 context.Context, arg1 *adminservice.PurgeDLQMessagesRequest) (*adminservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1626,6 +2051,21 @@ func (mr *MockAdminServiceServerMockRecorder) RebuildMutableState(arg0, arg1 any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildMutableState", reflect.TypeOf((*MockAdminServiceServer)(nil).RebuildMutableState), arg0, arg1)
 }
 
+// ReenqueueMatchingDLQTasks mocks base method.
+func (m *MockAdminServiceServer) ReenqueueMatchingDLQTasks(arg0 context.Context, arg1 *adminservice.ReenqueueMatchingDLQTasksRequest) (*adminservice.ReenqueueMatchingDLQTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReenqueueMatchingDLQTasks", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ReenqueueMatchingDLQTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReenqueueMatchingDLQTasks indicates an expected call of ReenqueueMatchingDLQTasks.
+func (mr *MockAdminServiceServerMockRecorder) ReenqueueMatchingDLQTasks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReenqueueMatchingDLQTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ReenqueueMatchingDLQTasks), arg0, arg1)
+}
+
 // RefreshWorkflowTasks mocks base method.
 func (m *MockAdminServiceServer) RefreshWorkflowTasks(arg0 context.Context, arg1 *adminservice.RefreshWorkflowTasksRequest) (*adminservice.RefreshWorkflowTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -1701,6 +2141,81 @@ func (mr *MockAdminServiceServerMockRecorder) ResendReplicationTasks(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ResendReplicationTasks), arg0, arg1)
 }
 
+// ResetWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) ResetWorkflowExecution(arg0 context.Context, arg1 *adminservice.ResetWorkflowExecutionRequest) (*adminservice.ResetWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ResetWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetWorkflowExecution indicates an expected call of ResetWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) ResetWorkflowExecution(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).ResetWorkflowExecution), arg0, arg1)
+}
+
+// SetScheduleConcurrencyGroup mocks base method.
+func (m *MockAdminServiceServer) SetScheduleConcurrencyGroup(arg0 context.Context, arg1 *adminservice.SetScheduleConcurrencyGroupRequest) (*adminservice.SetScheduleConcurrencyGroupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetScheduleConcurrencyGroup", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.SetScheduleConcurrencyGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetScheduleConcurrencyGroup indicates an expected call of SetScheduleConcurrencyGroup.
+func (mr *MockAdminServiceServerMockRecorder) SetScheduleConcurrencyGroup(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetScheduleConcurrencyGroup", reflect.TypeOf((*MockAdminServiceServer)(nil).SetScheduleConcurrencyGroup), arg0, arg1)
+}
+
+// SetScheduleDependencies mocks base method.
+func (m *MockAdminServiceServer) SetScheduleDependencies(arg0 context.Context, arg1 *adminservice.SetScheduleDependenciesRequest) (*adminservice.SetScheduleDependenciesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetScheduleDependencies", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.SetScheduleDependenciesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetScheduleDependencies indicates an expected call of SetScheduleDependencies.
+func (mr *MockAdminServiceServerMockRecorder) SetScheduleDependencies(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetScheduleDependencies", reflect.TypeOf((*MockAdminServiceServer)(nil).SetScheduleDependencies), arg0, arg1)
+}
+
+// SetScheduleSharedCalendars mocks base method.
+func (m *MockAdminServiceServer) SetScheduleSharedCalendars(arg0 context.Context, arg1 *adminservice.SetScheduleSharedCalendarsRequest) (*adminservice.SetScheduleSharedCalendarsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetScheduleSharedCalendars", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.SetScheduleSharedCalendarsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetScheduleSharedCalendars indicates an expected call of SetScheduleSharedCalendars.
+func (mr *MockAdminServiceServerMockRecorder) SetScheduleSharedCalendars(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetScheduleSharedCalendars", reflect.TypeOf((*MockAdminServiceServer)(nil).SetScheduleSharedCalendars), arg0, arg1)
+}
+
+// SetScheduleSla mocks base method.
+func (m *MockAdminServiceServer) SetScheduleSla(arg0 context.Context, arg1 *adminservice.SetScheduleSlaRequest) (*adminservice.SetScheduleSlaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetScheduleSla", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.SetScheduleSlaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetScheduleSla indicates an expected call of SetScheduleSla.
+func (mr *MockAdminServiceServerMockRecorder) SetScheduleSla(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetScheduleSla", reflect.TypeOf((*MockAdminServiceServer)(nil).SetScheduleSla), arg0, arg1)
+}
+
 // StreamWorkflowReplicationMessages mocks base method.
 func (m *MockAdminServiceServer) StreamWorkflowReplicationMessages(arg0 adminservice.AdminService_StreamWorkflowReplicationMessagesServer) error {
 	m.ctrl.T.Helper()
@@ -1730,6 +2245,51 @@ func (mr *MockAdminServiceServerMockRecorder) SyncWorkflowState(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncWorkflowState", reflect.TypeOf((*MockAdminServiceServer)(nil).SyncWorkflowState), arg0, arg1)
 }
 
+// UnpauseWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) UnpauseWorkflowExecution(arg0 context.Context, arg1 *adminservice.UnpauseWorkflowExecutionRequest) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UnpauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) UnpauseWorkflowExecution(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).UnpauseWorkflowExecution), arg0, arg1)
+}
+
+// UpdateNexusEndpointPolicy mocks base method.
+func (m *MockAdminServiceServer) UpdateNexusEndpointPolicy(arg0 context.Context, arg1 *adminservice.UpdateNexusEndpointPolicyRequest) (*adminservice.UpdateNexusEndpointPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNexusEndpointPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateNexusEndpointPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNexusEndpointPolicy indicates an expected call of UpdateNexusEndpointPolicy.
+func (mr *MockAdminServiceServerMockRecorder) UpdateNexusEndpointPolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNexusEndpointPolicy", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateNexusEndpointPolicy), arg0, arg1)
+}
+
+// UpdateWorkflowCallback mocks base method.
+func (m *MockAdminServiceServer) UpdateWorkflowCallback(arg0 context.Context, arg1 *adminservice.UpdateWorkflowCallbackRequest) (*adminservice.UpdateWorkflowCallbackResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkflowCallback", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateWorkflowCallbackResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkflowCallback indicates an expected call of UpdateWorkflowCallback.
+func (mr *MockAdminServiceServerMockRecorder) UpdateWorkflowCallback(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowCallback", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateWorkflowCallback), arg0, arg1)
+}
+
 // mustEmbedUnimplementedAdminServiceServer mocks base method.
 func (m *MockAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {
 	m.ctrl.T.Helper()
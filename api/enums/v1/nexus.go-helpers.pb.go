@@ -52,3 +52,41 @@ func NexusOperationStateFromString(s string) (NexusOperationState, error) {
 	}
 	return NexusOperationState(0), fmt.Errorf("%s is not a valid NexusOperationState", s)
 }
+
+var (
+	NexusCallerCloseBehavior_shorthandValue = map[string]int32{
+		"Unspecified":   0,
+		"Abandon":       1,
+		"RequestCancel": 2,
+	}
+)
+
+// NexusCallerCloseBehaviorFromString parses a NexusCallerCloseBehavior value from  either the protojson
+// canonical SCREAMING_CASE enum or the traditional temporal PascalCase enum to NexusCallerCloseBehavior
+func NexusCallerCloseBehaviorFromString(s string) (NexusCallerCloseBehavior, error) {
+	if v, ok := NexusCallerCloseBehavior_value[s]; ok {
+		return NexusCallerCloseBehavior(v), nil
+	} else if v, ok := NexusCallerCloseBehavior_shorthandValue[s]; ok {
+		return NexusCallerCloseBehavior(v), nil
+	}
+	return NexusCallerCloseBehavior(0), fmt.Errorf("%s is not a valid NexusCallerCloseBehavior", s)
+}
+
+var (
+	NexusResultCacheKey_shorthandValue = map[string]int32{
+		"Unspecified": 0,
+		"InputHash":   1,
+		"RequestId":   2,
+	}
+)
+
+// NexusResultCacheKeyFromString parses a NexusResultCacheKey value from  either the protojson
+// canonical SCREAMING_CASE enum or the traditional temporal PascalCase enum to NexusResultCacheKey
+func NexusResultCacheKeyFromString(s string) (NexusResultCacheKey, error) {
+	if v, ok := NexusResultCacheKey_value[s]; ok {
+		return NexusResultCacheKey(v), nil
+	} else if v, ok := NexusResultCacheKey_shorthandValue[s]; ok {
+		return NexusResultCacheKey(v), nil
+	}
+	return NexusResultCacheKey(0), fmt.Errorf("%s is not a valid NexusResultCacheKey", s)
+}
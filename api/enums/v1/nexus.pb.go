@@ -139,6 +139,133 @@ func (NexusOperationState) EnumDescriptor() ([]byte, []int) {
 	return file_temporal_server_api_enums_v1_nexus_proto_rawDescGZIP(), []int{0}
 }
 
+// Behavior for started Nexus operations when the caller workflow closes.
+type NexusCallerCloseBehavior int32
+
+const (
+	// Default value, same as abandon.
+	NEXUS_CALLER_CLOSE_BEHAVIOR_UNSPECIFIED NexusCallerCloseBehavior = 0
+	// Leave the operation running. Its completion is ignored.
+	NEXUS_CALLER_CLOSE_BEHAVIOR_ABANDON NexusCallerCloseBehavior = 1
+	// Request cancelation of the operation.
+	NEXUS_CALLER_CLOSE_BEHAVIOR_REQUEST_CANCEL NexusCallerCloseBehavior = 2
+)
+
+// Enum value maps for NexusCallerCloseBehavior.
+var (
+	NexusCallerCloseBehavior_name = map[int32]string{
+		0: "NEXUS_CALLER_CLOSE_BEHAVIOR_UNSPECIFIED",
+		1: "NEXUS_CALLER_CLOSE_BEHAVIOR_ABANDON",
+		2: "NEXUS_CALLER_CLOSE_BEHAVIOR_REQUEST_CANCEL",
+	}
+	NexusCallerCloseBehavior_value = map[string]int32{
+		"NEXUS_CALLER_CLOSE_BEHAVIOR_UNSPECIFIED":    0,
+		"NEXUS_CALLER_CLOSE_BEHAVIOR_ABANDON":        1,
+		"NEXUS_CALLER_CLOSE_BEHAVIOR_REQUEST_CANCEL": 2,
+	}
+)
+
+func (x NexusCallerCloseBehavior) Enum() *NexusCallerCloseBehavior {
+	p := new(NexusCallerCloseBehavior)
+	*p = x
+	return p
+}
+
+func (x NexusCallerCloseBehavior) String() string {
+	switch x {
+	case NEXUS_CALLER_CLOSE_BEHAVIOR_UNSPECIFIED:
+		return "Unspecified"
+	case NEXUS_CALLER_CLOSE_BEHAVIOR_ABANDON:
+		return "Abandon"
+	case NEXUS_CALLER_CLOSE_BEHAVIOR_REQUEST_CANCEL:
+		return "RequestCancel"
+	default:
+		return strconv.Itoa(int(x))
+	}
+
+}
+
+func (NexusCallerCloseBehavior) Descriptor() protoreflect.EnumDescriptor {
+	return file_temporal_server_api_enums_v1_nexus_proto_enumTypes[1].Descriptor()
+}
+
+func (NexusCallerCloseBehavior) Type() protoreflect.EnumType {
+	return &file_temporal_server_api_enums_v1_nexus_proto_enumTypes[1]
+}
+
+func (x NexusCallerCloseBehavior) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NexusCallerCloseBehavior.Descriptor instead.
+func (NexusCallerCloseBehavior) EnumDescriptor() ([]byte, []int) {
+	return file_temporal_server_api_enums_v1_nexus_proto_rawDescGZIP(), []int{1}
+}
+
+// What identifies a StartOperation request in the frontend Nexus result cache. Results are only shared between
+// requests from the same caller (identity and callback URL) with the same forwarded headers.
+type NexusResultCacheKey int32
+
+const (
+	// Default value, same as input hash.
+	NEXUS_RESULT_CACHE_KEY_UNSPECIFIED NexusResultCacheKey = 0
+	// Requests for the same service and operation with identical inputs share a result.
+	NEXUS_RESULT_CACHE_KEY_INPUT_HASH NexusResultCacheKey = 1
+	// Only requests with the same request ID share a result, which makes caller retries resolve from the cache.
+	NEXUS_RESULT_CACHE_KEY_REQUEST_ID NexusResultCacheKey = 2
+)
+
+// Enum value maps for NexusResultCacheKey.
+var (
+	NexusResultCacheKey_name = map[int32]string{
+		0: "NEXUS_RESULT_CACHE_KEY_UNSPECIFIED",
+		1: "NEXUS_RESULT_CACHE_KEY_INPUT_HASH",
+		2: "NEXUS_RESULT_CACHE_KEY_REQUEST_ID",
+	}
+	NexusResultCacheKey_value = map[string]int32{
+		"NEXUS_RESULT_CACHE_KEY_UNSPECIFIED": 0,
+		"NEXUS_RESULT_CACHE_KEY_INPUT_HASH":  1,
+		"NEXUS_RESULT_CACHE_KEY_REQUEST_ID":  2,
+	}
+)
+
+func (x NexusResultCacheKey) Enum() *NexusResultCacheKey {
+	p := new(NexusResultCacheKey)
+	*p = x
+	return p
+}
+
+func (x NexusResultCacheKey) String() string {
+	switch x {
+	case NEXUS_RESULT_CACHE_KEY_UNSPECIFIED:
+		return "Unspecified"
+	case NEXUS_RESULT_CACHE_KEY_INPUT_HASH:
+		return "InputHash"
+	case NEXUS_RESULT_CACHE_KEY_REQUEST_ID:
+		return "RequestId"
+	default:
+		return strconv.Itoa(int(x))
+	}
+
+}
+
+func (NexusResultCacheKey) Descriptor() protoreflect.EnumDescriptor {
+	return file_temporal_server_api_enums_v1_nexus_proto_enumTypes[2].Descriptor()
+}
+
+func (NexusResultCacheKey) Type() protoreflect.EnumType {
+	return &file_temporal_server_api_enums_v1_nexus_proto_enumTypes[2]
+}
+
+func (x NexusResultCacheKey) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NexusResultCacheKey.Descriptor instead.
+func (NexusResultCacheKey) EnumDescriptor() ([]byte, []int) {
+	return file_temporal_server_api_enums_v1_nexus_proto_rawDescGZIP(), []int{2}
+}
+
 var File_temporal_server_api_enums_v1_nexus_proto protoreflect.FileDescriptor
 
 var file_temporal_server_api_enums_v1_nexus_proto_rawDesc = []byte{
@@ -166,10 +293,29 @@ var file_temporal_server_api_enums_v1_nexus_proto_rawDesc = []byte{
 	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10,
 	0x06, 0x12, 0x23, 0x0a, 0x1f, 0x4e, 0x45, 0x58, 0x55, 0x53, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41,
 	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x44,
-	0x5f, 0x4f, 0x55, 0x54, 0x10, 0x07, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x6f, 0x2e, 0x74, 0x65, 0x6d,
-	0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x69, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6e, 0x75,
-	0x6d, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x5f, 0x4f, 0x55, 0x54, 0x10, 0x07, 0x2a, 0xa0, 0x01, 0x0a, 0x18, 0x4e, 0x65, 0x78, 0x75, 0x73,
+	0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x42, 0x65, 0x68, 0x61, 0x76,
+	0x69, 0x6f, 0x72, 0x12, 0x2b, 0x0a, 0x27, 0x4e, 0x45, 0x58, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4c,
+	0x4c, 0x45, 0x52, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x5f, 0x42, 0x45, 0x48, 0x41, 0x56, 0x49,
+	0x4f, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x27, 0x0a, 0x23, 0x4e, 0x45, 0x58, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4c, 0x4c, 0x45, 0x52,
+	0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x5f, 0x42, 0x45, 0x48, 0x41, 0x56, 0x49, 0x4f, 0x52, 0x5f,
+	0x41, 0x42, 0x41, 0x4e, 0x44, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x2e, 0x0a, 0x2a, 0x4e, 0x45, 0x58,
+	0x55, 0x53, 0x5f, 0x43, 0x41, 0x4c, 0x4c, 0x45, 0x52, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x5f,
+	0x42, 0x45, 0x48, 0x41, 0x56, 0x49, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54,
+	0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x10, 0x02, 0x2a, 0x8b, 0x01, 0x0a, 0x13, 0x4e, 0x65,
+	0x78, 0x75, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4b, 0x65,
+	0x79, 0x12, 0x26, 0x0a, 0x22, 0x4e, 0x45, 0x58, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c,
+	0x54, 0x5f, 0x43, 0x41, 0x43, 0x48, 0x45, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x25, 0x0a, 0x21, 0x4e, 0x45, 0x58,
+	0x55, 0x53, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x43, 0x41, 0x43, 0x48, 0x45, 0x5f,
+	0x4b, 0x45, 0x59, 0x5f, 0x49, 0x4e, 0x50, 0x55, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x10, 0x01,
+	0x12, 0x25, 0x0a, 0x21, 0x4e, 0x45, 0x58, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54,
+	0x5f, 0x43, 0x41, 0x43, 0x48, 0x45, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45,
+	0x53, 0x54, 0x5f, 0x49, 0x44, 0x10, 0x02, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x6f, 0x2e, 0x74, 0x65,
+	0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x69, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6e,
+	0x75, 0x6d, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -184,9 +330,11 @@ func file_temporal_server_api_enums_v1_nexus_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_enums_v1_nexus_proto_rawDescData
 }
 
-var file_temporal_server_api_enums_v1_nexus_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_temporal_server_api_enums_v1_nexus_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
 var file_temporal_server_api_enums_v1_nexus_proto_goTypes = []interface{}{
-	(NexusOperationState)(0), // 0: temporal.server.api.enums.v1.NexusOperationState
+	(NexusOperationState)(0),      // 0: temporal.server.api.enums.v1.NexusOperationState
+	(NexusCallerCloseBehavior)(0), // 1: temporal.server.api.enums.v1.NexusCallerCloseBehavior
+	(NexusResultCacheKey)(0),      // 2: temporal.server.api.enums.v1.NexusResultCacheKey
 }
 var file_temporal_server_api_enums_v1_nexus_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -206,7 +354,7 @@ func file_temporal_server_api_enums_v1_nexus_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_temporal_server_api_enums_v1_nexus_proto_rawDesc,
-			NumEnums:      1,
+			NumEnums:      3,
 			NumMessages:   0,
 			NumExtensions: 0,
 			NumServices:   0,
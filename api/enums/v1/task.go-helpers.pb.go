@@ -79,6 +79,7 @@ var (
 		"WorkflowExecutionTimeout":           29,
 		"ReplicationSyncHsm":                 30,
 		"ReplicationSyncVersionedTransition": 31,
+		"TransferChildExecutionProgress":     32,
 	}
 )
 
@@ -111,3 +112,23 @@ func TaskPriorityFromString(s string) (TaskPriority, error) {
 	}
 	return TaskPriority(0), fmt.Errorf("%s is not a valid TaskPriority", s)
 }
+
+var (
+	DeadLetterReason_shorthandValue = map[string]int32{
+		"Unspecified":       0,
+		"NonRetryableError": 1,
+		"Undispatchable":    2,
+		"Expired":           3,
+	}
+)
+
+// DeadLetterReasonFromString parses a DeadLetterReason value from  either the protojson
+// canonical SCREAMING_CASE enum or the traditional temporal PascalCase enum to DeadLetterReason
+func DeadLetterReasonFromString(s string) (DeadLetterReason, error) {
+	if v, ok := DeadLetterReason_value[s]; ok {
+		return DeadLetterReason(v), nil
+	} else if v, ok := DeadLetterReason_shorthandValue[s]; ok {
+		return DeadLetterReason(v), nil
+	}
+	return DeadLetterReason(0), fmt.Errorf("%s is not a valid DeadLetterReason", s)
+}
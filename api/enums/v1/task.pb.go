@@ -143,6 +143,8 @@ const (
 	TASK_TYPE_WORKFLOW_EXECUTION_TIMEOUT            TaskType = 29
 	TASK_TYPE_REPLICATION_SYNC_HSM                  TaskType = 30
 	TASK_TYPE_REPLICATION_SYNC_VERSIONED_TRANSITION TaskType = 31
+	// Reports the progress published by a child workflow to its parent.
+	TASK_TYPE_TRANSFER_CHILD_EXECUTION_PROGRESS TaskType = 32
 )
 
 // Enum value maps for TaskType.
@@ -177,6 +179,7 @@ var (
 		29: "TASK_TYPE_WORKFLOW_EXECUTION_TIMEOUT",
 		30: "TASK_TYPE_REPLICATION_SYNC_HSM",
 		31: "TASK_TYPE_REPLICATION_SYNC_VERSIONED_TRANSITION",
+		32: "TASK_TYPE_TRANSFER_CHILD_EXECUTION_PROGRESS",
 	}
 	TaskType_value = map[string]int32{
 		"TASK_TYPE_UNSPECIFIED":                           0,
@@ -208,6 +211,7 @@ var (
 		"TASK_TYPE_WORKFLOW_EXECUTION_TIMEOUT":            29,
 		"TASK_TYPE_REPLICATION_SYNC_HSM":                  30,
 		"TASK_TYPE_REPLICATION_SYNC_VERSIONED_TRANSITION": 31,
+		"TASK_TYPE_TRANSFER_CHILD_EXECUTION_PROGRESS":     32,
 	}
 )
 
@@ -287,6 +291,10 @@ func (x TaskType) String() string {
 		// Deprecated: Use TaskPriority.Descriptor instead.
 	case TASK_TYPE_REPLICATION_SYNC_VERSIONED_TRANSITION:
 		return "ReplicationSyncVersionedTransition"
+	case TASK_TYPE_TRANSFER_CHILD_EXECUTION_PROGRESS:
+		return "TransferChildExecutionProgress"
+
+		// DeadLetterReason is why matching dropped a task into the dead-letter queue of its namespace.
 	default:
 		return strconv.Itoa(int(x))
 	}
@@ -367,6 +375,73 @@ func (TaskPriority) EnumDescriptor() ([]byte, []int) {
 	return file_temporal_server_api_enums_v1_task_proto_rawDescGZIP(), []int{2}
 }
 
+type DeadLetterReason int32
+
+const (
+	DEAD_LETTER_REASON_UNSPECIFIED DeadLetterReason = 0
+	// Recording the task as started in history failed with a non-retryable error.
+	DEAD_LETTER_REASON_NON_RETRYABLE_ERROR DeadLetterReason = 1
+	// A backlog task could not be passed on to a matcher, e.g. because its versioning directive is invalid.
+	DEAD_LETTER_REASON_UNDISPATCHABLE DeadLetterReason = 2
+	// The task expired in the backlog before it was delivered to a worker.
+	DEAD_LETTER_REASON_EXPIRED DeadLetterReason = 3
+)
+
+// Enum value maps for DeadLetterReason.
+var (
+	DeadLetterReason_name = map[int32]string{
+		0: "DEAD_LETTER_REASON_UNSPECIFIED",
+		1: "DEAD_LETTER_REASON_NON_RETRYABLE_ERROR",
+		2: "DEAD_LETTER_REASON_UNDISPATCHABLE",
+		3: "DEAD_LETTER_REASON_EXPIRED",
+	}
+	DeadLetterReason_value = map[string]int32{
+		"DEAD_LETTER_REASON_UNSPECIFIED":         0,
+		"DEAD_LETTER_REASON_NON_RETRYABLE_ERROR": 1,
+		"DEAD_LETTER_REASON_UNDISPATCHABLE":      2,
+		"DEAD_LETTER_REASON_EXPIRED":             3,
+	}
+)
+
+func (x DeadLetterReason) Enum() *DeadLetterReason {
+	p := new(DeadLetterReason)
+	*p = x
+	return p
+}
+
+func (x DeadLetterReason) String() string {
+	switch x {
+	case DEAD_LETTER_REASON_UNSPECIFIED:
+		return "Unspecified"
+	case DEAD_LETTER_REASON_NON_RETRYABLE_ERROR:
+		return "NonRetryableError"
+	case DEAD_LETTER_REASON_UNDISPATCHABLE:
+		return "Undispatchable"
+	case DEAD_LETTER_REASON_EXPIRED:
+		return "Expired"
+	default:
+		return strconv.Itoa(int(x))
+	}
+
+}
+
+func (DeadLetterReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_temporal_server_api_enums_v1_task_proto_enumTypes[3].Descriptor()
+}
+
+func (DeadLetterReason) Type() protoreflect.EnumType {
+	return &file_temporal_server_api_enums_v1_task_proto_enumTypes[3]
+}
+
+func (x DeadLetterReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeadLetterReason.Descriptor instead.
+func (DeadLetterReason) EnumDescriptor() ([]byte, []int) {
+	return file_temporal_server_api_enums_v1_task_proto_rawDescGZIP(), []int{3}
+}
+
 var File_temporal_server_api_enums_v1_task_proto protoreflect.FileDescriptor
 
 var file_temporal_server_api_enums_v1_task_proto_rawDesc = []byte{
@@ -380,7 +455,7 @@ var file_temporal_server_api_enums_v1_task_proto_rawDesc = []byte{
 	0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43,
 	0x45, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x54,
 	0x41, 0x53, 0x4b, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x44, 0x42, 0x5f, 0x42, 0x41,
-	0x43, 0x4b, 0x4c, 0x4f, 0x47, 0x10, 0x02, 0x2a, 0x87, 0x09, 0x0a, 0x08, 0x54, 0x61, 0x73, 0x6b,
+	0x43, 0x4b, 0x4c, 0x4f, 0x47, 0x10, 0x02, 0x2a, 0xb8, 0x09, 0x0a, 0x08, 0x54, 0x61, 0x73, 0x6b,
 	0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50,
 	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
 	0x21, 0x0a, 0x1d, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x50,
@@ -451,18 +526,31 @@ var file_temporal_server_api_enums_v1_task_proto_rawDesc = []byte{
 	0x53, 0x59, 0x4e, 0x43, 0x5f, 0x48, 0x53, 0x4d, 0x10, 0x1e, 0x12, 0x33, 0x0a, 0x2f, 0x54, 0x41,
 	0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54,
 	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e,
-	0x45, 0x44, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x1f, 0x22,
-	0x04, 0x08, 0x09, 0x10, 0x09, 0x22, 0x04, 0x08, 0x0b, 0x10, 0x0b, 0x22, 0x04, 0x08, 0x17, 0x10,
-	0x17, 0x2a, 0x5c, 0x0a, 0x0c, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
-	0x79, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49,
-	0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
-	0x12, 0x16, 0x0a, 0x12, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54,
-	0x59, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x41, 0x53, 0x4b,
-	0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x4c, 0x4f, 0x57, 0x10, 0x0a, 0x42,
-	0x2a, 0x5a, 0x28, 0x67, 0x6f, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x69,
-	0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e, 0x75,
-	0x6d, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x45, 0x44, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x1f, 0x12,
+	0x2f, 0x0a, 0x2b, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x52, 0x41,
+	0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f, 0x43, 0x48, 0x49, 0x4c, 0x44, 0x5f, 0x45, 0x58, 0x45, 0x43,
+	0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x20,
+	0x22, 0x04, 0x08, 0x09, 0x10, 0x09, 0x22, 0x04, 0x08, 0x0b, 0x10, 0x0b, 0x22, 0x04, 0x08, 0x17,
+	0x10, 0x17, 0x2a, 0x5c, 0x0a, 0x0c, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52,
+	0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49,
+	0x54, 0x59, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x41, 0x53,
+	0x4b, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x4c, 0x4f, 0x57, 0x10, 0x0a,
+	0x2a, 0xa9, 0x01, 0x0a, 0x10, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x1e, 0x44, 0x45, 0x41, 0x44, 0x5f, 0x4c, 0x45,
+	0x54, 0x54, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x2a, 0x0a, 0x26, 0x44, 0x45, 0x41,
+	0x44, 0x5f, 0x4c, 0x45, 0x54, 0x54, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x4e, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x54, 0x52, 0x59, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x25, 0x0a, 0x21, 0x44, 0x45, 0x41, 0x44, 0x5f, 0x4c, 0x45,
+	0x54, 0x54, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x44, 0x49,
+	0x53, 0x50, 0x41, 0x54, 0x43, 0x48, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a,
+	0x44, 0x45, 0x41, 0x44, 0x5f, 0x4c, 0x45, 0x54, 0x54, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x42, 0x2a, 0x5a, 0x28,
+	0x67, 0x6f, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x69, 0x6f, 0x2f, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2f,
+	0x76, 0x31, 0x3b, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -477,11 +565,12 @@ func file_temporal_server_api_enums_v1_task_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_enums_v1_task_proto_rawDescData
 }
 
-var file_temporal_server_api_enums_v1_task_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_temporal_server_api_enums_v1_task_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
 var file_temporal_server_api_enums_v1_task_proto_goTypes = []interface{}{
-	(TaskSource)(0),   // 0: temporal.server.api.enums.v1.TaskSource
-	(TaskType)(0),     // 1: temporal.server.api.enums.v1.TaskType
-	(TaskPriority)(0), // 2: temporal.server.api.enums.v1.TaskPriority
+	(TaskSource)(0),       // 0: temporal.server.api.enums.v1.TaskSource
+	(TaskType)(0),         // 1: temporal.server.api.enums.v1.TaskType
+	(TaskPriority)(0),     // 2: temporal.server.api.enums.v1.TaskPriority
+	(DeadLetterReason)(0), // 3: temporal.server.api.enums.v1.DeadLetterReason
 }
 var file_temporal_server_api_enums_v1_task_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -501,7 +590,7 @@ func file_temporal_server_api_enums_v1_task_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_temporal_server_api_enums_v1_task_proto_rawDesc,
-			NumEnums:      3,
+			NumEnums:      4,
 			NumMessages:   0,
 			NumExtensions: 0,
 			NumServices:   0,
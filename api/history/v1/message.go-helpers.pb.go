@@ -324,3 +324,77 @@ func (this *StrippedHistoryEvents) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type CachedMutableStateInfo to the protobuf v3 wire format
+func (val *CachedMutableStateInfo) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CachedMutableStateInfo from the protobuf v3 wire format
+func (val *CachedMutableStateInfo) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CachedMutableStateInfo) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CachedMutableStateInfo values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CachedMutableStateInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CachedMutableStateInfo
+	switch t := that.(type) {
+	case *CachedMutableStateInfo:
+		that1 = t
+	case CachedMutableStateInfo:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ReapplyEventSelection to the protobuf v3 wire format
+func (val *ReapplyEventSelection) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ReapplyEventSelection from the protobuf v3 wire format
+func (val *ReapplyEventSelection) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ReapplyEventSelection) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ReapplyEventSelection values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ReapplyEventSelection) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ReapplyEventSelection
+	switch t := that.(type) {
+	case *ReapplyEventSelection:
+		that1 = t
+	case ReapplyEventSelection:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
@@ -1953,6 +1953,80 @@ func (this *RecordChildExecutionCompletedResponse) Equal(that interface{}) bool
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type RecordChildExecutionProgressRequest to the protobuf v3 wire format
+func (val *RecordChildExecutionProgressRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RecordChildExecutionProgressRequest from the protobuf v3 wire format
+func (val *RecordChildExecutionProgressRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RecordChildExecutionProgressRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RecordChildExecutionProgressRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RecordChildExecutionProgressRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RecordChildExecutionProgressRequest
+	switch t := that.(type) {
+	case *RecordChildExecutionProgressRequest:
+		that1 = t
+	case RecordChildExecutionProgressRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RecordChildExecutionProgressResponse to the protobuf v3 wire format
+func (val *RecordChildExecutionProgressResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RecordChildExecutionProgressResponse from the protobuf v3 wire format
+func (val *RecordChildExecutionProgressResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RecordChildExecutionProgressResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RecordChildExecutionProgressResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RecordChildExecutionProgressResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RecordChildExecutionProgressResponse
+	switch t := that.(type) {
+	case *RecordChildExecutionProgressResponse:
+		that1 = t
+	case RecordChildExecutionProgressResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type VerifyChildExecutionCompletionRecordedRequest to the protobuf v3 wire format
 func (val *VerifyChildExecutionCompletionRecordedRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -2101,6 +2175,80 @@ func (this *DescribeWorkflowExecutionResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type CallbackRecentAttempts to the protobuf v3 wire format
+func (val *CallbackRecentAttempts) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CallbackRecentAttempts from the protobuf v3 wire format
+func (val *CallbackRecentAttempts) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CallbackRecentAttempts) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CallbackRecentAttempts values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CallbackRecentAttempts) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CallbackRecentAttempts
+	switch t := that.(type) {
+	case *CallbackRecentAttempts:
+		that1 = t
+	case CallbackRecentAttempts:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ActivityRetryBudgetInfo to the protobuf v3 wire format
+func (val *ActivityRetryBudgetInfo) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ActivityRetryBudgetInfo from the protobuf v3 wire format
+func (val *ActivityRetryBudgetInfo) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ActivityRetryBudgetInfo) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ActivityRetryBudgetInfo values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ActivityRetryBudgetInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ActivityRetryBudgetInfo
+	switch t := that.(type) {
+	case *ActivityRetryBudgetInfo:
+		that1 = t
+	case ActivityRetryBudgetInfo:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type ReplicateEventsV2Request to the protobuf v3 wire format
 func (val *ReplicateEventsV2Request) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -2767,6 +2915,80 @@ func (this *GetShardResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type DescribeMutableStateCacheRequest to the protobuf v3 wire format
+func (val *DescribeMutableStateCacheRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeMutableStateCacheRequest from the protobuf v3 wire format
+func (val *DescribeMutableStateCacheRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeMutableStateCacheRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeMutableStateCacheRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeMutableStateCacheRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeMutableStateCacheRequest
+	switch t := that.(type) {
+	case *DescribeMutableStateCacheRequest:
+		that1 = t
+	case DescribeMutableStateCacheRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeMutableStateCacheResponse to the protobuf v3 wire format
+func (val *DescribeMutableStateCacheResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeMutableStateCacheResponse from the protobuf v3 wire format
+func (val *DescribeMutableStateCacheResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeMutableStateCacheResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeMutableStateCacheResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeMutableStateCacheResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeMutableStateCacheResponse
+	switch t := that.(type) {
+	case *DescribeMutableStateCacheResponse:
+		that1 = t
+	case DescribeMutableStateCacheResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type RemoveTaskRequest to the protobuf v3 wire format
 func (val *RemoveTaskRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -5431,6 +5653,302 @@ func (this *UnpauseActivityResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type PauseWorkflowExecutionRequest to the protobuf v3 wire format
+func (val *PauseWorkflowExecutionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PauseWorkflowExecutionRequest from the protobuf v3 wire format
+func (val *PauseWorkflowExecutionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PauseWorkflowExecutionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PauseWorkflowExecutionRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PauseWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PauseWorkflowExecutionRequest
+	switch t := that.(type) {
+	case *PauseWorkflowExecutionRequest:
+		that1 = t
+	case PauseWorkflowExecutionRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PauseWorkflowExecutionResponse to the protobuf v3 wire format
+func (val *PauseWorkflowExecutionResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PauseWorkflowExecutionResponse from the protobuf v3 wire format
+func (val *PauseWorkflowExecutionResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PauseWorkflowExecutionResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PauseWorkflowExecutionResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PauseWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PauseWorkflowExecutionResponse
+	switch t := that.(type) {
+	case *PauseWorkflowExecutionResponse:
+		that1 = t
+	case PauseWorkflowExecutionResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UnpauseWorkflowExecutionRequest to the protobuf v3 wire format
+func (val *UnpauseWorkflowExecutionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UnpauseWorkflowExecutionRequest from the protobuf v3 wire format
+func (val *UnpauseWorkflowExecutionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UnpauseWorkflowExecutionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UnpauseWorkflowExecutionRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UnpauseWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UnpauseWorkflowExecutionRequest
+	switch t := that.(type) {
+	case *UnpauseWorkflowExecutionRequest:
+		that1 = t
+	case UnpauseWorkflowExecutionRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UnpauseWorkflowExecutionResponse to the protobuf v3 wire format
+func (val *UnpauseWorkflowExecutionResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UnpauseWorkflowExecutionResponse from the protobuf v3 wire format
+func (val *UnpauseWorkflowExecutionResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UnpauseWorkflowExecutionResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UnpauseWorkflowExecutionResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UnpauseWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UnpauseWorkflowExecutionResponse
+	switch t := that.(type) {
+	case *UnpauseWorkflowExecutionResponse:
+		that1 = t
+	case UnpauseWorkflowExecutionResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeWorkflowCallbacksRequest to the protobuf v3 wire format
+func (val *DescribeWorkflowCallbacksRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeWorkflowCallbacksRequest from the protobuf v3 wire format
+func (val *DescribeWorkflowCallbacksRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeWorkflowCallbacksRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeWorkflowCallbacksRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeWorkflowCallbacksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeWorkflowCallbacksRequest
+	switch t := that.(type) {
+	case *DescribeWorkflowCallbacksRequest:
+		that1 = t
+	case DescribeWorkflowCallbacksRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeWorkflowCallbacksResponse to the protobuf v3 wire format
+func (val *DescribeWorkflowCallbacksResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeWorkflowCallbacksResponse from the protobuf v3 wire format
+func (val *DescribeWorkflowCallbacksResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeWorkflowCallbacksResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeWorkflowCallbacksResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeWorkflowCallbacksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeWorkflowCallbacksResponse
+	switch t := that.(type) {
+	case *DescribeWorkflowCallbacksResponse:
+		that1 = t
+	case DescribeWorkflowCallbacksResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateWorkflowCallbackRequest to the protobuf v3 wire format
+func (val *UpdateWorkflowCallbackRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateWorkflowCallbackRequest from the protobuf v3 wire format
+func (val *UpdateWorkflowCallbackRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateWorkflowCallbackRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateWorkflowCallbackRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateWorkflowCallbackRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateWorkflowCallbackRequest
+	switch t := that.(type) {
+	case *UpdateWorkflowCallbackRequest:
+		that1 = t
+	case UpdateWorkflowCallbackRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateWorkflowCallbackResponse to the protobuf v3 wire format
+func (val *UpdateWorkflowCallbackResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateWorkflowCallbackResponse from the protobuf v3 wire format
+func (val *UpdateWorkflowCallbackResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateWorkflowCallbackResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateWorkflowCallbackResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateWorkflowCallbackResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateWorkflowCallbackResponse
+	switch t := that.(type) {
+	case *UpdateWorkflowCallbackResponse:
+		that1 = t
+	case UpdateWorkflowCallbackResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type ResetActivityRequest to the protobuf v3 wire format
 func (val *ResetActivityRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -68,6 +68,7 @@ const (
 	HistoryService_ScheduleWorkflowTask_FullMethodName                   = "/temporal.server.api.historyservice.v1.HistoryService/ScheduleWorkflowTask"
 	HistoryService_VerifyFirstWorkflowTaskScheduled_FullMethodName       = "/temporal.server.api.historyservice.v1.HistoryService/VerifyFirstWorkflowTaskScheduled"
 	HistoryService_RecordChildExecutionCompleted_FullMethodName          = "/temporal.server.api.historyservice.v1.HistoryService/RecordChildExecutionCompleted"
+	HistoryService_RecordChildExecutionProgress_FullMethodName           = "/temporal.server.api.historyservice.v1.HistoryService/RecordChildExecutionProgress"
 	HistoryService_VerifyChildExecutionCompletionRecorded_FullMethodName = "/temporal.server.api.historyservice.v1.HistoryService/VerifyChildExecutionCompletionRecorded"
 	HistoryService_DescribeWorkflowExecution_FullMethodName              = "/temporal.server.api.historyservice.v1.HistoryService/DescribeWorkflowExecution"
 	HistoryService_ReplicateEventsV2_FullMethodName                      = "/temporal.server.api.historyservice.v1.HistoryService/ReplicateEventsV2"
@@ -78,6 +79,7 @@ const (
 	HistoryService_DescribeHistoryHost_FullMethodName                    = "/temporal.server.api.historyservice.v1.HistoryService/DescribeHistoryHost"
 	HistoryService_CloseShard_FullMethodName                             = "/temporal.server.api.historyservice.v1.HistoryService/CloseShard"
 	HistoryService_GetShard_FullMethodName                               = "/temporal.server.api.historyservice.v1.HistoryService/GetShard"
+	HistoryService_DescribeMutableStateCache_FullMethodName              = "/temporal.server.api.historyservice.v1.HistoryService/DescribeMutableStateCache"
 	HistoryService_RemoveTask_FullMethodName                             = "/temporal.server.api.historyservice.v1.HistoryService/RemoveTask"
 	HistoryService_GetReplicationMessages_FullMethodName                 = "/temporal.server.api.historyservice.v1.HistoryService/GetReplicationMessages"
 	HistoryService_GetDLQReplicationMessages_FullMethodName              = "/temporal.server.api.historyservice.v1.HistoryService/GetDLQReplicationMessages"
@@ -113,6 +115,10 @@ const (
 	HistoryService_PauseActivity_FullMethodName                          = "/temporal.server.api.historyservice.v1.HistoryService/PauseActivity"
 	HistoryService_UnpauseActivity_FullMethodName                        = "/temporal.server.api.historyservice.v1.HistoryService/UnpauseActivity"
 	HistoryService_ResetActivity_FullMethodName                          = "/temporal.server.api.historyservice.v1.HistoryService/ResetActivity"
+	HistoryService_PauseWorkflowExecution_FullMethodName                 = "/temporal.server.api.historyservice.v1.HistoryService/PauseWorkflowExecution"
+	HistoryService_UnpauseWorkflowExecution_FullMethodName               = "/temporal.server.api.historyservice.v1.HistoryService/UnpauseWorkflowExecution"
+	HistoryService_DescribeWorkflowCallbacks_FullMethodName              = "/temporal.server.api.historyservice.v1.HistoryService/DescribeWorkflowCallbacks"
+	HistoryService_UpdateWorkflowCallback_FullMethodName                 = "/temporal.server.api.historyservice.v1.HistoryService/UpdateWorkflowCallback"
 )
 
 // HistoryServiceClient is the client API for HistoryService service.
@@ -238,6 +244,9 @@ type HistoryServiceClient interface {
 	// RecordChildExecutionCompleted is used for reporting the completion of child workflow execution to parent.
 	// This is mainly called by transfer queue processor during the processing of DeleteExecution task.
 	RecordChildExecutionCompleted(ctx context.Context, in *RecordChildExecutionCompletedRequest, opts ...grpc.CallOption) (*RecordChildExecutionCompletedResponse, error)
+	// RecordChildExecutionProgress is used for reporting intermediate progress of a child workflow execution to its
+	// parent. The progress is delivered to the parent as a signal with a reserved name.
+	RecordChildExecutionProgress(ctx context.Context, in *RecordChildExecutionProgressRequest, opts ...grpc.CallOption) (*RecordChildExecutionProgressResponse, error)
 	// VerifyChildExecutionCompletionRecorded checks if child completion result is recorded in parent workflow.
 	// This is only used by standby transfer close execution logic to make sure parent workflow has the result
 	// recorded before completing the task, otherwise during namespace failover, it's possible that none of the
@@ -263,6 +272,8 @@ type HistoryServiceClient interface {
 	CloseShard(ctx context.Context, in *CloseShardRequest, opts ...grpc.CallOption) (*CloseShardResponse, error)
 	// GetShard gets the ShardInfo
 	GetShard(ctx context.Context, in *GetShardRequest, opts ...grpc.CallOption) (*GetShardResponse, error)
+	// DescribeMutableStateCache returns the largest entries of a shard in the mutable state cache.
+	DescribeMutableStateCache(ctx context.Context, in *DescribeMutableStateCacheRequest, opts ...grpc.CallOption) (*DescribeMutableStateCacheResponse, error)
 	// RemoveTask remove task based on type, taskid, shardid.
 	RemoveTask(ctx context.Context, in *RemoveTaskRequest, opts ...grpc.CallOption) (*RemoveTaskResponse, error)
 	// GetReplicationMessages return replication messages based on the read level
@@ -391,6 +402,30 @@ type HistoryServiceClient interface {
 	// (-- api-linter: core::0134::method-signature=disabled
 	// (-- api-linter: core::0134::response-message-name=disabled
 	ResetActivity(ctx context.Context, in *ResetActivityRequest, opts ...grpc.CallOption) (*ResetActivityResponse, error)
+	// PauseWorkflowExecution pauses a running workflow execution.
+	//
+	// While a workflow is paused:
+	// - workflow tasks, activity tasks and child workflow starts are not dispatched;
+	// - user timers don't fire;
+	// - signals and updates are accepted, and delivered once the workflow is unpaused.
+	// Tasks that were already dispatched to a worker can still be completed.
+	// If the workflow is already paused, calling this method will have no effect.
+	// (-- api-linter: core::0134::method-signature=disabled
+	// (-- api-linter: core::0134::response-message-name=disabled
+	PauseWorkflowExecution(ctx context.Context, in *PauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*PauseWorkflowExecutionResponse, error)
+	// UnpauseWorkflowExecution resumes a paused workflow execution and regenerates all of its tasks.
+	// If the workflow is not paused, calling this method will have no effect.
+	// (-- api-linter: core::0134::method-signature=disabled
+	// (-- api-linter: core::0134::response-message-name=disabled
+	UnpauseWorkflowExecution(ctx context.Context, in *UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*UnpauseWorkflowExecutionResponse, error)
+	// DescribeWorkflowCallbacks returns the completion callbacks of a workflow execution, including their recent
+	// delivery attempts.
+	DescribeWorkflowCallbacks(ctx context.Context, in *DescribeWorkflowCallbacksRequest, opts ...grpc.CallOption) (*DescribeWorkflowCallbacksResponse, error)
+	// UpdateWorkflowCallback retries, skips or retargets a single completion callback of a workflow execution.
+	// Returns a `NotFound` error if the workflow has no callback with the provided ID.
+	// (-- api-linter: core::0134::method-signature=disabled
+	// (-- api-linter: core::0134::response-message-name=disabled
+	UpdateWorkflowCallback(ctx context.Context, in *UpdateWorkflowCallbackRequest, opts ...grpc.CallOption) (*UpdateWorkflowCallbackResponse, error)
 }
 
 type historyServiceClient struct {
@@ -635,6 +670,15 @@ func (c *historyServiceClient) RecordChildExecutionCompleted(ctx context.Context
 	return out, nil
 }
 
+func (c *historyServiceClient) RecordChildExecutionProgress(ctx context.Context, in *RecordChildExecutionProgressRequest, opts ...grpc.CallOption) (*RecordChildExecutionProgressResponse, error) {
+	out := new(RecordChildExecutionProgressResponse)
+	err := c.cc.Invoke(ctx, HistoryService_RecordChildExecutionProgress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *historyServiceClient) VerifyChildExecutionCompletionRecorded(ctx context.Context, in *VerifyChildExecutionCompletionRecordedRequest, opts ...grpc.CallOption) (*VerifyChildExecutionCompletionRecordedResponse, error) {
 	out := new(VerifyChildExecutionCompletionRecordedResponse)
 	err := c.cc.Invoke(ctx, HistoryService_VerifyChildExecutionCompletionRecorded_FullMethodName, in, out, opts...)
@@ -725,6 +769,15 @@ func (c *historyServiceClient) GetShard(ctx context.Context, in *GetShardRequest
 	return out, nil
 }
 
+func (c *historyServiceClient) DescribeMutableStateCache(ctx context.Context, in *DescribeMutableStateCacheRequest, opts ...grpc.CallOption) (*DescribeMutableStateCacheResponse, error) {
+	out := new(DescribeMutableStateCacheResponse)
+	err := c.cc.Invoke(ctx, HistoryService_DescribeMutableStateCache_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *historyServiceClient) RemoveTask(ctx context.Context, in *RemoveTaskRequest, opts ...grpc.CallOption) (*RemoveTaskResponse, error) {
 	out := new(RemoveTaskResponse)
 	err := c.cc.Invoke(ctx, HistoryService_RemoveTask_FullMethodName, in, out, opts...)
@@ -1062,6 +1115,42 @@ func (c *historyServiceClient) ResetActivity(ctx context.Context, in *ResetActiv
 	return out, nil
 }
 
+func (c *historyServiceClient) PauseWorkflowExecution(ctx context.Context, in *PauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*PauseWorkflowExecutionResponse, error) {
+	out := new(PauseWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, HistoryService_PauseWorkflowExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) UnpauseWorkflowExecution(ctx context.Context, in *UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*UnpauseWorkflowExecutionResponse, error) {
+	out := new(UnpauseWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, HistoryService_UnpauseWorkflowExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) DescribeWorkflowCallbacks(ctx context.Context, in *DescribeWorkflowCallbacksRequest, opts ...grpc.CallOption) (*DescribeWorkflowCallbacksResponse, error) {
+	out := new(DescribeWorkflowCallbacksResponse)
+	err := c.cc.Invoke(ctx, HistoryService_DescribeWorkflowCallbacks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) UpdateWorkflowCallback(ctx context.Context, in *UpdateWorkflowCallbackRequest, opts ...grpc.CallOption) (*UpdateWorkflowCallbackResponse, error) {
+	out := new(UpdateWorkflowCallbackResponse)
+	err := c.cc.Invoke(ctx, HistoryService_UpdateWorkflowCallback_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HistoryServiceServer is the server API for HistoryService service.
 // All implementations must embed UnimplementedHistoryServiceServer
 // for forward compatibility
@@ -1185,6 +1274,9 @@ type HistoryServiceServer interface {
 	// RecordChildExecutionCompleted is used for reporting the completion of child workflow execution to parent.
 	// This is mainly called by transfer queue processor during the processing of DeleteExecution task.
 	RecordChildExecutionCompleted(context.Context, *RecordChildExecutionCompletedRequest) (*RecordChildExecutionCompletedResponse, error)
+	// RecordChildExecutionProgress is used for reporting intermediate progress of a child workflow execution to its
+	// parent. The progress is delivered to the parent as a signal with a reserved name.
+	RecordChildExecutionProgress(context.Context, *RecordChildExecutionProgressRequest) (*RecordChildExecutionProgressResponse, error)
 	// VerifyChildExecutionCompletionRecorded checks if child completion result is recorded in parent workflow.
 	// This is only used by standby transfer close execution logic to make sure parent workflow has the result
 	// recorded before completing the task, otherwise during namespace failover, it's possible that none of the
@@ -1210,6 +1302,8 @@ type HistoryServiceServer interface {
 	CloseShard(context.Context, *CloseShardRequest) (*CloseShardResponse, error)
 	// GetShard gets the ShardInfo
 	GetShard(context.Context, *GetShardRequest) (*GetShardResponse, error)
+	// DescribeMutableStateCache returns the largest entries of a shard in the mutable state cache.
+	DescribeMutableStateCache(context.Context, *DescribeMutableStateCacheRequest) (*DescribeMutableStateCacheResponse, error)
 	// RemoveTask remove task based on type, taskid, shardid.
 	RemoveTask(context.Context, *RemoveTaskRequest) (*RemoveTaskResponse, error)
 	// GetReplicationMessages return replication messages based on the read level
@@ -1338,6 +1432,30 @@ type HistoryServiceServer interface {
 	// (-- api-linter: core::0134::method-signature=disabled
 	// (-- api-linter: core::0134::response-message-name=disabled
 	ResetActivity(context.Context, *ResetActivityRequest) (*ResetActivityResponse, error)
+	// PauseWorkflowExecution pauses a running workflow execution.
+	//
+	// While a workflow is paused:
+	// - workflow tasks, activity tasks and child workflow starts are not dispatched;
+	// - user timers don't fire;
+	// - signals and updates are accepted, and delivered once the workflow is unpaused.
+	// Tasks that were already dispatched to a worker can still be completed.
+	// If the workflow is already paused, calling this method will have no effect.
+	// (-- api-linter: core::0134::method-signature=disabled
+	// (-- api-linter: core::0134::response-message-name=disabled
+	PauseWorkflowExecution(context.Context, *PauseWorkflowExecutionRequest) (*PauseWorkflowExecutionResponse, error)
+	// UnpauseWorkflowExecution resumes a paused workflow execution and regenerates all of its tasks.
+	// If the workflow is not paused, calling this method will have no effect.
+	// (-- api-linter: core::0134::method-signature=disabled
+	// (-- api-linter: core::0134::response-message-name=disabled
+	UnpauseWorkflowExecution(context.Context, *UnpauseWorkflowExecutionRequest) (*UnpauseWorkflowExecutionResponse, error)
+	// DescribeWorkflowCallbacks returns the completion callbacks of a workflow execution, including their recent
+	// delivery attempts.
+	DescribeWorkflowCallbacks(context.Context, *DescribeWorkflowCallbacksRequest) (*DescribeWorkflowCallbacksResponse, error)
+	// UpdateWorkflowCallback retries, skips or retargets a single completion callback of a workflow execution.
+	// Returns a `NotFound` error if the workflow has no callback with the provided ID.
+	// (-- api-linter: core::0134::method-signature=disabled
+	// (-- api-linter: core::0134::response-message-name=disabled
+	UpdateWorkflowCallback(context.Context, *UpdateWorkflowCallbackRequest) (*UpdateWorkflowCallbackResponse, error)
 	mustEmbedUnimplementedHistoryServiceServer()
 }
 
@@ -1423,6 +1541,9 @@ func (UnimplementedHistoryServiceServer) VerifyFirstWorkflowTaskScheduled(contex
 func (UnimplementedHistoryServiceServer) RecordChildExecutionCompleted(context.Context, *RecordChildExecutionCompletedRequest) (*RecordChildExecutionCompletedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecordChildExecutionCompleted not implemented")
 }
+func (UnimplementedHistoryServiceServer) RecordChildExecutionProgress(context.Context, *RecordChildExecutionProgressRequest) (*RecordChildExecutionProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordChildExecutionProgress not implemented")
+}
 func (UnimplementedHistoryServiceServer) VerifyChildExecutionCompletionRecorded(context.Context, *VerifyChildExecutionCompletionRecordedRequest) (*VerifyChildExecutionCompletionRecordedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifyChildExecutionCompletionRecorded not implemented")
 }
@@ -1453,6 +1574,9 @@ func (UnimplementedHistoryServiceServer) CloseShard(context.Context, *CloseShard
 func (UnimplementedHistoryServiceServer) GetShard(context.Context, *GetShardRequest) (*GetShardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetShard not implemented")
 }
+func (UnimplementedHistoryServiceServer) DescribeMutableStateCache(context.Context, *DescribeMutableStateCacheRequest) (*DescribeMutableStateCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeMutableStateCache not implemented")
+}
 func (UnimplementedHistoryServiceServer) RemoveTask(context.Context, *RemoveTaskRequest) (*RemoveTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveTask not implemented")
 }
@@ -1558,6 +1682,18 @@ func (UnimplementedHistoryServiceServer) UnpauseActivity(context.Context, *Unpau
 func (UnimplementedHistoryServiceServer) ResetActivity(context.Context, *ResetActivityRequest) (*ResetActivityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetActivity not implemented")
 }
+func (UnimplementedHistoryServiceServer) PauseWorkflowExecution(context.Context, *PauseWorkflowExecutionRequest) (*PauseWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseWorkflowExecution not implemented")
+}
+func (UnimplementedHistoryServiceServer) UnpauseWorkflowExecution(context.Context, *UnpauseWorkflowExecutionRequest) (*UnpauseWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseWorkflowExecution not implemented")
+}
+func (UnimplementedHistoryServiceServer) DescribeWorkflowCallbacks(context.Context, *DescribeWorkflowCallbacksRequest) (*DescribeWorkflowCallbacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeWorkflowCallbacks not implemented")
+}
+func (UnimplementedHistoryServiceServer) UpdateWorkflowCallback(context.Context, *UpdateWorkflowCallbackRequest) (*UpdateWorkflowCallbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkflowCallback not implemented")
+}
 func (UnimplementedHistoryServiceServer) mustEmbedUnimplementedHistoryServiceServer() {}
 
 // UnsafeHistoryServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -2039,6 +2175,24 @@ func _HistoryService_RecordChildExecutionCompleted_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_RecordChildExecutionProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordChildExecutionProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).RecordChildExecutionProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_RecordChildExecutionProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).RecordChildExecutionProgress(ctx, req.(*RecordChildExecutionProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HistoryService_VerifyChildExecutionCompletionRecorded_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VerifyChildExecutionCompletionRecordedRequest)
 	if err := dec(in); err != nil {
@@ -2219,6 +2373,24 @@ func _HistoryService_GetShard_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_DescribeMutableStateCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeMutableStateCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).DescribeMutableStateCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_DescribeMutableStateCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).DescribeMutableStateCache(ctx, req.(*DescribeMutableStateCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HistoryService_RemoveTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RemoveTaskRequest)
 	if err := dec(in); err != nil {
@@ -2857,6 +3029,78 @@ func _HistoryService_ResetActivity_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_PauseWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).PauseWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_PauseWorkflowExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).PauseWorkflowExecution(ctx, req.(*PauseWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_UnpauseWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpauseWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).UnpauseWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_UnpauseWorkflowExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).UnpauseWorkflowExecution(ctx, req.(*UnpauseWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_DescribeWorkflowCallbacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeWorkflowCallbacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).DescribeWorkflowCallbacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_DescribeWorkflowCallbacks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).DescribeWorkflowCallbacks(ctx, req.(*DescribeWorkflowCallbacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_UpdateWorkflowCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkflowCallbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).UpdateWorkflowCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_UpdateWorkflowCallback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).UpdateWorkflowCallback(ctx, req.(*UpdateWorkflowCallbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // HistoryService_ServiceDesc is the grpc.ServiceDesc for HistoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2968,6 +3212,10 @@ var HistoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RecordChildExecutionCompleted",
 			Handler:    _HistoryService_RecordChildExecutionCompleted_Handler,
 		},
+		{
+			MethodName: "RecordChildExecutionProgress",
+			Handler:    _HistoryService_RecordChildExecutionProgress_Handler,
+		},
 		{
 			MethodName: "VerifyChildExecutionCompletionRecorded",
 			Handler:    _HistoryService_VerifyChildExecutionCompletionRecorded_Handler,
@@ -3008,6 +3256,10 @@ var HistoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetShard",
 			Handler:    _HistoryService_GetShard_Handler,
 		},
+		{
+			MethodName: "DescribeMutableStateCache",
+			Handler:    _HistoryService_DescribeMutableStateCache_Handler,
+		},
 		{
 			MethodName: "RemoveTask",
 			Handler:    _HistoryService_RemoveTask_Handler,
@@ -3144,6 +3396,22 @@ var HistoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResetActivity",
 			Handler:    _HistoryService_ResetActivity_Handler,
 		},
+		{
+			MethodName: "PauseWorkflowExecution",
+			Handler:    _HistoryService_PauseWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "UnpauseWorkflowExecution",
+			Handler:    _HistoryService_UnpauseWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "DescribeWorkflowCallbacks",
+			Handler:    _HistoryService_DescribeWorkflowCallbacks_Handler,
+		},
+		{
+			MethodName: "UpdateWorkflowCallback",
+			Handler:    _HistoryService_UpdateWorkflowCallback_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
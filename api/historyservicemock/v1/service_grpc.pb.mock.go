@@ -246,6 +246,46 @@ func (mr *MockHistoryServiceClientMockRecorder) DescribeMutableState(ctx, in any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockHistoryServiceClient)(nil).DescribeMutableState), varargs...)
 }
 
+// DescribeMutableStateCache mocks base method.
+func (m *MockHistoryServiceClient) DescribeMutableStateCache(ctx context.Context, in *historyservice.DescribeMutableStateCacheRequest, opts ...grpc.CallOption) (*historyservice.DescribeMutableStateCacheResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeMutableStateCache", varargs...)
+	ret0, _ := ret[0].(*historyservice.DescribeMutableStateCacheResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeMutableStateCache indicates an expected call of DescribeMutableStateCache.
+func (mr *MockHistoryServiceClientMockRecorder) DescribeMutableStateCache(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableStateCache", reflect.TypeOf((*MockHistoryServiceClient)(nil).DescribeMutableStateCache), varargs...)
+}
+
+// DescribeWorkflowCallbacks mocks base method.
+func (m *MockHistoryServiceClient) DescribeWorkflowCallbacks(ctx context.Context, in *historyservice.DescribeWorkflowCallbacksRequest, opts ...grpc.CallOption) (*historyservice.DescribeWorkflowCallbacksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeWorkflowCallbacks", varargs...)
+	ret0, _ := ret[0].(*historyservice.DescribeWorkflowCallbacksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeWorkflowCallbacks indicates an expected call of DescribeWorkflowCallbacks.
+func (mr *MockHistoryServiceClientMockRecorder) DescribeWorkflowCallbacks(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowCallbacks", reflect.TypeOf((*MockHistoryServiceClient)(nil).DescribeWorkflowCallbacks), varargs...)
+}
+
 // DescribeWorkflowExecution mocks base method.
 func (m *MockHistoryServiceClient) DescribeWorkflowExecution(ctx context.Context, in *historyservice.DescribeWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.DescribeWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -706,6 +746,26 @@ func (mr *MockHistoryServiceClientMockRecorder) PauseActivity(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseActivity", reflect.TypeOf((*MockHistoryServiceClient)(nil).PauseActivity), varargs...)
 }
 
+// PauseWorkflowExecution mocks base method.
+func (m *MockHistoryServiceClient) PauseWorkflowExecution(ctx context.Context, in *historyservice.PauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.PauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*historyservice.PauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution.
+func (mr *MockHistoryServiceClientMockRecorder) PauseWorkflowExecution(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).PauseWorkflowExecution), varargs...)
+}
+
 // PollMutableState mocks base method.
 func (m *MockHistoryServiceClient) PollMutableState(ctx context.Context, in *historyservice.PollMutableStateRequest, opts ...grpc.CallOption) (*historyservice.PollMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -886,6 +946,26 @@ func (mr *MockHistoryServiceClientMockRecorder) RecordChildExecutionCompleted(ct
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordChildExecutionCompleted", reflect.TypeOf((*MockHistoryServiceClient)(nil).RecordChildExecutionCompleted), varargs...)
 }
 
+// RecordChildExecutionProgress mocks base method.
+func (m *MockHistoryServiceClient) RecordChildExecutionProgress(ctx context.Context, in *historyservice.RecordChildExecutionProgressRequest, opts ...grpc.CallOption) (*historyservice.RecordChildExecutionProgressResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RecordChildExecutionProgress", varargs...)
+	ret0, _ := ret[0].(*historyservice.RecordChildExecutionProgressResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordChildExecutionProgress indicates an expected call of RecordChildExecutionProgress.
+func (mr *MockHistoryServiceClientMockRecorder) RecordChildExecutionProgress(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordChildExecutionProgress", reflect.TypeOf((*MockHistoryServiceClient)(nil).RecordChildExecutionProgress), varargs...)
+}
+
 // RecordWorkflowTaskStarted mocks base method.
 func (m *MockHistoryServiceClient) RecordWorkflowTaskStarted(ctx context.Context, in *historyservice.RecordWorkflowTaskStartedRequest, opts ...grpc.CallOption) (*historyservice.RecordWorkflowTaskStartedResponse, error) {
 	m.ctrl.T.Helper()
@@ -1386,6 +1466,26 @@ func (mr *MockHistoryServiceClientMockRecorder) UnpauseActivity(ctx, in any, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseActivity", reflect.TypeOf((*MockHistoryServiceClient)(nil).UnpauseActivity), varargs...)
 }
 
+// UnpauseWorkflowExecution mocks base method.
+func (m *MockHistoryServiceClient) UnpauseWorkflowExecution(ctx context.Context, in *historyservice.UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.UnpauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*historyservice.UnpauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution.
+func (mr *MockHistoryServiceClientMockRecorder) UnpauseWorkflowExecution(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).UnpauseWorkflowExecution), varargs...)
+}
+
 // UpdateActivityOptions mocks base method.
 func (m *MockHistoryServiceClient) UpdateActivityOptions(ctx context.Context, in *historyservice.UpdateActivityOptionsRequest, opts ...grpc.CallOption) (*historyservice.UpdateActivityOptionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -1406,6 +1506,26 @@ func (mr *MockHistoryServiceClientMockRecorder) UpdateActivityOptions(ctx, in an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivityOptions", reflect.TypeOf((*MockHistoryServiceClient)(nil).UpdateActivityOptions), varargs...)
 }
 
+// UpdateWorkflowCallback mocks base method.
+func (m *MockHistoryServiceClient) UpdateWorkflowCallback(ctx context.Context, in *historyservice.UpdateWorkflowCallbackRequest, opts ...grpc.CallOption) (*historyservice.UpdateWorkflowCallbackResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateWorkflowCallback", varargs...)
+	ret0, _ := ret[0].(*historyservice.UpdateWorkflowCallbackResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkflowCallback indicates an expected call of UpdateWorkflowCallback.
+func (mr *MockHistoryServiceClientMockRecorder) UpdateWorkflowCallback(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowCallback", reflect.TypeOf((*MockHistoryServiceClient)(nil).UpdateWorkflowCallback), varargs...)
+}
+
 // UpdateWorkflowExecution mocks base method.
 func (m *MockHistoryServiceClient) UpdateWorkflowExecution(ctx context.Context, in *historyservice.UpdateWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.UpdateWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1781,6 +1901,36 @@ func (mr *MockHistoryServiceServerMockRecorder) DescribeMutableState(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockHistoryServiceServer)(nil).DescribeMutableState), arg0, arg1)
 }
 
+// DescribeMutableStateCache mocks base method.
+func (m *MockHistoryServiceServer) DescribeMutableStateCache(arg0 context.Context, arg1 *historyservice.DescribeMutableStateCacheRequest) (*historyservice.DescribeMutableStateCacheResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeMutableStateCache", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.DescribeMutableStateCacheResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeMutableStateCache indicates an expected call of DescribeMutableStateCache.
+func (mr *MockHistoryServiceServerMockRecorder) DescribeMutableStateCache(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableStateCache", reflect.TypeOf((*MockHistoryServiceServer)(nil).DescribeMutableStateCache), arg0, arg1)
+}
+
+// DescribeWorkflowCallbacks mocks base method.
+func (m *MockHistoryServiceServer) DescribeWorkflowCallbacks(arg0 context.Context, arg1 *historyservice.DescribeWorkflowCallbacksRequest) (*historyservice.DescribeWorkflowCallbacksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeWorkflowCallbacks", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.DescribeWorkflowCallbacksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeWorkflowCallbacks indicates an expected call of DescribeWorkflowCallbacks.
+func (mr *MockHistoryServiceServerMockRecorder) DescribeWorkflowCallbacks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowCallbacks", reflect.TypeOf((*MockHistoryServiceServer)(nil).DescribeWorkflowCallbacks), arg0, arg1)
+}
+
 // DescribeWorkflowExecution mocks base method.
 func (m *MockHistoryServiceServer) DescribeWorkflowExecution(arg0 context.Context, arg1 *historyservice.DescribeWorkflowExecutionRequest) (*historyservice.DescribeWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -2126,6 +2276,21 @@ func (mr *MockHistoryServiceServerMockRecorder) PauseActivity(arg0, arg1 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseActivity", reflect.TypeOf((*MockHistoryServiceServer)(nil).PauseActivity), arg0, arg1)
 }
 
+// PauseWorkflowExecution mocks base method.
+func (m *MockHistoryServiceServer) PauseWorkflowExecution(arg0 context.Context, arg1 *historyservice.PauseWorkflowExecutionRequest) (*historyservice.PauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.PauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution.
+func (mr *MockHistoryServiceServerMockRecorder) PauseWorkflowExecution(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).PauseWorkflowExecution), arg0, arg1)
+}
+
 // PollMutableState mocks base method.
 func (m *MockHistoryServiceServer) PollMutableState(arg0 context.Context, arg1 *historyservice.PollMutableStateRequest) (*historyservice.PollMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -2261,6 +2426,21 @@ func (mr *MockHistoryServiceServerMockRecorder) RecordChildExecutionCompleted(ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordChildExecutionCompleted", reflect.TypeOf((*MockHistoryServiceServer)(nil).RecordChildExecutionCompleted), arg0, arg1)
 }
 
+// RecordChildExecutionProgress mocks base method.
+func (m *MockHistoryServiceServer) RecordChildExecutionProgress(arg0 context.Context, arg1 *historyservice.RecordChildExecutionProgressRequest) (*historyservice.RecordChildExecutionProgressResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordChildExecutionProgress", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.RecordChildExecutionProgressResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordChildExecutionProgress indicates an expected call of RecordChildExecutionProgress.
+func (mr *MockHistoryServiceServerMockRecorder) RecordChildExecutionProgress(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordChildExecutionProgress", reflect.TypeOf((*MockHistoryServiceServer)(nil).RecordChildExecutionProgress), arg0, arg1)
+}
+
 // RecordWorkflowTaskStarted mocks base method.
 func (m *MockHistoryServiceServer) RecordWorkflowTaskStarted(arg0 context.Context, arg1 *historyservice.RecordWorkflowTaskStartedRequest) (*historyservice.RecordWorkflowTaskStartedResponse, error) {
 	m.ctrl.T.Helper()
@@ -2635,6 +2815,21 @@ func (mr *MockHistoryServiceServerMockRecorder) UnpauseActivity(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseActivity", reflect.TypeOf((*MockHistoryServiceServer)(nil).UnpauseActivity), arg0, arg1)
 }
 
+// UnpauseWorkflowExecution mocks base method.
+func (m *MockHistoryServiceServer) UnpauseWorkflowExecution(arg0 context.Context, arg1 *historyservice.UnpauseWorkflowExecutionRequest) (*historyservice.UnpauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.UnpauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution.
+func (mr *MockHistoryServiceServerMockRecorder) UnpauseWorkflowExecution(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).UnpauseWorkflowExecution), arg0, arg1)
+}
+
 // UpdateActivityOptions mocks base method.
 func (m *MockHistoryServiceServer) UpdateActivityOptions(arg0 context.Context, arg1 *historyservice.UpdateActivityOptionsRequest) (*historyservice.UpdateActivityOptionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -2650,6 +2845,21 @@ func (mr *MockHistoryServiceServerMockRecorder) UpdateActivityOptions(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivityOptions", reflect.TypeOf((*MockHistoryServiceServer)(nil).UpdateActivityOptions), arg0, arg1)
 }
 
+// UpdateWorkflowCallback mocks base method.
+func (m *MockHistoryServiceServer) UpdateWorkflowCallback(arg0 context.Context, arg1 *historyservice.UpdateWorkflowCallbackRequest) (*historyservice.UpdateWorkflowCallbackResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkflowCallback", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.UpdateWorkflowCallbackResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkflowCallback indicates an expected call of UpdateWorkflowCallback.
+func (mr *MockHistoryServiceServerMockRecorder) UpdateWorkflowCallback(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowCallback", reflect.TypeOf((*MockHistoryServiceServer)(nil).UpdateWorkflowCallback), arg0, arg1)
+}
+
 // UpdateWorkflowExecution mocks base method.
 func (m *MockHistoryServiceServer) UpdateWorkflowExecution(arg0 context.Context, arg1 *historyservice.UpdateWorkflowExecutionRequest) (*historyservice.UpdateWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1583,6 +1583,228 @@ func (this *ForceUnloadTaskQueuePartitionResponse) Equal(that interface{}) bool
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type HandoffStickyTaskQueueRequest to the protobuf v3 wire format
+func (val *HandoffStickyTaskQueueRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type HandoffStickyTaskQueueRequest from the protobuf v3 wire format
+func (val *HandoffStickyTaskQueueRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *HandoffStickyTaskQueueRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two HandoffStickyTaskQueueRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *HandoffStickyTaskQueueRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *HandoffStickyTaskQueueRequest
+	switch t := that.(type) {
+	case *HandoffStickyTaskQueueRequest:
+		that1 = t
+	case HandoffStickyTaskQueueRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type HandoffStickyTaskQueueResponse to the protobuf v3 wire format
+func (val *HandoffStickyTaskQueueResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type HandoffStickyTaskQueueResponse from the protobuf v3 wire format
+func (val *HandoffStickyTaskQueueResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *HandoffStickyTaskQueueResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two HandoffStickyTaskQueueResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *HandoffStickyTaskQueueResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *HandoffStickyTaskQueueResponse
+	switch t := that.(type) {
+	case *HandoffStickyTaskQueueResponse:
+		that1 = t
+	case HandoffStickyTaskQueueResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetDeadLetteredTasksRequest to the protobuf v3 wire format
+func (val *GetDeadLetteredTasksRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetDeadLetteredTasksRequest from the protobuf v3 wire format
+func (val *GetDeadLetteredTasksRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetDeadLetteredTasksRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetDeadLetteredTasksRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetDeadLetteredTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetDeadLetteredTasksRequest
+	switch t := that.(type) {
+	case *GetDeadLetteredTasksRequest:
+		that1 = t
+	case GetDeadLetteredTasksRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetDeadLetteredTasksResponse to the protobuf v3 wire format
+func (val *GetDeadLetteredTasksResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetDeadLetteredTasksResponse from the protobuf v3 wire format
+func (val *GetDeadLetteredTasksResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetDeadLetteredTasksResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetDeadLetteredTasksResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetDeadLetteredTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetDeadLetteredTasksResponse
+	switch t := that.(type) {
+	case *GetDeadLetteredTasksResponse:
+		that1 = t
+	case GetDeadLetteredTasksResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ReenqueueDeadLetteredTasksRequest to the protobuf v3 wire format
+func (val *ReenqueueDeadLetteredTasksRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ReenqueueDeadLetteredTasksRequest from the protobuf v3 wire format
+func (val *ReenqueueDeadLetteredTasksRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ReenqueueDeadLetteredTasksRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ReenqueueDeadLetteredTasksRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ReenqueueDeadLetteredTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ReenqueueDeadLetteredTasksRequest
+	switch t := that.(type) {
+	case *ReenqueueDeadLetteredTasksRequest:
+		that1 = t
+	case ReenqueueDeadLetteredTasksRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ReenqueueDeadLetteredTasksResponse to the protobuf v3 wire format
+func (val *ReenqueueDeadLetteredTasksResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ReenqueueDeadLetteredTasksResponse from the protobuf v3 wire format
+func (val *ReenqueueDeadLetteredTasksResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ReenqueueDeadLetteredTasksResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ReenqueueDeadLetteredTasksResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ReenqueueDeadLetteredTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ReenqueueDeadLetteredTasksResponse
+	switch t := that.(type) {
+	case *ReenqueueDeadLetteredTasksResponse:
+		that1 = t
+	case ReenqueueDeadLetteredTasksResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type UpdateTaskQueueUserDataRequest to the protobuf v3 wire format
 func (val *UpdateTaskQueueUserDataRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
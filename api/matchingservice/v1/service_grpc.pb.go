@@ -67,6 +67,9 @@ const (
 	MatchingService_ForceLoadTaskQueuePartition_FullMethodName            = "/temporal.server.api.matchingservice.v1.MatchingService/ForceLoadTaskQueuePartition"
 	MatchingService_ForceUnloadTaskQueue_FullMethodName                   = "/temporal.server.api.matchingservice.v1.MatchingService/ForceUnloadTaskQueue"
 	MatchingService_ForceUnloadTaskQueuePartition_FullMethodName          = "/temporal.server.api.matchingservice.v1.MatchingService/ForceUnloadTaskQueuePartition"
+	MatchingService_HandoffStickyTaskQueue_FullMethodName                 = "/temporal.server.api.matchingservice.v1.MatchingService/HandoffStickyTaskQueue"
+	MatchingService_GetDeadLetteredTasks_FullMethodName                   = "/temporal.server.api.matchingservice.v1.MatchingService/GetDeadLetteredTasks"
+	MatchingService_ReenqueueDeadLetteredTasks_FullMethodName             = "/temporal.server.api.matchingservice.v1.MatchingService/ReenqueueDeadLetteredTasks"
 	MatchingService_UpdateTaskQueueUserData_FullMethodName                = "/temporal.server.api.matchingservice.v1.MatchingService/UpdateTaskQueueUserData"
 	MatchingService_ReplicateTaskQueueUserData_FullMethodName             = "/temporal.server.api.matchingservice.v1.MatchingService/ReplicateTaskQueueUserData"
 	MatchingService_CheckTaskQueueUserDataPropagation_FullMethodName      = "/temporal.server.api.matchingservice.v1.MatchingService/CheckTaskQueueUserDataPropagation"
@@ -164,6 +167,17 @@ type MatchingServiceClient interface {
 	ForceUnloadTaskQueue(ctx context.Context, in *ForceUnloadTaskQueueRequest, opts ...grpc.CallOption) (*ForceUnloadTaskQueueResponse, error)
 	// Force unloading a task queue partition.
 	ForceUnloadTaskQueuePartition(ctx context.Context, in *ForceUnloadTaskQueuePartitionRequest, opts ...grpc.CallOption) (*ForceUnloadTaskQueuePartitionResponse, error)
+	// HandoffStickyTaskQueue is called by frontend when a restarted worker declares that its new sticky queue takes
+	// over from the sticky queue of its previous process. Routed to the owner of the old sticky queue, which
+	// redirects new and backlogged workflow tasks to the successor for a while, instead of letting them fall back to
+	// the normal queue and be replayed from scratch.
+	HandoffStickyTaskQueue(ctx context.Context, in *HandoffStickyTaskQueueRequest, opts ...grpc.CallOption) (*HandoffStickyTaskQueueResponse, error)
+	// GetDeadLetteredTasks returns a page of the tasks that matching dropped into the dead-letter queue of a
+	// namespace.
+	GetDeadLetteredTasks(ctx context.Context, in *GetDeadLetteredTasksRequest, opts ...grpc.CallOption) (*GetDeadLetteredTasksResponse, error)
+	// ReenqueueDeadLetteredTasks adds dead-lettered tasks back to their task queues and removes them from the
+	// dead-letter queue.
+	ReenqueueDeadLetteredTasks(ctx context.Context, in *ReenqueueDeadLetteredTasksRequest, opts ...grpc.CallOption) (*ReenqueueDeadLetteredTasksResponse, error)
 	// Update task queue user data in owning node for all updates in namespace.
 	// All user data updates must first go through the task queue owner using the `UpdateWorkerBuildIdCompatibility`
 	// API.
@@ -448,6 +462,33 @@ func (c *matchingServiceClient) ForceUnloadTaskQueuePartition(ctx context.Contex
 	return out, nil
 }
 
+func (c *matchingServiceClient) HandoffStickyTaskQueue(ctx context.Context, in *HandoffStickyTaskQueueRequest, opts ...grpc.CallOption) (*HandoffStickyTaskQueueResponse, error) {
+	out := new(HandoffStickyTaskQueueResponse)
+	err := c.cc.Invoke(ctx, MatchingService_HandoffStickyTaskQueue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) GetDeadLetteredTasks(ctx context.Context, in *GetDeadLetteredTasksRequest, opts ...grpc.CallOption) (*GetDeadLetteredTasksResponse, error) {
+	out := new(GetDeadLetteredTasksResponse)
+	err := c.cc.Invoke(ctx, MatchingService_GetDeadLetteredTasks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) ReenqueueDeadLetteredTasks(ctx context.Context, in *ReenqueueDeadLetteredTasksRequest, opts ...grpc.CallOption) (*ReenqueueDeadLetteredTasksResponse, error) {
+	out := new(ReenqueueDeadLetteredTasksResponse)
+	err := c.cc.Invoke(ctx, MatchingService_ReenqueueDeadLetteredTasks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *matchingServiceClient) UpdateTaskQueueUserData(ctx context.Context, in *UpdateTaskQueueUserDataRequest, opts ...grpc.CallOption) (*UpdateTaskQueueUserDataResponse, error) {
 	out := new(UpdateTaskQueueUserDataResponse)
 	err := c.cc.Invoke(ctx, MatchingService_UpdateTaskQueueUserData_FullMethodName, in, out, opts...)
@@ -599,6 +640,17 @@ type MatchingServiceServer interface {
 	ForceUnloadTaskQueue(context.Context, *ForceUnloadTaskQueueRequest) (*ForceUnloadTaskQueueResponse, error)
 	// Force unloading a task queue partition.
 	ForceUnloadTaskQueuePartition(context.Context, *ForceUnloadTaskQueuePartitionRequest) (*ForceUnloadTaskQueuePartitionResponse, error)
+	// HandoffStickyTaskQueue is called by frontend when a restarted worker declares that its new sticky queue takes
+	// over from the sticky queue of its previous process. Routed to the owner of the old sticky queue, which
+	// redirects new and backlogged workflow tasks to the successor for a while, instead of letting them fall back to
+	// the normal queue and be replayed from scratch.
+	HandoffStickyTaskQueue(context.Context, *HandoffStickyTaskQueueRequest) (*HandoffStickyTaskQueueResponse, error)
+	// GetDeadLetteredTasks returns a page of the tasks that matching dropped into the dead-letter queue of a
+	// namespace.
+	GetDeadLetteredTasks(context.Context, *GetDeadLetteredTasksRequest) (*GetDeadLetteredTasksResponse, error)
+	// ReenqueueDeadLetteredTasks adds dead-lettered tasks back to their task queues and removes them from the
+	// dead-letter queue.
+	ReenqueueDeadLetteredTasks(context.Context, *ReenqueueDeadLetteredTasksRequest) (*ReenqueueDeadLetteredTasksResponse, error)
 	// Update task queue user data in owning node for all updates in namespace.
 	// All user data updates must first go through the task queue owner using the `UpdateWorkerBuildIdCompatibility`
 	// API.
@@ -730,6 +782,15 @@ func (UnimplementedMatchingServiceServer) ForceUnloadTaskQueue(context.Context,
 func (UnimplementedMatchingServiceServer) ForceUnloadTaskQueuePartition(context.Context, *ForceUnloadTaskQueuePartitionRequest) (*ForceUnloadTaskQueuePartitionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForceUnloadTaskQueuePartition not implemented")
 }
+func (UnimplementedMatchingServiceServer) HandoffStickyTaskQueue(context.Context, *HandoffStickyTaskQueueRequest) (*HandoffStickyTaskQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandoffStickyTaskQueue not implemented")
+}
+func (UnimplementedMatchingServiceServer) GetDeadLetteredTasks(context.Context, *GetDeadLetteredTasksRequest) (*GetDeadLetteredTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeadLetteredTasks not implemented")
+}
+func (UnimplementedMatchingServiceServer) ReenqueueDeadLetteredTasks(context.Context, *ReenqueueDeadLetteredTasksRequest) (*ReenqueueDeadLetteredTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReenqueueDeadLetteredTasks not implemented")
+}
 func (UnimplementedMatchingServiceServer) UpdateTaskQueueUserData(context.Context, *UpdateTaskQueueUserDataRequest) (*UpdateTaskQueueUserDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateTaskQueueUserData not implemented")
 }
@@ -1214,6 +1275,60 @@ func _MatchingService_ForceUnloadTaskQueuePartition_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MatchingService_HandoffStickyTaskQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandoffStickyTaskQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).HandoffStickyTaskQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_HandoffStickyTaskQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).HandoffStickyTaskQueue(ctx, req.(*HandoffStickyTaskQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_GetDeadLetteredTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeadLetteredTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).GetDeadLetteredTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_GetDeadLetteredTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).GetDeadLetteredTasks(ctx, req.(*GetDeadLetteredTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_ReenqueueDeadLetteredTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReenqueueDeadLetteredTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).ReenqueueDeadLetteredTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_ReenqueueDeadLetteredTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).ReenqueueDeadLetteredTasks(ctx, req.(*ReenqueueDeadLetteredTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MatchingService_UpdateTaskQueueUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateTaskQueueUserDataRequest)
 	if err := dec(in); err != nil {
@@ -1447,6 +1562,18 @@ var MatchingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ForceUnloadTaskQueuePartition",
 			Handler:    _MatchingService_ForceUnloadTaskQueuePartition_Handler,
 		},
+		{
+			MethodName: "HandoffStickyTaskQueue",
+			Handler:    _MatchingService_HandoffStickyTaskQueue_Handler,
+		},
+		{
+			MethodName: "GetDeadLetteredTasks",
+			Handler:    _MatchingService_GetDeadLetteredTasks_Handler,
+		},
+		{
+			MethodName: "ReenqueueDeadLetteredTasks",
+			Handler:    _MatchingService_ReenqueueDeadLetteredTasks_Handler,
+		},
 		{
 			MethodName: "UpdateTaskQueueUserData",
 			Handler:    _MatchingService_UpdateTaskQueueUserData_Handler,
@@ -345,6 +345,26 @@ func (mr *MockMatchingServiceClientMockRecorder) GetBuildIdTaskQueueMapping(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBuildIdTaskQueueMapping", reflect.TypeOf((*MockMatchingServiceClient)(nil).GetBuildIdTaskQueueMapping), varargs...)
 }
 
+// GetDeadLetteredTasks mocks base method.
+func (m *MockMatchingServiceClient) GetDeadLetteredTasks(ctx context.Context, in *matchingservice.GetDeadLetteredTasksRequest, opts ...grpc.CallOption) (*matchingservice.GetDeadLetteredTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDeadLetteredTasks", varargs...)
+	ret0, _ := ret[0].(*matchingservice.GetDeadLetteredTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeadLetteredTasks indicates an expected call of GetDeadLetteredTasks.
+func (mr *MockMatchingServiceClientMockRecorder) GetDeadLetteredTasks(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadLetteredTasks", reflect.TypeOf((*MockMatchingServiceClient)(nil).GetDeadLetteredTasks), varargs...)
+}
+
 // GetTaskQueueUserData mocks base method.
 func (m *MockMatchingServiceClient) GetTaskQueueUserData(ctx context.Context, in *matchingservice.GetTaskQueueUserDataRequest, opts ...grpc.CallOption) (*matchingservice.GetTaskQueueUserDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -405,6 +425,26 @@ func (mr *MockMatchingServiceClientMockRecorder) GetWorkerVersioningRules(ctx, i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkerVersioningRules", reflect.TypeOf((*MockMatchingServiceClient)(nil).GetWorkerVersioningRules), varargs...)
 }
 
+// HandoffStickyTaskQueue mocks base method.
+func (m *MockMatchingServiceClient) HandoffStickyTaskQueue(ctx context.Context, in *matchingservice.HandoffStickyTaskQueueRequest, opts ...grpc.CallOption) (*matchingservice.HandoffStickyTaskQueueResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "HandoffStickyTaskQueue", varargs...)
+	ret0, _ := ret[0].(*matchingservice.HandoffStickyTaskQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HandoffStickyTaskQueue indicates an expected call of HandoffStickyTaskQueue.
+func (mr *MockMatchingServiceClientMockRecorder) HandoffStickyTaskQueue(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandoffStickyTaskQueue", reflect.TypeOf((*MockMatchingServiceClient)(nil).HandoffStickyTaskQueue), varargs...)
+}
+
 // ListNexusEndpoints mocks base method.
 func (m *MockMatchingServiceClient) ListNexusEndpoints(ctx context.Context, in *matchingservice.ListNexusEndpointsRequest, opts ...grpc.CallOption) (*matchingservice.ListNexusEndpointsResponse, error) {
 	m.ctrl.T.Helper()
@@ -525,6 +565,26 @@ func (mr *MockMatchingServiceClientMockRecorder) QueryWorkflow(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryWorkflow", reflect.TypeOf((*MockMatchingServiceClient)(nil).QueryWorkflow), varargs...)
 }
 
+// ReenqueueDeadLetteredTasks mocks base method.
+func (m *MockMatchingServiceClient) ReenqueueDeadLetteredTasks(ctx context.Context, in *matchingservice.ReenqueueDeadLetteredTasksRequest, opts ...grpc.CallOption) (*matchingservice.ReenqueueDeadLetteredTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReenqueueDeadLetteredTasks", varargs...)
+	ret0, _ := ret[0].(*matchingservice.ReenqueueDeadLetteredTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReenqueueDeadLetteredTasks indicates an expected call of ReenqueueDeadLetteredTasks.
+func (mr *MockMatchingServiceClientMockRecorder) ReenqueueDeadLetteredTasks(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReenqueueDeadLetteredTasks", reflect.TypeOf((*MockMatchingServiceClient)(nil).ReenqueueDeadLetteredTasks), varargs...)
+}
+
 // ReplicateTaskQueueUserData mocks base method.
 func (m *MockMatchingServiceClient) ReplicateTaskQueueUserData(ctx context.Context, in *matchingservice.ReplicateTaskQueueUserDataRequest, opts ...grpc.CallOption) (*matchingservice.ReplicateTaskQueueUserDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -938,6 +998,21 @@ func (mr *MockMatchingServiceServerMockRecorder) GetBuildIdTaskQueueMapping(arg0
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBuildIdTaskQueueMapping", reflect.TypeOf((*MockMatchingServiceServer)(nil).GetBuildIdTaskQueueMapping), arg0, arg1)
 }
 
+// GetDeadLetteredTasks mocks base method.
+func (m *MockMatchingServiceServer) GetDeadLetteredTasks(arg0 context.Context, arg1 *matchingservice.GetDeadLetteredTasksRequest) (*matchingservice.GetDeadLetteredTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeadLetteredTasks", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.GetDeadLetteredTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeadLetteredTasks indicates an expected call of GetDeadLetteredTasks.
+func (mr *MockMatchingServiceServerMockRecorder) GetDeadLetteredTasks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadLetteredTasks", reflect.TypeOf((*MockMatchingServiceServer)(nil).GetDeadLetteredTasks), arg0, arg1)
+}
+
 // GetTaskQueueUserData mocks base method.
 func (m *MockMatchingServiceServer) GetTaskQueueUserData(arg0 context.Context, arg1 *matchingservice.GetTaskQueueUserDataRequest) (*matchingservice.GetTaskQueueUserDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -983,6 +1058,21 @@ func (mr *MockMatchingServiceServerMockRecorder) GetWorkerVersioningRules(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkerVersioningRules", reflect.TypeOf((*MockMatchingServiceServer)(nil).GetWorkerVersioningRules), arg0, arg1)
 }
 
+// HandoffStickyTaskQueue mocks base method.
+func (m *MockMatchingServiceServer) HandoffStickyTaskQueue(arg0 context.Context, arg1 *matchingservice.HandoffStickyTaskQueueRequest) (*matchingservice.HandoffStickyTaskQueueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandoffStickyTaskQueue", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.HandoffStickyTaskQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HandoffStickyTaskQueue indicates an expected call of HandoffStickyTaskQueue.
+func (mr *MockMatchingServiceServerMockRecorder) HandoffStickyTaskQueue(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandoffStickyTaskQueue", reflect.TypeOf((*MockMatchingServiceServer)(nil).HandoffStickyTaskQueue), arg0, arg1)
+}
+
 // ListNexusEndpoints mocks base method.
 func (m *MockMatchingServiceServer) ListNexusEndpoints(arg0 context.Context, arg1 *matchingservice.ListNexusEndpointsRequest) (*matchingservice.ListNexusEndpointsResponse, error) {
 	m.ctrl.T.Helper()
@@ -1073,6 +1163,21 @@ func (mr *MockMatchingServiceServerMockRecorder) QueryWorkflow(arg0, arg1 any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryWorkflow", reflect.TypeOf((*MockMatchingServiceServer)(nil).QueryWorkflow), arg0, arg1)
 }
 
+// ReenqueueDeadLetteredTasks mocks base method.
+func (m *MockMatchingServiceServer) ReenqueueDeadLetteredTasks(arg0 context.Context, arg1 *matchingservice.ReenqueueDeadLetteredTasksRequest) (*matchingservice.ReenqueueDeadLetteredTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReenqueueDeadLetteredTasks", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.ReenqueueDeadLetteredTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReenqueueDeadLetteredTasks indicates an expected call of ReenqueueDeadLetteredTasks.
+func (mr *MockMatchingServiceServerMockRecorder) ReenqueueDeadLetteredTasks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReenqueueDeadLetteredTasks", reflect.TypeOf((*MockMatchingServiceServer)(nil).ReenqueueDeadLetteredTasks), arg0, arg1)
+}
+
 // ReplicateTaskQueueUserData mocks base method.
 func (m *MockMatchingServiceServer) ReplicateTaskQueueUserData(arg0 context.Context, arg1 *matchingservice.ReplicateTaskQueueUserDataRequest) (*matchingservice.ReplicateTaskQueueUserDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -843,6 +843,43 @@ func (this *CallbackInfo) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type CallbackAttempt to the protobuf v3 wire format
+func (val *CallbackAttempt) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CallbackAttempt from the protobuf v3 wire format
+func (val *CallbackAttempt) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CallbackAttempt) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CallbackAttempt values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CallbackAttempt) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CallbackAttempt
+	switch t := that.(type) {
+	case *CallbackAttempt:
+		that1 = t
+	case CallbackAttempt:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type NexusOperationInfo to the protobuf v3 wire format
 func (val *NexusOperationInfo) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -953,3 +990,40 @@ func (this *ResetChildInfo) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type WorkflowPauseInfo to the protobuf v3 wire format
+func (val *WorkflowPauseInfo) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type WorkflowPauseInfo from the protobuf v3 wire format
+func (val *WorkflowPauseInfo) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *WorkflowPauseInfo) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two WorkflowPauseInfo values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *WorkflowPauseInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *WorkflowPauseInfo
+	switch t := that.(type) {
+	case *WorkflowPauseInfo:
+		that1 = t
+	case WorkflowPauseInfo:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
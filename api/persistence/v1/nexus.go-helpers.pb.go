@@ -66,6 +66,43 @@ func (this *NexusEndpointSpec) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type NexusEndpointPolicy to the protobuf v3 wire format
+func (val *NexusEndpointPolicy) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type NexusEndpointPolicy from the protobuf v3 wire format
+func (val *NexusEndpointPolicy) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *NexusEndpointPolicy) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two NexusEndpointPolicy values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *NexusEndpointPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *NexusEndpointPolicy
+	switch t := that.(type) {
+	case *NexusEndpointPolicy:
+		that1 = t
+	case NexusEndpointPolicy:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type NexusEndpointTarget to the protobuf v3 wire format
 func (val *NexusEndpointTarget) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -55,9 +55,11 @@ import (
 	sync "sync"
 
 	v1 "go.temporal.io/api/common/v1"
-	v11 "go.temporal.io/server/api/clock/v1"
+	v12 "go.temporal.io/server/api/clock/v1"
+	v11 "go.temporal.io/server/api/enums/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -82,6 +84,9 @@ type NexusEndpointSpec struct {
 	Description *v1.Payload `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	// Target to route requests to.
 	Target *NexusEndpointTarget `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	// Policy for operations that target this endpoint. Not part of the public API, it is managed through the admin
+	// API. Updates that leave the policy unset keep the endpoint's current policy.
+	Policy *NexusEndpointPolicy `protobuf:"bytes,4,opt,name=policy,proto3" json:"policy,omitempty"`
 }
 
 func (x *NexusEndpointSpec) Reset() {
@@ -137,6 +142,102 @@ func (x *NexusEndpointSpec) GetTarget() *NexusEndpointTarget {
 	return nil
 }
 
+func (x *NexusEndpointSpec) GetPolicy() *NexusEndpointPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+// Endpoint-level limits and defaults for operations that target an endpoint.
+type NexusEndpointPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Upper bound for the schedule-to-close timeout of operations. Operations scheduled with a longer timeout, or
+	// without one, get this timeout instead. Unset or zero means no bound.
+	MaxOperationTimeout *durationpb.Duration `protobuf:"bytes,1,opt,name=max_operation_timeout,json=maxOperationTimeout,proto3" json:"max_operation_timeout,omitempty"`
+	// What happens to started operations when the caller workflow closes.
+	CallerCloseBehavior v11.NexusCallerCloseBehavior `protobuf:"varint,2,opt,name=caller_close_behavior,json=callerCloseBehavior,proto3,enum=temporal.server.api.enums.v1.NexusCallerCloseBehavior" json:"caller_close_behavior,omitempty"`
+	// Maximum number of operations targeting this endpoint that a single caller workflow may have started and not
+	// completed. Operations that are being started count too, the earliest scheduled ones start first, and the others
+	// are retried later. Zero means no limit. The limit is per caller workflow, it doesn't bound a caller namespace.
+	MaxConcurrentOperationsPerCallerWorkflow int32 `protobuf:"varint,3,opt,name=max_concurrent_operations_per_caller_workflow,json=maxConcurrentOperationsPerCallerWorkflow,proto3" json:"max_concurrent_operations_per_caller_workflow,omitempty"`
+	// How long the frontend caches synchronous StartOperation results for this endpoint. Cached results are served
+	// without dispatching the request to the endpoint's task queue. Unset or zero disables caching.
+	ResultCacheTtl *durationpb.Duration `protobuf:"bytes,4,opt,name=result_cache_ttl,json=resultCacheTtl,proto3" json:"result_cache_ttl,omitempty"`
+	// What identifies a request in the result cache.
+	ResultCacheKey v11.NexusResultCacheKey `protobuf:"varint,5,opt,name=result_cache_key,json=resultCacheKey,proto3,enum=temporal.server.api.enums.v1.NexusResultCacheKey" json:"result_cache_key,omitempty"`
+}
+
+func (x *NexusEndpointPolicy) Reset() {
+	*x = NexusEndpointPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NexusEndpointPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NexusEndpointPolicy) ProtoMessage() {}
+
+func (x *NexusEndpointPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NexusEndpointPolicy.ProtoReflect.Descriptor instead.
+func (*NexusEndpointPolicy) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_nexus_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NexusEndpointPolicy) GetMaxOperationTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.MaxOperationTimeout
+	}
+	return nil
+}
+
+func (x *NexusEndpointPolicy) GetCallerCloseBehavior() v11.NexusCallerCloseBehavior {
+	if x != nil {
+		return x.CallerCloseBehavior
+	}
+	return v11.NexusCallerCloseBehavior(0)
+}
+
+func (x *NexusEndpointPolicy) GetMaxConcurrentOperationsPerCallerWorkflow() int32 {
+	if x != nil {
+		return x.MaxConcurrentOperationsPerCallerWorkflow
+	}
+	return 0
+}
+
+func (x *NexusEndpointPolicy) GetResultCacheTtl() *durationpb.Duration {
+	if x != nil {
+		return x.ResultCacheTtl
+	}
+	return nil
+}
+
+func (x *NexusEndpointPolicy) GetResultCacheKey() v11.NexusResultCacheKey {
+	if x != nil {
+		return x.ResultCacheKey
+	}
+	return v11.NexusResultCacheKey(0)
+}
+
 // Target to route requests to.
 // Duplicated from the public API's temporal.api.nexus.v1.EndpointTarget where the worker target has a namespace name.
 // We store an ID in persistence to prevent namespace renames from breaking references.
@@ -155,7 +256,7 @@ type NexusEndpointTarget struct {
 func (x *NexusEndpointTarget) Reset() {
 	*x = NexusEndpointTarget{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[1]
+		mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -168,7 +269,7 @@ func (x *NexusEndpointTarget) String() string {
 func (*NexusEndpointTarget) ProtoMessage() {}
 
 func (x *NexusEndpointTarget) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[1]
+	mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -181,7 +282,7 @@ func (x *NexusEndpointTarget) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NexusEndpointTarget.ProtoReflect.Descriptor instead.
 func (*NexusEndpointTarget) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_persistence_v1_nexus_proto_rawDescGZIP(), []int{1}
+	return file_temporal_server_api_persistence_v1_nexus_proto_rawDescGZIP(), []int{2}
 }
 
 func (m *NexusEndpointTarget) GetVariant() isNexusEndpointTarget_Variant {
@@ -230,7 +331,7 @@ type NexusEndpoint struct {
 	// Updated whenever the endpoint is directly updated due to a user action but not when applying replication events.
 	// The clock is referenced when new timestamps are generated to ensure it produces monotonically increasing
 	// timestamps.
-	Clock *v11.HybridLogicalClock `protobuf:"bytes,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	Clock *v12.HybridLogicalClock `protobuf:"bytes,1,opt,name=clock,proto3" json:"clock,omitempty"`
 	// Endpoint specification. This is a mirror of the public API and is intended to be mutable.
 	Spec *NexusEndpointSpec `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
 	// The date and time when the endpoint was created.
@@ -243,7 +344,7 @@ type NexusEndpoint struct {
 func (x *NexusEndpoint) Reset() {
 	*x = NexusEndpoint{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[2]
+		mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -256,7 +357,7 @@ func (x *NexusEndpoint) String() string {
 func (*NexusEndpoint) ProtoMessage() {}
 
 func (x *NexusEndpoint) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[2]
+	mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -269,10 +370,10 @@ func (x *NexusEndpoint) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NexusEndpoint.ProtoReflect.Descriptor instead.
 func (*NexusEndpoint) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_persistence_v1_nexus_proto_rawDescGZIP(), []int{2}
+	return file_temporal_server_api_persistence_v1_nexus_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *NexusEndpoint) GetClock() *v11.HybridLogicalClock {
+func (x *NexusEndpoint) GetClock() *v12.HybridLogicalClock {
 	if x != nil {
 		return x.Clock
 	}
@@ -307,7 +408,7 @@ type NexusEndpointEntry struct {
 func (x *NexusEndpointEntry) Reset() {
 	*x = NexusEndpointEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[3]
+		mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -320,7 +421,7 @@ func (x *NexusEndpointEntry) String() string {
 func (*NexusEndpointEntry) ProtoMessage() {}
 
 func (x *NexusEndpointEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[3]
+	mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -333,7 +434,7 @@ func (x *NexusEndpointEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NexusEndpointEntry.ProtoReflect.Descriptor instead.
 func (*NexusEndpointEntry) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_persistence_v1_nexus_proto_rawDescGZIP(), []int{3}
+	return file_temporal_server_api_persistence_v1_nexus_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *NexusEndpointEntry) GetVersion() int64 {
@@ -372,7 +473,7 @@ type NexusEndpointTarget_Worker struct {
 func (x *NexusEndpointTarget_Worker) Reset() {
 	*x = NexusEndpointTarget_Worker{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[4]
+		mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -385,7 +486,7 @@ func (x *NexusEndpointTarget_Worker) String() string {
 func (*NexusEndpointTarget_Worker) ProtoMessage() {}
 
 func (x *NexusEndpointTarget_Worker) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[4]
+	mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -398,7 +499,7 @@ func (x *NexusEndpointTarget_Worker) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NexusEndpointTarget_Worker.ProtoReflect.Descriptor instead.
 func (*NexusEndpointTarget_Worker) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_persistence_v1_nexus_proto_rawDescGZIP(), []int{1, 0}
+	return file_temporal_server_api_persistence_v1_nexus_proto_rawDescGZIP(), []int{2, 0}
 }
 
 func (x *NexusEndpointTarget_Worker) GetNamespaceId() string {
@@ -433,7 +534,7 @@ type NexusEndpointTarget_External struct {
 func (x *NexusEndpointTarget_External) Reset() {
 	*x = NexusEndpointTarget_External{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[5]
+		mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -446,7 +547,7 @@ func (x *NexusEndpointTarget_External) String() string {
 func (*NexusEndpointTarget_External) ProtoMessage() {}
 
 func (x *NexusEndpointTarget_External) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[5]
+	mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -459,7 +560,7 @@ func (x *NexusEndpointTarget_External) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NexusEndpointTarget_External.ProtoReflect.Descriptor instead.
 func (*NexusEndpointTarget_External) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_persistence_v1_nexus_proto_rawDescGZIP(), []int{1, 1}
+	return file_temporal_server_api_persistence_v1_nexus_proto_rawDescGZIP(), []int{2, 1}
 }
 
 func (x *NexusEndpointTarget_External) GetUrl() string {
@@ -475,75 +576,115 @@ var file_temporal_server_api_persistence_v1_nexus_proto_rawDesc = []byte{
 	0x0a, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
 	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x2f,
 	0x76, 0x31, 0x2f, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x22, 0x74,
-	0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31,
-	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x24, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x2a, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2f, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x76, 0x31,
-	0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc7, 0x01,
-	0x0a, 0x11, 0x4e, 0x65, 0x78, 0x75, 0x73, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x70,
-	0x65, 0x63, 0x12, 0x16, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x02, 0x68, 0x00, 0x12, 0x45, 0x0a, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
-	0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x02, 0x68, 0x00, 0x12, 0x53, 0x0a,
-	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x74,
-	0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x4e, 0x65, 0x78, 0x75, 0x73, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x54, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x42, 0x02, 0x68, 0x00, 0x22, 0xd8,
-	0x02, 0x0a, 0x13, 0x4e, 0x65, 0x78, 0x75, 0x73, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x54,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x5c, 0x0a, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x73,
+	0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31,
+	0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x24,
+	0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x2a, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2f, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x76, 0x31, 0x2f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x28, 0x74, 0x65,
+	0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9c, 0x02, 0x0a, 0x11, 0x4e, 0x65, 0x78, 0x75, 0x73, 0x45, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x16, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x02, 0x68,
+	0x00, 0x12, 0x45, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x02, 0x68, 0x00, 0x12, 0x53, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x73,
 	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73,
 	0x74, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x78, 0x75, 0x73, 0x45, 0x6e, 0x64,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65,
-	0x72, 0x48, 0x00, 0x52, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x42, 0x02, 0x68, 0x00, 0x12, 0x62,
-	0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x40, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x78, 0x75, 0x73, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x48, 0x00,
-	0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x42, 0x02, 0x68, 0x00, 0x1a, 0x52,
-	0x0a, 0x06, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0c, 0x6e, 0x61, 0x6d, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x61,
-	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x42, 0x02, 0x68, 0x00, 0x12, 0x21, 0x0a, 0x0a,
-	0x74, 0x61, 0x73, 0x6b, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x42, 0x02, 0x68, 0x00, 0x1a, 0x20,
-	0x0a, 0x08, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x03, 0x75, 0x72, 0x6c,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x42, 0x02, 0x68, 0x00, 0x42, 0x09,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x42, 0x02, 0x68, 0x00, 0x12, 0x53, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x78, 0x75, 0x73, 0x45, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x42, 0x02, 0x68, 0x00, 0x22, 0xe7, 0x03, 0x0a, 0x13, 0x4e, 0x65, 0x78, 0x75,
+	0x73, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x51,
+	0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x02, 0x68, 0x00, 0x12, 0x6e,
+	0x0a, 0x15, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x62, 0x65,
+	0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x36, 0x2e, 0x74, 0x65,
+	0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x78, 0x75, 0x73, 0x43,
+	0x61, 0x6c, 0x6c, 0x65, 0x72, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f,
+	0x72, 0x52, 0x13, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x42, 0x65, 0x68,
+	0x61, 0x76, 0x69, 0x6f, 0x72, 0x42, 0x02, 0x68, 0x00, 0x12, 0x63, 0x0a, 0x2d, 0x6d, 0x61, 0x78,
+	0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x5f,
+	0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x28, 0x6d,
+	0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x65, 0x72, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x57, 0x6f,
+	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x42, 0x02, 0x68, 0x00, 0x12, 0x47, 0x0a, 0x10, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x74, 0x74, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x74, 0x6c, 0x42, 0x02, 0x68, 0x00, 0x12,
+	0x5f, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f,
+	0x72, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x65, 0x6e,
+	0x75, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x78, 0x75, 0x73, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4b, 0x65, 0x79, 0x42, 0x02, 0x68, 0x00, 0x22, 0xd8, 0x02, 0x0a,
+	0x13, 0x4e, 0x65, 0x78, 0x75, 0x73, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x12, 0x5c, 0x0a, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x78, 0x75, 0x73, 0x45, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+	0x48, 0x00, 0x52, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x42, 0x02, 0x68, 0x00, 0x12, 0x62, 0x0a,
+	0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x40,
+	0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4e, 0x65, 0x78, 0x75, 0x73, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x48, 0x00, 0x52,
+	0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x42, 0x02, 0x68, 0x00, 0x1a, 0x52, 0x0a,
+	0x06, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0c, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x42, 0x02, 0x68, 0x00, 0x12, 0x21, 0x0a, 0x0a,
+	0x74, 0x61, 0x73, 0x6b, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x74, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x42, 0x02, 0x68, 0x00, 0x1a, 0x20, 0x0a,
+	0x08, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x42, 0x02, 0x68, 0x00, 0x42, 0x09,
 	0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x22, 0xed, 0x01, 0x0a, 0x0d, 0x4e, 0x65, 0x78,
-	0x75, 0x73, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x4a, 0x0a, 0x05, 0x63, 0x6c,
-	0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f,
+	0x75, 0x73, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x4a, 0x0a, 0x05, 0x63, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f,
 	0x72, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c,
 	0x6f, 0x63, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x79, 0x62, 0x72, 0x69, 0x64, 0x4c, 0x6f, 0x67, 0x69,
-	0x63, 0x61, 0x6c, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x42,
-	0x02, 0x68, 0x00, 0x12, 0x4d, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x63, 0x61, 0x6c, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x02,
+	0x68, 0x00, 0x12, 0x4d, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x35, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
 	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65,
 	0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x78, 0x75, 0x73, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
 	0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x42, 0x02, 0x68, 0x00, 0x12, 0x41,
 	0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x02, 0x68, 0x00, 0x22, 0x99,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x02, 0x68, 0x00, 0x22, 0x99,
 	0x01, 0x0a, 0x12, 0x4e, 0x65, 0x78, 0x75, 0x73, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x45,
 	0x6e, 0x74, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x02, 0x68, 0x00,
-	0x12, 0x12, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x42,
-	0x02, 0x68, 0x00, 0x12, 0x51, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73,
-	0x74, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x78, 0x75, 0x73, 0x45, 0x6e, 0x64,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x42,
-	0x02, 0x68, 0x00, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x6f, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61,
-	0x6c, 0x2e, 0x69, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70,
-	0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x65, 0x72,
-	0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x02, 0x68,
+	0x00, 0x12, 0x12, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x42, 0x02, 0x68, 0x00, 0x12, 0x51, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x78, 0x75, 0x73, 0x45, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x42,
+	0x02, 0x68, 0x00, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x6f, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72,
+	0x61, 0x6c, 0x2e, 0x69, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x65,
+	0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -558,32 +699,41 @@ func file_temporal_server_api_persistence_v1_nexus_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_persistence_v1_nexus_proto_rawDescData
 }
 
-var file_temporal_server_api_persistence_v1_nexus_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_temporal_server_api_persistence_v1_nexus_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_temporal_server_api_persistence_v1_nexus_proto_goTypes = []interface{}{
 	(*NexusEndpointSpec)(nil),            // 0: temporal.server.api.persistence.v1.NexusEndpointSpec
-	(*NexusEndpointTarget)(nil),          // 1: temporal.server.api.persistence.v1.NexusEndpointTarget
-	(*NexusEndpoint)(nil),                // 2: temporal.server.api.persistence.v1.NexusEndpoint
-	(*NexusEndpointEntry)(nil),           // 3: temporal.server.api.persistence.v1.NexusEndpointEntry
-	(*NexusEndpointTarget_Worker)(nil),   // 4: temporal.server.api.persistence.v1.NexusEndpointTarget.Worker
-	(*NexusEndpointTarget_External)(nil), // 5: temporal.server.api.persistence.v1.NexusEndpointTarget.External
-	(*v1.Payload)(nil),                   // 6: temporal.api.common.v1.Payload
-	(*v11.HybridLogicalClock)(nil),       // 7: temporal.server.api.clock.v1.HybridLogicalClock
-	(*timestamppb.Timestamp)(nil),        // 8: google.protobuf.Timestamp
+	(*NexusEndpointPolicy)(nil),          // 1: temporal.server.api.persistence.v1.NexusEndpointPolicy
+	(*NexusEndpointTarget)(nil),          // 2: temporal.server.api.persistence.v1.NexusEndpointTarget
+	(*NexusEndpoint)(nil),                // 3: temporal.server.api.persistence.v1.NexusEndpoint
+	(*NexusEndpointEntry)(nil),           // 4: temporal.server.api.persistence.v1.NexusEndpointEntry
+	(*NexusEndpointTarget_Worker)(nil),   // 5: temporal.server.api.persistence.v1.NexusEndpointTarget.Worker
+	(*NexusEndpointTarget_External)(nil), // 6: temporal.server.api.persistence.v1.NexusEndpointTarget.External
+	(*v1.Payload)(nil),                   // 7: temporal.api.common.v1.Payload
+	(*durationpb.Duration)(nil),          // 8: google.protobuf.Duration
+	(v11.NexusCallerCloseBehavior)(0),    // 9: temporal.server.api.enums.v1.NexusCallerCloseBehavior
+	(v11.NexusResultCacheKey)(0),         // 10: temporal.server.api.enums.v1.NexusResultCacheKey
+	(*v12.HybridLogicalClock)(nil),       // 11: temporal.server.api.clock.v1.HybridLogicalClock
+	(*timestamppb.Timestamp)(nil),        // 12: google.protobuf.Timestamp
 }
 var file_temporal_server_api_persistence_v1_nexus_proto_depIdxs = []int32{
-	6, // 0: temporal.server.api.persistence.v1.NexusEndpointSpec.description:type_name -> temporal.api.common.v1.Payload
-	1, // 1: temporal.server.api.persistence.v1.NexusEndpointSpec.target:type_name -> temporal.server.api.persistence.v1.NexusEndpointTarget
-	4, // 2: temporal.server.api.persistence.v1.NexusEndpointTarget.worker:type_name -> temporal.server.api.persistence.v1.NexusEndpointTarget.Worker
-	5, // 3: temporal.server.api.persistence.v1.NexusEndpointTarget.external:type_name -> temporal.server.api.persistence.v1.NexusEndpointTarget.External
-	7, // 4: temporal.server.api.persistence.v1.NexusEndpoint.clock:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
-	0, // 5: temporal.server.api.persistence.v1.NexusEndpoint.spec:type_name -> temporal.server.api.persistence.v1.NexusEndpointSpec
-	8, // 6: temporal.server.api.persistence.v1.NexusEndpoint.created_time:type_name -> google.protobuf.Timestamp
-	2, // 7: temporal.server.api.persistence.v1.NexusEndpointEntry.endpoint:type_name -> temporal.server.api.persistence.v1.NexusEndpoint
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	7,  // 0: temporal.server.api.persistence.v1.NexusEndpointSpec.description:type_name -> temporal.api.common.v1.Payload
+	2,  // 1: temporal.server.api.persistence.v1.NexusEndpointSpec.target:type_name -> temporal.server.api.persistence.v1.NexusEndpointTarget
+	1,  // 2: temporal.server.api.persistence.v1.NexusEndpointSpec.policy:type_name -> temporal.server.api.persistence.v1.NexusEndpointPolicy
+	8,  // 3: temporal.server.api.persistence.v1.NexusEndpointPolicy.max_operation_timeout:type_name -> google.protobuf.Duration
+	9,  // 4: temporal.server.api.persistence.v1.NexusEndpointPolicy.caller_close_behavior:type_name -> temporal.server.api.enums.v1.NexusCallerCloseBehavior
+	8,  // 5: temporal.server.api.persistence.v1.NexusEndpointPolicy.result_cache_ttl:type_name -> google.protobuf.Duration
+	10, // 6: temporal.server.api.persistence.v1.NexusEndpointPolicy.result_cache_key:type_name -> temporal.server.api.enums.v1.NexusResultCacheKey
+	5,  // 7: temporal.server.api.persistence.v1.NexusEndpointTarget.worker:type_name -> temporal.server.api.persistence.v1.NexusEndpointTarget.Worker
+	6,  // 8: temporal.server.api.persistence.v1.NexusEndpointTarget.external:type_name -> temporal.server.api.persistence.v1.NexusEndpointTarget.External
+	11, // 9: temporal.server.api.persistence.v1.NexusEndpoint.clock:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
+	0,  // 10: temporal.server.api.persistence.v1.NexusEndpoint.spec:type_name -> temporal.server.api.persistence.v1.NexusEndpointSpec
+	12, // 11: temporal.server.api.persistence.v1.NexusEndpoint.created_time:type_name -> google.protobuf.Timestamp
+	3,  // 12: temporal.server.api.persistence.v1.NexusEndpointEntry.endpoint:type_name -> temporal.server.api.persistence.v1.NexusEndpoint
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_persistence_v1_nexus_proto_init() }
@@ -605,7 +755,7 @@ func file_temporal_server_api_persistence_v1_nexus_proto_init() {
 			}
 		}
 		file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NexusEndpointTarget); i {
+			switch v := v.(*NexusEndpointPolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -617,7 +767,7 @@ func file_temporal_server_api_persistence_v1_nexus_proto_init() {
 			}
 		}
 		file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NexusEndpoint); i {
+			switch v := v.(*NexusEndpointTarget); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -629,7 +779,7 @@ func file_temporal_server_api_persistence_v1_nexus_proto_init() {
 			}
 		}
 		file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NexusEndpointEntry); i {
+			switch v := v.(*NexusEndpoint); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -641,7 +791,7 @@ func file_temporal_server_api_persistence_v1_nexus_proto_init() {
 			}
 		}
 		file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NexusEndpointTarget_Worker); i {
+			switch v := v.(*NexusEndpointEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -653,6 +803,18 @@ func file_temporal_server_api_persistence_v1_nexus_proto_init() {
 			}
 		}
 		file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NexusEndpointTarget_Worker); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NexusEndpointTarget_External); i {
 			case 0:
 				return &v.state
@@ -665,7 +827,7 @@ func file_temporal_server_api_persistence_v1_nexus_proto_init() {
 			}
 		}
 	}
-	file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[1].OneofWrappers = []interface{}{
+	file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[2].OneofWrappers = []interface{}{
 		(*NexusEndpointTarget_Worker_)(nil),
 		(*NexusEndpointTarget_External_)(nil),
 	}
@@ -675,7 +837,7 @@ func file_temporal_server_api_persistence_v1_nexus_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_temporal_server_api_persistence_v1_nexus_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -251,6 +251,43 @@ func (this *DeploymentData) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type AdaptivePartitions to the protobuf v3 wire format
+func (val *AdaptivePartitions) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type AdaptivePartitions from the protobuf v3 wire format
+func (val *AdaptivePartitions) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *AdaptivePartitions) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two AdaptivePartitions values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *AdaptivePartitions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *AdaptivePartitions
+	switch t := that.(type) {
+	case *AdaptivePartitions:
+		that1 = t
+	case AdaptivePartitions:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type TaskQueueTypeUserData to the protobuf v3 wire format
 func (val *TaskQueueTypeUserData) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
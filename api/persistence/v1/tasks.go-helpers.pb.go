@@ -103,6 +103,43 @@ func (this *TaskInfo) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type DeadLetteredTask to the protobuf v3 wire format
+func (val *DeadLetteredTask) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeadLetteredTask from the protobuf v3 wire format
+func (val *DeadLetteredTask) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeadLetteredTask) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeadLetteredTask values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeadLetteredTask) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeadLetteredTask
+	switch t := that.(type) {
+	case *DeadLetteredTask:
+		that1 = t
+	case DeadLetteredTask:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type TaskQueueInfo to the protobuf v3 wire format
 func (val *TaskQueueInfo) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -140,6 +177,43 @@ func (this *TaskQueueInfo) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type StickyHandoff to the protobuf v3 wire format
+func (val *StickyHandoff) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StickyHandoff from the protobuf v3 wire format
+func (val *StickyHandoff) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StickyHandoff) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StickyHandoff values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StickyHandoff) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StickyHandoff
+	switch t := that.(type) {
+	case *StickyHandoff:
+		that1 = t
+	case StickyHandoff:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type SubqueueInfo to the protobuf v3 wire format
 func (val *SubqueueInfo) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -103,6 +103,635 @@ func (this *InternalState) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type SetConcurrencyGroupRequest to the protobuf v3 wire format
+func (val *SetConcurrencyGroupRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SetConcurrencyGroupRequest from the protobuf v3 wire format
+func (val *SetConcurrencyGroupRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SetConcurrencyGroupRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SetConcurrencyGroupRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SetConcurrencyGroupRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SetConcurrencyGroupRequest
+	switch t := that.(type) {
+	case *SetConcurrencyGroupRequest:
+		that1 = t
+	case SetConcurrencyGroupRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ConcurrencyGroupAcquireRequest to the protobuf v3 wire format
+func (val *ConcurrencyGroupAcquireRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ConcurrencyGroupAcquireRequest from the protobuf v3 wire format
+func (val *ConcurrencyGroupAcquireRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ConcurrencyGroupAcquireRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ConcurrencyGroupAcquireRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ConcurrencyGroupAcquireRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ConcurrencyGroupAcquireRequest
+	switch t := that.(type) {
+	case *ConcurrencyGroupAcquireRequest:
+		that1 = t
+	case ConcurrencyGroupAcquireRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ConcurrencyGroupReleaseRequest to the protobuf v3 wire format
+func (val *ConcurrencyGroupReleaseRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ConcurrencyGroupReleaseRequest from the protobuf v3 wire format
+func (val *ConcurrencyGroupReleaseRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ConcurrencyGroupReleaseRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ConcurrencyGroupReleaseRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ConcurrencyGroupReleaseRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ConcurrencyGroupReleaseRequest
+	switch t := that.(type) {
+	case *ConcurrencyGroupReleaseRequest:
+		that1 = t
+	case ConcurrencyGroupReleaseRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ConcurrencyGroupState to the protobuf v3 wire format
+func (val *ConcurrencyGroupState) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ConcurrencyGroupState from the protobuf v3 wire format
+func (val *ConcurrencyGroupState) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ConcurrencyGroupState) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ConcurrencyGroupState values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ConcurrencyGroupState) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ConcurrencyGroupState
+	switch t := that.(type) {
+	case *ConcurrencyGroupState:
+		that1 = t
+	case ConcurrencyGroupState:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type FindDeletedSchedulesRequest to the protobuf v3 wire format
+func (val *FindDeletedSchedulesRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type FindDeletedSchedulesRequest from the protobuf v3 wire format
+func (val *FindDeletedSchedulesRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *FindDeletedSchedulesRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two FindDeletedSchedulesRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *FindDeletedSchedulesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *FindDeletedSchedulesRequest
+	switch t := that.(type) {
+	case *FindDeletedSchedulesRequest:
+		that1 = t
+	case FindDeletedSchedulesRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type FindDeletedSchedulesResponse to the protobuf v3 wire format
+func (val *FindDeletedSchedulesResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type FindDeletedSchedulesResponse from the protobuf v3 wire format
+func (val *FindDeletedSchedulesResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *FindDeletedSchedulesResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two FindDeletedSchedulesResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *FindDeletedSchedulesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *FindDeletedSchedulesResponse
+	switch t := that.(type) {
+	case *FindDeletedSchedulesResponse:
+		that1 = t
+	case FindDeletedSchedulesResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SignalConcurrencyGroupRequest to the protobuf v3 wire format
+func (val *SignalConcurrencyGroupRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SignalConcurrencyGroupRequest from the protobuf v3 wire format
+func (val *SignalConcurrencyGroupRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SignalConcurrencyGroupRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SignalConcurrencyGroupRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SignalConcurrencyGroupRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SignalConcurrencyGroupRequest
+	switch t := that.(type) {
+	case *SignalConcurrencyGroupRequest:
+		that1 = t
+	case SignalConcurrencyGroupRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ScheduleSla to the protobuf v3 wire format
+func (val *ScheduleSla) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ScheduleSla from the protobuf v3 wire format
+func (val *ScheduleSla) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ScheduleSla) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ScheduleSla values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ScheduleSla) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ScheduleSla
+	switch t := that.(type) {
+	case *ScheduleSla:
+		that1 = t
+	case ScheduleSla:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SetSlaRequest to the protobuf v3 wire format
+func (val *SetSlaRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SetSlaRequest from the protobuf v3 wire format
+func (val *SetSlaRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SetSlaRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SetSlaRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SetSlaRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SetSlaRequest
+	switch t := that.(type) {
+	case *SetSlaRequest:
+		that1 = t
+	case SetSlaRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SlaViolation to the protobuf v3 wire format
+func (val *SlaViolation) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SlaViolation from the protobuf v3 wire format
+func (val *SlaViolation) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SlaViolation) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SlaViolation values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SlaViolation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SlaViolation
+	switch t := that.(type) {
+	case *SlaViolation:
+		that1 = t
+	case SlaViolation:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type NotifySlaViolationRequest to the protobuf v3 wire format
+func (val *NotifySlaViolationRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type NotifySlaViolationRequest from the protobuf v3 wire format
+func (val *NotifySlaViolationRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *NotifySlaViolationRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two NotifySlaViolationRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *NotifySlaViolationRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *NotifySlaViolationRequest
+	switch t := that.(type) {
+	case *NotifySlaViolationRequest:
+		that1 = t
+	case NotifySlaViolationRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ScheduleDependency to the protobuf v3 wire format
+func (val *ScheduleDependency) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ScheduleDependency from the protobuf v3 wire format
+func (val *ScheduleDependency) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ScheduleDependency) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ScheduleDependency values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ScheduleDependency) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ScheduleDependency
+	switch t := that.(type) {
+	case *ScheduleDependency:
+		that1 = t
+	case ScheduleDependency:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SetDependenciesRequest to the protobuf v3 wire format
+func (val *SetDependenciesRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SetDependenciesRequest from the protobuf v3 wire format
+func (val *SetDependenciesRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SetDependenciesRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SetDependenciesRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SetDependenciesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SetDependenciesRequest
+	switch t := that.(type) {
+	case *SetDependenciesRequest:
+		that1 = t
+	case SetDependenciesRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RegisterDependentRequest to the protobuf v3 wire format
+func (val *RegisterDependentRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RegisterDependentRequest from the protobuf v3 wire format
+func (val *RegisterDependentRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RegisterDependentRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RegisterDependentRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RegisterDependentRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RegisterDependentRequest
+	switch t := that.(type) {
+	case *RegisterDependentRequest:
+		that1 = t
+	case RegisterDependentRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpstreamActionCompleted to the protobuf v3 wire format
+func (val *UpstreamActionCompleted) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpstreamActionCompleted from the protobuf v3 wire format
+func (val *UpstreamActionCompleted) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpstreamActionCompleted) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpstreamActionCompleted values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpstreamActionCompleted) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpstreamActionCompleted
+	switch t := that.(type) {
+	case *UpstreamActionCompleted:
+		that1 = t
+	case UpstreamActionCompleted:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SetSharedExcludeCalendarsRequest to the protobuf v3 wire format
+func (val *SetSharedExcludeCalendarsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SetSharedExcludeCalendarsRequest from the protobuf v3 wire format
+func (val *SetSharedExcludeCalendarsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SetSharedExcludeCalendarsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SetSharedExcludeCalendarsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SetSharedExcludeCalendarsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SetSharedExcludeCalendarsRequest
+	switch t := that.(type) {
+	case *SetSharedExcludeCalendarsRequest:
+		that1 = t
+	case SetSharedExcludeCalendarsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SharedCalendar to the protobuf v3 wire format
+func (val *SharedCalendar) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SharedCalendar from the protobuf v3 wire format
+func (val *SharedCalendar) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SharedCalendar) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SharedCalendar values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SharedCalendar) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SharedCalendar
+	switch t := that.(type) {
+	case *SharedCalendar:
+		that1 = t
+	case SharedCalendar:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type StartScheduleArgs to the protobuf v3 wire format
 func (val *StartScheduleArgs) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -287,3 +287,40 @@ func (this *TaskForwardInfo) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type PartitionCounts to the protobuf v3 wire format
+func (val *PartitionCounts) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PartitionCounts from the protobuf v3 wire format
+func (val *PartitionCounts) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PartitionCounts) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PartitionCounts values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PartitionCounts) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PartitionCounts
+	switch t := that.(type) {
+	case *PartitionCounts:
+		that1 = t
+	case PartitionCounts:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
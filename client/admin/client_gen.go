@@ -143,6 +143,26 @@ func (c *clientImpl) DescribeMutableState(
 	return c.client.DescribeMutableState(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeMutableStateCache(
+	ctx context.Context,
+	request *adminservice.DescribeMutableStateCacheRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeMutableStateCacheResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeMutableStateCache(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeSchedule(
+	ctx context.Context,
+	request *adminservice.DescribeScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeScheduleResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeSchedule(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.DescribeTaskQueuePartitionRequest,
@@ -153,6 +173,26 @@ func (c *clientImpl) DescribeTaskQueuePartition(
 	return c.client.DescribeTaskQueuePartition(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeWorkflowCallbacks(
+	ctx context.Context,
+	request *adminservice.DescribeWorkflowCallbacksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeWorkflowCallbacksResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeWorkflowCallbacks(ctx, request, opts...)
+}
+
+func (c *clientImpl) DiffWorkflowExecutionHistories(
+	ctx context.Context,
+	request *adminservice.DiffWorkflowExecutionHistoriesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DiffWorkflowExecutionHistoriesResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DiffWorkflowExecutionHistories(ctx, request, opts...)
+}
+
 func (c *clientImpl) ForceUnloadTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.ForceUnloadTaskQueuePartitionRequest,
@@ -203,6 +243,16 @@ func (c *clientImpl) GetDLQTasks(
 	return c.client.GetDLQTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) GetMatchingDLQTasks(
+	ctx context.Context,
+	request *adminservice.GetMatchingDLQTasksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetMatchingDLQTasksResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetMatchingDLQTasks(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetNamespace(
 	ctx context.Context,
 	request *adminservice.GetNamespaceRequest,
@@ -223,6 +273,16 @@ func (c *clientImpl) GetNamespaceReplicationMessages(
 	return c.client.GetNamespaceReplicationMessages(ctx, request, opts...)
 }
 
+func (c *clientImpl) GetNexusEndpointPolicy(
+	ctx context.Context,
+	request *adminservice.GetNexusEndpointPolicyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetNexusEndpointPolicyResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetNexusEndpointPolicy(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetReplicationMessages(
 	ctx context.Context,
 	request *adminservice.GetReplicationMessagesRequest,
@@ -353,6 +413,16 @@ func (c *clientImpl) MergeDLQTasks(
 	return c.client.MergeDLQTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.PauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PauseWorkflowExecutionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.PauseWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *clientImpl) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -393,6 +463,16 @@ func (c *clientImpl) RebuildMutableState(
 	return c.client.RebuildMutableState(ctx, request, opts...)
 }
 
+func (c *clientImpl) ReenqueueMatchingDLQTasks(
+	ctx context.Context,
+	request *adminservice.ReenqueueMatchingDLQTasksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ReenqueueMatchingDLQTasksResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ReenqueueMatchingDLQTasks(ctx, request, opts...)
+}
+
 func (c *clientImpl) RefreshWorkflowTasks(
 	ctx context.Context,
 	request *adminservice.RefreshWorkflowTasksRequest,
@@ -443,6 +523,56 @@ func (c *clientImpl) ResendReplicationTasks(
 	return c.client.ResendReplicationTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) ResetWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ResetWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ResetWorkflowExecutionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ResetWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) SetScheduleConcurrencyGroup(
+	ctx context.Context,
+	request *adminservice.SetScheduleConcurrencyGroupRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetScheduleConcurrencyGroupResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.SetScheduleConcurrencyGroup(ctx, request, opts...)
+}
+
+func (c *clientImpl) SetScheduleDependencies(
+	ctx context.Context,
+	request *adminservice.SetScheduleDependenciesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetScheduleDependenciesResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.SetScheduleDependencies(ctx, request, opts...)
+}
+
+func (c *clientImpl) SetScheduleSharedCalendars(
+	ctx context.Context,
+	request *adminservice.SetScheduleSharedCalendarsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetScheduleSharedCalendarsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.SetScheduleSharedCalendars(ctx, request, opts...)
+}
+
+func (c *clientImpl) SetScheduleSla(
+	ctx context.Context,
+	request *adminservice.SetScheduleSlaRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetScheduleSlaResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.SetScheduleSla(ctx, request, opts...)
+}
+
 func (c *clientImpl) SyncWorkflowState(
 	ctx context.Context,
 	request *adminservice.SyncWorkflowStateRequest,
@@ -452,3 +582,33 @@ func (c *clientImpl) SyncWorkflowState(
 	defer cancel()
 	return c.client.SyncWorkflowState(ctx, request, opts...)
 }
+
+func (c *clientImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateNexusEndpointPolicy(
+	ctx context.Context,
+	request *adminservice.UpdateNexusEndpointPolicyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateNexusEndpointPolicyResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateNexusEndpointPolicy(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateWorkflowCallback(
+	ctx context.Context,
+	request *adminservice.UpdateWorkflowCallbackRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateWorkflowCallbackResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateWorkflowCallback(ctx, request, opts...)
+}
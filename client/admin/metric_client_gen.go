@@ -187,6 +187,34 @@ func (c *metricClient) DescribeMutableState(
 	return c.client.DescribeMutableState(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeMutableStateCache(
+	ctx context.Context,
+	request *adminservice.DescribeMutableStateCacheRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeMutableStateCacheResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeMutableStateCache")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeMutableStateCache(ctx, request, opts...)
+}
+
+func (c *metricClient) DescribeSchedule(
+	ctx context.Context,
+	request *adminservice.DescribeScheduleRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeScheduleResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeSchedule")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeSchedule(ctx, request, opts...)
+}
+
 func (c *metricClient) DescribeTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.DescribeTaskQueuePartitionRequest,
@@ -201,6 +229,34 @@ func (c *metricClient) DescribeTaskQueuePartition(
 	return c.client.DescribeTaskQueuePartition(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeWorkflowCallbacks(
+	ctx context.Context,
+	request *adminservice.DescribeWorkflowCallbacksRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeWorkflowCallbacksResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeWorkflowCallbacks")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeWorkflowCallbacks(ctx, request, opts...)
+}
+
+func (c *metricClient) DiffWorkflowExecutionHistories(
+	ctx context.Context,
+	request *adminservice.DiffWorkflowExecutionHistoriesRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DiffWorkflowExecutionHistoriesResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDiffWorkflowExecutionHistories")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DiffWorkflowExecutionHistories(ctx, request, opts...)
+}
+
 func (c *metricClient) ForceUnloadTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.ForceUnloadTaskQueuePartitionRequest,
@@ -271,6 +327,20 @@ func (c *metricClient) GetDLQTasks(
 	return c.client.GetDLQTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) GetMatchingDLQTasks(
+	ctx context.Context,
+	request *adminservice.GetMatchingDLQTasksRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.GetMatchingDLQTasksResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientGetMatchingDLQTasks")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetMatchingDLQTasks(ctx, request, opts...)
+}
+
 func (c *metricClient) GetNamespace(
 	ctx context.Context,
 	request *adminservice.GetNamespaceRequest,
@@ -299,6 +369,20 @@ func (c *metricClient) GetNamespaceReplicationMessages(
 	return c.client.GetNamespaceReplicationMessages(ctx, request, opts...)
 }
 
+func (c *metricClient) GetNexusEndpointPolicy(
+	ctx context.Context,
+	request *adminservice.GetNexusEndpointPolicyRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.GetNexusEndpointPolicyResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientGetNexusEndpointPolicy")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetNexusEndpointPolicy(ctx, request, opts...)
+}
+
 func (c *metricClient) GetReplicationMessages(
 	ctx context.Context,
 	request *adminservice.GetReplicationMessagesRequest,
@@ -481,6 +565,20 @@ func (c *metricClient) MergeDLQTasks(
 	return c.client.MergeDLQTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.PauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.PauseWorkflowExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientPauseWorkflowExecution")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.PauseWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *metricClient) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -537,6 +635,20 @@ func (c *metricClient) RebuildMutableState(
 	return c.client.RebuildMutableState(ctx, request, opts...)
 }
 
+func (c *metricClient) ReenqueueMatchingDLQTasks(
+	ctx context.Context,
+	request *adminservice.ReenqueueMatchingDLQTasksRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ReenqueueMatchingDLQTasksResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientReenqueueMatchingDLQTasks")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ReenqueueMatchingDLQTasks(ctx, request, opts...)
+}
+
 func (c *metricClient) RefreshWorkflowTasks(
 	ctx context.Context,
 	request *adminservice.RefreshWorkflowTasksRequest,
@@ -607,6 +719,76 @@ func (c *metricClient) ResendReplicationTasks(
 	return c.client.ResendReplicationTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) ResetWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ResetWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ResetWorkflowExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientResetWorkflowExecution")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ResetWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *metricClient) SetScheduleConcurrencyGroup(
+	ctx context.Context,
+	request *adminservice.SetScheduleConcurrencyGroupRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.SetScheduleConcurrencyGroupResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientSetScheduleConcurrencyGroup")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.SetScheduleConcurrencyGroup(ctx, request, opts...)
+}
+
+func (c *metricClient) SetScheduleDependencies(
+	ctx context.Context,
+	request *adminservice.SetScheduleDependenciesRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.SetScheduleDependenciesResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientSetScheduleDependencies")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.SetScheduleDependencies(ctx, request, opts...)
+}
+
+func (c *metricClient) SetScheduleSharedCalendars(
+	ctx context.Context,
+	request *adminservice.SetScheduleSharedCalendarsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.SetScheduleSharedCalendarsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientSetScheduleSharedCalendars")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.SetScheduleSharedCalendars(ctx, request, opts...)
+}
+
+func (c *metricClient) SetScheduleSla(
+	ctx context.Context,
+	request *adminservice.SetScheduleSlaRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.SetScheduleSlaResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientSetScheduleSla")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.SetScheduleSla(ctx, request, opts...)
+}
+
 func (c *metricClient) SyncWorkflowState(
 	ctx context.Context,
 	request *adminservice.SyncWorkflowStateRequest,
@@ -620,3 +802,45 @@ func (c *metricClient) SyncWorkflowState(
 
 	return c.client.SyncWorkflowState(ctx, request, opts...)
 }
+
+func (c *metricClient) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UnpauseWorkflowExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientUnpauseWorkflowExecution")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *metricClient) UpdateNexusEndpointPolicy(
+	ctx context.Context,
+	request *adminservice.UpdateNexusEndpointPolicyRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UpdateNexusEndpointPolicyResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientUpdateNexusEndpointPolicy")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateNexusEndpointPolicy(ctx, request, opts...)
+}
+
+func (c *metricClient) UpdateWorkflowCallback(
+	ctx context.Context,
+	request *adminservice.UpdateWorkflowCallbackRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UpdateWorkflowCallbackResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientUpdateWorkflowCallback")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateWorkflowCallback(ctx, request, opts...)
+}
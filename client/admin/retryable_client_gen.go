@@ -200,6 +200,36 @@ func (c *retryableClient) DescribeMutableState(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeMutableStateCache(
+	ctx context.Context,
+	request *adminservice.DescribeMutableStateCacheRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeMutableStateCacheResponse, error) {
+	var resp *adminservice.DescribeMutableStateCacheResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeMutableStateCache(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeSchedule(
+	ctx context.Context,
+	request *adminservice.DescribeScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeScheduleResponse, error) {
+	var resp *adminservice.DescribeScheduleResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeSchedule(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.DescribeTaskQueuePartitionRequest,
@@ -215,6 +245,36 @@ func (c *retryableClient) DescribeTaskQueuePartition(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeWorkflowCallbacks(
+	ctx context.Context,
+	request *adminservice.DescribeWorkflowCallbacksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeWorkflowCallbacksResponse, error) {
+	var resp *adminservice.DescribeWorkflowCallbacksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeWorkflowCallbacks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DiffWorkflowExecutionHistories(
+	ctx context.Context,
+	request *adminservice.DiffWorkflowExecutionHistoriesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DiffWorkflowExecutionHistoriesResponse, error) {
+	var resp *adminservice.DiffWorkflowExecutionHistoriesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DiffWorkflowExecutionHistories(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ForceUnloadTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.ForceUnloadTaskQueuePartitionRequest,
@@ -290,6 +350,21 @@ func (c *retryableClient) GetDLQTasks(
 	return resp, err
 }
 
+func (c *retryableClient) GetMatchingDLQTasks(
+	ctx context.Context,
+	request *adminservice.GetMatchingDLQTasksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetMatchingDLQTasksResponse, error) {
+	var resp *adminservice.GetMatchingDLQTasksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetMatchingDLQTasks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetNamespace(
 	ctx context.Context,
 	request *adminservice.GetNamespaceRequest,
@@ -320,6 +395,21 @@ func (c *retryableClient) GetNamespaceReplicationMessages(
 	return resp, err
 }
 
+func (c *retryableClient) GetNexusEndpointPolicy(
+	ctx context.Context,
+	request *adminservice.GetNexusEndpointPolicyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetNexusEndpointPolicyResponse, error) {
+	var resp *adminservice.GetNexusEndpointPolicyResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetNexusEndpointPolicy(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetReplicationMessages(
 	ctx context.Context,
 	request *adminservice.GetReplicationMessagesRequest,
@@ -515,6 +605,21 @@ func (c *retryableClient) MergeDLQTasks(
 	return resp, err
 }
 
+func (c *retryableClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.PauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PauseWorkflowExecutionResponse, error) {
+	var resp *adminservice.PauseWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.PauseWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -575,6 +680,21 @@ func (c *retryableClient) RebuildMutableState(
 	return resp, err
 }
 
+func (c *retryableClient) ReenqueueMatchingDLQTasks(
+	ctx context.Context,
+	request *adminservice.ReenqueueMatchingDLQTasksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ReenqueueMatchingDLQTasksResponse, error) {
+	var resp *adminservice.ReenqueueMatchingDLQTasksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ReenqueueMatchingDLQTasks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) RefreshWorkflowTasks(
 	ctx context.Context,
 	request *adminservice.RefreshWorkflowTasksRequest,
@@ -650,6 +770,81 @@ func (c *retryableClient) ResendReplicationTasks(
 	return resp, err
 }
 
+func (c *retryableClient) ResetWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ResetWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ResetWorkflowExecutionResponse, error) {
+	var resp *adminservice.ResetWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ResetWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) SetScheduleConcurrencyGroup(
+	ctx context.Context,
+	request *adminservice.SetScheduleConcurrencyGroupRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetScheduleConcurrencyGroupResponse, error) {
+	var resp *adminservice.SetScheduleConcurrencyGroupResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.SetScheduleConcurrencyGroup(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) SetScheduleDependencies(
+	ctx context.Context,
+	request *adminservice.SetScheduleDependenciesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetScheduleDependenciesResponse, error) {
+	var resp *adminservice.SetScheduleDependenciesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.SetScheduleDependencies(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) SetScheduleSharedCalendars(
+	ctx context.Context,
+	request *adminservice.SetScheduleSharedCalendarsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetScheduleSharedCalendarsResponse, error) {
+	var resp *adminservice.SetScheduleSharedCalendarsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.SetScheduleSharedCalendars(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) SetScheduleSla(
+	ctx context.Context,
+	request *adminservice.SetScheduleSlaRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetScheduleSlaResponse, error) {
+	var resp *adminservice.SetScheduleSlaResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.SetScheduleSla(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) SyncWorkflowState(
 	ctx context.Context,
 	request *adminservice.SyncWorkflowStateRequest,
@@ -664,3 +859,48 @@ func (c *retryableClient) SyncWorkflowState(
 	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+	var resp *adminservice.UnpauseWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateNexusEndpointPolicy(
+	ctx context.Context,
+	request *adminservice.UpdateNexusEndpointPolicyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateNexusEndpointPolicyResponse, error) {
+	var resp *adminservice.UpdateNexusEndpointPolicyResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateNexusEndpointPolicy(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateWorkflowCallback(
+	ctx context.Context,
+	request *adminservice.UpdateWorkflowCallbackRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateWorkflowCallbackResponse, error) {
+	var resp *adminservice.UpdateWorkflowCallbackResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateWorkflowCallback(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
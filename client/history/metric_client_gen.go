@@ -159,6 +159,34 @@ func (c *metricClient) DescribeMutableState(
 	return c.client.DescribeMutableState(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeMutableStateCache(
+	ctx context.Context,
+	request *historyservice.DescribeMutableStateCacheRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.DescribeMutableStateCacheResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientDescribeMutableStateCache")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeMutableStateCache(ctx, request, opts...)
+}
+
+func (c *metricClient) DescribeWorkflowCallbacks(
+	ctx context.Context,
+	request *historyservice.DescribeWorkflowCallbacksRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.DescribeWorkflowCallbacksResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientDescribeWorkflowCallbacks")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeWorkflowCallbacks(ctx, request, opts...)
+}
+
 func (c *metricClient) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.DescribeWorkflowExecutionRequest,
@@ -481,6 +509,20 @@ func (c *metricClient) PauseActivity(
 	return c.client.PauseActivity(ctx, request, opts...)
 }
 
+func (c *metricClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.PauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.PauseWorkflowExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientPauseWorkflowExecution")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.PauseWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *metricClient) PollMutableState(
 	ctx context.Context,
 	request *historyservice.PollMutableStateRequest,
@@ -607,6 +649,20 @@ func (c *metricClient) RecordChildExecutionCompleted(
 	return c.client.RecordChildExecutionCompleted(ctx, request, opts...)
 }
 
+func (c *metricClient) RecordChildExecutionProgress(
+	ctx context.Context,
+	request *historyservice.RecordChildExecutionProgressRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.RecordChildExecutionProgressResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientRecordChildExecutionProgress")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.RecordChildExecutionProgress(ctx, request, opts...)
+}
+
 func (c *metricClient) RecordWorkflowTaskStarted(
 	ctx context.Context,
 	request *historyservice.RecordWorkflowTaskStartedRequest,
@@ -943,6 +999,20 @@ func (c *metricClient) UnpauseActivity(
 	return c.client.UnpauseActivity(ctx, request, opts...)
 }
 
+func (c *metricClient) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.UnpauseWorkflowExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientUnpauseWorkflowExecution")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *metricClient) UpdateActivityOptions(
 	ctx context.Context,
 	request *historyservice.UpdateActivityOptionsRequest,
@@ -957,6 +1027,20 @@ func (c *metricClient) UpdateActivityOptions(
 	return c.client.UpdateActivityOptions(ctx, request, opts...)
 }
 
+func (c *metricClient) UpdateWorkflowCallback(
+	ctx context.Context,
+	request *historyservice.UpdateWorkflowCallbackRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.UpdateWorkflowCallbackResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientUpdateWorkflowCallback")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateWorkflowCallback(ctx, request, opts...)
+}
+
 func (c *metricClient) UpdateWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.UpdateWorkflowExecutionRequest,
@@ -170,6 +170,36 @@ func (c *retryableClient) DescribeMutableState(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeMutableStateCache(
+	ctx context.Context,
+	request *historyservice.DescribeMutableStateCacheRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DescribeMutableStateCacheResponse, error) {
+	var resp *historyservice.DescribeMutableStateCacheResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeMutableStateCache(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeWorkflowCallbacks(
+	ctx context.Context,
+	request *historyservice.DescribeWorkflowCallbacksRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DescribeWorkflowCallbacksResponse, error) {
+	var resp *historyservice.DescribeWorkflowCallbacksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeWorkflowCallbacks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.DescribeWorkflowExecutionRequest,
@@ -515,6 +545,21 @@ func (c *retryableClient) PauseActivity(
 	return resp, err
 }
 
+func (c *retryableClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.PauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.PauseWorkflowExecutionResponse, error) {
+	var resp *historyservice.PauseWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.PauseWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) PollMutableState(
 	ctx context.Context,
 	request *historyservice.PollMutableStateRequest,
@@ -650,6 +695,21 @@ func (c *retryableClient) RecordChildExecutionCompleted(
 	return resp, err
 }
 
+func (c *retryableClient) RecordChildExecutionProgress(
+	ctx context.Context,
+	request *historyservice.RecordChildExecutionProgressRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.RecordChildExecutionProgressResponse, error) {
+	var resp *historyservice.RecordChildExecutionProgressResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RecordChildExecutionProgress(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) RecordWorkflowTaskStarted(
 	ctx context.Context,
 	request *historyservice.RecordWorkflowTaskStartedRequest,
@@ -1010,6 +1070,21 @@ func (c *retryableClient) UnpauseActivity(
 	return resp, err
 }
 
+func (c *retryableClient) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UnpauseWorkflowExecutionResponse, error) {
+	var resp *historyservice.UnpauseWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) UpdateActivityOptions(
 	ctx context.Context,
 	request *historyservice.UpdateActivityOptionsRequest,
@@ -1025,6 +1100,21 @@ func (c *retryableClient) UpdateActivityOptions(
 	return resp, err
 }
 
+func (c *retryableClient) UpdateWorkflowCallback(
+	ctx context.Context,
+	request *historyservice.UpdateWorkflowCallbackRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UpdateWorkflowCallbackResponse, error) {
+	var resp *historyservice.UpdateWorkflowCallbackResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateWorkflowCallback(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) UpdateWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.UpdateWorkflowExecutionRequest,
@@ -34,6 +34,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/debug"
 	"go.temporal.io/server/common/log"
@@ -84,7 +85,7 @@ func (c *clientImpl) AddActivityTask(
 	ctx context.Context,
 	request *matchingservice.AddActivityTaskRequest,
 	opts ...grpc.CallOption) (*matchingservice.AddActivityTaskResponse, error) {
-	client, err := c.pickClientForWrite(
+	client, tq, err := c.pickClientForWrite(
 		request.GetTaskQueue(),
 		request.GetNamespaceId(),
 		enumspb.TASK_QUEUE_TYPE_ACTIVITY,
@@ -94,14 +95,16 @@ func (c *clientImpl) AddActivityTask(
 	}
 	ctx, cancel := c.createContext(ctx)
 	defer cancel()
-	return client.AddActivityTask(ctx, request, opts...)
+	resp, err := client.AddActivityTask(ctx, request, opts...)
+	c.updatePartitionCounts(tq, resp.GetPartitionCounts())
+	return resp, err
 }
 
 func (c *clientImpl) AddWorkflowTask(
 	ctx context.Context,
 	request *matchingservice.AddWorkflowTaskRequest,
 	opts ...grpc.CallOption) (*matchingservice.AddWorkflowTaskResponse, error) {
-	client, err := c.pickClientForWrite(
+	client, tq, err := c.pickClientForWrite(
 		request.GetTaskQueue(),
 		request.GetNamespaceId(),
 		enumspb.TASK_QUEUE_TYPE_WORKFLOW,
@@ -111,14 +114,16 @@ func (c *clientImpl) AddWorkflowTask(
 	}
 	ctx, cancel := c.createContext(ctx)
 	defer cancel()
-	return client.AddWorkflowTask(ctx, request, opts...)
+	resp, err := client.AddWorkflowTask(ctx, request, opts...)
+	c.updatePartitionCounts(tq, resp.GetPartitionCounts())
+	return resp, err
 }
 
 func (c *clientImpl) PollActivityTaskQueue(
 	ctx context.Context,
 	request *matchingservice.PollActivityTaskQueueRequest,
 	opts ...grpc.CallOption) (*matchingservice.PollActivityTaskQueueResponse, error) {
-	client, release, err := c.pickClientForRead(
+	client, tq, release, err := c.pickClientForRead(
 		request.GetPollRequest().GetTaskQueue(),
 		request.GetNamespaceId(),
 		enumspb.TASK_QUEUE_TYPE_ACTIVITY,
@@ -131,14 +136,16 @@ func (c *clientImpl) PollActivityTaskQueue(
 	}
 	ctx, cancel := c.createLongPollContext(ctx)
 	defer cancel()
-	return client.PollActivityTaskQueue(ctx, request, opts...)
+	resp, err := client.PollActivityTaskQueue(ctx, request, opts...)
+	c.updatePartitionCounts(tq, resp.GetPartitionCounts())
+	return resp, err
 }
 
 func (c *clientImpl) PollWorkflowTaskQueue(
 	ctx context.Context,
 	request *matchingservice.PollWorkflowTaskQueueRequest,
 	opts ...grpc.CallOption) (*matchingservice.PollWorkflowTaskQueueResponse, error) {
-	client, release, err := c.pickClientForRead(
+	client, tq, release, err := c.pickClientForRead(
 		request.GetPollRequest().GetTaskQueue(),
 		request.GetNamespaceId(),
 		enumspb.TASK_QUEUE_TYPE_WORKFLOW,
@@ -151,11 +158,13 @@ func (c *clientImpl) PollWorkflowTaskQueue(
 	}
 	ctx, cancel := c.createLongPollContext(ctx)
 	defer cancel()
-	return client.PollWorkflowTaskQueue(ctx, request, opts...)
+	resp, err := client.PollWorkflowTaskQueue(ctx, request, opts...)
+	c.updatePartitionCounts(tq, resp.GetPartitionCounts())
+	return resp, err
 }
 
 func (c *clientImpl) QueryWorkflow(ctx context.Context, request *matchingservice.QueryWorkflowRequest, opts ...grpc.CallOption) (*matchingservice.QueryWorkflowResponse, error) {
-	client, err := c.pickClientForWrite(request.GetTaskQueue(), request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_WORKFLOW, request.GetForwardInfo().GetSourcePartition())
+	client, _, err := c.pickClientForWrite(request.GetTaskQueue(), request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_WORKFLOW, request.GetForwardInfo().GetSourcePartition())
 	if err != nil {
 		return nil, err
 	}
@@ -188,17 +197,23 @@ func (c *clientImpl) processInputPartition(proto *taskqueuepb.TaskQueue, nsid st
 	}
 }
 
-func (c *clientImpl) pickClientForWrite(proto *taskqueuepb.TaskQueue, nsid string, taskType enumspb.TaskQueueType, forwardedFrom string) (matchingservice.MatchingServiceClient, error) {
+// pickClientForWrite returns the client for the partition to write to, and the task queue if the partition was picked
+// by the load balancer.
+func (c *clientImpl) pickClientForWrite(proto *taskqueuepb.TaskQueue, nsid string, taskType enumspb.TaskQueueType, forwardedFrom string) (matchingservice.MatchingServiceClient, *tqid.TaskQueue, error) {
 	p, tq := c.processInputPartition(proto, nsid, taskType, forwardedFrom)
 	if tq != nil {
 		p = c.loadBalancer.PickWritePartition(tq)
 	}
 	proto.Name = p.RpcName()
-	return c.getClientForTaskQueuePartition(p)
+	client, err := c.getClientForTaskQueuePartition(p)
+	return client, tq, err
 }
 
-func (c *clientImpl) pickClientForRead(proto *taskqueuepb.TaskQueue, nsid string, taskType enumspb.TaskQueueType, forwardedFrom string) (client matchingservice.MatchingServiceClient, release func(), err error) {
-	p, tq := c.processInputPartition(proto, nsid, taskType, forwardedFrom)
+// pickClientForRead returns the client for the partition to read from, and the task queue if the partition was picked
+// by the load balancer.
+func (c *clientImpl) pickClientForRead(proto *taskqueuepb.TaskQueue, nsid string, taskType enumspb.TaskQueueType, forwardedFrom string) (client matchingservice.MatchingServiceClient, tq *tqid.TaskQueue, release func(), err error) {
+	var p tqid.Partition
+	p, tq = c.processInputPartition(proto, nsid, taskType, forwardedFrom)
 	if tq != nil {
 		token := c.loadBalancer.PickReadPartition(tq)
 		p = token.TQPartition
@@ -207,7 +222,14 @@ func (c *clientImpl) pickClientForRead(proto *taskqueuepb.TaskQueue, nsid string
 
 	proto.Name = p.RpcName()
 	client, err = c.getClientForTaskQueuePartition(p)
-	return client, release, err
+	return client, tq, release, err
+}
+
+// updatePartitionCounts passes partition counts returned by matching on to the load balancer.
+func (c *clientImpl) updatePartitionCounts(tq *tqid.TaskQueue, counts *taskqueuespb.PartitionCounts) {
+	if tq != nil && counts != nil {
+		c.loadBalancer.UpdatePartitionCounts(tq, counts)
+	}
 }
 
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
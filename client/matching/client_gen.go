@@ -268,6 +268,26 @@ func (c *clientImpl) GetBuildIdTaskQueueMapping(
 	return client.GetBuildIdTaskQueueMapping(ctx, request, opts...)
 }
 
+func (c *clientImpl) GetDeadLetteredTasks(
+	ctx context.Context,
+	request *matchingservice.GetDeadLetteredTasksRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.GetDeadLetteredTasksResponse, error) {
+
+	p, err := tqid.NormalPartitionFromRpcName(fmt.Sprintf("not-applicable-%d", rand.Int()), request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_UNSPECIFIED)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := c.getClientForTaskQueuePartition(p)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.GetDeadLetteredTasks(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.GetTaskQueueUserDataRequest,
@@ -328,6 +348,26 @@ func (c *clientImpl) GetWorkerVersioningRules(
 	return client.GetWorkerVersioningRules(ctx, request, opts...)
 }
 
+func (c *clientImpl) HandoffStickyTaskQueue(
+	ctx context.Context,
+	request *matchingservice.HandoffStickyTaskQueueRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.HandoffStickyTaskQueueResponse, error) {
+
+	p, err := tqid.PartitionFromProto(request.GetTaskQueue(), request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := c.getClientForTaskQueuePartition(p)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.HandoffStickyTaskQueue(ctx, request, opts...)
+}
+
 func (c *clientImpl) ListNexusEndpoints(
 	ctx context.Context,
 	request *matchingservice.ListNexusEndpointsRequest,
@@ -388,6 +428,26 @@ func (c *clientImpl) PollNexusTaskQueue(
 	return client.PollNexusTaskQueue(ctx, request, opts...)
 }
 
+func (c *clientImpl) ReenqueueDeadLetteredTasks(
+	ctx context.Context,
+	request *matchingservice.ReenqueueDeadLetteredTasksRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.ReenqueueDeadLetteredTasksResponse, error) {
+
+	p, err := tqid.NormalPartitionFromRpcName(fmt.Sprintf("not-applicable-%d", rand.Int()), request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_UNSPECIFIED)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := c.getClientForTaskQueuePartition(p)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ReenqueueDeadLetteredTasks(ctx, request, opts...)
+}
+
 func (c *clientImpl) ReplicateTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.ReplicateTaskQueueUserDataRequest,
@@ -27,13 +27,20 @@ package matching
 import (
 	"math/rand"
 	"sync"
+	"time"
 
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/testing/testhooks"
 	"go.temporal.io/server/common/tqid"
 )
 
+// partitionCountsTTL is how long partition counts reported by matching are used after the last response that carried
+// them. Matching includes them in every add and poll response, so they only expire once adaptive partitioning is
+// turned off for a task queue or the task queue goes idle.
+const partitionCountsTTL = 5 * time.Minute
+
 type (
 	// LoadBalancer is the interface for implementers of
 	// component that distributes add/poll api calls across
@@ -55,6 +62,13 @@ type (
 		PickReadPartition(
 			taskQueue *tqid.TaskQueue,
 		) *pollToken
+
+		// UpdatePartitionCounts records the partition counts that matching reported for a task queue using adaptive
+		// partitioning. They take precedence over the dynamic config until they expire.
+		UpdatePartitionCounts(
+			taskQueue *tqid.TaskQueue,
+			counts *taskqueuespb.PartitionCounts,
+		)
 	}
 
 	defaultLoadBalancer struct {
@@ -72,6 +86,10 @@ type (
 		taskQueue    *tqid.TaskQueue
 		pollerCounts []int // keep track of poller count of each partition
 		lock         sync.Mutex
+
+		// partition counts reported by matching, only used until countsExpiry
+		counts       *taskqueuespb.PartitionCounts
+		countsExpiry time.Time
 	}
 
 	pollToken struct {
@@ -110,6 +128,9 @@ func (lb *defaultLoadBalancer) PickWritePartition(
 	}
 
 	n := max(1, lb.nWritePartitions(nsName.String(), taskQueue.Name(), taskQueue.TaskType()))
+	if counts := lb.getTaskQueueLoadBalancer(taskQueue).partitionCounts(); counts != nil {
+		n = max(1, int(counts.GetWritePartitions()))
+	}
 	return taskQueue.NormalPartition(rand.Intn(n))
 }
 
@@ -128,6 +149,9 @@ func (lb *defaultLoadBalancer) PickReadPartition(
 	if err == nil {
 		partitionCount = lb.nReadPartitions(string(namespaceName), taskQueue.Name(), taskQueue.TaskType())
 	}
+	if counts := tqlb.partitionCounts(); counts != nil {
+		partitionCount = max(1, int(counts.GetReadPartitions()))
+	}
 
 	if n, ok := testhooks.Get[int](lb.testHooks, testhooks.MatchingLBForceWritePartition); ok {
 		return tqlb.forceReadPartition(partitionCount, n)
@@ -136,6 +160,16 @@ func (lb *defaultLoadBalancer) PickReadPartition(
 	return tqlb.pickReadPartition(partitionCount)
 }
 
+func (lb *defaultLoadBalancer) UpdatePartitionCounts(
+	taskQueue *tqid.TaskQueue,
+	counts *taskqueuespb.PartitionCounts,
+) {
+	if counts == nil {
+		return
+	}
+	lb.getTaskQueueLoadBalancer(taskQueue).setPartitionCounts(counts, time.Now().Add(partitionCountsTTL))
+}
+
 func (lb *defaultLoadBalancer) getTaskQueueLoadBalancer(tq *tqid.TaskQueue) *tqLoadBalancer {
 	lb.lock.RLock()
 	tqlb, ok := lb.taskQueueLBs[*tq]
@@ -160,6 +194,23 @@ func newTaskQueueLoadBalancer(tq *tqid.TaskQueue) *tqLoadBalancer {
 	}
 }
 
+func (b *tqLoadBalancer) setPartitionCounts(counts *taskqueuespb.PartitionCounts, expiry time.Time) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.counts = counts
+	b.countsExpiry = expiry
+}
+
+// partitionCounts returns the partition counts reported by matching, or nil if there are none or they have expired.
+func (b *tqLoadBalancer) partitionCounts() *taskqueuespb.PartitionCounts {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.counts == nil || time.Now().After(b.countsExpiry) {
+		return nil
+	}
+	return b.counts
+}
+
 func (b *tqLoadBalancer) pickReadPartition(partitionCount int) *pollToken {
 	b.lock.Lock()
 	defer b.lock.Unlock()
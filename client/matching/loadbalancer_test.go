@@ -27,9 +27,12 @@ package matching
 import (
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/tqid"
 )
 
@@ -178,6 +181,37 @@ func TestLoadBalancer_ReducedPartitionCount(t *testing.T) {
 	assert.Equal(t, 2, maxPollerCount(tqlb))
 }
 
+func TestLoadBalancer_PartitionCountsFromMatching(t *testing.T) {
+	lb := &defaultLoadBalancer{
+		namespaceIDToName: func(id namespace.ID) (namespace.Name, error) {
+			return "fake-namespace", nil
+		},
+		nReadPartitions:  func(string, string, enumspb.TaskQueueType) int { return 4 },
+		nWritePartitions: func(string, string, enumspb.TaskQueueType) int { return 4 },
+		taskQueueLBs:     make(map[tqid.TaskQueue]*tqLoadBalancer),
+	}
+	f, err := tqid.NewTaskQueueFamily("fake-namespace-id", "fake-taskqueue")
+	assert.NoError(t, err)
+	tq := f.TaskQueue(enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+
+	lb.UpdatePartitionCounts(tq, &taskqueuespb.PartitionCounts{ReadPartitions: 2, WritePartitions: 1})
+	for i := 0; i < 20; i++ {
+		assert.Equal(t, 0, lb.PickWritePartition(tq).PartitionId())
+		assert.Less(t, lb.PickReadPartition(tq).TQPartition.PartitionId(), 2)
+	}
+
+	// expired counts fall back to dynamic config
+	lb.getTaskQueueLoadBalancer(tq).setPartitionCounts(
+		&taskqueuespb.PartitionCounts{ReadPartitions: 2, WritePartitions: 1},
+		time.Now().Add(-time.Second),
+	)
+	seen := make(map[int]bool)
+	for i := 0; i < 100; i++ {
+		seen[lb.PickWritePartition(tq).PartitionId()] = true
+	}
+	assert.Greater(t, len(seen), 1)
+}
+
 func maxPollerCount(tqlb *tqLoadBalancer) int {
 	res := -1
 	for _, c := range tqlb.pollerCounts {
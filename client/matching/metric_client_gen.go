@@ -201,6 +201,20 @@ func (c *metricClient) GetBuildIdTaskQueueMapping(
 	return c.client.GetBuildIdTaskQueueMapping(ctx, request, opts...)
 }
 
+func (c *metricClient) GetDeadLetteredTasks(
+	ctx context.Context,
+	request *matchingservice.GetDeadLetteredTasksRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.GetDeadLetteredTasksResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "MatchingClientGetDeadLetteredTasks")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetDeadLetteredTasks(ctx, request, opts...)
+}
+
 func (c *metricClient) GetTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.GetTaskQueueUserDataRequest,
@@ -243,6 +257,20 @@ func (c *metricClient) GetWorkerVersioningRules(
 	return c.client.GetWorkerVersioningRules(ctx, request, opts...)
 }
 
+func (c *metricClient) HandoffStickyTaskQueue(
+	ctx context.Context,
+	request *matchingservice.HandoffStickyTaskQueueRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.HandoffStickyTaskQueueResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "MatchingClientHandoffStickyTaskQueue")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.HandoffStickyTaskQueue(ctx, request, opts...)
+}
+
 func (c *metricClient) ListNexusEndpoints(
 	ctx context.Context,
 	request *matchingservice.ListNexusEndpointsRequest,
@@ -285,6 +313,20 @@ func (c *metricClient) PollNexusTaskQueue(
 	return c.client.PollNexusTaskQueue(ctx, request, opts...)
 }
 
+func (c *metricClient) ReenqueueDeadLetteredTasks(
+	ctx context.Context,
+	request *matchingservice.ReenqueueDeadLetteredTasksRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.ReenqueueDeadLetteredTasksResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "MatchingClientReenqueueDeadLetteredTasks")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ReenqueueDeadLetteredTasks(ctx, request, opts...)
+}
+
 func (c *metricClient) ReplicateTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.ReplicateTaskQueueUserDataRequest,
@@ -245,6 +245,21 @@ func (c *retryableClient) GetBuildIdTaskQueueMapping(
 	return resp, err
 }
 
+func (c *retryableClient) GetDeadLetteredTasks(
+	ctx context.Context,
+	request *matchingservice.GetDeadLetteredTasksRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.GetDeadLetteredTasksResponse, error) {
+	var resp *matchingservice.GetDeadLetteredTasksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetDeadLetteredTasks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.GetTaskQueueUserDataRequest,
@@ -290,6 +305,21 @@ func (c *retryableClient) GetWorkerVersioningRules(
 	return resp, err
 }
 
+func (c *retryableClient) HandoffStickyTaskQueue(
+	ctx context.Context,
+	request *matchingservice.HandoffStickyTaskQueueRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.HandoffStickyTaskQueueResponse, error) {
+	var resp *matchingservice.HandoffStickyTaskQueueResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.HandoffStickyTaskQueue(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ListNexusEndpoints(
 	ctx context.Context,
 	request *matchingservice.ListNexusEndpointsRequest,
@@ -380,6 +410,21 @@ func (c *retryableClient) QueryWorkflow(
 	return resp, err
 }
 
+func (c *retryableClient) ReenqueueDeadLetteredTasks(
+	ctx context.Context,
+	request *matchingservice.ReenqueueDeadLetteredTasksRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.ReenqueueDeadLetteredTasksResponse, error) {
+	var resp *matchingservice.ReenqueueDeadLetteredTasksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ReenqueueDeadLetteredTasks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ReplicateTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.ReplicateTaskQueueUserDataRequest,
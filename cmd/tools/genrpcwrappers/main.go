@@ -136,6 +136,7 @@ var (
 		"StartWorkflowExecutionRequest.RootExecutionInfo": true,
 		// these get routed to the parent
 		"RecordChildExecutionCompletedRequest.ChildExecution":          true,
+		"RecordChildExecutionProgressRequest.ChildExecution":           true,
 		"VerifyChildExecutionCompletionRecordedRequest.ChildExecution": true,
 	}
 )
@@ -330,7 +331,9 @@ func makeGetMatchingClient(reqType reflect.Type) string {
 	var nsID, tqp, tq, tqt fieldWithPath
 
 	switch t.Name() {
-	case "GetBuildIdTaskQueueMappingRequest":
+	case "GetBuildIdTaskQueueMappingRequest",
+		"GetDeadLetteredTasksRequest",
+		"ReenqueueDeadLetteredTasksRequest":
 		// Pick a random node for this request, it's not associated with a specific task queue.
 		tq = fieldWithPath{path: "fmt.Sprintf(\"not-applicable-%d\", rand.Int())"}
 		tqt = fieldWithPath{path: "enumspb.TASK_QUEUE_TYPE_UNSPECIFIED"}
@@ -349,7 +352,8 @@ func makeGetMatchingClient(reqType reflect.Type) string {
 		"CheckTaskQueueUserDataPropagationRequest",
 		"ApplyTaskQueueUserDataReplicationEventRequest",
 		"GetWorkerVersioningRulesRequest",
-		"UpdateWorkerVersioningRulesRequest":
+		"UpdateWorkerVersioningRulesRequest",
+		"HandoffStickyTaskQueueRequest":
 		tq = findOneNestedField(t, "TaskQueue", "request", 2)
 		tqt = fieldWithPath{path: "enumspb.TASK_QUEUE_TYPE_WORKFLOW"}
 		nsID = findOneNestedField(t, "NamespaceId", "request", 1)
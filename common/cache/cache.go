@@ -73,6 +73,15 @@ type Options struct {
 	OnPut func(val any)
 
 	OnEvict func(val any)
+
+	// PartitionFn optionally assigns every key to a partition. Together with PartitionMaxSize it bounds the total size
+	// of the entries of a single partition, so that one partition cannot take over the whole cache.
+	PartitionFn func(key any) string
+
+	// PartitionMaxSize returns the maximum total size of the entries of the given partition. When a partition grows
+	// past it, its least recently used entries that are not pinned are evicted. A value of 0 or less disables the
+	// limit for the partition.
+	PartitionMaxSize func(partition string) int
 }
 
 // SimpleOptions provides options that can be used to configure SimpleCache.
@@ -108,3 +117,11 @@ type Entry interface {
 	// CreateTime represents the time when the entry is created
 	CreateTime() time.Time
 }
+
+// SizedEntry is optionally implemented by the entries returned by an Iterator to report the size the cache accounts
+// for them. Entries of the LRU cache implement it.
+type SizedEntry interface {
+	Entry
+	// Size represents the size of the entry as accounted by the cache
+	Size() int
+}
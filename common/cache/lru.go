@@ -48,6 +48,12 @@ var (
 
 const emptyEntrySize = 0
 
+const (
+	evictionReasonCapacity       metrics.ReasonString = "capacity"
+	evictionReasonPartitionLimit metrics.ReasonString = "partition_limit"
+	evictionReasonExpired        metrics.ReasonString = "expired"
+)
+
 // lru is a concurrent fixed size cache that evicts elements in lru order
 type (
 	lru struct {
@@ -63,6 +69,18 @@ type (
 		pin            bool
 		timeSource     clock.TimeSource
 		metricsHandler metrics.Handler
+
+		partitionFn      func(key any) string
+		partitionMaxSize func(partition string) int
+		partitions       map[string]*partition
+	}
+
+	// partition indexes the entries of one partition, in the same order as the cache access list, so that it can be
+	// bounded without scanning the entries of other partitions.
+	partition struct {
+		size        int
+		pinnedCount int
+		byAccess    *list.List // elements of the cache access list
 	}
 
 	iteratorImpl struct {
@@ -77,6 +95,9 @@ type (
 		value      interface{}
 		refCount   int
 		size       int
+
+		partition        string
+		partitionElement *list.Element
 	}
 )
 
@@ -114,7 +135,7 @@ func (it *iteratorImpl) prepareNext() {
 		entry := it.nextItem.Value.(*entryImpl)
 		if it.lru.isEntryExpired(entry, it.createTime) {
 			nextItem := it.nextItem.Next()
-			it.lru.deleteInternal(it.nextItem)
+			it.lru.evictInternal(it.nextItem, evictionReasonExpired)
 			it.nextItem = nextItem
 		} else {
 			return
@@ -181,6 +202,10 @@ func NewWithMetrics(maxSize int, opts *Options, handler metrics.Handler) Cache {
 		onEvict:        opts.OnEvict,
 		timeSource:     timeSource,
 		metricsHandler: handler,
+
+		partitionFn:      opts.PartitionFn,
+		partitionMaxSize: opts.PartitionMaxSize,
+		partitions:       make(map[string]*partition),
 	}
 }
 
@@ -209,12 +234,13 @@ func (c *lru) Get(key interface{}) interface{} {
 
 	if c.isEntryExpired(entry, c.timeSource.Now().UTC()) {
 		// Entry has expired
-		c.deleteInternal(element)
+		c.evictInternal(element, evictionReasonExpired)
 		return nil
 	}
 
 	c.updateEntryRefCount(entry)
 	c.byAccess.MoveToFront(element)
+	c.moveToFrontOfPartition(entry)
 	return entry.value
 }
 
@@ -273,14 +299,19 @@ func (c *lru) Release(key interface{}) {
 	if entry.refCount == 0 {
 		c.pinnedSize -= entry.Size()
 		metrics.CachePinnedUsage.With(c.metricsHandler).Record(float64(c.pinnedSize))
+		if entry.partitionElement != nil {
+			c.partitions[entry.partition].pinnedCount--
+		}
 	}
 	// Entry size might have changed. Recalculate size and evict entries if necessary.
 	newEntrySize := getSize(entry.value)
 	c.currSize = c.calculateNewCacheSize(newEntrySize, entry.Size())
+	c.updatePartitionSize(entry, newEntrySize-entry.Size())
 	entry.size = newEntrySize
 	if c.currSize > c.maxSize {
 		c.tryEvictUntilCacheSizeUnderLimit()
 	}
+	c.tryEvictUntilPartitionSizeUnderLimit(entry.partition, nil)
 	metrics.CacheUsage.With(c.metricsHandler).Record(float64(c.currSize))
 }
 
@@ -330,9 +361,11 @@ func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool)
 						return nil, ErrCacheFull
 					}
 				}
+				c.updatePartitionSize(existingEntry, newEntrySize-existingEntry.Size())
 				existingEntry.value = value
 				existingEntry.size = newEntrySize
 				c.currSize = newCacheSize
+				c.tryEvictUntilPartitionSizeUnderLimit(existingEntry.partition, existingEntry)
 				metrics.CacheUsage.With(c.metricsHandler).Record(float64(c.currSize))
 				c.updateEntryTTL(existingEntry)
 
@@ -343,11 +376,12 @@ func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool)
 
 			c.updateEntryRefCount(existingEntry)
 			c.byAccess.MoveToFront(elt)
+			c.moveToFrontOfPartition(existingEntry)
 			return existingVal, nil
 		}
 
 		// Entry has expired
-		c.deleteInternal(elt)
+		c.evictInternal(elt, evictionReasonExpired)
 	}
 
 	c.tryEvictUntilEnoughSpaceWithSkipEntry(newEntrySize, nil)
@@ -368,6 +402,8 @@ func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool)
 	element := c.byAccess.PushFront(entry)
 	c.byKey[key] = element
 	c.currSize = newCacheSize
+	c.addToPartition(entry, element)
+	c.tryEvictUntilPartitionSizeUnderLimit(entry.partition, entry)
 	metrics.CacheUsage.With(c.metricsHandler).Record(float64(c.currSize))
 
 	if c.onPut != nil {
@@ -384,6 +420,7 @@ func (c *lru) calculateNewCacheSize(newEntrySize int, existingEntrySize int) int
 func (c *lru) deleteInternal(element *list.Element) {
 	entry := c.byAccess.Remove(element).(*entryImpl)
 	c.currSize -= entry.Size()
+	c.removeFromPartition(entry)
 	metrics.CacheUsage.With(c.metricsHandler).Record(float64(c.currSize))
 	metrics.CacheEntryAgeOnEviction.With(c.metricsHandler).Record(c.timeSource.Now().UTC().Sub(entry.createTime))
 	delete(c.byKey, entry.key)
@@ -393,6 +430,81 @@ func (c *lru) deleteInternal(element *list.Element) {
 	}
 }
 
+func (c *lru) evictInternal(element *list.Element, reason metrics.ReasonString) {
+	metrics.CacheEvictions.With(c.metricsHandler).Record(1, metrics.ReasonTag(reason))
+	c.deleteInternal(element)
+}
+
+func (c *lru) addToPartition(entry *entryImpl, element *list.Element) {
+	if c.partitionFn == nil {
+		return
+	}
+	entry.partition = c.partitionFn(entry.key)
+	p, ok := c.partitions[entry.partition]
+	if !ok {
+		p = &partition{byAccess: list.New()}
+		c.partitions[entry.partition] = p
+	}
+	entry.partitionElement = p.byAccess.PushFront(element)
+	p.size += entry.Size()
+	if entry.refCount > 0 {
+		p.pinnedCount++
+	}
+}
+
+func (c *lru) removeFromPartition(entry *entryImpl) {
+	if entry.partitionElement == nil {
+		return
+	}
+	p := c.partitions[entry.partition]
+	p.byAccess.Remove(entry.partitionElement)
+	entry.partitionElement = nil
+	p.size -= entry.Size()
+	if entry.refCount > 0 {
+		p.pinnedCount--
+	}
+	if p.byAccess.Len() == 0 {
+		delete(c.partitions, entry.partition)
+	}
+}
+
+func (c *lru) moveToFrontOfPartition(entry *entryImpl) {
+	if entry.partitionElement != nil {
+		c.partitions[entry.partition].byAccess.MoveToFront(entry.partitionElement)
+	}
+}
+
+func (c *lru) updatePartitionSize(entry *entryImpl, delta int) {
+	if entry.partitionElement != nil {
+		c.partitions[entry.partition].size += delta
+	}
+}
+
+// tryEvictUntilPartitionSizeUnderLimit tries to evict least recently used entries of the given partition until the
+// partition is within its limit. The skipped entry is never evicted because it is being inserted or updated. Only the
+// entries of the partition are visited, and none when they are all pinned.
+func (c *lru) tryEvictUntilPartitionSizeUnderLimit(name string, skipEntry *entryImpl) {
+	p, ok := c.partitions[name]
+	if !ok || c.partitionMaxSize == nil {
+		return
+	}
+	maxSize := c.partitionMaxSize(name)
+	if maxSize <= 0 {
+		return
+	}
+
+	element := p.byAccess.Back()
+	for p.size > maxSize && p.pinnedCount < p.byAccess.Len() && element != nil {
+		elementPrev := element.Prev()
+		//revive:disable-next-line:unchecked-type-assertion
+		cacheElement := element.Value.(*list.Element)
+		if entry := cacheElement.Value.(*entryImpl); entry != skipEntry && entry.refCount == 0 {
+			c.evictInternal(cacheElement, evictionReasonPartitionLimit)
+		}
+		element = elementPrev
+	}
+}
+
 // tryEvictUntilCacheSizeUnderLimit tries to evict entries until c.currSize is less than c.maxSize.
 func (c *lru) tryEvictUntilCacheSizeUnderLimit() {
 	c.tryEvictUntilEnoughSpaceWithSkipEntry(0, nil)
@@ -421,7 +533,7 @@ func (c *lru) tryEvictAndGetPreviousElement(entry *entryImpl, element *list.Elem
 	if entry.refCount == 0 {
 		elementPrev := element.Prev()
 		// currSize will be updated within deleteInternal
-		c.deleteInternal(element)
+		c.evictInternal(element, evictionReasonCapacity)
 		return elementPrev
 	}
 	// entry.refCount > 0
@@ -445,6 +557,9 @@ func (c *lru) updateEntryRefCount(entry *entryImpl) {
 		if entry.refCount == 1 {
 			c.pinnedSize += entry.Size()
 			metrics.CachePinnedUsage.With(c.metricsHandler).Record(float64(c.pinnedSize))
+			if entry.partitionElement != nil {
+				c.partitions[entry.partition].pinnedCount++
+			}
 		}
 	}
 }
@@ -759,3 +759,107 @@ func TestCache_InvokeLifecycleCallbacks(t *testing.T) {
 	assert.Nil(t, cache.Get("key"))
 	require.Equal(t, 2, onEvict, "expected OnEvict callback to be invoked")
 }
+
+func TestCache_PartitionMaxSize(t *testing.T) {
+	t.Parallel()
+	metricsHandler := metricstest.NewCaptureHandler()
+	capture := metricsHandler.StartCapture()
+
+	partitionOf := func(key any) string { return key.(string)[:1] }
+	cache := NewWithMetrics(10, &Options{
+		Pin:         true,
+		PartitionFn: partitionOf,
+		PartitionMaxSize: func(partition string) int {
+			if partition == "a" {
+				return 2
+			}
+			return 0
+		},
+	}, metricsHandler)
+
+	for _, key := range []string{"a1", "b1", "a2", "b2"} {
+		_, err := cache.PutIfNotExist(key, &testEntryWithCacheSize{1})
+		require.NoError(t, err)
+		cache.Release(key)
+	}
+
+	// a1 is the least recently used entry of partition "a" and is evicted, the unlimited partition "b" is untouched.
+	_, err := cache.PutIfNotExist("a3", &testEntryWithCacheSize{1})
+	require.NoError(t, err)
+	assert.Nil(t, cache.Get("a1"))
+	assert.Equal(t, 4, cache.Size())
+
+	// Pinned entries are never evicted, even when their partition is over its limit.
+	_, err = cache.PutIfNotExist("a4", &testEntryWithCacheSize{1})
+	require.NoError(t, err)
+	assert.Equal(t, 4, cache.Size())
+	_, err = cache.PutIfNotExist("a5", &testEntryWithCacheSize{1})
+	require.NoError(t, err)
+	assert.Equal(t, 5, cache.Size())
+
+	// Once released, the partition is brought back within its limit.
+	cache.Release("a3")
+	cache.Release("a4")
+	cache.Release("a5")
+	assert.Equal(t, 4, cache.Size())
+	assert.NotNil(t, cache.Get("b1"))
+	assert.NotNil(t, cache.Get("b2"))
+
+	snapshot := capture.Snapshot()
+	evictions := snapshot[metrics.CacheEvictions.Name()]
+	require.NotEmpty(t, evictions)
+	for _, eviction := range evictions {
+		assert.Equal(t, string(evictionReasonPartitionLimit), eviction.Tags["reason"])
+	}
+}
+
+func TestCache_PartitionEvictsInAccessOrder(t *testing.T) {
+	t.Parallel()
+
+	cache := New(10, &Options{
+		Pin:              true,
+		PartitionFn:      func(key any) string { return key.(string)[:1] },
+		PartitionMaxSize: func(string) int { return 2 },
+	})
+	put := func(key string) {
+		_, err := cache.PutIfNotExist(key, &testEntryWithCacheSize{1})
+		require.NoError(t, err)
+		cache.Release(key)
+	}
+
+	put("a1")
+	put("a2")
+	require.NotNil(t, cache.Get("a1"))
+	cache.Release("a1")
+
+	// a2 is now the least recently used entry of partition "a".
+	put("a3")
+	assert.Nil(t, cache.Get("a2"))
+	assert.NotNil(t, cache.Get("a1"))
+	cache.Release("a1")
+
+	// Deleting a pinned entry releases its place in the partition.
+	_, err := cache.PutIfNotExist("a4", &testEntryWithCacheSize{1})
+	require.NoError(t, err)
+	assert.Nil(t, cache.Get("a3"))
+	cache.Delete("a4")
+	assert.Equal(t, 1, cache.Size())
+	put("a5")
+	assert.Equal(t, 2, cache.Size())
+	assert.NotNil(t, cache.Get("a1"))
+	cache.Release("a1")
+}
+
+func TestIterator_SizedEntry(t *testing.T) {
+	t.Parallel()
+
+	cache := New(10, nil)
+	cache.Put("A", &testEntryWithCacheSize{3})
+
+	it := cache.Iterator()
+	defer it.Close()
+	require.True(t, it.HasNext())
+	entry, ok := it.Next().(SizedEntry)
+	require.True(t, ok)
+	assert.Equal(t, 3, entry.Size())
+}
@@ -0,0 +1,165 @@
+// The MIT License
+//
+// Copyright (c) 2025 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+type (
+	// VirtualTimeSource is a TimeSource that moves forward with the wall clock but can additionally be advanced by an
+	// arbitrary amount with Advance. Timers are scheduled against the virtual time, so advancing the clock fires all
+	// timers that became due immediately. The zero value is not valid, use NewVirtualTimeSource.
+	VirtualTimeSource struct {
+		mu     sync.Mutex
+		offset time.Duration
+		timers map[*virtualTimer]struct{}
+	}
+
+	virtualTimer struct {
+		timeSource *VirtualTimeSource
+		callback   func()
+		// deadline, timer and generation are protected by timeSource.mu
+		deadline   time.Time
+		timer      *time.Timer
+		generation int
+	}
+)
+
+var _ TimeSource = (*VirtualTimeSource)(nil)
+
+// NewVirtualTimeSource returns a VirtualTimeSource that starts at the current wall clock time.
+func NewVirtualTimeSource() *VirtualTimeSource {
+	return &VirtualTimeSource{
+		timers: make(map[*virtualTimer]struct{}),
+	}
+}
+
+// Now returns the current virtual time, with the location set to UTC.
+func (ts *VirtualTimeSource) Now() time.Time {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	return ts.nowLocked()
+}
+
+// Since returns the virtual time elapsed since t.
+func (ts *VirtualTimeSource) Since(t time.Time) time.Duration {
+	return ts.Now().Sub(t)
+}
+
+// Offset returns how far the virtual time is ahead of the wall clock.
+func (ts *VirtualTimeSource) Offset() time.Duration {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	return ts.offset
+}
+
+// AfterFunc calls f in its own goroutine once the virtual time reaches now + d.
+func (ts *VirtualTimeSource) AfterFunc(d time.Duration, f func()) Timer {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	t := &virtualTimer{
+		timeSource: ts,
+		callback:   f,
+	}
+	t.scheduleLocked(d)
+	return t
+}
+
+// NewTimer creates a Timer that will send the virtual time on a channel once the virtual time reaches now + d.
+func (ts *VirtualTimeSource) NewTimer(d time.Duration) (<-chan time.Time, Timer) {
+	c := make(chan time.Time, 1)
+	t := ts.AfterFunc(d, func() {
+		select {
+		case c <- ts.Now():
+		default:
+		}
+	})
+	return c, t
+}
+
+// Advance moves the virtual time forward by d and fires all timers that became due.
+func (ts *VirtualTimeSource) Advance(d time.Duration) {
+	if d <= 0 {
+		return
+	}
+
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	ts.offset += d
+	now := ts.nowLocked()
+	for t := range ts.timers {
+		// Re-arm the underlying wall clock timers against the new virtual time, timers that are due now fire right away.
+		t.timer.Reset(t.deadline.Sub(now))
+	}
+}
+
+func (ts *VirtualTimeSource) nowLocked() time.Time {
+	return time.Now().UTC().Add(ts.offset)
+}
+
+func (t *virtualTimer) scheduleLocked(d time.Duration) {
+	t.generation++
+	generation := t.generation
+	t.deadline = t.timeSource.nowLocked().Add(d)
+	t.timeSource.timers[t] = struct{}{}
+	t.timer = time.AfterFunc(d, func() { t.fire(generation) })
+}
+
+func (t *virtualTimer) fire(generation int) {
+	t.timeSource.mu.Lock()
+	// A wall clock timer replaced by Reset may still fire, ignore it.
+	if _, ok := t.timeSource.timers[t]; !ok || t.generation != generation {
+		t.timeSource.mu.Unlock()
+		return
+	}
+	delete(t.timeSource.timers, t)
+	t.timeSource.mu.Unlock()
+
+	t.callback()
+}
+
+// Reset changes the expiration of the timer to d after the current virtual time.
+func (t *virtualTimer) Reset(d time.Duration) bool {
+	t.timeSource.mu.Lock()
+	defer t.timeSource.mu.Unlock()
+
+	_, active := t.timeSource.timers[t]
+	t.timer.Stop()
+	t.scheduleLocked(d)
+	return active
+}
+
+// Stop prevents the timer from firing.
+func (t *virtualTimer) Stop() bool {
+	t.timeSource.mu.Lock()
+	defer t.timeSource.mu.Unlock()
+
+	_, active := t.timeSource.timers[t]
+	delete(t.timeSource.timers, t)
+	t.timer.Stop()
+	return active
+}
@@ -0,0 +1,70 @@
+// The MIT License
+//
+// Copyright (c) 2025 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package clock_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.temporal.io/server/common/clock"
+)
+
+func TestVirtualTimeSource_Advance(t *testing.T) {
+	t.Parallel()
+
+	ts := clock.NewVirtualTimeSource()
+	before := ts.Now()
+	ts.Advance(time.Hour)
+	assert.Equal(t, time.Hour, ts.Offset())
+	assert.GreaterOrEqual(t, ts.Now().Sub(before), time.Hour)
+}
+
+func TestVirtualTimeSource_AdvanceFiresTimers(t *testing.T) {
+	t.Parallel()
+
+	ts := clock.NewVirtualTimeSource()
+	fired := make(chan struct{})
+	ts.AfterFunc(time.Hour, func() { close(fired) })
+	ch, _ := ts.NewTimer(2 * time.Hour)
+	stopped, stoppedTimer := ts.NewTimer(time.Hour)
+	assert.True(t, stoppedTimer.Stop())
+
+	ts.Advance(2 * time.Hour)
+
+	select {
+	case <-fired:
+	case <-time.After(time.Second):
+		t.Fatal("AfterFunc callback was not triggered")
+	}
+	select {
+	case <-ch:
+	case <-time.After(time.Second):
+		t.Fatal("timer channel was not triggered")
+	}
+	select {
+	case <-stopped:
+		t.Fatal("stopped timer was triggered")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
@@ -69,6 +69,8 @@ type (
 		NamespaceDefaults NamespaceDefaults `yaml:"namespaceDefaults"`
 		// ExporterConfig allows the specification of process-wide OTEL exporters
 		ExporterConfig telemetry.ExportConfig `yaml:"otel"`
+		// PayloadStore is the config for the store that holds payloads offloaded from history
+		PayloadStore PayloadStore `yaml:"payloadStore"`
 	}
 
 	// Service contains the service specific config items
@@ -486,6 +488,22 @@ type (
 		DirMode  string `yaml:"dirMode"`
 	}
 
+	// PayloadStore contains the config for the store of offloaded payloads
+	PayloadStore struct {
+		Filestore *FilestorePayloadStore `yaml:"filestore"`
+	}
+
+	// FilestorePayloadStore contains the config for the local filesystem payload store. Unless Shared is set, the
+	// store is only usable while a single history host runs, other history hosts could not read its payloads. The
+	// server refuses to start with a non shared store if static hosts define more than one history host. Offloaded
+	// payloads are deleted with the history of the last run referencing them.
+	FilestorePayloadStore struct {
+		// Directory is the root directory offloaded payloads are written to
+		Directory string `yaml:"directory"`
+		// Shared must be set if Directory is on a filesystem mounted by every history host
+		Shared bool `yaml:"shared"`
+	}
+
 	// GstorageArchiver contain the config for google storage archiver
 	GstorageArchiver struct {
 		CredentialsPath string `yaml:"credentialsPath"`
@@ -77,3 +77,10 @@ const (
 	// DefaultOperatorRPSRatio is the default percentage of rate limit that should be used for operator priority requests
 	DefaultOperatorRPSRatio float64 = 0.2
 )
+
+const (
+	// ChildProgressMemoKey is the memo field a child workflow upserts to publish its progress to its parent
+	ChildProgressMemoKey = "__temporal_child_progress"
+	// ChildProgressSignalName is the name of the signal that delivers the progress of a child workflow to its parent
+	ChildProgressSignalName = "__temporal_child_progress"
+)
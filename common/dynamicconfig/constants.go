@@ -305,6 +305,19 @@ operator API calls (highest priority). Should be >0.0 and <= 1.0 (defaults to 20
 		512*1024,
 		`BlobSizeLimitWarn is the per event blob size limit for warning`,
 	)
+	PayloadOffloadThreshold = NewNamespaceIntSetting(
+		"limit.payloadOffload.threshold",
+		0,
+		`PayloadOffloadThreshold is the payload size in bytes above which history offloads payloads to the
+payload store and keeps only a reference in history events. 0 disables offloading. Offloading requires a payload store
+to be configured.`,
+	)
+	OffloadedBlobSizeLimitError = NewNamespaceIntSetting(
+		"limit.payloadOffload.blobSizeError",
+		32*1024*1024,
+		`OffloadedBlobSizeLimitError replaces BlobSizeLimitError as the per event blob size limit for namespaces
+with payload offloading enabled.`,
+	)
 	MemoSizeLimitError = NewNamespaceIntSetting(
 		"limit.memoSize.error",
 		2*1024*1024,
@@ -902,6 +915,12 @@ used when the first cache layer has a miss. Requires server restart for change t
 		[]string(nil),
 		`Nexus request headers to be removed before being sent to a user handler.
 Wildcards (*) are expanded to allow any substring. By default blacklist is empty.`,
+	)
+	FrontendNexusResultCacheMaxSizeBytes = NewGlobalIntSetting(
+		"frontend.nexusResultCacheMaxSizeBytes",
+		32*1024*1024,
+		`Maximum total size of the synchronous Nexus StartOperation results cached by the frontend for endpoints whose
+policy sets a result cache TTL. Zero disables the cache. Requires server restart for change to be applied.`,
 	)
 	FrontendCallbackURLMaxLength = NewNamespaceIntSetting(
 		"frontend.callbackURLMaxLength",
@@ -1142,6 +1161,88 @@ Note: this should be greater than matching.longPollExpirationInterval and matchi
 		defaultNumTaskQueuePartitions,
 		`MatchingNumTaskqueueReadPartitions is the number of read partitions for a task queue`,
 	)
+	MatchingEnableAdaptivePartitions = NewTaskQueueBoolSetting(
+		"matching.enableAdaptivePartitions",
+		false,
+		`MatchingEnableAdaptivePartitions lets matching choose the number of read and write partitions of a task queue
+based on its observed load. When enabled, matching.numTaskqueueReadPartitions and matching.numTaskqueueWritePartitions
+are only used as the starting point, and the counts chosen by matching are propagated to matching clients.`,
+	)
+	MatchingAdaptivePartitionsMin = NewTaskQueueIntSetting(
+		"matching.adaptivePartitionsMin",
+		1,
+		`MatchingAdaptivePartitionsMin is the minimum number of write partitions adaptive partitioning will scale down to.`,
+	)
+	MatchingAdaptivePartitionsMax = NewTaskQueueIntSetting(
+		"matching.adaptivePartitionsMax",
+		32,
+		`MatchingAdaptivePartitionsMax is the maximum number of write partitions adaptive partitioning will scale up to.`,
+	)
+	MatchingAdaptivePartitionsTargetRatePerPartition = NewTaskQueueFloatSetting(
+		"matching.adaptivePartitionsTargetRatePerPartition",
+		200,
+		`MatchingAdaptivePartitionsTargetRatePerPartition is the add or dispatch rate (tasks per second) that adaptive
+partitioning aims to handle in each partition.`,
+	)
+	MatchingAdaptivePartitionsBacklogAgeScaleUp = NewTaskQueueDurationSetting(
+		"matching.adaptivePartitionsBacklogAgeScaleUp",
+		time.Minute,
+		`MatchingAdaptivePartitionsBacklogAgeScaleUp is the backlog age above which adaptive partitioning adds a write
+partition even if the observed rates don't call for it.`,
+	)
+	MatchingAdaptivePartitionsEvaluationInterval = NewTaskQueueDurationSetting(
+		"matching.adaptivePartitionsEvaluationInterval",
+		30*time.Second,
+		`MatchingAdaptivePartitionsEvaluationInterval is how often the root partition re-evaluates the partition counts
+of a task queue with adaptive partitioning enabled.`,
+	)
+	MatchingAdaptivePartitionsScaleDownDelay = NewTaskQueueDurationSetting(
+		"matching.adaptivePartitionsScaleDownDelay",
+		10*time.Minute,
+		`MatchingAdaptivePartitionsScaleDownDelay is the minimum time between a change in partition counts and the next
+reduction of the write or read partition count. It should be comfortably larger than the time it takes matching
+clients to pick up new counts, so that no tasks are written to a partition that is no longer polled.`,
+	)
+	MatchingEnableStickyHandoff = NewNamespaceBoolSetting(
+		"matching.enableStickyHandoff",
+		false,
+		`MatchingEnableStickyHandoff allows a restarting worker to hand off its previous sticky task queue to the
+sticky task queue of its new process. Workflow tasks sent to the old sticky queue, including its backlog, are then
+redirected to the new one instead of falling back to the normal queue.`,
+	)
+	MatchingStickyHandoffTTL = NewGlobalDurationSetting(
+		"matching.stickyHandoffTTL",
+		10*time.Minute,
+		`MatchingStickyHandoffTTL is how long matching keeps redirecting tasks from a handed off sticky queue to its
+successor. The expiry is computed and stored with the handoff when it is made.`,
+	)
+	MatchingEnableDeadLetterQueue = NewNamespaceIDBoolSetting(
+		"matching.enableDeadLetterQueue",
+		false,
+		`MatchingEnableDeadLetterQueue makes matching record tasks it drops without delivering them to a worker, e.g.
+because history returned a non-retryable error, in the dead-letter queue of their namespace. Dead-lettered tasks can be
+inspected and re-enqueued with the admin API.`,
+	)
+	MatchingDeadLetterExpiredTasks = NewNamespaceIDBoolSetting(
+		"matching.deadLetterExpiredTasks",
+		false,
+		`MatchingDeadLetterExpiredTasks makes matching also record backlog tasks that expired before they were delivered
+to a worker in the dead-letter queue. Only has an effect if matching.enableDeadLetterQueue is enabled.`,
+	)
+	MatchingDeadLetterExpiredTaskThreshold = NewNamespaceIDIntSetting(
+		"matching.deadLetterExpiredTaskThreshold",
+		3,
+		`MatchingDeadLetterExpiredTaskThreshold is the number of backlog tasks of the same workflow execution that must
+expire on a task queue before an expired task is dead-lettered. A single expiry is usually a slow worker rather than a
+stuck task. Counts are kept in memory by the matching host that owns the task queue and are reset when it moves.`,
+	)
+	MatchingDeadLetterQueueBufferSize = NewGlobalIntSetting(
+		"matching.deadLetterQueueBufferSize",
+		1000,
+		`MatchingDeadLetterQueueBufferSize is the number of tasks that can wait to be written to the dead-letter queue.
+When it is full, the task reader or poller that drops a task writes it itself and waits for the write. It is read once
+at startup.`,
+	)
 	MetricsBreakdownByTaskQueue = NewTaskQueueBoolSetting(
 		"metrics.breakdownByTaskQueue",
 		true,
@@ -1251,6 +1352,18 @@ these log lines can be noisy, we want to be able to turn on and sample selective
 		time.Second,
 		`TaskQueueInfoByBuildIdTTL serves as a TTL for the cache holding DescribeTaskQueue partition results`,
 	)
+	MatchingMaxActivityTasksPerPoll = NewTaskQueueIntSetting(
+		"matching.maxActivityTasksPerPoll",
+		1,
+		`MatchingMaxActivityTasksPerPoll is the maximum number of activity tasks returned in a single response to a
+poller that opted in to batched polling. A value of one disables batching.`,
+	)
+	MatchingActivityPollBatchWaitDuration = NewTaskQueueDurationSetting(
+		"matching.activityPollBatchWaitDuration",
+		10*time.Millisecond,
+		`MatchingActivityPollBatchWaitDuration is how long a batched activity poll waits for each additional task once
+it has obtained its first one.`,
+	)
 	MatchingDropNonRetryableTasks = NewGlobalBoolSetting(
 		"matching.dropNonRetryableTasks",
 		false,
@@ -1401,6 +1514,13 @@ HistoryCacheSizeBasedLimit is set to true.`,
 		500*time.Millisecond,
 		`HistoryCacheNonUserContextLockTimeout controls how long non-user call (callerType != API or Operator)
 will wait on workflow lock acquisition. Requires service restart to take effect.`,
+	)
+	HistoryCacheNamespaceMaxSizeRatio = NewNamespaceIDFloatSetting(
+		"history.cacheNamespaceMaxSizeRatio",
+		0,
+		`HistoryCacheNamespaceMaxSizeRatio is the fraction of the history cache (shard or host level, counted in entries or
+bytes depending on HistoryCacheSizeBasedLimit) that a single namespace may occupy. When a namespace grows past it, its
+least recently used entries that are not in use are evicted. 0 disables the limit.`,
 	)
 	EnableHostHistoryCache = NewGlobalBoolSetting(
 		"history.enableHostHistoryCache",
@@ -1858,6 +1978,43 @@ Fields (see gobreaker reference for more details):
 - Interval (duration): Cyclic period in closed state to clear the internal counts;
   if interval is 0, then it never clears the internal counts (default 0).
 - Timeout (duration): Period of open state before changing to half-open state (default 60s).`,
+	)
+	ActivityRetryBudgetFailureThreshold = NewNamespaceIntSetting(
+		"history.activityRetryBudget.failureThreshold",
+		0,
+		`ActivityRetryBudgetFailureThreshold is the number of consecutive failed attempts of the same activity type on
+the same task queue, across all workflows of a namespace, after which the activity retry circuit breaker trips
+and further retries of that activity type are deferred. 0 disables activity retry budgets.`,
+	)
+	ActivityRetryBudgetCircuitBreakerSettings = NewNamespaceTypedSetting(
+		"history.activityRetryBudget.circuitBreakerSettings",
+		CircuitBreakerSettings{},
+		`ActivityRetryBudgetCircuitBreakerSettings are the circuit breaker settings of activity retry budgets.
+See OutboundQueueCircuitBreakerSettings for the available fields.`,
+	)
+	ActivityRetryBudgetDeferralInterval = NewNamespaceDurationSetting(
+		"history.activityRetryBudget.deferralInterval",
+		10*time.Second,
+		`ActivityRetryBudgetDeferralInterval is the initial interval an activity retry is deferred by while its
+retry circuit breaker is open. Consecutive deferrals of the same attempt back off exponentially.`,
+	)
+	ActivityRetryBudgetMaxDeferralInterval = NewNamespaceDurationSetting(
+		"history.activityRetryBudget.maxDeferralInterval",
+		5*time.Minute,
+		`ActivityRetryBudgetMaxDeferralInterval is the maximum interval an activity retry is deferred by while its
+retry circuit breaker is open.`,
+	)
+	ActivityRetryBudgetCacheMaxSize = NewGlobalIntSetting(
+		"history.activityRetryBudget.cacheMaxSize",
+		10000,
+		`ActivityRetryBudgetCacheMaxSize is the maximum number of activity retry circuit breakers kept per history host.
+The least recently used circuit breaker is dropped when the limit is reached.`,
+	)
+	ActivityRetryBudgetCacheTTL = NewGlobalDurationSetting(
+		"history.activityRetryBudget.cacheTTL",
+		time.Hour,
+		`ActivityRetryBudgetCacheTTL is how long an activity retry circuit breaker is kept after it was last
+used to record an attempt. Expired circuit breakers start over from a closed state.`,
 	)
 	OutboundStandbyTaskMissingEventsDiscardDelay = NewDestinationDurationSetting(
 		"history.outboundQueue.standbyTaskMissingEventsDiscardDelay",
@@ -2042,6 +2199,41 @@ archivalQueueProcessor`,
 		0.9,
 		`WorkflowExecutionMaxTotalUpdatesSuggestContinueAsNewThreshold is the percentage threshold of total updates that any given workflow execution can receive before suggesting to continue-as-new.`,
 	)
+	ContinueAsNewPolicyWarnThreshold = NewNamespaceFloatSetting(
+		"history.continueAsNewPolicy.warnThreshold",
+		0,
+		`ContinueAsNewPolicyWarnThreshold is the fraction of the history count or size error limit above which a completed
+workflow task that does not continue-as-new is reported by a metric and by the search attribute named in
+ContinueAsNewPolicyWarnSearchAttribute. 0 disables the continue-as-new policy.`,
+	)
+	ContinueAsNewPolicyWarnSearchAttribute = NewNamespaceStringSetting(
+		"history.continueAsNewPolicy.warnSearchAttribute",
+		"",
+		`ContinueAsNewPolicyWarnSearchAttribute is the name of a custom Bool search attribute that is set to true once a
+workflow crosses ContinueAsNewPolicyWarnThreshold. The search attribute must be registered in the namespace. It is
+recomputed whenever the workflow is updated rather than recorded in history, so it also applies after reset.`,
+	)
+	ContinueAsNewPolicyFailWorkflowTaskThreshold = NewNamespaceFloatSetting(
+		"history.continueAsNewPolicy.failWorkflowTaskThreshold",
+		0,
+		`ContinueAsNewPolicyFailWorkflowTaskThreshold is the fraction of the history count or size error limit above which
+workflow tasks are failed unless they close the workflow or continue-as-new. The failure has cause FORCE_CLOSE_COMMAND and
+is an application failure of type ContinueAsNewRequired. Like other workflow task failures, it is only recorded on the first
+attempt, later attempts are retried after the workflow task timeout. 0 disables failing workflow tasks.`,
+	)
+	ContinueAsNewPolicyAutoContinueAsNewThreshold = NewNamespaceFloatSetting(
+		"history.continueAsNewPolicy.autoContinueAsNewThreshold",
+		0,
+		`ContinueAsNewPolicyAutoContinueAsNewThreshold is the fraction of the history count or size error limit above which
+the server continues-as-new workflows of the types listed in ContinueAsNewPolicyAutoContinueAsNewWorkflowTypes with
+the input of the current run. 0 disables automatic continue-as-new.`,
+	)
+	ContinueAsNewPolicyAutoContinueAsNewWorkflowTypes = NewNamespaceTypedSetting(
+		"history.continueAsNewPolicy.autoContinueAsNewWorkflowTypes",
+		[]string(nil),
+		`ContinueAsNewPolicyAutoContinueAsNewWorkflowTypes lists the workflow types that opt in to automatic
+continue-as-new. Pending activities, timers and child workflows of the current run are dropped when it happens.`,
+	)
 
 	ReplicatorTaskBatchSize = NewGlobalIntSetting(
 		"history.replicatorTaskBatchSize",
@@ -2079,6 +2271,18 @@ state. The total size is determined by the sum of the size, in bytes, of each Hi
 		10000,
 		`MaximumSignalsPerExecution is max number of signals supported by single execution`,
 	)
+	SignalRequestIDDedupWindow = NewNamespaceDurationSetting(
+		"history.signalRequestIDDedupWindow",
+		0,
+		`SignalRequestIDDedupWindow is how long the request ID of an accepted signal keeps deduplicating retried signals
+across continue-as-new, retries and cron runs of the same workflow. 0 restricts deduplication to the current run.`,
+	)
+	SignalRequestIDDedupMaxIDs = NewNamespaceIntSetting(
+		"history.signalRequestIDDedupMaxIDs",
+		1000,
+		`SignalRequestIDDedupMaxIDs is the maximum number of signal request IDs carried over to the next run of a workflow
+for SignalRequestIDDedupWindow. The most recent IDs are kept.`,
+	)
 	ShardUpdateMinInterval = NewGlobalDurationSetting(
 		"history.shardUpdateMinInterval",
 		5*time.Minute,
@@ -2136,6 +2340,14 @@ This configuration will be become the default behavior in the next release and r
 		true,
 		`Allows resetting of workflows with pending children when set to true`,
 	)
+	EnableChildWorkflowProgress = NewNamespaceBoolSetting(
+		"history.enableChildWorkflowProgress",
+		false,
+		`EnableChildWorkflowProgress lets child workflows publish progress to their parent. A child publishes progress by
+upserting the "__temporal_child_progress" memo field. The parent receives it as a "__temporal_child_progress" signal,
+sent by the child execution, whose input is the memo field value. Only the latest progress is delivered when the child
+publishes again before the previous progress was delivered.`,
+	)
 	HistoryMaxAutoResetPoints = NewNamespaceIntSetting(
 		"history.historyMaxAutoResetPoints",
 		primitives.DefaultHistoryMaxAutoResetPoints,
@@ -2652,6 +2864,23 @@ If the service configures with archival feature enabled, update worker.historySc
 		5*time.Second,
 		`How long to sleep within a local activity before pushing to workflow level sleep (don't make this
 close to or more than the workflow task timeout)`,
+	)
+	SchedulerSLAWebhookEnabled = NewNamespaceBoolSetting(
+		"worker.schedulerSLAWebhookEnabled",
+		false,
+		`SchedulerSLAWebhookEnabled controls whether schedules may post SLA violations to the webhook URL in their SLA`,
+	)
+	SchedulerSLAWebhookTimeout = NewNamespaceDurationSetting(
+		"worker.schedulerSLAWebhookTimeout",
+		10*time.Second,
+		`SchedulerSLAWebhookTimeout is the timeout of a single request posting an SLA violation to a schedule's webhook`,
+	)
+	SchedulerConcurrencyGroupLimits = NewNamespaceTypedSetting(
+		"worker.schedulerConcurrencyGroupLimits",
+		map[string]int{},
+		`SchedulerConcurrencyGroupLimits maps schedule concurrency group names to the maximum number of workflows
+started by schedules in that group that may run at once in the namespace. Groups that are not listed (or have
+a limit of zero or less) are unlimited.`,
 	)
 	WorkerDeleteNamespaceActivityLimits = NewGlobalTypedSetting(
 		"worker.deleteNamespaceActivityLimitsConfig",
@@ -33,6 +33,11 @@ import (
 
 const (
 	failureSourceServer = "Server"
+
+	// ContinueAsNewRequiredFailureType is the application failure type of the workflow task failures recorded by the
+	// namespace continue-as-new policy. Their cause is FORCE_CLOSE_COMMAND, the type is what tells them apart from other
+	// failures forced by the server.
+	ContinueAsNewRequiredFailureType = "ContinueAsNewRequired"
 )
 
 func NewServerFailure(message string, nonRetryable bool) *failurepb.Failure {
@@ -46,6 +51,18 @@ func NewServerFailure(message string, nonRetryable bool) *failurepb.Failure {
 	return f
 }
 
+func NewContinueAsNewRequiredFailure(message string) *failurepb.Failure {
+	f := &failurepb.Failure{
+		Message: message,
+		Source:  failureSourceServer,
+		FailureInfo: &failurepb.Failure_ApplicationFailureInfo{ApplicationFailureInfo: &failurepb.ApplicationFailureInfo{
+			Type: ContinueAsNewRequiredFailureType,
+		}},
+	}
+
+	return f
+}
+
 func NewResetWorkflowFailure(message string, lastHeartbeatDetails *commonpb.Payloads) *failurepb.Failure {
 	f := &failurepb.Failure{
 		Message: message,
@@ -42,6 +42,10 @@ const (
 	CallerNameHeaderName = "caller-name"
 	CallerTypeHeaderName = "caller-type"
 	CallOriginHeaderName = "call-initiation"
+
+	// StickyPredecessorHeaderName is set by a restarted worker on sticky workflow task polls. Its value is the name of
+	// the sticky queue of the worker's previous process, which is then handed off to the polled sticky queue.
+	StickyPredecessorHeaderName = "temporal-sticky-predecessor"
 )
 
 var (
@@ -85,6 +85,7 @@ var (
 	WorkflowActionChildWorkflowFailed           = workflowAction("add-childworkflow-failed-event")
 	WorkflowActionChildWorkflowTerminated       = workflowAction("add-childworkflow-terminated-event")
 	WorkflowActionChildWorkflowTimedOut         = workflowAction("add-childworkflow-timedout-event")
+	WorkflowActionChildWorkflowProgress         = workflowAction("update-childworkflow-progress")
 
 	// external workflow cancellation
 	WorkflowActionExternalWorkflowCancelInitiated = workflowAction("add-externalworkflow-cancel-initiated-event")
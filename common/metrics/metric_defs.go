@@ -558,6 +558,7 @@ const (
 	TaskTypeTransferActiveTaskStartChildExecution         = "TransferActiveTaskStartChildExecution"
 	TaskTypeTransferActiveTaskResetWorkflow               = "TransferActiveTaskResetWorkflow"
 	TaskTypeTransferActiveTaskDeleteExecution             = "TransferActiveTaskDeleteExecution"
+	TaskTypeTransferActiveTaskChildExecutionProgress      = "TransferActiveTaskChildExecutionProgress"
 	TaskTypeTransferStandbyTaskActivity                   = "TransferStandbyTaskActivity"
 	TaskTypeTransferStandbyTaskWorkflowTask               = "TransferStandbyTaskWorkflowTask"
 	TaskTypeTransferStandbyTaskCloseExecution             = "TransferStandbyTaskCloseExecution"
@@ -566,6 +567,7 @@ const (
 	TaskTypeTransferStandbyTaskStartChildExecution        = "TransferStandbyTaskStartChildExecution"
 	TaskTypeTransferStandbyTaskResetWorkflow              = "TransferStandbyTaskResetWorkflow"
 	TaskTypeTransferStandbyTaskDeleteExecution            = "TransferStandbyTaskDeleteExecution"
+	TaskTypeTransferStandbyTaskChildExecutionProgress     = "TransferStandbyTaskChildExecutionProgress"
 	TaskTypeVisibilityTaskStartExecution                  = "VisibilityTaskStartExecution"
 	TaskTypeVisibilityTaskUpsertExecution                 = "VisibilityTaskUpsertExecution"
 	TaskTypeVisibilityTaskCloseExecution                  = "VisibilityTaskCloseExecution"
@@ -596,6 +598,9 @@ const (
 	ScheduleActionStartWorkflow = "start_workflow"
 )
 
+// ScheduleSlaViolationTypeTag tags schedule SLA violation metrics with the violation type
+const ScheduleSlaViolationTypeTag = "sla_violation_type"
+
 var (
 	ServiceRequests = NewCounterDef(
 		"service_requests",
@@ -691,6 +696,14 @@ var (
 		"nexus_latency",
 		WithDescription("Latency of Nexus requests."),
 	)
+	NexusResultCacheHits = NewCounterDef(
+		"nexus_result_cache_hits",
+		WithDescription("The number of Nexus StartOperation requests resolved from the frontend result cache."),
+	)
+	NexusResultCacheMisses = NewCounterDef(
+		"nexus_result_cache_misses",
+		WithDescription("The number of cacheable Nexus StartOperation requests that were not found in the frontend result cache."),
+	)
 	NexusCompletionRequests = NewCounterDef(
 		"nexus_completion_requests",
 		WithDescription("The number of Nexus completion (callback) requests received by the service."),
@@ -718,6 +731,7 @@ var (
 	CacheTtl                                     = NewTimerDef("cache_ttl")
 	CacheEntryAgeOnGet                           = NewTimerDef("cache_entry_age_on_get")
 	CacheEntryAgeOnEviction                      = NewTimerDef("cache_entry_age_on_eviction")
+	CacheEvictions                               = NewCounterDef("cache_evictions")
 	HistoryEventNotificationQueueingLatency      = NewTimerDef("history_event_notification_queueing_latency")
 	HistoryEventNotificationFanoutLatency        = NewTimerDef("history_event_notification_fanout_latency")
 	HistoryEventNotificationInFlightMessageGauge = NewGaugeDef("history_event_notification_inflight_message_gauge")
@@ -820,6 +834,7 @@ var (
 	QueueSliceCountHistogram                             = NewDimensionlessHistogramDef("queue_slice_count")
 	QueueActionCounter                                   = NewCounterDef("queue_actions")
 	ActivityE2ELatency                                   = NewTimerDef("activity_end_to_end_latency")
+	ActivityRetryDeferred                                = NewCounterDef("activity_retry_deferred")
 	AckLevelUpdateCounter                                = NewCounterDef("ack_level_update")
 	AckLevelUpdateFailedCounter                          = NewCounterDef("ack_level_update_failed")
 	CommandCounter                                       = NewCounterDef("command")
@@ -843,6 +858,10 @@ var (
 	SpeculativeWorkflowTaskCommits                       = NewCounterDef("speculative_workflow_task_commits")
 	SpeculativeWorkflowTaskRollbacks                     = NewCounterDef("speculative_workflow_task_rollbacks")
 
+	// ContinueAsNewPolicyCounter is emitted when a workflow task completes past a threshold of the continue-as-new
+	// policy. Its "action" tag is one of "warn", "fail_workflow_task" or "continue_as_new".
+	ContinueAsNewPolicyCounter = NewCounterDef("continue_as_new_policy")
+
 	ActivityEagerExecutionCounter = NewCounterDef("activity_eager_execution")
 	// WorkflowEagerExecutionCounter is emitted any time eager workflow start is requested.
 	WorkflowEagerExecutionCounter = NewCounterDef("workflow_eager_execution")
@@ -1050,6 +1069,27 @@ var (
 	NonRetryableTasks                                 = NewCounterDef(
 		"non_retryable_tasks",
 		WithDescription("The number of non-retryable matching tasks which are dropped due to specific errors"))
+	BatchedActivityTasksPerPoll = NewDimensionlessHistogramDef(
+		"batched_activity_tasks_per_poll",
+		WithDescription("The number of additional activity tasks returned by a batched activity poll"))
+	AdaptiveWritePartitionsGauge = NewGaugeDef(
+		"adaptive_write_partitions",
+		WithDescription("The number of write partitions chosen by adaptive partitioning for a task queue"))
+	AdaptiveReadPartitionsGauge = NewGaugeDef(
+		"adaptive_read_partitions",
+		WithDescription("The number of read partitions chosen by adaptive partitioning for a task queue"))
+	StickyHandoffRedirectedTasksCounter = NewCounterDef(
+		"sticky_handoff_redirected_tasks",
+		WithDescription("The number of workflow tasks redirected from a handed off sticky task queue to its successor"))
+	DeadLetteredTasksCounter = NewCounterDef(
+		"dead_lettered_tasks",
+		WithDescription("The number of matching tasks written to the dead-letter queue of their namespace"))
+	DeadLetterQueueFailuresCounter = NewCounterDef(
+		"dead_letter_queue_failures",
+		WithDescription("The number of matching tasks that should have been dead-lettered but could not be written"))
+	DeadLetterQueueSyncWritesCounter = NewCounterDef(
+		"dead_letter_queue_sync_writes",
+		WithDescription("The number of matching tasks written to the dead-letter queue by their caller because the buffer was full"))
 
 	// Versioning and Reachability
 	ReachabilityExitPointCounter = NewCounterDef("reachability_exit_point_count")
@@ -1186,6 +1226,10 @@ var (
 		"schedule_action_delay",
 		WithDescription("Delay between when scheduled actions should/actually happen"),
 	)
+	ScheduleSlaViolations = NewCounterDef(
+		"schedule_sla_violations",
+		WithDescription("The number of times a schedule violated one of its configured SLAs"),
+	)
 
 	// Force replication
 	EncounterZombieWorkflowCount        = NewCounterDef("encounter_zombie_workflow_count")
@@ -0,0 +1,332 @@
+// The MIT License
+//
+// Copyright (c) 2025 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payloadstore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/proxy"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// MetadataEncodingOffloaded is the encoding of a payload that references an offloaded payload. SDKs don't know
+	// this encoding, so a reference that could not be re-hydrated fails loudly instead of being decoded as user data.
+	MetadataEncodingOffloaded = "binary/temporal-offloaded"
+
+	metadataEncoding    = "encoding"
+	metadataNamespaceID = "temporal-offload-namespace-id"
+	metadataTreeID      = "temporal-offload-tree-id"
+)
+
+// Offloader moves payloads above a per-namespace size threshold from history events into a Store and re-hydrates
+// them on the way out. An Offloader without a store is disabled and leaves all payloads untouched.
+type Offloader struct {
+	store             Store
+	namespaceRegistry namespace.Registry
+	historyBranchUtil persistence.HistoryBranchUtil
+	threshold         dynamicconfig.IntPropertyFnWithNamespaceFilter
+	historyHostCount  func() int
+	logger            log.Logger
+}
+
+var (
+	digestPattern = regexp.MustCompile(`^[0-9a-f]{64}$`)
+	treeIDPattern = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+
+	errHostLocalStore = serviceerror.NewUnavailable(
+		"payload store is host local but more than one history host is running, configure a shared payload store",
+	)
+)
+
+// NewOffloader returns an Offloader for the given store. historyHostCount returns the number of running history
+// hosts, it is used to refuse offloading to a HostLocal store in a multi host cluster.
+func NewOffloader(
+	store Store,
+	namespaceRegistry namespace.Registry,
+	historyBranchUtil persistence.HistoryBranchUtil,
+	threshold dynamicconfig.IntPropertyFnWithNamespaceFilter,
+	historyHostCount func() int,
+	logger log.Logger,
+) *Offloader {
+	return &Offloader{
+		store:             store,
+		namespaceRegistry: namespaceRegistry,
+		historyBranchUtil: historyBranchUtil,
+		threshold:         threshold,
+		historyHostCount:  historyHostCount,
+		logger:            logger,
+	}
+}
+
+// Enabled returns true if a payload store is configured. A nil Offloader is disabled.
+func (o *Offloader) Enabled() bool {
+	return o != nil && o.store != nil
+}
+
+// OffloadWorkflowEvents offloads the large payloads of all given events batches in place.
+func (o *Offloader) OffloadWorkflowEvents(
+	ctx context.Context,
+	workflowEventsSeq ...*persistence.WorkflowEvents,
+) error {
+	for _, workflowEvents := range workflowEventsSeq {
+		if err := o.OffloadEvents(
+			ctx,
+			namespace.ID(workflowEvents.NamespaceID),
+			workflowEvents.RunID,
+			workflowEvents.BranchToken,
+			workflowEvents.Events,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OffloadEvents replaces every payload of the given events that is larger than the namespace threshold with a
+// reference to a copy kept in the store under the history tree of the branch. Events are modified in place. Search
+// attributes are never offloaded since they have to stay readable by visibility.
+func (o *Offloader) OffloadEvents(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	runID string,
+	branchToken []byte,
+	events []*historypb.HistoryEvent,
+) error {
+	if !o.Enabled() || len(events) == 0 {
+		return nil
+	}
+	namespaceName, err := o.namespaceRegistry.GetNamespaceName(namespaceID)
+	if err != nil {
+		return err
+	}
+	threshold := o.threshold(namespaceName.String())
+	if threshold <= 0 {
+		return nil
+	}
+	branch, err := o.historyBranchUtil.ParseHistoryBranchInfo(branchToken)
+	if err != nil {
+		return err
+	}
+	if !treeIDPattern.MatchString(branch.GetTreeId()) {
+		return serviceerror.NewInternal(fmt.Sprintf("unable to offload payloads of history tree %q", branch.GetTreeId()))
+	}
+	if len(branch.GetAncestors()) > 0 {
+		// The branch was forked from another branch of the tree and may show payloads offloaded by another run.
+		if err := o.store.Reference(ctx, namespaceID, branch.GetTreeId(), runID); err != nil {
+			return err
+		}
+	}
+
+	options := proxy.VisitPayloadsOptions{
+		SkipSearchAttributes: true,
+		Visitor: func(vpc *proxy.VisitPayloadsContext, payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+			for i, payload := range payloads {
+				if proto.Size(payload) <= threshold || IsOffloaded(payload) {
+					continue
+				}
+				if err := o.checkStoreReachable(); err != nil {
+					return nil, err
+				}
+				ref, err := o.offload(vpc, namespaceID, branch.GetTreeId(), runID, payload)
+				if err != nil {
+					return nil, err
+				}
+				payloads[i] = ref
+			}
+			return payloads, nil
+		},
+	}
+	for _, event := range events {
+		if err := proxy.VisitPayloads(ctx, event, options); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Rehydrate replaces the references to offloaded payloads found in msg with the original payloads. msg is modified in
+// place, callers must clone messages they don't own (e.g. events from the events cache). Only references that belong
+// to the given namespace are resolved. References whose payload is missing from the store are left untouched.
+func (o *Offloader) Rehydrate(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	msg proto.Message,
+) error {
+	if !o.Enabled() {
+		return nil
+	}
+	return proxy.VisitPayloads(ctx, msg, proxy.VisitPayloadsOptions{
+		SkipSearchAttributes: true,
+		Visitor: func(vpc *proxy.VisitPayloadsContext, payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+			for i, payload := range payloads {
+				if !IsOffloaded(payload) {
+					continue
+				}
+				original, err := o.load(vpc, namespaceID, payload)
+				if errors.Is(err, ErrPayloadNotFound) {
+					o.logger.Warn("Offloaded payload not found in payload store.",
+						tag.WorkflowNamespaceID(namespaceID.String()),
+						tag.Value(string(payload.GetData())),
+					)
+					continue
+				}
+				if err != nil {
+					return nil, err
+				}
+				if original != nil {
+					payloads[i] = original
+				}
+			}
+			return payloads, nil
+		},
+	})
+}
+
+// Release deletes the offloaded payloads of the run's history tree unless another run still references them. It is
+// called once the history of the run is deleted.
+func (o *Offloader) Release(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	runID string,
+	branchToken []byte,
+) error {
+	if !o.Enabled() {
+		return nil
+	}
+	branch, err := o.historyBranchUtil.ParseHistoryBranchInfo(branchToken)
+	if err != nil {
+		return err
+	}
+	return o.store.Release(ctx, namespaceID, branch.GetTreeId(), runID)
+}
+
+// checkStoreReachable returns an error if the store is host local and other history hosts are running. Payloads
+// offloaded to such a store would not be readable by the host that owns the shard after it moves.
+func (o *Offloader) checkStoreReachable() error {
+	local, ok := o.store.(HostLocal)
+	if !ok || !local.HostLocal() || o.historyHostCount == nil || o.historyHostCount() <= 1 {
+		return nil
+	}
+	o.logger.Error("Unable to offload payload to a host local payload store in a multi host cluster.")
+	return errHostLocalStore
+}
+
+// RehydratePayload returns the original payload if payload is a reference to an offloaded payload of the given
+// namespace, and payload itself otherwise. Unlike Rehydrate, payload is never modified.
+func (o *Offloader) RehydratePayload(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	payload *commonpb.Payload,
+) (*commonpb.Payload, error) {
+	if !o.Enabled() || !IsOffloaded(payload) {
+		return payload, nil
+	}
+	payloads := &commonpb.Payloads{Payloads: []*commonpb.Payload{payload}}
+	if err := o.Rehydrate(ctx, namespaceID, payloads); err != nil {
+		return nil, err
+	}
+	return payloads.Payloads[0], nil
+}
+
+// IsOffloaded returns true if the payload is a reference to an offloaded payload.
+func IsOffloaded(payload *commonpb.Payload) bool {
+	return string(payload.GetMetadata()[metadataEncoding]) == MetadataEncodingOffloaded
+}
+
+func (o *Offloader) offload(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	treeID string,
+	runID string,
+	payload *commonpb.Payload,
+) (*commonpb.Payload, error) {
+	data, err := proto.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(data)
+	digest := hex.EncodeToString(sum[:])
+	if err := o.store.Put(ctx, namespaceID, treeID, runID, digest, data); err != nil {
+		return nil, err
+	}
+	return &commonpb.Payload{
+		Metadata: map[string][]byte{
+			metadataEncoding:    []byte(MetadataEncodingOffloaded),
+			metadataNamespaceID: []byte(namespaceID.String()),
+			metadataTreeID:      []byte(treeID),
+		},
+		Data: []byte(digest),
+	}, nil
+}
+
+// load returns the original payload for the reference, or nil if the reference is not resolvable in the namespace.
+func (o *Offloader) load(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	ref *commonpb.Payload,
+) (*commonpb.Payload, error) {
+	digest := string(ref.GetData())
+	treeID := string(ref.GetMetadata()[metadataTreeID])
+	if string(ref.GetMetadata()[metadataNamespaceID]) != namespaceID.String() ||
+		!digestPattern.MatchString(digest) ||
+		!treeIDPattern.MatchString(treeID) {
+		return nil, nil
+	}
+	data, err := o.store.Get(ctx, namespaceID, treeID, digest)
+	if err != nil {
+		return nil, err
+	}
+	payload := &commonpb.Payload{}
+	if err := proto.Unmarshal(data, payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// BlobSizeLimitError returns the per event blob size limit. Namespaces that offload payloads get the (larger)
+// offloaded limit instead of the regular one, since large payloads no longer end up in history.
+func BlobSizeLimitError(dc *dynamicconfig.Collection) dynamicconfig.IntPropertyFnWithNamespaceFilter {
+	blobSizeLimit := dynamicconfig.BlobSizeLimitError.Get(dc)
+	offloadThreshold := dynamicconfig.PayloadOffloadThreshold.Get(dc)
+	offloadedBlobSizeLimit := dynamicconfig.OffloadedBlobSizeLimitError.Get(dc)
+	return func(namespace string) int {
+		if offloadThreshold(namespace) > 0 {
+			return offloadedBlobSizeLimit(namespace)
+		}
+		return blobSizeLimit(namespace)
+	}
+}
@@ -0,0 +1,261 @@
+// The MIT License
+//
+// Copyright (c) 2025 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payloadstore
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/persistence"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	testNamespaceID   = namespace.ID("4ec8e2c6-7e3c-4a4b-b6a8-7b7e2b4e0c11")
+	testNamespaceName = namespace.Name("test-namespace")
+	testRunID         = "0f3f2a43-6d1c-4b8e-9a57-2f5d6c0d9f10"
+	testTreeID        = "7c1d0b5e-3a2f-4e6d-8b9c-1d2e3f4a5b6c"
+)
+
+var testBranchToken = newTestBranchToken(testTreeID, false)
+
+func newTestBranchToken(treeID string, forked bool) []byte {
+	var ancestors []*persistencespb.HistoryBranchRange
+	if forked {
+		ancestors = []*persistencespb.HistoryBranchRange{{
+			BranchId:    "2b8a4c1e-5d6f-4a7b-8c9d-0e1f2a3b4c5d",
+			BeginNodeId: 1,
+			EndNodeId:   5,
+		}}
+	}
+	token, err := (&persistence.HistoryBranchUtilImpl{}).NewHistoryBranch(
+		testNamespaceID.String(),
+		"workflow-id",
+		testRunID,
+		treeID,
+		nil,
+		ancestors,
+		0,
+		0,
+		0,
+	)
+	if err != nil {
+		panic(err)
+	}
+	return token
+}
+
+func newTestOffloader(t *testing.T, threshold int) *Offloader {
+	return newTestOffloaderWithHosts(t, threshold, 1)
+}
+
+func newTestOffloaderWithHosts(t *testing.T, threshold int, historyHosts int) *Offloader {
+	store, err := NewFileStore(t.TempDir(), false)
+	require.NoError(t, err)
+	registry := namespace.NewMockRegistry(gomock.NewController(t))
+	registry.EXPECT().GetNamespaceName(testNamespaceID).Return(testNamespaceName, nil).AnyTimes()
+	return NewOffloader(
+		store,
+		registry,
+		&persistence.HistoryBranchUtilImpl{},
+		dynamicconfig.GetIntPropertyFnFilteredByNamespace(threshold),
+		func() int { return historyHosts },
+		log.NewTestLogger(),
+	)
+}
+
+func newSignalEvent(input ...*commonpb.Payload) *historypb.HistoryEvent {
+	return &historypb.HistoryEvent{
+		EventId:   5,
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+		Attributes: &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{
+			WorkflowExecutionSignaledEventAttributes: &historypb.WorkflowExecutionSignaledEventAttributes{
+				SignalName: "signal",
+				Input:      &commonpb.Payloads{Payloads: input},
+			},
+		},
+	}
+}
+
+func TestOffloader_OffloadAndRehydrate(t *testing.T) {
+	offloader := newTestOffloader(t, 1024)
+	ctx := context.Background()
+
+	small := payload.EncodeString("small")
+	large := payload.EncodeBytes(bytes.Repeat([]byte("x"), 4096))
+	event := newSignalEvent(small, large)
+	original := proto.Clone(event)
+
+	require.NoError(t, offloader.OffloadEvents(ctx, testNamespaceID, testRunID, testBranchToken, []*historypb.HistoryEvent{event}))
+	payloads := event.GetWorkflowExecutionSignaledEventAttributes().GetInput().GetPayloads()
+	require.Same(t, small, payloads[0])
+	require.True(t, IsOffloaded(payloads[1]))
+	require.Less(t, proto.Size(event), 1024)
+
+	// Offloading again leaves references alone.
+	offloaded := proto.Clone(event)
+	require.NoError(t, offloader.OffloadEvents(ctx, testNamespaceID, testRunID, testBranchToken, []*historypb.HistoryEvent{event}))
+	require.True(t, proto.Equal(offloaded, event))
+
+	history := &historypb.History{Events: []*historypb.HistoryEvent{event}}
+	require.NoError(t, offloader.Rehydrate(ctx, testNamespaceID, history))
+	require.True(t, proto.Equal(original, history.Events[0]))
+}
+
+func TestOffloader_Disabled(t *testing.T) {
+	ctx := context.Background()
+	large := payload.EncodeBytes(bytes.Repeat([]byte("x"), 4096))
+
+	offloader := newTestOffloader(t, 0)
+	event := newSignalEvent(large)
+	require.NoError(t, offloader.OffloadEvents(ctx, testNamespaceID, testRunID, testBranchToken, []*historypb.HistoryEvent{event}))
+	require.False(t, IsOffloaded(event.GetWorkflowExecutionSignaledEventAttributes().GetInput().GetPayloads()[0]))
+
+	noStore := NewOffloader(nil, nil, &persistence.HistoryBranchUtilImpl{}, dynamicconfig.GetIntPropertyFnFilteredByNamespace(1), nil, log.NewTestLogger())
+	require.False(t, noStore.Enabled())
+	require.NoError(t, noStore.OffloadEvents(ctx, testNamespaceID, testRunID, testBranchToken, []*historypb.HistoryEvent{event}))
+	require.False(t, IsOffloaded(event.GetWorkflowExecutionSignaledEventAttributes().GetInput().GetPayloads()[0]))
+}
+
+func TestOffloader_HostLocalStoreWithMultipleHistoryHosts(t *testing.T) {
+	offloader := newTestOffloaderWithHosts(t, 1024, 2)
+	ctx := context.Background()
+
+	small := newSignalEvent(payload.EncodeString("small"))
+	require.NoError(t, offloader.OffloadEvents(ctx, testNamespaceID, testRunID, testBranchToken, []*historypb.HistoryEvent{small}))
+
+	large := newSignalEvent(payload.EncodeBytes(bytes.Repeat([]byte("x"), 4096)))
+	err := offloader.OffloadEvents(ctx, testNamespaceID, testRunID, testBranchToken, []*historypb.HistoryEvent{large})
+	var unavailable *serviceerror.Unavailable
+	require.ErrorAs(t, err, &unavailable)
+	require.False(t, IsOffloaded(large.GetWorkflowExecutionSignaledEventAttributes().GetInput().GetPayloads()[0]))
+}
+
+func TestOffloader_RehydratePayload(t *testing.T) {
+	offloader := newTestOffloader(t, 1024)
+	ctx := context.Background()
+
+	large := payload.EncodeBytes(bytes.Repeat([]byte("x"), 4096))
+	event := newSignalEvent(common.CloneProto(large))
+	require.NoError(t, offloader.OffloadEvents(ctx, testNamespaceID, testRunID, testBranchToken, []*historypb.HistoryEvent{event}))
+	ref := event.GetWorkflowExecutionSignaledEventAttributes().GetInput().GetPayloads()[0]
+	require.True(t, IsOffloaded(ref))
+
+	rehydrated, err := offloader.RehydratePayload(ctx, testNamespaceID, ref)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(large, rehydrated))
+	require.True(t, IsOffloaded(ref))
+
+	small := payload.EncodeString("small")
+	rehydrated, err = offloader.RehydratePayload(ctx, testNamespaceID, small)
+	require.NoError(t, err)
+	require.Same(t, small, rehydrated)
+
+	var disabled *Offloader
+	rehydrated, err = disabled.RehydratePayload(ctx, testNamespaceID, ref)
+	require.NoError(t, err)
+	require.Same(t, ref, rehydrated)
+}
+
+func TestOffloader_RehydrateIgnoresForeignReferences(t *testing.T) {
+	offloader := newTestOffloader(t, 1024)
+	ctx := context.Background()
+
+	event := newSignalEvent(payload.EncodeBytes(bytes.Repeat([]byte("x"), 4096)))
+	require.NoError(t, offloader.OffloadEvents(ctx, testNamespaceID, testRunID, testBranchToken, []*historypb.HistoryEvent{event}))
+	offloaded := proto.Clone(event)
+
+	// References are only resolved in the namespace that owns them.
+	require.NoError(t, offloader.Rehydrate(ctx, namespace.ID("a51a54e4-28b0-4b3f-a7bb-3b2b0b1e7e44"), event))
+	require.True(t, proto.Equal(offloaded, event))
+
+	// Forged references that don't look like a digest are never passed to the store.
+	forged := &commonpb.Payload{
+		Metadata: map[string][]byte{
+			metadataEncoding:    []byte(MetadataEncodingOffloaded),
+			metadataNamespaceID: []byte(testNamespaceID.String()),
+			metadataTreeID:      []byte(testTreeID),
+		},
+		Data: []byte("../../etc/passwd"),
+	}
+	forgedEvent := newSignalEvent(forged)
+	require.NoError(t, offloader.Rehydrate(ctx, testNamespaceID, forgedEvent))
+	require.Same(t, forged, forgedEvent.GetWorkflowExecutionSignaledEventAttributes().GetInput().GetPayloads()[0])
+
+	forgedTree := common.CloneProto(offloaded.(*historypb.HistoryEvent))
+	forgedRef := forgedTree.GetWorkflowExecutionSignaledEventAttributes().GetInput().GetPayloads()[0]
+	forgedRef.Metadata[metadataTreeID] = []byte("../" + testTreeID)
+	require.NoError(t, offloader.Rehydrate(ctx, testNamespaceID, forgedTree))
+	require.True(t, IsOffloaded(forgedTree.GetWorkflowExecutionSignaledEventAttributes().GetInput().GetPayloads()[0]))
+}
+
+func TestOffloader_Release(t *testing.T) {
+	offloader := newTestOffloader(t, 1024)
+	ctx := context.Background()
+	large := payload.EncodeBytes(bytes.Repeat([]byte("x"), 4096))
+
+	event := newSignalEvent(common.CloneProto(large))
+	require.NoError(t, offloader.OffloadEvents(ctx, testNamespaceID, testRunID, testBranchToken, []*historypb.HistoryEvent{event}))
+
+	// A run forked from the tree (e.g. by reset) keeps the payloads alive after the original run is deleted.
+	resetRunID := "5e6f7a8b-9c0d-4e1f-a2b3-c4d5e6f7a8b9"
+	forkedEvent := newSignalEvent(payload.EncodeString("small"))
+	require.NoError(t, offloader.OffloadEvents(
+		ctx, testNamespaceID, resetRunID, newTestBranchToken(testTreeID, true), []*historypb.HistoryEvent{forkedEvent},
+	))
+	require.NoError(t, offloader.Release(ctx, testNamespaceID, testRunID, testBranchToken))
+
+	rehydrated := common.CloneProto(event)
+	require.NoError(t, offloader.Rehydrate(ctx, testNamespaceID, rehydrated))
+	require.True(t, proto.Equal(large, rehydrated.GetWorkflowExecutionSignaledEventAttributes().GetInput().GetPayloads()[0]))
+
+	// Once the last run of the tree is deleted the payloads are gone.
+	require.NoError(t, offloader.Release(ctx, testNamespaceID, resetRunID, testBranchToken))
+	rehydrated = common.CloneProto(event)
+	require.NoError(t, offloader.Rehydrate(ctx, testNamespaceID, rehydrated))
+	require.True(t, IsOffloaded(rehydrated.GetWorkflowExecutionSignaledEventAttributes().GetInput().GetPayloads()[0]))
+}
+
+func TestBlobSizeLimitError(t *testing.T) {
+	dc := dynamicconfig.NewMemoryClient()
+	collection := dynamicconfig.NewCollection(dc, log.NewNoopLogger())
+	limit := BlobSizeLimitError(collection)
+
+	require.Equal(t, 2*1024*1024, limit(testNamespaceName.String()))
+
+	dc.OverrideValue(dynamicconfig.PayloadOffloadThreshold.Key(), 1024)
+	require.Equal(t, 32*1024*1024, limit(testNamespaceName.String()))
+}
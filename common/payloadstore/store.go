@@ -0,0 +1,199 @@
+// The MIT License
+//
+// Copyright (c) 2025 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package payloadstore offloads large payloads out of workflow history into a blob store and re-hydrates them when
+// history is handed back to callers.
+package payloadstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"go.temporal.io/server/common/namespace"
+)
+
+type (
+	// Store is a blob store for offloaded payloads. Blobs are kept per history tree and content addressed within it:
+	// the digest is the hex encoded SHA-256 of the blob, so Put is idempotent and a blob is never overwritten with
+	// different content. The store tracks which runs reference the blobs of a tree, so that they can be deleted
+	// together with the history of the last such run.
+	Store interface {
+		// Put stores a blob of the history tree and records runID as a run referencing the blobs of the tree.
+		Put(ctx context.Context, namespaceID namespace.ID, treeID string, runID string, digest string, data []byte) error
+		Get(ctx context.Context, namespaceID namespace.ID, treeID string, digest string) ([]byte, error)
+		// Reference records runID as a run referencing the blobs of the history tree without storing a blob. Runs
+		// forked from another run of the tree (e.g. by reset) reference blobs they didn't write.
+		Reference(ctx context.Context, namespaceID namespace.ID, treeID string, runID string) error
+		// Release removes runID from the runs referencing the blobs of the history tree and deletes the blobs once
+		// no run references them anymore. It is called when the history of the run is deleted.
+		Release(ctx context.Context, namespaceID namespace.ID, treeID string, runID string) error
+	}
+
+	// HostLocal is implemented by stores whose blobs may only be readable on the host that wrote them. Payloads are
+	// not offloaded to a host local store while more than one history host runs.
+	HostLocal interface {
+		HostLocal() bool
+	}
+
+	fileStore struct {
+		directory string
+		shared    bool
+	}
+)
+
+const (
+	fileStoreDirMode  = 0o700
+	fileStoreFileMode = 0o600
+
+	fileStoreBlobsDir = "blobs"
+	fileStoreRunsDir  = "runs"
+)
+
+// ErrPayloadNotFound is returned by Store.Get when no blob exists for the given digest.
+var ErrPayloadNotFound = errors.New("offloaded payload not found")
+
+var (
+	_ Store     = (*fileStore)(nil)
+	_ HostLocal = (*fileStore)(nil)
+)
+
+// NewFileStore returns a Store that keeps blobs on the filesystem under the given directory. shared must only be set
+// if the directory is on a filesystem mounted by every history host, otherwise the store is host local.
+func NewFileStore(directory string, shared bool) (Store, error) {
+	if directory == "" {
+		return nil, errors.New("payload store directory is empty")
+	}
+	if err := os.MkdirAll(directory, fileStoreDirMode); err != nil {
+		return nil, fmt.Errorf("unable to create payload store directory: %w", err)
+	}
+	return &fileStore{directory: directory, shared: shared}, nil
+}
+
+func (s *fileStore) HostLocal() bool {
+	return !s.shared
+}
+
+func (s *fileStore) Put(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	treeID string,
+	runID string,
+	digest string,
+	data []byte,
+) error {
+	if err := s.Reference(ctx, namespaceID, treeID, runID); err != nil {
+		return err
+	}
+	path := s.blobPath(namespaceID, treeID, digest)
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), fileStoreDirMode); err != nil {
+		return err
+	}
+
+	// Write to a temp file first so that readers never observe a partially written blob.
+	tmp, err := os.CreateTemp(filepath.Dir(path), digest+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(fileStoreFileMode); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func (s *fileStore) Get(
+	_ context.Context,
+	namespaceID namespace.ID,
+	treeID string,
+	digest string,
+) ([]byte, error) {
+	// #nosec G304 -- namespace ID, tree ID and digest are validated by the offloader before reaching the store.
+	data, err := os.ReadFile(s.blobPath(namespaceID, treeID, digest))
+	if os.IsNotExist(err) {
+		return nil, ErrPayloadNotFound
+	}
+	return data, err
+}
+
+func (s *fileStore) Reference(
+	_ context.Context,
+	namespaceID namespace.ID,
+	treeID string,
+	runID string,
+) error {
+	path := filepath.Join(s.treePath(namespaceID, treeID), fileStoreRunsDir, runID)
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), fileStoreDirMode); err != nil {
+		return err
+	}
+	return os.WriteFile(path, nil, fileStoreFileMode)
+}
+
+// Release deletes the tree directory once the last run marker is gone. Runs of a tree are owned by the same shard, so
+// Release doesn't race with Put or Reference of another run of the tree.
+func (s *fileStore) Release(
+	_ context.Context,
+	namespaceID namespace.ID,
+	treeID string,
+	runID string,
+) error {
+	treePath := s.treePath(namespaceID, treeID)
+	runsPath := filepath.Join(treePath, fileStoreRunsDir)
+	if err := os.Remove(filepath.Join(runsPath, runID)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	runs, err := os.ReadDir(runsPath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if len(runs) > 0 {
+		return nil
+	}
+	return os.RemoveAll(treePath)
+}
+
+func (s *fileStore) treePath(namespaceID namespace.ID, treeID string) string {
+	return filepath.Join(s.directory, namespaceID.String(), treeID)
+}
+
+func (s *fileStore) blobPath(namespaceID namespace.ID, treeID string, digest string) string {
+	return filepath.Join(s.treePath(namespaceID, treeID), fileStoreBlobsDir, digest[:2], digest)
+}
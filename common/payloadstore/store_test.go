@@ -0,0 +1,104 @@
+// The MIT License
+//
+// Copyright (c) 2025 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payloadstore
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/namespace"
+)
+
+func TestFileStore_PutGet(t *testing.T) {
+	store, err := NewFileStore(t.TempDir(), false)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	namespaceID := namespace.ID("4ec8e2c6-7e3c-4a4b-b6a8-7b7e2b4e0c11")
+	treeID := "7c1d0b5e-3a2f-4e6d-8b9c-1d2e3f4a5b6c"
+	runID := "0f3f2a43-6d1c-4b8e-9a57-2f5d6c0d9f10"
+	digest := "a3f1c2d4e5b60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f90"
+
+	_, err = store.Get(ctx, namespaceID, treeID, digest)
+	require.ErrorIs(t, err, ErrPayloadNotFound)
+
+	require.NoError(t, store.Put(ctx, namespaceID, treeID, runID, digest, []byte("payload")))
+	// Put is idempotent for the same digest.
+	require.NoError(t, store.Put(ctx, namespaceID, treeID, runID, digest, []byte("payload")))
+
+	data, err := store.Get(ctx, namespaceID, treeID, digest)
+	require.NoError(t, err)
+	require.Equal(t, []byte("payload"), data)
+
+	_, err = store.Get(ctx, namespace.ID("a51a54e4-28b0-4b3f-a7bb-3b2b0b1e7e44"), treeID, digest)
+	require.ErrorIs(t, err, ErrPayloadNotFound)
+	_, err = store.Get(ctx, namespaceID, "2b8a4c1e-5d6f-4a7b-8c9d-0e1f2a3b4c5d", digest)
+	require.ErrorIs(t, err, ErrPayloadNotFound)
+}
+
+func TestFileStore_Release(t *testing.T) {
+	directory := t.TempDir()
+	store, err := NewFileStore(directory, false)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	namespaceID := namespace.ID("4ec8e2c6-7e3c-4a4b-b6a8-7b7e2b4e0c11")
+	treeID := "7c1d0b5e-3a2f-4e6d-8b9c-1d2e3f4a5b6c"
+	runID := "0f3f2a43-6d1c-4b8e-9a57-2f5d6c0d9f10"
+	resetRunID := "5e6f7a8b-9c0d-4e1f-a2b3-c4d5e6f7a8b9"
+	digest := "a3f1c2d4e5b60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f90"
+
+	// Releasing a tree that never had blobs is a noop.
+	require.NoError(t, store.Release(ctx, namespaceID, treeID, runID))
+
+	require.NoError(t, store.Put(ctx, namespaceID, treeID, runID, digest, []byte("payload")))
+	require.NoError(t, store.Reference(ctx, namespaceID, treeID, resetRunID))
+
+	require.NoError(t, store.Release(ctx, namespaceID, treeID, runID))
+	_, err = store.Get(ctx, namespaceID, treeID, digest)
+	require.NoError(t, err)
+
+	require.NoError(t, store.Release(ctx, namespaceID, treeID, resetRunID))
+	_, err = store.Get(ctx, namespaceID, treeID, digest)
+	require.ErrorIs(t, err, ErrPayloadNotFound)
+	_, err = os.Stat(filepath.Join(directory, namespaceID.String(), treeID))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestNewFileStore_EmptyDirectory(t *testing.T) {
+	_, err := NewFileStore("", false)
+	require.Error(t, err)
+}
+
+func TestFileStore_HostLocal(t *testing.T) {
+	local, err := NewFileStore(t.TempDir(), false)
+	require.NoError(t, err)
+	require.True(t, local.(HostLocal).HostLocal())
+
+	shared, err := NewFileStore(t.TempDir(), true)
+	require.NoError(t, err)
+	require.False(t, shared.(HostLocal).HostLocal())
+}
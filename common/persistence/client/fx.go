@@ -89,6 +89,7 @@ var Module = fx.Options(
 	fx.Provide(managerProvider(Factory.NewShardManager)),
 	fx.Provide(managerProvider(Factory.NewExecutionManager)),
 	fx.Provide(managerProvider(Factory.NewHistoryTaskQueueManager)),
+	fx.Provide(managerProvider(Factory.NewMatchingTaskDLQManager)),
 	fx.Provide(managerProvider(Factory.NewNexusEndpointManager)),
 
 	fx.Provide(ClusterNameProvider),
@@ -1331,6 +1331,49 @@ type (
 		Queues        []QueueInfo
 		NextPageToken []byte
 	}
+
+	// MatchingTaskDLQManager manages the per-namespace dead-letter queues of tasks that matching dropped without
+	// delivering them to a worker.
+	MatchingTaskDLQManager interface {
+		Closeable
+		EnqueueTask(ctx context.Context, request *EnqueueMatchingDLQTaskRequest) (*EnqueueMatchingDLQTaskResponse, error)
+		ReadTasks(ctx context.Context, request *ReadMatchingDLQTasksRequest) (*ReadMatchingDLQTasksResponse, error)
+		DeleteTasks(ctx context.Context, request *DeleteMatchingDLQTasksRequest) (*DeleteMatchingDLQTasksResponse, error)
+	}
+
+	EnqueueMatchingDLQTaskRequest struct {
+		NamespaceID string
+		Task        *persistencespb.DeadLetteredTask
+	}
+
+	EnqueueMatchingDLQTaskResponse struct {
+		Metadata MessageMetadata
+	}
+
+	ReadMatchingDLQTasksRequest struct {
+		NamespaceID   string
+		PageSize      int
+		NextPageToken []byte
+	}
+
+	MatchingDLQTask struct {
+		MessageMetadata MessageMetadata
+		Task            *persistencespb.DeadLetteredTask
+	}
+
+	ReadMatchingDLQTasksResponse struct {
+		Tasks         []MatchingDLQTask
+		NextPageToken []byte
+	}
+
+	DeleteMatchingDLQTasksRequest struct {
+		NamespaceID                 string
+		InclusiveMaxMessageMetadata MessageMetadata
+	}
+
+	DeleteMatchingDLQTasksResponse struct {
+		MessagesDeleted int64
+	}
 )
 
 func (e *InvalidPersistenceRequestError) Error() string {
@@ -0,0 +1,161 @@
+// The MIT License
+//
+// Copyright (c) 2025 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+const (
+	ErrMsgDeserializeMatchingDLQTask = "failed to deserialize dead-lettered matching task"
+)
+
+var (
+	ErrEnqueueMatchingDLQTaskIsNil = errors.New("enqueue matching dlq task request task is nil")
+)
+
+type (
+	// MatchingTaskDLQManagerImpl stores dead-lettered matching tasks in one QueueV2 queue per namespace, named by
+	// the namespace ID.
+	MatchingTaskDLQManagerImpl struct {
+		queue QueueV2
+	}
+)
+
+func NewMatchingTaskDLQManager(queue QueueV2) *MatchingTaskDLQManagerImpl {
+	return &MatchingTaskDLQManagerImpl{
+		queue: queue,
+	}
+}
+
+// EnqueueTask appends a task to the dead-letter queue of its namespace, creating the queue if it doesn't exist yet.
+func (m *MatchingTaskDLQManagerImpl) EnqueueTask(
+	ctx context.Context,
+	request *EnqueueMatchingDLQTaskRequest,
+) (*EnqueueMatchingDLQTaskResponse, error) {
+	if request.Task == nil {
+		return nil, ErrEnqueueMatchingDLQTaskIsNil
+	}
+	taskBytes, err := request.Task.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	enqueueRequest := &InternalEnqueueMessageRequest{
+		QueueType: QueueTypeMatchingDLQ,
+		QueueName: request.NamespaceID,
+		Blob: &commonpb.DataBlob{
+			EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+			Data:         taskBytes,
+		},
+	}
+
+	message, err := m.queue.EnqueueMessage(ctx, enqueueRequest)
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		_, err = m.queue.CreateQueue(ctx, &InternalCreateQueueRequest{
+			QueueType: QueueTypeMatchingDLQ,
+			QueueName: request.NamespaceID,
+		})
+		if err != nil && !errors.Is(err, ErrQueueAlreadyExists) {
+			return nil, err
+		}
+		message, err = m.queue.EnqueueMessage(ctx, enqueueRequest)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &EnqueueMatchingDLQTaskResponse{
+		Metadata: message.Metadata,
+	}, nil
+}
+
+// ReadTasks returns a page of tasks from the dead-letter queue of a namespace. A namespace that never had a task
+// dead-lettered has no tasks.
+func (m *MatchingTaskDLQManagerImpl) ReadTasks(
+	ctx context.Context,
+	request *ReadMatchingDLQTasksRequest,
+) (*ReadMatchingDLQTasksResponse, error) {
+	if request.PageSize <= 0 {
+		return nil, fmt.Errorf("%w: %v", ErrReadTasksNonPositivePageSize, request.PageSize)
+	}
+
+	response, err := m.queue.ReadMessages(ctx, &InternalReadMessagesRequest{
+		QueueType:     QueueTypeMatchingDLQ,
+		QueueName:     request.NamespaceID,
+		PageSize:      request.PageSize,
+		NextPageToken: request.NextPageToken,
+	})
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		return &ReadMatchingDLQTasksResponse{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]MatchingDLQTask, len(response.Messages))
+	for i, message := range response.Messages {
+		task := &persistencespb.DeadLetteredTask{}
+		err := serialization.Proto3Decode(message.Data.GetData(), message.Data.GetEncodingType(), task)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", ErrMsgDeserializeMatchingDLQTask, err)
+		}
+		tasks[i] = MatchingDLQTask{
+			MessageMetadata: message.MetaData,
+			Task:            task,
+		}
+	}
+
+	return &ReadMatchingDLQTasksResponse{
+		Tasks:         tasks,
+		NextPageToken: response.NextPageToken,
+	}, nil
+}
+
+// DeleteTasks deletes all tasks up to and including the given message from the dead-letter queue of a namespace.
+func (m *MatchingTaskDLQManagerImpl) DeleteTasks(
+	ctx context.Context,
+	request *DeleteMatchingDLQTasksRequest,
+) (*DeleteMatchingDLQTasksResponse, error) {
+	resp, err := m.queue.RangeDeleteMessages(ctx, &InternalRangeDeleteMessagesRequest{
+		QueueType:                   QueueTypeMatchingDLQ,
+		QueueName:                   request.NamespaceID,
+		InclusiveMaxMessageMetadata: request.InclusiveMaxMessageMetadata,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &DeleteMatchingDLQTasksResponse{MessagesDeleted: resp.MessagesDeleted}, nil
+}
+
+func (m *MatchingTaskDLQManagerImpl) Close() {
+}
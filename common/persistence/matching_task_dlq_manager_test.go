@@ -0,0 +1,134 @@
+// The MIT License
+//
+// Copyright (c) 2025 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMatchingTaskDLQManager_EnqueueCreatesQueue(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	queue := mock.NewMockQueueV2(ctrl)
+	m := persistence.NewMatchingTaskDLQManager(queue)
+
+	gomock.InOrder(
+		queue.EXPECT().EnqueueMessage(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("queue not found")),
+		queue.EXPECT().CreateQueue(gomock.Any(), &persistence.InternalCreateQueueRequest{
+			QueueType: persistence.QueueTypeMatchingDLQ,
+			QueueName: "ns-id",
+		}).Return(&persistence.InternalCreateQueueResponse{}, nil),
+		queue.EXPECT().EnqueueMessage(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, req *persistence.InternalEnqueueMessageRequest) (*persistence.InternalEnqueueMessageResponse, error) {
+				assert.Equal(t, persistence.QueueTypeMatchingDLQ, req.QueueType)
+				assert.Equal(t, "ns-id", req.QueueName)
+				return &persistence.InternalEnqueueMessageResponse{
+					Metadata: persistence.MessageMetadata{ID: persistence.FirstQueueMessageID},
+				}, nil
+			}),
+	)
+
+	resp, err := m.EnqueueTask(context.Background(), &persistence.EnqueueMatchingDLQTaskRequest{
+		NamespaceID: "ns-id",
+		Task:        &persistencespb.DeadLetteredTask{TaskQueue: "tq"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(persistence.FirstQueueMessageID), resp.Metadata.ID)
+}
+
+func TestMatchingTaskDLQManager_EnqueueNilTask(t *testing.T) {
+	t.Parallel()
+
+	m := persistence.NewMatchingTaskDLQManager(nil)
+	_, err := m.EnqueueTask(context.Background(), &persistence.EnqueueMatchingDLQTaskRequest{NamespaceID: "ns-id"})
+	assert.ErrorIs(t, err, persistence.ErrEnqueueMatchingDLQTaskIsNil)
+}
+
+func TestMatchingTaskDLQManager_ReadMissingQueue(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	queue := mock.NewMockQueueV2(ctrl)
+	m := persistence.NewMatchingTaskDLQManager(queue)
+
+	queue.EXPECT().ReadMessages(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("queue not found"))
+	resp, err := m.ReadTasks(context.Background(), &persistence.ReadMatchingDLQTasksRequest{
+		NamespaceID: "ns-id",
+		PageSize:    10,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Tasks)
+	assert.Empty(t, resp.NextPageToken)
+}
+
+func TestMatchingTaskDLQManager_ReadTasks(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	queue := mock.NewMockQueueV2(ctrl)
+	m := persistence.NewMatchingTaskDLQManager(queue)
+
+	task := &persistencespb.DeadLetteredTask{TaskQueue: "tq", ErrorMessage: "boom"}
+	data, err := task.Marshal()
+	require.NoError(t, err)
+	queue.EXPECT().ReadMessages(gomock.Any(), gomock.Any()).Return(&persistence.InternalReadMessagesResponse{
+		Messages: []persistence.QueueV2Message{
+			{
+				MetaData: persistence.MessageMetadata{ID: 5},
+				Data:     &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: data},
+			},
+		},
+		NextPageToken: []byte("token"),
+	}, nil)
+
+	resp, err := m.ReadTasks(context.Background(), &persistence.ReadMatchingDLQTasksRequest{
+		NamespaceID: "ns-id",
+		PageSize:    1,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Tasks, 1)
+	assert.Equal(t, int64(5), resp.Tasks[0].MessageMetadata.ID)
+	assert.Equal(t, "tq", resp.Tasks[0].Task.GetTaskQueue())
+	assert.Equal(t, "boom", resp.Tasks[0].Task.GetErrorMessage())
+	assert.Equal(t, []byte("token"), resp.NextPageToken)
+}
+
+func TestMatchingTaskDLQManager_ReadNonPositivePageSize(t *testing.T) {
+	t.Parallel()
+
+	m := persistence.NewMatchingTaskDLQManager(nil)
+	_, err := m.ReadTasks(context.Background(), &persistence.ReadMatchingDLQTasksRequest{NamespaceID: "ns-id"})
+	assert.ErrorIs(t, err, persistence.ErrReadTasksNonPositivePageSize)
+}
@@ -113,6 +113,8 @@ func (s *TaskSerializer) serializeTransferTask(
 		transferTask = s.transferResetTaskToProto(task)
 	case *tasks.DeleteExecutionTask:
 		transferTask = s.transferDeleteExecutionTaskToProto(task)
+	case *tasks.ChildExecutionProgressTask:
+		transferTask = s.transferChildProgressTaskToProto(task)
 	default:
 		return nil, serviceerror.NewInternal(fmt.Sprintf("Unknown transfer task type: %v", task))
 	}
@@ -146,6 +148,8 @@ func (s *TaskSerializer) deserializeTransferTasks(
 		task = s.transferResetTaskFromProto(transferTask)
 	case enumsspb.TASK_TYPE_TRANSFER_DELETE_EXECUTION:
 		task = s.transferDeleteExecutionTaskFromProto(transferTask)
+	case enumsspb.TASK_TYPE_TRANSFER_CHILD_EXECUTION_PROGRESS:
+		task = s.transferChildProgressTaskFromProto(transferTask)
 	default:
 		return nil, serviceerror.NewInternal(fmt.Sprintf("Unknown transfer task type: %v", transferTask.TaskType))
 	}
@@ -621,6 +625,37 @@ func (s *TaskSerializer) transferResetTaskFromProto(
 	}
 }
 
+func (s *TaskSerializer) transferChildProgressTaskToProto(
+	progressTask *tasks.ChildExecutionProgressTask,
+) *persistencespb.TransferTaskInfo {
+	return &persistencespb.TransferTaskInfo{
+		NamespaceId:      progressTask.WorkflowKey.NamespaceID,
+		WorkflowId:       progressTask.WorkflowKey.WorkflowID,
+		RunId:            progressTask.WorkflowKey.RunID,
+		TaskType:         enumsspb.TASK_TYPE_TRANSFER_CHILD_EXECUTION_PROGRESS,
+		ScheduledEventId: progressTask.EventID,
+		Version:          progressTask.Version,
+		TaskId:           progressTask.TaskID,
+		VisibilityTime:   timestamppb.New(progressTask.VisibilityTimestamp),
+	}
+}
+
+func (s *TaskSerializer) transferChildProgressTaskFromProto(
+	progressTask *persistencespb.TransferTaskInfo,
+) *tasks.ChildExecutionProgressTask {
+	return &tasks.ChildExecutionProgressTask{
+		WorkflowKey: definition.NewWorkflowKey(
+			progressTask.NamespaceId,
+			progressTask.WorkflowId,
+			progressTask.RunId,
+		),
+		VisibilityTimestamp: progressTask.VisibilityTime.AsTime(),
+		TaskID:              progressTask.TaskId,
+		EventID:             progressTask.ScheduledEventId,
+		Version:             progressTask.Version,
+	}
+}
+
 func (s *TaskSerializer) transferDeleteExecutionTaskToProto(
 	deleteExecutionTask *tasks.DeleteExecutionTask,
 ) *persistencespb.TransferTaskInfo {
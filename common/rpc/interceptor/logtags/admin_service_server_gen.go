@@ -86,10 +86,32 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		}
 	case *adminservice.DescribeMutableStateResponse:
 		return nil
+	case *adminservice.DescribeMutableStateCacheRequest:
+		return nil
+	case *adminservice.DescribeMutableStateCacheResponse:
+		return nil
+	case *adminservice.DescribeScheduleRequest:
+		return nil
+	case *adminservice.DescribeScheduleResponse:
+		return nil
 	case *adminservice.DescribeTaskQueuePartitionRequest:
 		return nil
 	case *adminservice.DescribeTaskQueuePartitionResponse:
 		return nil
+	case *adminservice.DescribeWorkflowCallbacksRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetExecution().GetRunId()),
+		}
+	case *adminservice.DescribeWorkflowCallbacksResponse:
+		return nil
+	case *adminservice.DiffWorkflowExecutionHistoriesRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetExecution().GetRunId()),
+		}
+	case *adminservice.DiffWorkflowExecutionHistoriesResponse:
+		return nil
 	case *adminservice.ForceUnloadTaskQueuePartitionRequest:
 		return nil
 	case *adminservice.ForceUnloadTaskQueuePartitionResponse:
@@ -113,6 +135,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.GetDLQTasksResponse:
 		return nil
+	case *adminservice.GetMatchingDLQTasksRequest:
+		return nil
+	case *adminservice.GetMatchingDLQTasksResponse:
+		return nil
 	case *adminservice.GetNamespaceRequest:
 		return nil
 	case *adminservice.GetNamespaceResponse:
@@ -121,6 +147,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.GetNamespaceReplicationMessagesResponse:
 		return nil
+	case *adminservice.GetNexusEndpointPolicyRequest:
+		return nil
+	case *adminservice.GetNexusEndpointPolicyResponse:
+		return nil
 	case *adminservice.GetReplicationMessagesRequest:
 		return nil
 	case *adminservice.GetReplicationMessagesResponse:
@@ -182,6 +212,13 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.MergeDLQTasksResponse:
 		return nil
+	case *adminservice.PauseWorkflowExecutionRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetExecution().GetRunId()),
+		}
+	case *adminservice.PauseWorkflowExecutionResponse:
+		return nil
 	case *adminservice.PurgeDLQMessagesRequest:
 		return nil
 	case *adminservice.PurgeDLQMessagesResponse:
@@ -204,6 +241,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		}
 	case *adminservice.RebuildMutableStateResponse:
 		return nil
+	case *adminservice.ReenqueueMatchingDLQTasksRequest:
+		return nil
+	case *adminservice.ReenqueueMatchingDLQTasksResponse:
+		return nil
 	case *adminservice.RefreshWorkflowTasksRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
@@ -230,6 +271,31 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		}
 	case *adminservice.ResendReplicationTasksResponse:
 		return nil
+	case *adminservice.ResetWorkflowExecutionRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetResetRequest().GetWorkflowExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetResetRequest().GetWorkflowExecution().GetRunId()),
+		}
+	case *adminservice.ResetWorkflowExecutionResponse:
+		return []tag.Tag{
+			tag.WorkflowRunID(r.GetRunId()),
+		}
+	case *adminservice.SetScheduleConcurrencyGroupRequest:
+		return nil
+	case *adminservice.SetScheduleConcurrencyGroupResponse:
+		return nil
+	case *adminservice.SetScheduleDependenciesRequest:
+		return nil
+	case *adminservice.SetScheduleDependenciesResponse:
+		return nil
+	case *adminservice.SetScheduleSharedCalendarsRequest:
+		return nil
+	case *adminservice.SetScheduleSharedCalendarsResponse:
+		return nil
+	case *adminservice.SetScheduleSlaRequest:
+		return nil
+	case *adminservice.SetScheduleSlaResponse:
+		return nil
 	case *adminservice.SyncWorkflowStateRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
@@ -237,6 +303,24 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		}
 	case *adminservice.SyncWorkflowStateResponse:
 		return nil
+	case *adminservice.UnpauseWorkflowExecutionRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetExecution().GetRunId()),
+		}
+	case *adminservice.UnpauseWorkflowExecutionResponse:
+		return nil
+	case *adminservice.UpdateNexusEndpointPolicyRequest:
+		return nil
+	case *adminservice.UpdateNexusEndpointPolicyResponse:
+		return nil
+	case *adminservice.UpdateWorkflowCallbackRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetExecution().GetRunId()),
+		}
+	case *adminservice.UpdateWorkflowCallbackResponse:
+		return nil
 	default:
 		return nil
 	}
@@ -84,6 +84,17 @@ func (wt *WorkflowTags) extractFromHistoryServiceServerMessage(message any) []ta
 		}
 	case *historyservice.DescribeMutableStateResponse:
 		return nil
+	case *historyservice.DescribeMutableStateCacheRequest:
+		return nil
+	case *historyservice.DescribeMutableStateCacheResponse:
+		return nil
+	case *historyservice.DescribeWorkflowCallbacksRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetExecution().GetRunId()),
+		}
+	case *historyservice.DescribeWorkflowCallbacksResponse:
+		return nil
 	case *historyservice.DescribeWorkflowExecutionRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetRequest().GetExecution().GetWorkflowId()),
@@ -220,6 +231,13 @@ func (wt *WorkflowTags) extractFromHistoryServiceServerMessage(message any) []ta
 		}
 	case *historyservice.PauseActivityResponse:
 		return nil
+	case *historyservice.PauseWorkflowExecutionRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetExecution().GetRunId()),
+		}
+	case *historyservice.PauseWorkflowExecutionResponse:
+		return nil
 	case *historyservice.PollMutableStateRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
@@ -280,6 +298,13 @@ func (wt *WorkflowTags) extractFromHistoryServiceServerMessage(message any) []ta
 		}
 	case *historyservice.RecordChildExecutionCompletedResponse:
 		return nil
+	case *historyservice.RecordChildExecutionProgressRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetParentExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetParentExecution().GetRunId()),
+		}
+	case *historyservice.RecordChildExecutionProgressResponse:
+		return nil
 	case *historyservice.RecordWorkflowTaskStartedRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetWorkflowExecution().GetWorkflowId()),
@@ -431,6 +456,13 @@ func (wt *WorkflowTags) extractFromHistoryServiceServerMessage(message any) []ta
 		}
 	case *historyservice.UnpauseActivityResponse:
 		return nil
+	case *historyservice.UnpauseWorkflowExecutionRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetExecution().GetRunId()),
+		}
+	case *historyservice.UnpauseWorkflowExecutionResponse:
+		return nil
 	case *historyservice.UpdateActivityOptionsRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetUpdateRequest().GetExecution().GetWorkflowId()),
@@ -438,6 +470,13 @@ func (wt *WorkflowTags) extractFromHistoryServiceServerMessage(message any) []ta
 		}
 	case *historyservice.UpdateActivityOptionsResponse:
 		return nil
+	case *historyservice.UpdateWorkflowCallbackRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetExecution().GetRunId()),
+		}
+	case *historyservice.UpdateWorkflowCallbackResponse:
+		return nil
 	case *historyservice.UpdateWorkflowExecutionRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetRequest().GetWorkflowExecution().GetWorkflowId()),
@@ -95,6 +95,10 @@ func (wt *WorkflowTags) extractFromMatchingServiceServerMessage(message any) []t
 		return nil
 	case *matchingservice.GetBuildIdTaskQueueMappingResponse:
 		return nil
+	case *matchingservice.GetDeadLetteredTasksRequest:
+		return nil
+	case *matchingservice.GetDeadLetteredTasksResponse:
+		return nil
 	case *matchingservice.GetTaskQueueUserDataRequest:
 		return nil
 	case *matchingservice.GetTaskQueueUserDataResponse:
@@ -107,6 +111,10 @@ func (wt *WorkflowTags) extractFromMatchingServiceServerMessage(message any) []t
 		return nil
 	case *matchingservice.GetWorkerVersioningRulesResponse:
 		return nil
+	case *matchingservice.HandoffStickyTaskQueueRequest:
+		return nil
+	case *matchingservice.HandoffStickyTaskQueueResponse:
+		return nil
 	case *matchingservice.ListNexusEndpointsRequest:
 		return nil
 	case *matchingservice.ListNexusEndpointsResponse:
@@ -140,6 +148,10 @@ func (wt *WorkflowTags) extractFromMatchingServiceServerMessage(message any) []t
 		}
 	case *matchingservice.QueryWorkflowResponse:
 		return nil
+	case *matchingservice.ReenqueueDeadLetteredTasksRequest:
+		return nil
+	case *matchingservice.ReenqueueDeadLetteredTasksResponse:
+		return nil
 	case *matchingservice.ReplicateTaskQueueUserDataRequest:
 		return nil
 	case *matchingservice.ReplicateTaskQueueUserDataResponse:
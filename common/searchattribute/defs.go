@@ -82,18 +82,12 @@ const (
 	ScheduleID = "ScheduleId"
 
 	// TemporalPauseInfo is a search attribute that stores the information about paused entities in the workflow.
-	// Format of a single paused entity: "<key>:<value>".
+	// Format of a single paused entity: "property:<key>=<value>".
 	//  * <key> is something that can be used to identify the filtering condition
 	//  * <value> is the value of the corresponding filtering condition.
 	// examples:
-	//   - for paused activities, manual pause, we may have 2 <key>:<value> pairs:
-	//     * "Activity:MyCoolActivityType"
-	//     * "Reason:ManualActivityPause"
-	//     * or
-	//     * "Policy:<some policy id>"
-	//   - for paused workflows, we may have the following <key>:<value> pairs:
-	//     * "Workflow:WorkflowID"
-	//     * "Reason:ManualWorkflowPause"
+	//   - for paused activities: "property:activityType=MyCoolActivityType"
+	//   - for a paused workflow: "property:workflowPaused=true"
 	TemporalPauseInfo = "TemporalPauseInfo"
 
 	// BuildIds is a KeywordList that holds information about current and past build ids
@@ -43,7 +43,8 @@ type (
 		timeSource clock.TimeSource
 
 		// the actual timer which will fire
-		timer *time.Timer
+		timer   clock.Timer
+		timerCh <-chan time.Time
 		// variable indicating when the above timer will fire
 		nextWakeupTime time.Time
 	}
@@ -51,8 +52,11 @@ type (
 
 // NewLocalGate create a new timer gate instance
 func NewLocalGate(timeSource clock.TimeSource) LocalGate {
+	// the timer is created through the time source so that it follows the time source's notion of time
+	timerCh, timer := timeSource.NewTimer(0)
 	lg := &LocalGateImpl{
-		timer:          time.NewTimer(0),
+		timer:          timer,
+		timerCh:        timerCh,
 		nextWakeupTime: time.Time{},
 		fireCh:         make(chan struct{}, 1),
 		closeCh:        make(chan struct{}),
@@ -61,7 +65,7 @@ func NewLocalGate(timeSource clock.TimeSource) LocalGate {
 	// the timer should be stopped when initialized
 	if !lg.timer.Stop() {
 		// drain the existing signal if exist
-		<-lg.timer.C
+		<-lg.timerCh
 	}
 
 	go func() {
@@ -70,7 +74,7 @@ func NewLocalGate(timeSource clock.TimeSource) LocalGate {
 	loop:
 		for {
 			select {
-			case <-lg.timer.C:
+			case <-lg.timerCh:
 				select {
 				// re-transmit on gateC
 				case lg.fireCh <- struct{}{}:
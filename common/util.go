@@ -126,6 +126,9 @@ const (
 	FailureReasonHistorySizeExceedsLimit = "Workflow history size exceeds limit."
 	// FailureReasonHistorySizeExceedsLimit is reason to fail workflow when history count exceeds limit
 	FailureReasonHistoryCountExceedsLimit = "Workflow history count exceeds limit."
+	// FailureReasonHistoryContinueAsNewRequired is the reason to fail a workflow task that neither closes nor
+	// continues-as-new a workflow whose history has crossed the continue-as-new policy threshold
+	FailureReasonHistoryContinueAsNewRequired = "Workflow history is close to its limit, continue-as-new is required."
 	// FailureReasonMutableStateSizeExceedsLimit is reason to fail workflow when mutable state size exceeds limit
 	FailureReasonMutableStateSizeExceedsLimit = "Workflow mutable state size exceeds limit."
 	// FailureReasonTransactionSizeExceedsLimit is the failureReason for when transaction cannot be committed because it exceeds size limit
@@ -49,14 +49,24 @@ var RetryPolicyMaximumInterval = dynamicconfig.NewGlobalDurationSetting(
 	`The maximum backoff interval between every callback request attempt for a given callback.`,
 )
 
+var WebhookSigningSecrets = dynamicconfig.NewNamespaceTypedSetting(
+	"component.callbacks.webhookSigningSecrets",
+	map[string]string{},
+	`The per-namespace map of webhook callback signing secret names to the paths of files containing the secrets.
+Webhook callbacks that reference a signing secret are signed with HMAC-SHA256 of the request body, and fail if the
+secret can't be resolved.`,
+)
+
 type Config struct {
-	RequestTimeout dynamicconfig.DurationPropertyFnWithDestinationFilter
-	RetryPolicy    func() backoff.RetryPolicy
+	RequestTimeout        dynamicconfig.DurationPropertyFnWithDestinationFilter
+	RetryPolicy           func() backoff.RetryPolicy
+	WebhookSigningSecrets dynamicconfig.TypedPropertyFnWithNamespaceFilter[map[string]string]
 }
 
 func ConfigProvider(dc *dynamicconfig.Collection) *Config {
 	return &Config{
-		RequestTimeout: RequestTimeout.Get(dc),
+		RequestTimeout:        RequestTimeout.Get(dc),
+		WebhookSigningSecrets: WebhookSigningSecrets.Get(dc),
 		RetryPolicy: func() backoff.RetryPolicy {
 			return backoff.NewExponentialRetryPolicy(
 				RetryPolicyInitialInterval.Get(dc)(),
@@ -74,7 +84,8 @@ var AllowedAddresses = dynamicconfig.NewNamespaceTypedSettingWithConverter(
 	allowedAddressConverter,
 	[]AddressMatchRule(nil),
 	`The per-namespace list of addresses that are allowed for callbacks and whether secure connections (https) are required.
-URLs are checked against each in order when starting a workflow with attached callbacks and only need to match one to pass validation.
+URLs are checked against each in order when starting a workflow with attached callbacks (and when a schedule posts to its SLA webhook)
+and only need to match one to pass validation.
 Default is no address rules, meaning all callbacks will be rejected. Any invalid entries are ignored. Each entry is a map with possible values:
 	 - "Pattern":string (required) the host:port pattern to which this config applies.
 		Wildcards, '*', are supported and can match any number of characters (e.g. '*' matches everything, 'prefix.*.domain' matches 'prefix.a.domain' as well as 'prefix.a.b.domain').
@@ -144,8 +144,24 @@ func (e taskExecutor) executeInvocationTask(
 	)
 	defer cancel()
 
-	result := invokable.Invoke(callCtx, ns, e, task)
-	saveErr := e.saveResult(ctx, env, ref, result)
+	// Capture the status code of the last HTTP response for the callback's attempt history.
+	var details AttemptDetails
+	recordingExecutor := e
+	recordingExecutor.HTTPCallerProvider = func(key queues.NamespaceIDAndDestination) HTTPCaller {
+		caller := e.HTTPCallerProvider(key)
+		return func(request *http.Request) (*http.Response, error) {
+			response, err := caller(request)
+			if response != nil {
+				details.StatusCode = response.StatusCode
+			}
+			return response, err
+		}
+	}
+
+	startTime := env.Now()
+	result := invokable.Invoke(callCtx, ns, recordingExecutor, task)
+	details.Latency = env.Now().Sub(startTime)
+	saveErr := e.saveResult(ctx, env, ref, result, details)
 	return invokable.WrapError(result, saveErr)
 }
 
@@ -190,6 +206,19 @@ func (e taskExecutor) loadInvocationArgs(
 				return err
 			}
 			invokable = hsmInvokable
+		case *persistencespb.Callback_Webhook_:
+			target, err := hsm.MachineData[CanGetHSMCompletionCallbackArg](node.Parent)
+			if err != nil {
+				return err
+			}
+			// variant struct is immutable and ok to reference without copying
+			webhookInvokable := webhookInvocation{}
+			webhookInvokable.webhook = variant.Webhook
+			webhookInvokable.callbackArg, err = target.GetHSMCompletionCallbackArg(ctx)
+			if err != nil {
+				return err
+			}
+			invokable = webhookInvokable
 		default:
 			return queues.NewUnprocessableTaskError(
 				fmt.Sprintf("unprocessable callback variant: %v", variant),
@@ -205,24 +234,28 @@ func (e taskExecutor) saveResult(
 	env hsm.Environment,
 	ref hsm.Ref,
 	result invocationResult,
+	details AttemptDetails,
 ) error {
 	return env.Access(ctx, ref, hsm.AccessWrite, func(node *hsm.Node) error {
 		return hsm.MachineTransition(node, func(callback Callback) (hsm.TransitionOutput, error) {
 			switch result.(type) {
 			case invocationResultOK:
 				return TransitionSucceeded.Apply(callback, EventSucceeded{
-					Time: env.Now(),
+					AttemptDetails: details,
+					Time:           env.Now(),
 				})
 			case invocationResultRetry:
 				return TransitionAttemptFailed.Apply(callback, EventAttemptFailed{
-					Time:        env.Now(),
-					Err:         result.error(),
-					RetryPolicy: e.Config.RetryPolicy(),
+					AttemptDetails: details,
+					Time:           env.Now(),
+					Err:            result.error(),
+					RetryPolicy:    e.Config.RetryPolicy(),
 				})
 			case invocationResultFail:
 				return TransitionFailed.Apply(callback, EventFailed{
-					Time: env.Now(),
-					Err:  result.error(),
+					AttemptDetails: details,
+					Time:           env.Now(),
+					Err:            result.error(),
 				})
 			default:
 				return hsm.TransitionOutput{}, queues.NewUnprocessableTaskError(fmt.Sprintf("unrecognized callback result %v", result))
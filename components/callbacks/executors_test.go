@@ -24,9 +24,13 @@ package callbacks_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -389,6 +393,164 @@ func TestProcessInvocationTaskHsm_Outcomes(t *testing.T) {
 	}
 }
 
+func TestProcessInvocationTaskWebhook_Outcomes(t *testing.T) {
+	secretPath := filepath.Join(t.TempDir(), "secret")
+	require.NoError(t, os.WriteFile(secretPath, []byte("s3cr3t\n"), 0o600))
+
+	cases := []struct {
+		name            string
+		secretName      string
+		statusCode      int
+		destinationDown bool
+		expectedState   enumsspb.CallbackState
+	}{
+		{
+			name:          "success",
+			statusCode:    200,
+			expectedState: enumsspb.CALLBACK_STATE_SUCCEEDED,
+		},
+		{
+			name:          "signed",
+			secretName:    "mysecret",
+			statusCode:    204,
+			expectedState: enumsspb.CALLBACK_STATE_SUCCEEDED,
+		},
+		{
+			name:            "unknown-secret",
+			secretName:      "othersecret",
+			destinationDown: true,
+			expectedState:   enumsspb.CALLBACK_STATE_BACKING_OFF,
+		},
+		{
+			name:            "retryable-error",
+			statusCode:      503,
+			destinationDown: true,
+			expectedState:   enumsspb.CALLBACK_STATE_BACKING_OFF,
+		},
+		{
+			name:          "non-retryable-error",
+			statusCode:    404,
+			expectedState: enumsspb.CALLBACK_STATE_FAILED,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			namespaceRegistryMock := namespace.NewMockRegistry(ctrl)
+			namespaceRegistryMock.EXPECT().GetNamespaceByID(namespace.ID("namespace-id")).Return(
+				namespace.FromPersistentState(&persistencespb.NamespaceDetail{
+					Info: &persistencespb.NamespaceInfo{
+						Id:   "namespace-id",
+						Name: "namespace-name",
+					},
+					Config: &persistencespb.NamespaceConfig{},
+				}),
+				nil,
+			)
+
+			caller := func(r *http.Request) (*http.Response, error) {
+				require.Equal(t, "https://example.com/hook", r.URL.String())
+				require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				require.Equal(t, "value", r.Header.Get("key"))
+				require.Empty(t, r.Header.Get("source"))
+
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				var completion callbacks.WebhookCompletion
+				require.NoError(t, json.Unmarshal(body, &completion))
+				require.Equal(t, "namespace-name", completion.Namespace)
+				require.Equal(t, "mywid", completion.WorkflowID)
+				require.Equal(t, "myrid", completion.RunID)
+				require.Equal(t, enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED.String(), completion.Status)
+				require.Contains(t, string(completion.CompletionEvent), `"eventId":"42"`)
+
+				if tc.secretName != "" {
+					require.Equal(t, callbacks.SignWebhookBody([]byte("s3cr3t"), body), r.Header.Get(callbacks.WebhookSignatureHeader))
+				} else {
+					require.Empty(t, r.Header.Get(callbacks.WebhookSignatureHeader))
+				}
+				return &http.Response{StatusCode: tc.statusCode, Body: http.NoBody}, nil
+			}
+
+			root := newRoot(t)
+			cb := callbacks.Callback{
+				CallbackInfo: &persistencespb.CallbackInfo{
+					Callback: &persistencespb.Callback{
+						Variant: &persistencespb.Callback_Webhook_{
+							Webhook: &persistencespb.Callback_Webhook{
+								Url:               "https://example.com/hook",
+								Header:            map[string]string{"key": "value", "source": "cluster"},
+								SigningSecretName: tc.secretName,
+							},
+						},
+					},
+					State: enumsspb.CALLBACK_STATE_SCHEDULED,
+				},
+			}
+			coll := callbacks.MachineCollection(root)
+			node, err := coll.Add("ID", cb)
+			require.NoError(t, err)
+			env := fakeEnv{node}
+
+			key := definition.NewWorkflowKey("namespace-id", "", "")
+			reg := hsm.NewRegistry()
+			require.NoError(t, callbacks.RegisterExecutor(
+				reg,
+				callbacks.TaskExecutorOptions{
+					NamespaceRegistry: namespaceRegistryMock,
+					MetricsHandler:    metrics.NoopMetricsHandler,
+					HTTPCallerProvider: func(nid queues.NamespaceIDAndDestination) callbacks.HTTPCaller {
+						require.Equal(t, "https://example.com", nid.Destination)
+						return caller
+					},
+					Logger: log.NewNoopLogger(),
+					Config: &callbacks.Config{
+						RequestTimeout: dynamicconfig.GetDurationPropertyFnFilteredByDestination(time.Second),
+						RetryPolicy: func() backoff.RetryPolicy {
+							return backoff.NewExponentialRetryPolicy(time.Second)
+						},
+						WebhookSigningSecrets: dynamicconfig.GetTypedPropertyFnFilteredByNamespace(
+							map[string]string{"mysecret": secretPath},
+						),
+					},
+				},
+			))
+
+			err = reg.ExecuteImmediateTask(
+				context.Background(),
+				env,
+				hsm.Ref{
+					WorkflowKey: key,
+					StateMachineRef: &persistencespb.StateMachineRef{
+						Path: []*persistencespb.StateMachineKey{
+							{
+								Type: callbacks.StateMachineType,
+								Id:   "ID",
+							},
+						},
+					},
+				},
+				callbacks.NewInvocationTask("https://example.com"),
+			)
+
+			if tc.destinationDown {
+				var destinationDownErr *queues.DestinationDownError
+				require.ErrorAs(t, err, &destinationDownErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			cb, err = coll.Data("ID")
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedState, cb.State())
+			require.Len(t, cb.RecentAttempts, 1)
+			require.Equal(t, int32(tc.statusCode), cb.RecentAttempts[0].StatusCode)
+			require.Equal(t, tc.expectedState == enumsspb.CALLBACK_STATE_SUCCEEDED, cb.RecentAttempts[0].Failure == nil)
+		})
+	}
+}
+
 func TestProcessBackoffTask(t *testing.T) {
 	root := newRoot(t)
 	cb := callbacks.Callback{
@@ -32,10 +32,12 @@ import (
 	"go.temporal.io/api/serviceerror"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/service/history/hsm"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -46,6 +48,9 @@ const (
 	// A marker for the first return value from a progress() that indicates the machine is in a terminal state.
 	// TODO: Remove this once transition history is fully implemented.
 	terminalStage = 3
+
+	// Maximum number of attempts kept in a callback's recent attempts.
+	maxRecentAttempts = 10
 )
 
 // MachineCollection creates a new typed [statemachines.Collection] for callbacks.
@@ -85,9 +90,28 @@ func (c Callback) SetState(state enumsspb.CallbackState) {
 	c.CallbackInfo.State = state
 }
 
-func (c Callback) recordAttempt(ts time.Time) {
+// AttemptDetails describes how a single delivery attempt went, beyond its outcome.
+type AttemptDetails struct {
+	// HTTP status code of the response, zero if no HTTP response was received.
+	StatusCode int
+	// Time spent making the request.
+	Latency time.Duration
+}
+
+func (c Callback) recordAttempt(ts time.Time, details AttemptDetails, failure *failurepb.Failure) {
 	c.CallbackInfo.Attempt++
 	c.CallbackInfo.LastAttemptCompleteTime = timestamppb.New(ts)
+	c.CallbackInfo.LastAttemptFailure = failure
+	attempts := append(c.CallbackInfo.RecentAttempts, &persistencespb.CallbackAttempt{
+		CompleteTime: timestamppb.New(ts),
+		StatusCode:   int32(details.StatusCode),
+		Latency:      durationpb.New(details.Latency),
+		Failure:      failure,
+	})
+	if len(attempts) > maxRecentAttempts {
+		attempts = attempts[len(attempts)-maxRecentAttempts:]
+	}
+	c.CallbackInfo.RecentAttempts = attempts
 }
 
 func (c Callback) RegenerateTasks(*hsm.Node) ([]hsm.Task, error) {
@@ -102,6 +126,12 @@ func (c Callback) RegenerateTasks(*hsm.Node) ([]hsm.Task, error) {
 				return nil, fmt.Errorf("failed to parse URL: %v: %w", &c, err)
 			}
 			return []hsm.Task{InvocationTask{destination: u.Scheme + "://" + u.Host}}, nil
+		case *persistencespb.Callback_Webhook_:
+			u, err := url.Parse(c.Callback.GetWebhook().Url)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse URL: %v: %w", &c, err)
+			}
+			return []hsm.Task{InvocationTask{destination: u.Scheme + "://" + u.Host}}, nil
 		case *persistencespb.Callback_Hsm:
 			// Destination is empty on the internal queue.
 			return []hsm.Task{InvocationTask{"TODO(bergundy): make this empty"}}, nil
@@ -222,6 +252,7 @@ var TransitionRescheduled = hsm.NewTransition(
 
 // EventAttemptFailed is triggered when an attempt is failed with a retryable error.
 type EventAttemptFailed struct {
+	AttemptDetails
 	Time        time.Time
 	Err         error
 	RetryPolicy backoff.RetryPolicy
@@ -231,25 +262,25 @@ var TransitionAttemptFailed = hsm.NewTransition(
 	[]enumsspb.CallbackState{enumsspb.CALLBACK_STATE_SCHEDULED},
 	enumsspb.CALLBACK_STATE_BACKING_OFF,
 	func(cb Callback, event EventAttemptFailed) (hsm.TransitionOutput, error) {
-		cb.recordAttempt(event.Time)
-		// Use 0 for elapsed time as we don't limit the retry by time (for now).
-		nextDelay := event.RetryPolicy.ComputeNextDelay(0, int(cb.Attempt), event.Err)
-		nextAttemptScheduleTime := event.Time.Add(nextDelay)
-		cb.CallbackInfo.NextAttemptScheduleTime = timestamppb.New(nextAttemptScheduleTime)
-		cb.CallbackInfo.LastAttemptFailure = &failurepb.Failure{
+		cb.recordAttempt(event.Time, event.AttemptDetails, &failurepb.Failure{
 			Message: event.Err.Error(),
 			FailureInfo: &failurepb.Failure_ApplicationFailureInfo{
 				ApplicationFailureInfo: &failurepb.ApplicationFailureInfo{
 					NonRetryable: false,
 				},
 			},
-		}
+		})
+		// Use 0 for elapsed time as we don't limit the retry by time (for now).
+		nextDelay := event.RetryPolicy.ComputeNextDelay(0, int(cb.Attempt), event.Err)
+		nextAttemptScheduleTime := event.Time.Add(nextDelay)
+		cb.CallbackInfo.NextAttemptScheduleTime = timestamppb.New(nextAttemptScheduleTime)
 		return cb.output()
 	},
 )
 
 // EventFailed is triggered when an attempt is failed with a non retryable error.
 type EventFailed struct {
+	AttemptDetails
 	Time time.Time
 	Err  error
 }
@@ -258,21 +289,21 @@ var TransitionFailed = hsm.NewTransition(
 	[]enumsspb.CallbackState{enumsspb.CALLBACK_STATE_SCHEDULED},
 	enumsspb.CALLBACK_STATE_FAILED,
 	func(cb Callback, event EventFailed) (hsm.TransitionOutput, error) {
-		cb.recordAttempt(event.Time)
-		cb.CallbackInfo.LastAttemptFailure = &failurepb.Failure{
+		cb.recordAttempt(event.Time, event.AttemptDetails, &failurepb.Failure{
 			Message: event.Err.Error(),
 			FailureInfo: &failurepb.Failure_ApplicationFailureInfo{
 				ApplicationFailureInfo: &failurepb.ApplicationFailureInfo{
 					NonRetryable: true,
 				},
 			},
-		}
+		})
 		return cb.output()
 	},
 )
 
 // EventSucceeded is triggered when an attempt succeeds.
 type EventSucceeded struct {
+	AttemptDetails
 	Time time.Time
 }
 
@@ -280,8 +311,73 @@ var TransitionSucceeded = hsm.NewTransition(
 	[]enumsspb.CallbackState{enumsspb.CALLBACK_STATE_SCHEDULED},
 	enumsspb.CALLBACK_STATE_SUCCEEDED,
 	func(cb Callback, event EventSucceeded) (hsm.TransitionOutput, error) {
-		cb.recordAttempt(event.Time)
-		cb.CallbackInfo.LastAttemptFailure = nil
+		cb.recordAttempt(event.Time, event.AttemptDetails, nil)
+		return cb.output()
+	},
+)
+
+// EventRetried is triggered when an operator requests a failed or backing off callback to be delivered immediately.
+type EventRetried struct{}
+
+var TransitionRetried = hsm.NewTransition(
+	[]enumsspb.CallbackState{enumsspb.CALLBACK_STATE_BACKING_OFF, enumsspb.CALLBACK_STATE_FAILED},
+	enumsspb.CALLBACK_STATE_SCHEDULED,
+	func(cb Callback, event EventRetried) (hsm.TransitionOutput, error) {
+		cb.CallbackInfo.NextAttemptScheduleTime = nil
+		return cb.output()
+	},
+)
+
+// EventSkipped is triggered when an operator gives up on delivering a callback that hasn't completed.
+type EventSkipped struct {
+	Reason string
+}
+
+var TransitionSkipped = hsm.NewTransition(
+	[]enumsspb.CallbackState{
+		enumsspb.CALLBACK_STATE_STANDBY,
+		enumsspb.CALLBACK_STATE_SCHEDULED,
+		enumsspb.CALLBACK_STATE_BACKING_OFF,
+	},
+	enumsspb.CALLBACK_STATE_FAILED,
+	func(cb Callback, event EventSkipped) (hsm.TransitionOutput, error) {
+		cb.CallbackInfo.NextAttemptScheduleTime = nil
+		cb.CallbackInfo.LastAttemptFailure = &failurepb.Failure{
+			Message: "callback skipped: " + event.Reason,
+			FailureInfo: &failurepb.Failure_ApplicationFailureInfo{
+				ApplicationFailureInfo: &failurepb.ApplicationFailureInfo{
+					NonRetryable: true,
+				},
+			},
+		}
 		return cb.output()
 	},
 )
+
+// UpdateURL replaces the URL of a Nexus or webhook callback that hasn't succeeded. A scheduled invocation is
+// regenerated for the new destination.
+func UpdateURL(node *hsm.Node, rawURL string) error {
+	return hsm.MachineTransition(node, func(cb Callback) (hsm.TransitionOutput, error) {
+		if cb.State() == enumsspb.CALLBACK_STATE_SUCCEEDED {
+			return hsm.TransitionOutput{}, fmt.Errorf("%w: callback already succeeded", hsm.ErrInvalidTransition)
+		}
+		// The variant may be referenced by an in-flight invocation, replace it instead of mutating it.
+		switch variant := cb.Callback.GetVariant().(type) {
+		case *persistencespb.Callback_Nexus_:
+			nexus := common.CloneProto(variant.Nexus)
+			nexus.Url = rawURL
+			cb.CallbackInfo.Callback = &persistencespb.Callback{
+				Variant: &persistencespb.Callback_Nexus_{Nexus: nexus},
+			}
+		case *persistencespb.Callback_Webhook_:
+			webhook := common.CloneProto(variant.Webhook)
+			webhook.Url = rawURL
+			cb.CallbackInfo.Callback = &persistencespb.Callback{
+				Variant: &persistencespb.Callback_Webhook_{Webhook: webhook},
+			}
+		default:
+			return hsm.TransitionOutput{}, fmt.Errorf("%w: callback variant %T has no URL", hsm.ErrInvalidTransition, variant)
+		}
+		return cb.output()
+	})
+}
@@ -34,6 +34,7 @@ import (
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/components/callbacks"
 	"go.temporal.io/server/service/history/hsm"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestValidTransitions(t *testing.T) {
@@ -129,6 +130,128 @@ func TestValidTransitions(t *testing.T) {
 	require.Equal(t, 0, len(out.Tasks))
 }
 
+func TestRecentAttempts(t *testing.T) {
+	currentTime := time.Now().UTC()
+	callback := callbacks.Callback{
+		&persistencespb.CallbackInfo{
+			Callback: &persistencespb.Callback{
+				Variant: &persistencespb.Callback_Nexus_{
+					Nexus: &persistencespb.Callback_Nexus{
+						Url: "http://address:666/path/to/callback",
+					},
+				},
+			},
+			State: enumsspb.CALLBACK_STATE_SCHEDULED,
+		},
+	}
+	for i := range 12 {
+		_, err := callbacks.TransitionAttemptFailed.Apply(callback, callbacks.EventAttemptFailed{
+			AttemptDetails: callbacks.AttemptDetails{StatusCode: 500 + i, Latency: time.Duration(i) * time.Millisecond},
+			Time:           currentTime.Add(time.Duration(i) * time.Second),
+			Err:            fmt.Errorf("attempt %d", i),
+			RetryPolicy:    backoff.NewExponentialRetryPolicy(time.Second),
+		})
+		require.NoError(t, err)
+		_, err = callbacks.TransitionRescheduled.Apply(callback, callbacks.EventRescheduled{})
+		require.NoError(t, err)
+	}
+	_, err := callbacks.TransitionSucceeded.Apply(callback, callbacks.EventSucceeded{
+		AttemptDetails: callbacks.AttemptDetails{StatusCode: 200, Latency: time.Second},
+		Time:           currentTime.Add(time.Minute),
+	})
+	require.NoError(t, err)
+
+	// Only the most recent attempts are kept, oldest first.
+	require.Equal(t, int32(13), callback.Attempt)
+	require.Len(t, callback.RecentAttempts, 10)
+	first := callback.RecentAttempts[0]
+	require.Equal(t, int32(503), first.StatusCode)
+	require.Equal(t, 3*time.Millisecond, first.Latency.AsDuration())
+	require.Equal(t, "attempt 3", first.Failure.Message)
+	require.Equal(t, currentTime.Add(3*time.Second), first.CompleteTime.AsTime())
+	last := callback.RecentAttempts[9]
+	require.Equal(t, int32(200), last.StatusCode)
+	require.Equal(t, time.Second, last.Latency.AsDuration())
+	require.Nil(t, last.Failure)
+}
+
+func TestManualTransitions(t *testing.T) {
+	root := newRoot(t)
+	coll := callbacks.MachineCollection(root)
+	node, err := coll.Add("ID", callbacks.Callback{
+		CallbackInfo: &persistencespb.CallbackInfo{
+			Callback: &persistencespb.Callback{
+				Variant: &persistencespb.Callback_Webhook_{
+					Webhook: &persistencespb.Callback_Webhook{
+						Url:    "https://old.example.com/hook",
+						Header: map[string]string{"key": "value"},
+					},
+				},
+			},
+			State:                   enumsspb.CALLBACK_STATE_BACKING_OFF,
+			Attempt:                 3,
+			NextAttemptScheduleTime: timestamppb.Now(),
+		},
+	})
+	require.NoError(t, err)
+
+	// Retry a backing off callback.
+	require.NoError(t, hsm.MachineTransition(node, func(cb callbacks.Callback) (hsm.TransitionOutput, error) {
+		out, err := callbacks.TransitionRetried.Apply(cb, callbacks.EventRetried{})
+		require.Len(t, out.Tasks, 1)
+		require.Equal(t, "https://old.example.com", out.Tasks[0].(callbacks.InvocationTask).Destination())
+		return out, err
+	}))
+	cb, err := coll.Data("ID")
+	require.NoError(t, err)
+	require.Equal(t, enumsspb.CALLBACK_STATE_SCHEDULED, cb.State())
+	require.Equal(t, int32(3), cb.Attempt)
+	require.Nil(t, cb.NextAttemptScheduleTime)
+
+	// Update the URL of the scheduled callback, keeping its headers.
+	old := cb.Callback
+	require.NoError(t, callbacks.UpdateURL(node, "https://new.example.com/hook"))
+	cb, err = coll.Data("ID")
+	require.NoError(t, err)
+	require.Equal(t, "https://new.example.com/hook", cb.Callback.GetWebhook().Url)
+	require.Equal(t, map[string]string{"key": "value"}, cb.Callback.GetWebhook().Header)
+	require.Equal(t, "https://old.example.com/hook", old.GetWebhook().Url)
+	tasks, err := cb.RegenerateTasks(node)
+	require.NoError(t, err)
+	require.Equal(t, "https://new.example.com", tasks[0].(callbacks.InvocationTask).Destination())
+
+	// Skip it.
+	require.NoError(t, hsm.MachineTransition(node, func(cb callbacks.Callback) (hsm.TransitionOutput, error) {
+		return callbacks.TransitionSkipped.Apply(cb, callbacks.EventSkipped{Reason: "endpoint decommissioned"})
+	}))
+	cb, err = coll.Data("ID")
+	require.NoError(t, err)
+	require.Equal(t, enumsspb.CALLBACK_STATE_FAILED, cb.State())
+	require.Equal(t, "callback skipped: endpoint decommissioned", cb.LastAttemptFailure.Message)
+	require.True(t, cb.LastAttemptFailure.GetApplicationFailureInfo().NonRetryable)
+
+	// A failed callback can't be skipped again, but can be retried.
+	err = hsm.MachineTransition(node, func(cb callbacks.Callback) (hsm.TransitionOutput, error) {
+		return callbacks.TransitionSkipped.Apply(cb, callbacks.EventSkipped{})
+	})
+	require.ErrorIs(t, err, hsm.ErrInvalidTransition)
+	require.NoError(t, hsm.MachineTransition(node, func(cb callbacks.Callback) (hsm.TransitionOutput, error) {
+		return callbacks.TransitionRetried.Apply(cb, callbacks.EventRetried{})
+	}))
+
+	// HSM callbacks have no URL.
+	hsmNode, err := coll.Add("HSM", callbacks.Callback{
+		CallbackInfo: &persistencespb.CallbackInfo{
+			Callback: &persistencespb.Callback{
+				Variant: &persistencespb.Callback_Hsm{Hsm: &persistencespb.Callback_HSM{}},
+			},
+			State: enumsspb.CALLBACK_STATE_FAILED,
+		},
+	})
+	require.NoError(t, err)
+	require.ErrorIs(t, callbacks.UpdateURL(hsmNode, "https://example.com"), hsm.ErrInvalidTransition)
+}
+
 func TestCompareState(t *testing.T) {
 	reg := hsm.NewRegistry()
 	require.NoError(t, callbacks.RegisterStateMachine(reg))
@@ -0,0 +1,197 @@
+// The MIT License
+//
+// Copyright (c) 2025 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package callbacks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/service/history/queues"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// WebhookSignatureHeader is the header that carries the HMAC-SHA256 signature of a webhook request body, in the
+// form "sha256=<hex digest>".
+const WebhookSignatureHeader = "Temporal-Webhook-Signature"
+
+// WebhookCompletion is the JSON body of a webhook callback request.
+type WebhookCompletion struct {
+	Namespace   string    `json:"namespace"`
+	NamespaceID string    `json:"namespaceId"`
+	WorkflowID  string    `json:"workflowId"`
+	RunID       string    `json:"runId"`
+	Status      string    `json:"status"`
+	CloseTime   time.Time `json:"closeTime"`
+	// The workflow's closing history event, in the proto JSON format.
+	CompletionEvent json.RawMessage `json:"completionEvent"`
+}
+
+type webhookInvocation struct {
+	webhook     *persistencespb.Callback_Webhook
+	callbackArg *persistencespb.HSMCompletionCallbackArg
+}
+
+func (w webhookInvocation) WrapError(result invocationResult, err error) error {
+	if failure, ok := result.(invocationResultRetry); ok {
+		return queues.NewDestinationDownError(failure.err.Error(), err)
+	}
+	return err
+}
+
+func (w webhookInvocation) Invoke(ctx context.Context, ns *namespace.Namespace, e taskExecutor, task InvocationTask) invocationResult {
+	body, err := w.completionBody(ns)
+	if err != nil {
+		return invocationResultFail{queues.NewUnprocessableTaskError(
+			fmt.Sprintf("failed to construct webhook body: %v", err),
+		)}
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, w.webhook.Url, bytes.NewReader(body))
+	if err != nil {
+		return invocationResultFail{queues.NewUnprocessableTaskError(
+			fmt.Sprintf("failed to construct webhook request: %v", err),
+		)}
+	}
+	for k, v := range w.webhook.Header {
+		request.Header.Set(k, v)
+	}
+	// Only Nexus callbacks are routed between clusters.
+	request.Header.Del(callbackSourceHeader)
+	request.Header.Set("Content-Type", "application/json")
+
+	if name := w.webhook.SigningSecretName; name != "" {
+		secret, err := webhookSigningSecret(e.Config, ns.Name().String(), name)
+		if err != nil {
+			// The secret may be configured later, keep retrying.
+			e.Logger.Error("Failed to resolve webhook signing secret", tag.Error(err), tag.WorkflowNamespace(ns.Name().String()))
+			return invocationResultRetry{err}
+		}
+		request.Header.Set(WebhookSignatureHeader, SignWebhookBody(secret, body))
+	}
+
+	caller := e.HTTPCallerProvider(queues.NamespaceIDAndDestination{
+		NamespaceID: ns.ID().String(),
+		Destination: task.Destination(),
+	})
+	// Make the call and record metrics.
+	startTime := time.Now()
+	response, err := caller(request)
+
+	namespaceTag := metrics.NamespaceTag(ns.Name().String())
+	destTag := metrics.DestinationTag(task.Destination())
+	statusCodeTag := metrics.OutcomeTag(outcomeTag(ctx, response, err))
+	e.MetricsHandler.Counter(RequestCounter.Name()).Record(1, namespaceTag, destTag, statusCodeTag)
+	e.MetricsHandler.Timer(RequestLatencyHistogram.Name()).Record(time.Since(startTime), namespaceTag, destTag, statusCodeTag)
+
+	if err != nil {
+		e.Logger.Error("Webhook callback request failed with error", tag.Error(err))
+		return invocationResultRetry{err}
+	}
+
+	// Body is not used but should be discarded to keep the underlying TCP connection alive.
+	_, _ = io.Copy(io.Discard, response.Body)
+	_ = response.Body.Close()
+
+	if response.StatusCode >= 200 && response.StatusCode < 300 {
+		return invocationResultOK{}
+	}
+
+	retryable := isRetryableHTTPResponse(response)
+	err = fmt.Errorf("webhook request failed with: %v", response.Status)
+	e.Logger.Error("Webhook callback request failed", tag.Error(err), tag.NewBoolTag("retryable", retryable))
+	if retryable {
+		return invocationResultRetry{err}
+	}
+	return invocationResultFail{err}
+}
+
+func (w webhookInvocation) completionBody(ns *namespace.Namespace) ([]byte, error) {
+	event := w.callbackArg.GetLastEvent()
+	eventJSON, err := protojson.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(WebhookCompletion{
+		Namespace:       ns.Name().String(),
+		NamespaceID:     w.callbackArg.GetNamespaceId(),
+		WorkflowID:      w.callbackArg.GetWorkflowId(),
+		RunID:           w.callbackArg.GetRunId(),
+		Status:          closeStatusFromEventType(event.GetEventType()).String(),
+		CloseTime:       event.GetEventTime().AsTime(),
+		CompletionEvent: eventJSON,
+	})
+}
+
+func closeStatusFromEventType(eventType enumspb.EventType) enumspb.WorkflowExecutionStatus {
+	// nolint:exhaustive
+	switch eventType {
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED:
+		return enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_FAILED:
+		return enumspb.WORKFLOW_EXECUTION_STATUS_FAILED
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CANCELED:
+		return enumspb.WORKFLOW_EXECUTION_STATUS_CANCELED
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED:
+		return enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TIMED_OUT:
+		return enumspb.WORKFLOW_EXECUTION_STATUS_TIMED_OUT
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW:
+		return enumspb.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW
+	default:
+		return enumspb.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED
+	}
+}
+
+func webhookSigningSecret(config *Config, ns, name string) ([]byte, error) {
+	path, ok := config.WebhookSigningSecrets(ns)[name]
+	if !ok {
+		return nil, fmt.Errorf("webhook signing secret %q is not configured", name)
+	}
+	secret, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read webhook signing secret %q: %w", name, err)
+	}
+	return []byte(strings.TrimSpace(string(secret))), nil
+}
+
+// SignWebhookBody returns the value of the [WebhookSignatureHeader] for the given request body.
+func SignWebhookBody(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -104,6 +104,7 @@ var defaultDisallowedOperationHeaders = []string{
 	headers.CallerNameHeaderName,
 	headers.CallerTypeHeaderName,
 	headers.CallOriginHeaderName,
+	headers.StickyPredecessorHeaderName,
 }
 
 var DisallowedOperationHeaders = dynamicconfig.NewGlobalTypedSetting(
@@ -122,6 +123,20 @@ commands that specify no schedule-to-close timeout or a longer timeout than perm
 schedule-to-close timeout capped to this value. 0 implies no limit.`,
 )
 
+var OutboundAuth = dynamicconfig.NewDestinationTypedSetting(
+	"component.nexusoperations.outbound.auth",
+	OutboundAuthConfig{},
+	`OutboundAuth configures authentication of requests to external Nexus endpoints, filtered by endpoint name as the
+destination. Secrets are read from files on the history hosts and re-read when the files change. The value is a map
+with the following keys:
+	 - "Type":string one of "" (no authentication, the default), "bearer", "mtls" or "oauth2".
+	 - "TokenFile":string (bearer) file containing the token sent in the Authorization header.
+	 - "CertFile":string and "KeyFile":string (mtls) files containing the PEM encoded client certificate and key.
+	 - "CAFile":string (mtls, optional) file containing PEM encoded CAs to verify the endpoint, defaults to the system pool.
+	 - "TokenURL":string, "ClientID":string and "ClientSecretFile":string (oauth2) client credentials flow settings.
+	 - "Scopes":string (oauth2, optional) space separated scopes to request.`,
+)
+
 var CallbackURLTemplate = dynamicconfig.NewGlobalStringSetting(
 	"component.nexusoperations.callback.endpoint.template",
 	"unset",
@@ -158,6 +173,7 @@ requirements and query complexity. Consider the cardinality impact when enabling
 type Config struct {
 	Enabled                            dynamicconfig.BoolPropertyFn
 	RequestTimeout                     dynamicconfig.DurationPropertyFnWithDestinationFilter
+	OutboundAuth                       dynamicconfig.TypedPropertyFnWithDestinationFilter[OutboundAuthConfig]
 	MinOperationTimeout                dynamicconfig.DurationPropertyFnWithNamespaceFilter
 	MaxConcurrentOperations            dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MaxServiceNameLength               dynamicconfig.IntPropertyFnWithNamespaceFilter
@@ -176,6 +192,7 @@ func ConfigProvider(dc *dynamicconfig.Collection) *Config {
 	return &Config{
 		Enabled:                 dynamicconfig.EnableNexus.Get(dc),
 		RequestTimeout:          RequestTimeout.Get(dc),
+		OutboundAuth:            OutboundAuth.Get(dc),
 		MinOperationTimeout:     MinOperationTimeout.Get(dc),
 		MaxConcurrentOperations: MaxConcurrentOperations.Get(dc),
 		MaxServiceNameLength:    MaxServiceNameLength.Get(dc),
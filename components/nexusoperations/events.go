@@ -32,6 +32,9 @@ import (
 	"go.temporal.io/server/service/history/hsm"
 )
 
+// ScheduledEventDefinition adds operations without a caller close behavior, which isn't part of the event. The command
+// handler records the behavior at schedule time, and it reaches other clusters with state-based replication. Operations
+// added by applying the event, e.g. when rebuilding mutable state, are abandoned when the caller closes.
 type ScheduledEventDefinition struct{}
 
 func (d ScheduledEventDefinition) IsWorkflowTaskTrigger() bool {
@@ -30,6 +30,7 @@ import (
 	"github.com/stretchr/testify/require"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/components/nexusoperations"
 	"go.temporal.io/server/service/history/hsm"
 	"go.temporal.io/server/service/history/hsm/hsmtest"
@@ -143,6 +144,16 @@ func TestCherryPick(t *testing.T) {
 	})
 }
 
+func TestScheduledEventDefinitionCallerCloseBehavior(t *testing.T) {
+	// The behavior isn't part of the event, it's only recorded by the command handler at schedule time.
+	root := newRoot(t, &hsmtest.NodeBackend{})
+	event := mustNewScheduledEvent(time.Now(), time.Hour)
+	require.NoError(t, nexusoperations.ScheduledEventDefinition{}.Apply(root, event))
+	op, err := nexusoperations.MachineCollection(root).Data(strconv.FormatInt(event.EventId, 10))
+	require.NoError(t, err)
+	require.Equal(t, enumsspb.NEXUS_CALLER_CLOSE_BEHAVIOR_UNSPECIFIED, op.CallerCloseBehavior)
+}
+
 func TestTerminalStatesDeletion(t *testing.T) {
 	testCases := []struct {
 		name       string
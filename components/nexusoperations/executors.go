@@ -39,6 +39,7 @@ import (
 	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/sdk/temporalnexus"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	tokenspb "go.temporal.io/server/api/token/v1"
 	"go.temporal.io/server/common"
@@ -47,6 +48,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	commonnexus "go.temporal.io/server/common/nexus"
+	"go.temporal.io/server/common/payloadstore"
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/hsm"
 	"go.temporal.io/server/service/history/queues"
@@ -70,13 +72,16 @@ type TaskExecutorOptions struct {
 	ClientProvider         ClientProvider
 	EndpointRegistry       commonnexus.EndpointRegistry
 	HTTPTraceProvider      commonnexus.HTTPClientTraceProvider
+	PayloadOffloader       *payloadstore.Offloader
 }
 
 func RegisterExecutor(
 	registry *hsm.Registry,
 	options TaskExecutorOptions,
 ) error {
-	exec := taskExecutor{options}
+	exec := taskExecutor{
+		TaskExecutorOptions: options,
+	}
 	if err := hsm.RegisterImmediateExecutor(
 		registry,
 		exec.executeInvocationTask,
@@ -121,6 +126,10 @@ func (e taskExecutor) executeInvocationTask(ctx context.Context, env hsm.Environ
 	if err != nil {
 		return fmt.Errorf("failed to load operation args: %w", err)
 	}
+	args.payload, err = e.PayloadOffloader.RehydratePayload(ctx, ns.ID(), args.payload)
+	if err != nil {
+		return fmt.Errorf("failed to load operation input: %w", err)
+	}
 
 	// This happens when we accept the ScheduleNexusOperation command when the endpoint is not found in the registry as
 	// indicated by the EndpointNotFoundAlwaysNonRetryable dynamic config.
@@ -139,6 +148,14 @@ func (e taskExecutor) executeInvocationTask(ctx context.Context, env hsm.Environ
 		return err
 	}
 
+	if limit := endpoint.GetEndpoint().GetSpec().GetPolicy().GetMaxConcurrentOperationsPerCallerWorkflow(); limit > 0 && args.operationsAhead >= int(limit) {
+		// The queue reschedules the task with backoff.
+		return serviceerror.NewResourceExhausted(
+			enumspb.RESOURCE_EXHAUSTED_CAUSE_CONCURRENT_LIMIT,
+			fmt.Sprintf("workflow reached the limit of %d concurrent operations for endpoint %q", limit, args.endpointName),
+		)
+	}
+
 	if e.Config.CallbackURLTemplate() == "unset" {
 		return serviceerror.NewInternal(fmt.Sprintf("dynamic config %q is unset", CallbackURLTemplate.Key().String()))
 	}
@@ -302,6 +319,7 @@ type startArgs struct {
 	payload                  *commonpb.Payload
 	nexusLink                nexus.Link
 	namespaceFailoverVersion int64
+	operationsAhead          int
 }
 
 func (e taskExecutor) loadOperationArgs(
@@ -343,11 +361,49 @@ func (e taskExecutor) loadOperationArgs(
 			},
 		})
 		args.namespaceFailoverVersion = event.Version
-		return nil
+		args.operationsAhead, err = operationsAhead(node, operation)
+		return err
 	})
 	return
 }
 
+// operationsAhead counts the other operations of the caller workflow that target the same endpoint and either started
+// and haven't completed, or were scheduled before the given operation and haven't started yet.
+func operationsAhead(node *hsm.Node, operation Operation) (int, error) {
+	eventID, err := hsm.EventIDFromToken(operation.ScheduledEventToken)
+	if err != nil {
+		return 0, err
+	}
+	coll := MachineCollection(node.Parent)
+	count := 0
+	for _, other := range coll.List() {
+		if other.Key == node.Key {
+			continue
+		}
+		op, err := coll.Data(other.Key.ID)
+		if err != nil {
+			return 0, err
+		}
+		if op.EndpointId != operation.EndpointId {
+			continue
+		}
+		switch op.State() {
+		case enumsspb.NEXUS_OPERATION_STATE_STARTED:
+			count++
+		case enumsspb.NEXUS_OPERATION_STATE_SCHEDULED, enumsspb.NEXUS_OPERATION_STATE_BACKING_OFF:
+			otherEventID, err := hsm.EventIDFromToken(op.ScheduledEventToken)
+			if err != nil {
+				return 0, err
+			}
+			if otherEventID < eventID {
+				count++
+			}
+		default:
+		}
+	}
+	return count, nil
+}
+
 func (e taskExecutor) saveResult(ctx context.Context, env hsm.Environment, ref hsm.Ref, result *nexus.ClientStartOperationResult[*commonpb.Payload], callErr error) error {
 	return env.Access(ctx, ref, hsm.AccessWrite, func(node *hsm.Node) error {
 		operation, err := hsm.MachineData[Operation](node)
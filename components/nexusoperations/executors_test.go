@@ -26,6 +26,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -40,6 +41,7 @@ import (
 	"go.temporal.io/sdk/temporalnexus"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/dynamicconfig"
@@ -565,6 +567,103 @@ func TestProcessInvocationTask(t *testing.T) {
 	}
 }
 
+func TestProcessInvocationTask_EndpointConcurrencyLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	listenAddr := nexustest.AllocListenAddress()
+	var startRequests atomic.Int32
+	h := nexustest.Handler{}
+	h.OnStartOperation = func(
+		ctx context.Context,
+		service, operation string,
+		input *nexus.LazyValue,
+		options nexus.StartOperationOptions,
+	) (nexus.HandlerStartOperationResult[any], error) {
+		startRequests.Add(1)
+		return &nexus.HandlerStartOperationResultAsync{OperationToken: "op-token"}, nil
+	}
+	nexustest.NewNexusServer(t, listenAddr, h)
+
+	entry := common.CloneProto(endpointEntry)
+	entry.Endpoint.Spec.Policy = &persistencespb.NexusEndpointPolicy{MaxConcurrentOperationsPerCallerWorkflow: 1}
+	namespaceRegistry := namespace.NewMockRegistry(ctrl)
+	namespaceRegistry.EXPECT().GetNamespaceByID(namespace.ID("ns-id")).Return(
+		namespace.NewNamespaceForTest(&persistencespb.NamespaceInfo{Name: "ns-name"}, nil, false, nil, 0), nil).AnyTimes()
+
+	reg := newRegistry(t)
+	require.NoError(t, nexusoperations.RegisterExecutor(reg, nexusoperations.TaskExecutorOptions{
+		Config: &nexusoperations.Config{
+			Enabled:                 dynamicconfig.GetBoolPropertyFn(true),
+			RequestTimeout:          dynamicconfig.GetDurationPropertyFnFilteredByDestination(time.Minute),
+			MaxOperationTokenLength: dynamicconfig.GetIntPropertyFnFilteredByNamespace(10),
+			MinOperationTimeout:     dynamicconfig.GetDurationPropertyFnFilteredByNamespace(time.Millisecond),
+			PayloadSizeLimit:        dynamicconfig.GetIntPropertyFnFilteredByNamespace(2 * 1024 * 1024),
+			CallbackURLTemplate:     dynamicconfig.GetStringPropertyFn("http://localhost/callback"),
+			RetryPolicy: func() backoff.RetryPolicy {
+				return backoff.NewExponentialRetryPolicy(time.Second)
+			},
+		},
+		CallbackTokenGenerator: commonnexus.NewCallbackTokenGenerator(),
+		NamespaceRegistry:      namespaceRegistry,
+		MetricsHandler:         metrics.NoopMetricsHandler,
+		Logger:                 log.NewNoopLogger(),
+		EndpointRegistry: nexustest.FakeEndpointRegistry{
+			OnGetByID: func(ctx context.Context, endpointID string) (*persistencespb.NexusEndpointEntry, error) {
+				return entry, nil
+			},
+		},
+		ClientProvider: func(ctx context.Context, namespaceID string, entry *persistencespb.NexusEndpointEntry, service string) (*nexus.HTTPClient, error) {
+			return nexus.NewHTTPClient(nexus.HTTPClientOptions{
+				BaseURL:    "http://" + listenAddr,
+				Service:    service,
+				Serializer: commonnexus.PayloadSerializer,
+			})
+		},
+	}))
+
+	// Two operations of the same caller workflow.
+	first := mustNewScheduledEvent(time.Now(), time.Hour)
+	second := mustNewScheduledEvent(time.Now(), time.Hour)
+	second.EventId = 2
+	backend := &hsmtest.NodeBackend{Events: []*historypb.HistoryEvent{first, second}}
+	firstNode := newOperationNode(t, backend, first)
+	token, err := hsm.GenerateEventLoadToken(second)
+	require.NoError(t, err)
+	secondNode, err := nexusoperations.AddChild(firstNode.Parent, "2", second, token)
+	require.NoError(t, err)
+
+	execute := func(node *hsm.Node) error {
+		return reg.ExecuteImmediateTask(
+			context.Background(),
+			fakeEnv{node},
+			hsm.Ref{
+				WorkflowKey:     definition.NewWorkflowKey("ns-id", "wf-id", "run-id"),
+				StateMachineRef: &persistencespb.StateMachineRef{},
+			},
+			nexusoperations.InvocationTask{EndpointName: "endpoint"},
+		)
+	}
+	requireLimited := func(node *hsm.Node) {
+		var resourceExhaustedErr *serviceerror.ResourceExhausted
+		require.ErrorAs(t, execute(node), &resourceExhaustedErr)
+		require.Equal(t, enumspb.RESOURCE_EXHAUSTED_CAUSE_CONCURRENT_LIMIT, resourceExhaustedErr.Cause)
+		op, err := hsm.MachineData[nexusoperations.Operation](node)
+		require.NoError(t, err)
+		require.Equal(t, enumsspb.NEXUS_OPERATION_STATE_SCHEDULED, op.State())
+		require.Equal(t, int32(0), op.Attempt)
+	}
+
+	// The operation scheduled first starts first.
+	requireLimited(secondNode)
+	require.NoError(t, execute(firstNode))
+	op, err := hsm.MachineData[nexusoperations.Operation](firstNode)
+	require.NoError(t, err)
+	require.Equal(t, enumsspb.NEXUS_OPERATION_STATE_STARTED, op.State())
+
+	// The first operation holds the slot until it completes, the second one is left for the queue to retry.
+	requireLimited(secondNode)
+	require.Equal(t, int32(1), startRequests.Load())
+}
+
 func TestProcessBackoffTask(t *testing.T) {
 	reg := newRegistry(t)
 	backend := &hsmtest.NodeBackend{}
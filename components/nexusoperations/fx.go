@@ -32,7 +32,6 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cluster"
-	"go.temporal.io/server/common/collection"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
@@ -85,8 +84,8 @@ type NexusTransportProvider func(namespaceID, serviceName string) http.RoundTrip
 
 func DefaultNexusTransportProvider() NexusTransportProvider {
 	return func(namespaceID, serviceName string) http.RoundTripper {
-		// In the future, we'll want to inject headers and certs here.
-		// For now this is must be done externally via a custom transport provider.
+		// Auth configured via the OutboundAuth dynamic config is layered on top of this transport. Other customizations
+		// must be done externally via a custom transport provider.
 		return http.DefaultTransport
 	}
 }
@@ -104,6 +103,7 @@ func ClientProviderFactory(
 	httpTransportProvider NexusTransportProvider,
 	clusterMetadata cluster.Metadata,
 	rpcFactory common.RPCFactory,
+	config *Config,
 ) (ClientProvider, error) {
 	cl, err := rpcFactory.CreateLocalFrontendHTTPClient()
 	if err != nil {
@@ -111,8 +111,11 @@ func ClientProviderFactory(
 	}
 
 	// TODO(bergundy): This should use an LRU or other form of cache that supports eviction.
-	m := collection.NewFallibleOnceMap(func(key clientProviderCacheKey) (*http.Client, error) {
-		transport := httpTransportProvider(key.namespaceID, key.endpointID)
+	m := newAuthClientCache(func(key clientProviderCacheKey, auth OutboundAuthConfig) (*http.Client, error) {
+		transport, err := newAuthTransport(httpTransportProvider(key.namespaceID, key.endpointID), auth)
+		if err != nil {
+			return nil, fmt.Errorf("invalid outbound auth config: %w", err)
+		}
 		return &http.Client{
 			Transport: ResponseSizeLimiter{transport},
 		}, nil
@@ -123,8 +126,12 @@ func ClientProviderFactory(
 		switch variant := entry.Endpoint.Spec.Target.Variant.(type) {
 		case *persistencespb.NexusEndpointTarget_External_:
 			url = variant.External.GetUrl()
-			var err error
-			httpClient, err = m.Get(clientProviderCacheKey{namespaceID, entry.Id, url})
+			nsName, err := namespaceRegistry.GetNamespaceName(namespace.ID(namespaceID))
+			if err != nil {
+				return nil, err
+			}
+			auth := config.OutboundAuth(nsName.String(), entry.Endpoint.Spec.GetName())
+			httpClient, err = m.get(clientProviderCacheKey{namespaceID, entry.Id, url}, auth)
 			if err != nil {
 				return nil, err
 			}
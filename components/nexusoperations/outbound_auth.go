@@ -0,0 +1,274 @@
+// The MIT License
+//
+// Copyright (c) 2025 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nexusoperations
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// Supported values for [OutboundAuthConfig.Type].
+const (
+	OutboundAuthTypeNone   = ""
+	OutboundAuthTypeBearer = "bearer"
+	OutboundAuthTypeMTLS   = "mtls"
+	OutboundAuthTypeOAuth2 = "oauth2"
+)
+
+// oauth2TokenRequestTimeout bounds requests to the OAuth2 token endpoint.
+const oauth2TokenRequestTimeout = 10 * time.Second
+
+// OutboundAuthConfig configures how requests to an external Nexus endpoint are authenticated. Secrets are read from
+// files on the history hosts and re-read when the files change.
+type OutboundAuthConfig struct {
+	// Type of authentication, one of "" (none), "bearer", "mtls" and "oauth2".
+	Type string
+	// TokenFile contains the token sent in the Authorization header with the bearer type.
+	TokenFile string
+	// CertFile and KeyFile contain the PEM encoded client certificate and key presented with the mtls type.
+	CertFile string
+	KeyFile  string
+	// CAFile optionally contains PEM encoded CAs used to verify the endpoint with the mtls type. The system pool is used
+	// when unset.
+	CAFile string
+	// TokenURL, ClientID and ClientSecretFile configure the OAuth2 client credentials flow with the oauth2 type.
+	TokenURL         string
+	ClientID         string
+	ClientSecretFile string
+	// Scopes requested with the oauth2 type, space separated as in the OAuth2 scope parameter.
+	Scopes string
+}
+
+// authClientCache caches a client per key, along with the auth config it was created with. A client is replaced when
+// the config of its key changes, so config changes apply to new requests without leaking a client per config.
+type authClientCache[K comparable, C any] struct {
+	create func(K, OutboundAuthConfig) (C, error)
+
+	mu      sync.Mutex
+	entries map[K]authClientCacheEntry[C]
+}
+
+type authClientCacheEntry[C any] struct {
+	auth   OutboundAuthConfig
+	client C
+}
+
+func newAuthClientCache[K comparable, C any](create func(K, OutboundAuthConfig) (C, error)) *authClientCache[K, C] {
+	return &authClientCache[K, C]{create: create, entries: make(map[K]authClientCacheEntry[C])}
+}
+
+// get returns the client for key, creating it if there's none or if it was created with a different auth config.
+// Errors are not cached.
+func (c *authClientCache[K, C]) get(key K, auth OutboundAuthConfig) (C, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if entry, ok := c.entries[key]; ok && entry.auth == auth {
+		return entry.client, nil
+	}
+	client, err := c.create(key, auth)
+	if err != nil {
+		return client, err
+	}
+	c.entries[key] = authClientCacheEntry[C]{auth: auth, client: client}
+	return client, nil
+}
+
+// newAuthTransport wraps base to authenticate requests as configured.
+func newAuthTransport(base http.RoundTripper, config OutboundAuthConfig) (http.RoundTripper, error) {
+	switch config.Type {
+	case OutboundAuthTypeNone:
+		return base, nil
+	case OutboundAuthTypeBearer:
+		if config.TokenFile == "" {
+			return nil, errors.New("bearer auth requires TokenFile")
+		}
+		return &bearerTokenTransport{base: base, token: newFileSecret(config.TokenFile)}, nil
+	case OutboundAuthTypeMTLS:
+		return newMTLSTransport(base, config)
+	case OutboundAuthTypeOAuth2:
+		if config.TokenURL == "" || config.ClientID == "" || config.ClientSecretFile == "" {
+			return nil, errors.New("oauth2 auth requires TokenURL, ClientID and ClientSecretFile")
+		}
+		source := &clientCredentialsTokenSource{
+			config: config,
+			secret: newFileSecret(config.ClientSecretFile),
+			client: &http.Client{Transport: base, Timeout: oauth2TokenRequestTimeout},
+		}
+		return &oauth2.Transport{Source: oauth2.ReuseTokenSource(nil, source), Base: base}, nil
+	default:
+		return nil, fmt.Errorf("unknown outbound auth type: %q", config.Type)
+	}
+}
+
+// fileSecret reads a secret from a file, caching it until the file's modification time changes.
+type fileSecret struct {
+	path string
+
+	mu      sync.Mutex
+	modTime time.Time
+	value   string
+}
+
+func newFileSecret(path string) *fileSecret {
+	return &fileSecret{path: path}
+}
+
+func (s *fileSecret) get() (string, error) {
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return "", fmt.Errorf("failed to stat secret file: %w", err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.value != "" && info.ModTime().Equal(s.modTime) {
+		return s.value, nil
+	}
+	b, err := os.ReadFile(s.path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read secret file: %w", err)
+	}
+	value := strings.TrimSpace(string(b))
+	if value == "" {
+		return "", fmt.Errorf("secret file %q is empty", s.path)
+	}
+	s.value = value
+	s.modTime = info.ModTime()
+	return s.value, nil
+}
+
+type bearerTokenTransport struct {
+	base  http.RoundTripper
+	token *fileSecret
+}
+
+func (t *bearerTokenTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	token, err := t.token.get()
+	if err != nil {
+		return nil, err
+	}
+	// RoundTrippers must not modify the given request.
+	r = r.Clone(r.Context())
+	r.Header.Set("Authorization", "Bearer "+token)
+	return t.base.RoundTrip(r)
+}
+
+// keyPairLoader loads a client certificate, reloading it when either file changes.
+type keyPairLoader struct {
+	certFile, keyFile string
+
+	mu                sync.Mutex
+	certTime, keyTime time.Time
+	cert              *tls.Certificate
+}
+
+func (l *keyPairLoader) get(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	certInfo, err := os.Stat(l.certFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat client certificate: %w", err)
+	}
+	keyInfo, err := os.Stat(l.keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat client key: %w", err)
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.cert != nil && certInfo.ModTime().Equal(l.certTime) && keyInfo.ModTime().Equal(l.keyTime) {
+		return l.cert, nil
+	}
+	cert, err := tls.LoadX509KeyPair(l.certFile, l.keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load client certificate: %w", err)
+	}
+	l.cert = &cert
+	l.certTime = certInfo.ModTime()
+	l.keyTime = keyInfo.ModTime()
+	return l.cert, nil
+}
+
+func newMTLSTransport(base http.RoundTripper, config OutboundAuthConfig) (http.RoundTripper, error) {
+	if config.CertFile == "" || config.KeyFile == "" {
+		return nil, errors.New("mtls auth requires CertFile and KeyFile")
+	}
+	baseTransport, ok := base.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("mtls auth requires an *http.Transport, got %T", base)
+	}
+	loader := &keyPairLoader{certFile: config.CertFile, keyFile: config.KeyFile}
+	// Fail early on a bad key pair instead of on the first request.
+	if _, err := loader.get(nil); err != nil {
+		return nil, err
+	}
+
+	transport := baseTransport.Clone()
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if transport.TLSClientConfig != nil {
+		tlsConfig = transport.TLSClientConfig.Clone()
+	}
+	tlsConfig.GetClientCertificate = loader.get
+	if config.CAFile != "" {
+		pem, err := os.ReadFile(config.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in CA file %q", config.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	transport.TLSClientConfig = tlsConfig
+	return transport, nil
+}
+
+// clientCredentialsTokenSource fetches tokens with the OAuth2 client credentials flow, reading the client secret on
+// every fetch to pick up rotations.
+type clientCredentialsTokenSource struct {
+	config OutboundAuthConfig
+	secret *fileSecret
+	client *http.Client
+}
+
+func (s *clientCredentialsTokenSource) Token() (*oauth2.Token, error) {
+	secret, err := s.secret.get()
+	if err != nil {
+		return nil, err
+	}
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, s.client)
+	return (&clientcredentials.Config{
+		ClientID:     s.config.ClientID,
+		ClientSecret: secret,
+		TokenURL:     s.config.TokenURL,
+		Scopes:       strings.Fields(s.config.Scopes),
+	}).Token(ctx)
+}
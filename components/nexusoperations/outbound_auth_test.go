@@ -0,0 +1,260 @@
+// The MIT License
+//
+// Copyright (c) 2025 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nexusoperations
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestOutboundAuth_None(t *testing.T) {
+	transport, err := newAuthTransport(http.DefaultTransport, OutboundAuthConfig{})
+	require.NoError(t, err)
+	require.Equal(t, http.DefaultTransport, transport)
+
+	_, err = newAuthTransport(http.DefaultTransport, OutboundAuthConfig{Type: "kerberos"})
+	require.ErrorContains(t, err, "unknown outbound auth type")
+}
+
+func TestOutboundAuth_Bearer(t *testing.T) {
+	var gotAuth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+	}))
+	defer srv.Close()
+
+	_, err := newAuthTransport(http.DefaultTransport, OutboundAuthConfig{Type: OutboundAuthTypeBearer})
+	require.ErrorContains(t, err, "requires TokenFile")
+
+	dir := t.TempDir()
+	tokenFile := writeFile(t, dir, "token", "first-token\n")
+	transport, err := newAuthTransport(http.DefaultTransport, OutboundAuthConfig{
+		Type:      OutboundAuthTypeBearer,
+		TokenFile: tokenFile,
+	})
+	require.NoError(t, err)
+	client := &http.Client{Transport: transport}
+
+	req, err := http.NewRequest(http.MethodPost, srv.URL, nil)
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, "Bearer first-token", gotAuth)
+	require.Empty(t, req.Header.Get("Authorization"), "the original request must not be modified")
+
+	// Rotated tokens are picked up.
+	writeFile(t, dir, "token", "second-token")
+	require.NoError(t, os.Chtimes(tokenFile, time.Now().Add(time.Minute), time.Now().Add(time.Minute)))
+	resp, err = client.Post(srv.URL, "", nil)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, "Bearer second-token", gotAuth)
+}
+
+func TestOutboundAuth_OAuth2(t *testing.T) {
+	tokenRequests := 0
+	tokenSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokenRequests++
+		clientID, secret, ok := r.BasicAuth()
+		if !ok || clientID != "client-id" || secret != "client-secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if err := r.ParseForm(); err != nil || r.Form.Get("grant_type") != "client_credentials" || r.Form.Get("scope") != "a b" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "access-token",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+		})
+	}))
+	defer tokenSrv.Close()
+	var gotAuth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+	}))
+	defer srv.Close()
+
+	_, err := newAuthTransport(http.DefaultTransport, OutboundAuthConfig{Type: OutboundAuthTypeOAuth2, TokenURL: tokenSrv.URL})
+	require.ErrorContains(t, err, "requires TokenURL, ClientID and ClientSecretFile")
+
+	transport, err := newAuthTransport(http.DefaultTransport, OutboundAuthConfig{
+		Type:             OutboundAuthTypeOAuth2,
+		TokenURL:         tokenSrv.URL,
+		ClientID:         "client-id",
+		ClientSecretFile: writeFile(t, t.TempDir(), "secret", "client-secret"),
+		Scopes:           "a b",
+	})
+	require.NoError(t, err)
+	client := &http.Client{Transport: transport}
+	for range 2 {
+		resp, err := client.Post(srv.URL, "", nil)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, "Bearer access-token", gotAuth)
+	}
+	require.Equal(t, 1, tokenRequests, "tokens are reused until they expire")
+}
+
+func TestOutboundAuth_MTLS(t *testing.T) {
+	dir := t.TempDir()
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, caKey.Public(), caKey)
+	require.NoError(t, err)
+	caCert, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+	caPool := x509.NewCertPool()
+	caPool.AddCert(caCert)
+
+	// A single leaf is used by both the server and the client.
+	leafKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	leafDER, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}, caCert, leafKey.Public(), caKey)
+	require.NoError(t, err)
+	leafKeyDER, err := x509.MarshalECPrivateKey(leafKey)
+	require.NoError(t, err)
+	certPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leafDER}))
+	keyPEM := string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: leafKeyDER}))
+	caPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}))
+	serverCert, err := tls.X509KeyPair([]byte(certPEM), []byte(keyPEM))
+	require.NoError(t, err)
+
+	var gotClientCN string
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotClientCN = r.TLS.PeerCertificates[0].Subject.CommonName
+	}))
+	srv.TLS = &tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    caPool,
+		MinVersion:   tls.VersionTLS12,
+	}
+	srv.StartTLS()
+	defer srv.Close()
+
+	_, err = newAuthTransport(http.DefaultTransport, OutboundAuthConfig{Type: OutboundAuthTypeMTLS})
+	require.ErrorContains(t, err, "requires CertFile and KeyFile")
+
+	_, err = newAuthTransport(http.DefaultTransport, OutboundAuthConfig{
+		Type:     OutboundAuthTypeMTLS,
+		CertFile: filepath.Join(dir, "missing.pem"),
+		KeyFile:  filepath.Join(dir, "missing.key"),
+	})
+	require.Error(t, err)
+
+	transport, err := newAuthTransport(http.DefaultTransport, OutboundAuthConfig{
+		Type:     OutboundAuthTypeMTLS,
+		CertFile: writeFile(t, dir, "cert.pem", certPEM),
+		KeyFile:  writeFile(t, dir, "key.pem", keyPEM),
+		CAFile:   writeFile(t, dir, "ca.pem", caPEM),
+	})
+	require.NoError(t, err)
+	resp, err := (&http.Client{Transport: transport}).Get(srv.URL)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, "localhost", gotClientCN)
+
+	// Without a client certificate the server rejects the handshake.
+	plain := http.DefaultTransport.(*http.Transport).Clone()
+	plain.TLSClientConfig = &tls.Config{RootCAs: caPool, MinVersion: tls.VersionTLS12}
+	_, err = (&http.Client{Transport: plain}).Get(srv.URL) // nolint:bodyclose
+	require.Error(t, err)
+}
+
+func TestAuthClientCache(t *testing.T) {
+	created := 0
+	cache := newAuthClientCache(func(key string, auth OutboundAuthConfig) (string, error) {
+		if auth.Type == "kerberos" {
+			return "", errors.New("unknown outbound auth type")
+		}
+		created++
+		return fmt.Sprintf("%s-%s-%d", key, auth.Type, created), nil
+	})
+
+	client, err := cache.get("endpoint", OutboundAuthConfig{})
+	require.NoError(t, err)
+	require.Equal(t, "endpoint--1", client)
+	client, err = cache.get("endpoint", OutboundAuthConfig{})
+	require.NoError(t, err)
+	require.Equal(t, "endpoint--1", client)
+
+	// A config change replaces the client instead of adding one.
+	client, err = cache.get("endpoint", OutboundAuthConfig{Type: OutboundAuthTypeBearer, TokenFile: "token"})
+	require.NoError(t, err)
+	require.Equal(t, "endpoint-bearer-2", client)
+	require.Len(t, cache.entries, 1)
+
+	// Errors are not cached and keep the previous client.
+	_, err = cache.get("endpoint", OutboundAuthConfig{Type: "kerberos"})
+	require.Error(t, err)
+	client, err = cache.get("endpoint", OutboundAuthConfig{Type: OutboundAuthTypeBearer, TokenFile: "token"})
+	require.NoError(t, err)
+	require.Equal(t, "endpoint-bearer-2", client)
+}
@@ -66,6 +66,18 @@ type Operation struct {
 
 // AddChild adds a new operation child machine to the given node and transitions it to the SCHEDULED state.
 func AddChild(node *hsm.Node, id string, event *historypb.HistoryEvent, eventToken []byte) (*hsm.Node, error) {
+	return AddChildWithCallerCloseBehavior(node, id, event, eventToken, enumsspb.NEXUS_CALLER_CLOSE_BEHAVIOR_UNSPECIFIED)
+}
+
+// AddChildWithCallerCloseBehavior is like [AddChild] and also records what should happen to the operation when the
+// caller workflow closes, as determined by the endpoint policy at schedule time. See [ScheduledEventDefinition].
+func AddChildWithCallerCloseBehavior(
+	node *hsm.Node,
+	id string,
+	event *historypb.HistoryEvent,
+	eventToken []byte,
+	callerCloseBehavior enumsspb.NexusCallerCloseBehavior,
+) (*hsm.Node, error) {
 	attrs := event.GetNexusOperationScheduledEventAttributes()
 
 	node, err := node.AddChild(hsm.Key{Type: OperationMachineType, ID: id}, Operation{
@@ -79,6 +91,7 @@ func AddChild(node *hsm.Node, id string, event *historypb.HistoryEvent, eventTok
 			RequestId:              attrs.RequestId,
 			State:                  enumsspb.NEXUS_OPERATION_STATE_UNSPECIFIED,
 			ScheduledEventToken:    eventToken,
+			CallerCloseBehavior:    callerCloseBehavior,
 		},
 	})
 
@@ -432,6 +445,48 @@ func (o Operation) Cancel(node *hsm.Node, t time.Time) (hsm.TransitionOutput, er
 	})
 }
 
+// CancelOnCallerClose requests cancelation of started operations that were scheduled with the
+// NEXUS_CALLER_CLOSE_BEHAVIOR_REQUEST_CANCEL endpoint policy and have no cancelation yet. Called when the caller
+// workflow closes. The cancelation is marked as requested on caller close for its tasks to be executed even though the
+// workflow is no longer running.
+func CancelOnCallerClose(root *hsm.Node, t time.Time) error {
+	coll := MachineCollection(root)
+	for _, node := range coll.List() {
+		op, err := coll.Data(node.Key.ID)
+		if err != nil {
+			return err
+		}
+		if op.CallerCloseBehavior != enumsspb.NEXUS_CALLER_CLOSE_BEHAVIOR_REQUEST_CANCEL ||
+			op.State() != enumsspb.NEXUS_OPERATION_STATE_STARTED {
+			continue
+		}
+		requested, err := op.cancelRequested(node)
+		if err != nil {
+			return err
+		}
+		if requested {
+			continue
+		}
+		child, err := node.AddChild(CancelationMachineKey, Cancelation{
+			NexusOperationCancellationInfo: &persistencespb.NexusOperationCancellationInfo{
+				RequestedOnCallerClose: true,
+			},
+		})
+		if err != nil {
+			return err
+		}
+		if err := hsm.MachineTransition(child, func(c Cancelation) (hsm.TransitionOutput, error) {
+			return TransitionCancelationScheduled.Apply(c, EventCancelationScheduled{
+				Time: t,
+				Node: child,
+			})
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // TODO: Remove this implementation once transition history is fully implemented.
 func (o Operation) progress() (int, int32, error) {
 	switch o.State() {
@@ -23,6 +23,7 @@
 package nexusoperations_test
 
 import (
+	"strconv"
 	"testing"
 	"time"
 
@@ -37,6 +38,7 @@ import (
 	"go.temporal.io/server/components/nexusoperations"
 	"go.temporal.io/server/service/history/hsm"
 	"go.temporal.io/server/service/history/hsm/hsmtest"
+	"go.temporal.io/server/service/history/workflow"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -464,6 +466,62 @@ func TestCancel(t *testing.T) {
 	require.Equal(t, enumspb.NEXUS_OPERATION_CANCELLATION_STATE_SCHEDULED, cancelation.State())
 }
 
+type closedRoot struct{}
+
+func (closedRoot) IsWorkflowExecutionRunning() bool {
+	return false
+}
+
+func (closedRoot) IsTransitionHistoryEnabled() bool {
+	return false
+}
+
+func TestCancelOnCallerClose(t *testing.T) {
+	reg := hsm.NewRegistry()
+	require.NoError(t, workflow.RegisterStateMachine(reg))
+	require.NoError(t, nexusoperations.RegisterStateMachines(reg))
+	root, err := hsm.NewRoot(reg, workflow.StateMachineType, closedRoot{}, make(map[string]*persistencespb.StateMachineMap), &hsmtest.NodeBackend{})
+	require.NoError(t, err)
+
+	addStartedOperation := func(id int64, behavior enumsspb.NexusCallerCloseBehavior) *hsm.Node {
+		event := mustNewScheduledEvent(time.Now(), time.Hour)
+		event.EventId = id
+		token, err := hsm.GenerateEventLoadToken(event)
+		require.NoError(t, err)
+		node, err := nexusoperations.AddChildWithCallerCloseBehavior(root, strconv.FormatInt(id, 10), event, token, behavior)
+		require.NoError(t, err)
+		require.NoError(t, hsm.MachineTransition(node, func(op nexusoperations.Operation) (hsm.TransitionOutput, error) {
+			return nexusoperations.TransitionStarted.Apply(op, nexusoperations.EventStarted{
+				Time: time.Now(),
+				Node: node,
+				Attributes: &historypb.NexusOperationStartedEventAttributes{
+					OperationToken: "op-token",
+				},
+			})
+		}))
+		return node
+	}
+	abandoned := addStartedOperation(1, enumsspb.NEXUS_CALLER_CLOSE_BEHAVIOR_ABANDON)
+	canceled := addStartedOperation(2, enumsspb.NEXUS_CALLER_CLOSE_BEHAVIOR_REQUEST_CANCEL)
+
+	require.NoError(t, nexusoperations.CancelOnCallerClose(root, time.Now()))
+	// Operations that already have a cancelation are skipped.
+	require.NoError(t, nexusoperations.CancelOnCallerClose(root, time.Now()))
+
+	_, err = abandoned.Child([]hsm.Key{nexusoperations.CancelationMachineKey})
+	require.ErrorIs(t, err, hsm.ErrStateMachineNotFound)
+
+	node, err := canceled.Child([]hsm.Key{nexusoperations.CancelationMachineKey})
+	require.NoError(t, err)
+	cancelation, err := hsm.MachineData[nexusoperations.Cancelation](node)
+	require.NoError(t, err)
+	require.Equal(t, enumspb.NEXUS_OPERATION_CANCELLATION_STATE_SCHEDULED, cancelation.State())
+	require.True(t, cancelation.RequestedOnCallerClose)
+
+	// The cancelation task remains valid even though the workflow is closed.
+	require.NoError(t, nexusoperations.CancelationTask{EndpointName: "endpoint"}.Validate(nil, node))
+}
+
 func TestCancelationValidTransitions(t *testing.T) {
 	// Setup
 	root := newOperationNode(t, &hsmtest.NodeBackend{}, mustNewScheduledEvent(time.Now(), time.Hour))
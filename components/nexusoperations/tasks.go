@@ -196,12 +196,25 @@ func (t CancelationTask) Destination() string {
 }
 
 func (CancelationTask) Validate(ref *persistencespb.StateMachineRef, node *hsm.Node) error {
-	if err := node.CheckRunning(); err != nil {
+	if err := checkCancelationRunning(node); err != nil {
 		return err
 	}
 	return hsm.ValidateState[enumspb.NexusOperationCancellationState, Cancelation](node, enumspb.NEXUS_OPERATION_CANCELLATION_STATE_SCHEDULED)
 }
 
+// checkCancelationRunning verifies that the workflow is running, unless the cancelation was requested because the
+// workflow closed.
+func checkCancelationRunning(node *hsm.Node) error {
+	c, err := hsm.MachineData[Cancelation](node)
+	if err != nil {
+		return err
+	}
+	if c.RequestedOnCallerClose {
+		return nil
+	}
+	return node.CheckRunning()
+}
+
 type CancelationTaskSerializer struct{}
 
 func (CancelationTaskSerializer) Deserialize(data []byte, attrs hsm.TaskAttributes) (hsm.Task, error) {
@@ -241,7 +254,7 @@ func (CancelationBackoffTask) Destination() string {
 }
 
 func (CancelationBackoffTask) Validate(ref *persistencespb.StateMachineRef, node *hsm.Node) error {
-	if err := node.CheckRunning(); err != nil {
+	if err := checkCancelationRunning(node); err != nil {
 		return err
 	}
 	return hsm.ValidateState[enumspb.NexusOperationCancellationState, Cancelation](node, enumspb.NEXUS_OPERATION_CANCELLATION_STATE_BACKING_OFF)
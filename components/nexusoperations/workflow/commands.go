@@ -167,6 +167,13 @@ func (ch *commandHandler) HandleScheduleCommand(
 	// Trim timeout to max allowed timeout.
 	if maxTimeout := ch.config.MaxOperationScheduleToCloseTimeout(nsName); maxTimeout > 0 && opTimeout > maxTimeout {
 		attrs.ScheduleToCloseTimeout = durationpb.New(maxTimeout)
+		opTimeout = maxTimeout
+	}
+
+	// Trim timeout to the endpoint's max timeout, which also applies to operations without a timeout.
+	policy := endpoint.GetEndpoint().GetSpec().GetPolicy()
+	if maxTimeout := policy.GetMaxOperationTimeout().AsDuration(); maxTimeout > 0 && (opTimeout == 0 || opTimeout > maxTimeout) {
+		attrs.ScheduleToCloseTimeout = durationpb.New(maxTimeout)
 	}
 
 	event := ms.AddHistoryEvent(enumspb.EVENT_TYPE_NEXUS_OPERATION_SCHEDULED, func(he *historypb.HistoryEvent) {
@@ -186,7 +193,18 @@ func (ch *commandHandler) HandleScheduleCommand(
 		he.UserMetadata = command.UserMetadata
 	})
 
-	return nexusoperations.ScheduledEventDefinition{}.Apply(root, event)
+	token, err := hsm.GenerateEventLoadToken(event)
+	if err != nil {
+		return err
+	}
+	_, err = nexusoperations.AddChildWithCallerCloseBehavior(
+		root,
+		strconv.FormatInt(event.EventId, 10),
+		event,
+		token,
+		policy.GetCallerCloseBehavior(),
+	)
+	return err
 }
 
 func (ch *commandHandler) HandleCancelCommand(
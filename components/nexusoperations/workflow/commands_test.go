@@ -36,6 +36,7 @@ import (
 	historypb "go.temporal.io/api/history/v1"
 	sdkpb "go.temporal.io/api/sdk/v1"
 	"go.temporal.io/api/serviceerror"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/namespace"
@@ -79,13 +80,23 @@ var defaultConfig = &nexusoperations.Config{
 }
 
 func newTestContext(t *testing.T, cfg *nexusoperations.Config) testContext {
+	return newTestContextWithEndpointPolicy(t, cfg, nil)
+}
+
+func newTestContextWithEndpointPolicy(t *testing.T, cfg *nexusoperations.Config, policy *persistencespb.NexusEndpointPolicy) testContext {
+	// Only the ID and policy are taken here.
+	entry := &persistencespb.NexusEndpointEntry{
+		Id: "endpoint-id",
+		Endpoint: &persistencespb.NexusEndpoint{
+			Spec: &persistencespb.NexusEndpointSpec{Policy: policy},
+		},
+	}
 	endpointReg := nexustest.FakeEndpointRegistry{
 		OnGetByName: func(ctx context.Context, namespaceID namespace.ID, endpointName string) (*persistencespb.NexusEndpointEntry, error) {
 			if endpointName != "endpoint" {
 				return nil, serviceerror.NewNotFound("endpoint not found")
 			}
-			// Only the ID is taken here.
-			return &persistencespb.NexusEndpointEntry{Id: "endpoint-id"}, nil
+			return entry, nil
 		},
 	}
 	chReg := workflow.NewCommandHandlerRegistry()
@@ -356,6 +367,68 @@ func TestHandleScheduleCommand(t *testing.T) {
 		require.Equal(t, time.Minute, tcx.history.Events[0].GetNexusOperationScheduledEventAttributes().ScheduleToCloseTimeout.AsDuration())
 	})
 
+	endpointTimeoutCases := []struct {
+		name            string
+		commandTimeout  *durationpb.Duration
+		expectedTimeout time.Duration
+	}{
+		{
+			name:            "operation timeout defaults to endpoint max timeout",
+			commandTimeout:  nil,
+			expectedTimeout: time.Minute,
+		},
+		{
+			name:            "operation timeout trimmed to endpoint max timeout",
+			commandTimeout:  durationpb.New(time.Hour),
+			expectedTimeout: time.Minute,
+		},
+		{
+			name:            "operation timeout left as is if less than endpoint max timeout",
+			commandTimeout:  durationpb.New(time.Second),
+			expectedTimeout: time.Second,
+		},
+	}
+	for _, tc := range endpointTimeoutCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tcx := newTestContextWithEndpointPolicy(t, defaultConfig, &persistencespb.NexusEndpointPolicy{
+				MaxOperationTimeout: durationpb.New(time.Minute),
+			})
+			err := tcx.scheduleHandler(context.Background(), tcx.ms, commandValidator{maxPayloadSize: 1}, 1, &commandpb.Command{
+				Attributes: &commandpb.Command_ScheduleNexusOperationCommandAttributes{
+					ScheduleNexusOperationCommandAttributes: &commandpb.ScheduleNexusOperationCommandAttributes{
+						Endpoint:               "endpoint",
+						Service:                "service",
+						Operation:              "op",
+						ScheduleToCloseTimeout: tc.commandTimeout,
+					},
+				},
+			})
+			require.NoError(t, err)
+			require.Equal(t, 1, len(tcx.history.Events))
+			require.Equal(t, tc.expectedTimeout, tcx.history.Events[0].GetNexusOperationScheduledEventAttributes().ScheduleToCloseTimeout.AsDuration())
+		})
+	}
+
+	t.Run("records endpoint caller close behavior", func(t *testing.T) {
+		tcx := newTestContextWithEndpointPolicy(t, defaultConfig, &persistencespb.NexusEndpointPolicy{
+			CallerCloseBehavior: enumsspb.NEXUS_CALLER_CLOSE_BEHAVIOR_REQUEST_CANCEL,
+		})
+		err := tcx.scheduleHandler(context.Background(), tcx.ms, commandValidator{maxPayloadSize: 1}, 1, &commandpb.Command{
+			Attributes: &commandpb.Command_ScheduleNexusOperationCommandAttributes{
+				ScheduleNexusOperationCommandAttributes: &commandpb.ScheduleNexusOperationCommandAttributes{
+					Endpoint:  "endpoint",
+					Service:   "service",
+					Operation: "op",
+				},
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(tcx.history.Events))
+		op, err := nexusoperations.MachineCollection(tcx.ms.HSM()).Data(strconv.FormatInt(tcx.history.Events[0].EventId, 10))
+		require.NoError(t, err)
+		require.Equal(t, enumsspb.NEXUS_CALLER_CLOSE_BEHAVIOR_REQUEST_CANCEL, op.CallerCloseBehavior)
+	})
+
 	timeoutCases := []struct {
 		name               string
 		workflowRunTimeout *durationpb.Duration
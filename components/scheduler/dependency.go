@@ -0,0 +1,136 @@
+// The MIT License
+//
+// Copyright (c) 2025 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/service/history/hsm"
+	scheduler1 "go.temporal.io/server/service/worker/scheduler"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Dependency triggers follow the workflow scheduler: a schedule buffers an
+// action once the latest action of every upstream schedule has closed with a
+// matching status. The functions below apply the dependency requests to a
+// Scheduler node. Registering with upstream schedules and notifying dependents
+// is left to the caller, as the Scheduler does not track its started
+// workflows yet.
+
+// SetDependencies replaces the upstream schedules that the Scheduler depends
+// on. Progress towards upstreams that are no longer dependencies is dropped.
+// The schedule IDs of the upstreams that were added and removed are returned,
+// for the caller to (un)register with.
+func SetDependencies(
+	node *hsm.Node,
+	req *schedulespb.SetDependenciesRequest,
+) (added, removed []string, err error) {
+	err = hsm.MachineTransition(node, func(s Scheduler) (hsm.TransitionOutput, error) {
+		if req.GetConflictToken() != 0 && req.GetConflictToken() != s.ConflictToken {
+			return hsm.TransitionOutput{}, serviceerror.NewFailedPrecondition("conflicting concurrent update")
+		}
+
+		deps, _ := scheduler1.FilterDependencies(s.ScheduleId, req.GetDependencies())
+		oldIDs := scheduler1.DependencyScheduleIDs(s.Dependencies)
+		newIDs := scheduler1.DependencyScheduleIDs(deps)
+		for _, id := range newIDs {
+			if !slices.Contains(oldIDs, id) {
+				added = append(added, id)
+			}
+		}
+		for _, id := range oldIDs {
+			if !slices.Contains(newIDs, id) {
+				removed = append(removed, id)
+				delete(s.SatisfiedDependencies, id)
+			}
+		}
+
+		s.Dependencies = deps
+		s.updateConflictToken()
+		return hsm.TransitionOutput{}, nil
+	})
+	return added, removed, err
+}
+
+// RegisterDependent adds or removes a schedule that depends on the Scheduler.
+func RegisterDependent(node *hsm.Node, req *schedulespb.RegisterDependentRequest) error {
+	return hsm.MachineTransition(node, func(s Scheduler) (hsm.TransitionOutput, error) {
+		s.DependentScheduleIds = scheduler1.RegisterDependent(s.DependentScheduleIds, req)
+		return hsm.TransitionOutput{}, nil
+	})
+}
+
+// UpstreamCompleted records a closed action of an upstream schedule. Once every
+// dependency is satisfied, an action is buffered with the Executor, with the
+// latest upstream close time as its nominal time. As with actions buffered by
+// the Generator, no action is buffered while paused, out of actions, or past the
+// catchup window.
+func UpstreamCompleted(
+	node *hsm.Node,
+	tweakables Tweakables,
+	now time.Time,
+	completed *schedulespb.UpstreamActionCompleted,
+) error {
+	var start *schedulespb.BufferedStart
+	err := hsm.MachineTransition(node, func(s Scheduler) (hsm.TransitionOutput, error) {
+		var nominalTime time.Time
+		var ready bool
+		s.SatisfiedDependencies, nominalTime, ready = scheduler1.SatisfyDependency(
+			s.Dependencies,
+			s.SatisfiedDependencies,
+			completed,
+			now,
+		)
+		if ready && s.useScheduledAction(false) && now.Sub(nominalTime) <= catchupWindow(s, tweakables) {
+			start = &schedulespb.BufferedStart{
+				NominalTime:   timestamppb.New(nominalTime),
+				ActualTime:    timestamppb.New(now),
+				OverlapPolicy: s.overlapPolicy(),
+				RequestId:     generateRequestID(s, "dependency", nominalTime, now),
+			}
+		}
+		return hsm.TransitionOutput{}, nil
+	})
+	if err != nil || start == nil {
+		return err
+	}
+
+	executorNode, err := node.Child([]hsm.Key{ExecutorMachineKey})
+	if err != nil {
+		return fmt.Errorf(
+			"%w: %w",
+			serviceerror.NewInternal("Scheduler is missing its Executor node"),
+			err,
+		)
+	}
+	return hsm.MachineTransition(executorNode, func(e Executor) (hsm.TransitionOutput, error) {
+		return TransitionExecute.Apply(e, EventExecute{
+			Node:           executorNode,
+			BufferedStarts: []*schedulespb.BufferedStart{start},
+		})
+	})
+}
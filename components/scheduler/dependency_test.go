@@ -0,0 +1,122 @@
+// The MIT License
+//
+// Copyright (c) 2025 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/components/scheduler"
+	"go.temporal.io/server/service/history/hsm"
+	"go.temporal.io/server/service/history/hsm/hsmtest"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestUpstreamCompleted(t *testing.T) {
+	env := newFakeEnv()
+	registry := newRegistry(t)
+	backend := &hsmtest.NodeBackend{}
+	root := newRoot(t, registry, backend)
+	schedulerNode := newSchedulerTree(t, registry, root, defaultSchedule(), nil)
+
+	added, removed, err := scheduler.SetDependencies(schedulerNode, &schedulespb.SetDependenciesRequest{
+		Dependencies: []*schedulespb.ScheduleDependency{
+			{ScheduleId: "upstream-a"},
+			{ScheduleId: "upstream-b", Statuses: []enumspb.WorkflowExecutionStatus{enumspb.WORKFLOW_EXECUTION_STATUS_FAILED}},
+			{ScheduleId: scheduleID},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"upstream-a", "upstream-b"}, added)
+	require.Empty(t, removed)
+
+	// A stale conflict token is rejected.
+	_, _, err = scheduler.SetDependencies(schedulerNode, &schedulespb.SetDependenciesRequest{ConflictToken: 1000})
+	require.Error(t, err)
+
+	executorNode, err := schedulerNode.Child([]hsm.Key{scheduler.ExecutorMachineKey})
+	require.NoError(t, err)
+	bufferedStarts := func() []*schedulespb.BufferedStart {
+		executor, err := hsm.MachineData[scheduler.Executor](executorNode)
+		require.NoError(t, err)
+		return executor.BufferedStarts
+	}
+
+	// Upstream A is satisfied, B closed with a non-matching status.
+	closeTimeA := env.Now().Add(-time.Minute)
+	require.NoError(t, scheduler.UpstreamCompleted(schedulerNode, scheduler.DefaultTweakables, env.Now(), &schedulespb.UpstreamActionCompleted{
+		ScheduleId: "upstream-a",
+		Status:     enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		CloseTime:  timestamppb.New(closeTimeA),
+	}))
+	require.NoError(t, scheduler.UpstreamCompleted(schedulerNode, scheduler.DefaultTweakables, env.Now(), &schedulespb.UpstreamActionCompleted{
+		ScheduleId: "upstream-b",
+		Status:     enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+	}))
+	require.Empty(t, bufferedStarts())
+
+	// Once B is satisfied too, an action is buffered at the latest close time.
+	require.NoError(t, scheduler.UpstreamCompleted(schedulerNode, scheduler.DefaultTweakables, env.Now(), &schedulespb.UpstreamActionCompleted{
+		ScheduleId: "upstream-b",
+		Status:     enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+	}))
+	starts := bufferedStarts()
+	require.Len(t, starts, 1)
+	require.Equal(t, env.Now().UTC(), starts[0].NominalTime.AsTime())
+	require.Equal(t, env.Now().UTC(), starts[0].ActualTime.AsTime())
+	require.NotEmpty(t, starts[0].RequestId)
+
+	// Dependencies start over for the next action.
+	s, err := hsm.MachineData[scheduler.Scheduler](schedulerNode)
+	require.NoError(t, err)
+	require.Empty(t, s.SatisfiedDependencies)
+
+	// Removing a dependency reports the upstream to unregister from.
+	added, removed, err = scheduler.SetDependencies(schedulerNode, &schedulespb.SetDependenciesRequest{
+		Dependencies: []*schedulespb.ScheduleDependency{{ScheduleId: "upstream-a"}},
+	})
+	require.NoError(t, err)
+	require.Empty(t, added)
+	require.Equal(t, []string{"upstream-b"}, removed)
+}
+
+func TestRegisterDependent(t *testing.T) {
+	registry := newRegistry(t)
+	backend := &hsmtest.NodeBackend{}
+	root := newRoot(t, registry, backend)
+	schedulerNode := newSchedulerTree(t, registry, root, defaultSchedule(), nil)
+
+	require.NoError(t, scheduler.RegisterDependent(schedulerNode, &schedulespb.RegisterDependentRequest{ScheduleId: "dependent"}))
+	require.NoError(t, scheduler.RegisterDependent(schedulerNode, &schedulespb.RegisterDependentRequest{ScheduleId: "dependent"}))
+	s, err := hsm.MachineData[scheduler.Scheduler](schedulerNode)
+	require.NoError(t, err)
+	require.Equal(t, []string{"dependent"}, s.DependentScheduleIds)
+
+	require.NoError(t, scheduler.RegisterDependent(schedulerNode, &schedulespb.RegisterDependentRequest{ScheduleId: "dependent", Unregister: true}))
+	s, err = hsm.MachineData[scheduler.Scheduler](schedulerNode)
+	require.NoError(t, err)
+	require.Empty(t, s.DependentScheduleIds)
+}
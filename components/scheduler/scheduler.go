@@ -32,6 +32,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	schedulepb "go.temporal.io/api/schedule/v1"
 	schedulespb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/service/history/hsm"
 	"go.temporal.io/server/service/worker/scheduler"
 	"google.golang.org/protobuf/proto"
@@ -199,7 +200,7 @@ func (s *Scheduler) getCompiledSpec(specBuilder *scheduler.SpecBuilder) (*schedu
 
 	// Cache compiled spec.
 	if s.compiledSpec == nil {
-		cspec, err := specBuilder.NewCompiledSpec(s.Schedule.Spec)
+		cspec, err := specBuilder.NewCompiledSpecForNamespace(namespace.ID(s.NamespaceId), s.Schedule.Spec, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -40,6 +40,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 	namespacepb "go.temporal.io/api/namespace/v1"
+	querypb "go.temporal.io/api/query/v1"
 	replicationpb "go.temporal.io/api/replication/v1"
 	"go.temporal.io/api/serviceerror"
 	workflowpb "go.temporal.io/api/workflow/v1"
@@ -54,6 +55,7 @@ import (
 	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
 	serverClient "go.temporal.io/server/client"
 	"go.temporal.io/server/client/admin"
 	"go.temporal.io/server/client/frontend"
@@ -71,13 +73,16 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/namespace/nsreplication"
+	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
 	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
 	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/rpc/interceptor"
 	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/common/searchattribute"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
@@ -86,8 +91,12 @@ import (
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/worker/addsearchattributes"
 	"go.temporal.io/server/service/worker/dlq"
+	"go.temporal.io/server/service/worker/scheduler"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -136,6 +145,8 @@ type (
 		// use the following components.
 		taskCategoryRegistry tasks.TaskCategoryRegistry
 		matchingClient       matchingservice.MatchingServiceClient
+
+		nexusEndpointClient *NexusEndpointClient
 	}
 
 	NewAdminHandlerArgs struct {
@@ -170,6 +181,8 @@ type (
 		// use the following components.
 		CategoryRegistry tasks.TaskCategoryRegistry
 		matchingClient   matchingservice.MatchingServiceClient
+
+		nexusEndpointClient *NexusEndpointClient
 	}
 )
 
@@ -250,6 +263,7 @@ func NewAdminHandler(
 		historyHealthChecker: historyHealthChecker,
 		taskCategoryRegistry: args.CategoryRegistry,
 		matchingClient:       args.matchingClient,
+		nexusEndpointClient:  args.nexusEndpointClient,
 	}
 }
 
@@ -886,6 +900,237 @@ func (adh *AdminHandler) CloseShard(ctx context.Context, request *adminservice.C
 	return &adminservice.CloseShardResponse{}, err
 }
 
+// DescribeMutableStateCache returns the largest entries of a shard in the mutable state cache
+func (adh *AdminHandler) DescribeMutableStateCache(
+	ctx context.Context,
+	request *adminservice.DescribeMutableStateCacheRequest,
+) (_ *adminservice.DescribeMutableStateCacheResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetShardId() <= 0 {
+		return nil, errInvalidShardID
+	}
+
+	resp, err := adh.historyClient.DescribeMutableStateCache(ctx, &historyservice.DescribeMutableStateCacheRequest{
+		ShardId:        request.GetShardId(),
+		MaximumEntries: request.GetMaximumEntries(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.DescribeMutableStateCacheResponse{
+		Entries:         resp.GetEntries(),
+		ShardEntryCount: resp.GetShardEntryCount(),
+		ShardSize:       resp.GetShardSize(),
+		CacheSize:       resp.GetCacheSize(),
+		Truncated:       resp.GetTruncated(),
+	}, nil
+}
+
+// DescribeSchedule returns the internal state of a schedule
+func (adh *AdminHandler) DescribeSchedule(
+	ctx context.Context,
+	request *adminservice.DescribeScheduleRequest,
+) (_ *adminservice.DescribeScheduleResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetScheduleId() == "" {
+		return nil, errScheduleIDNotSet
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+	res, err := adh.historyClient.QueryWorkflow(ctx, &historyservice.QueryWorkflowRequest{
+		NamespaceId: namespaceID.String(),
+		Request: &workflowservice.QueryWorkflowRequest{
+			Namespace: request.GetNamespace(),
+			Execution: &commonpb.WorkflowExecution{WorkflowId: scheduler.WorkflowIDPrefix + request.GetScheduleId()},
+			Query:     &querypb.WorkflowQuery{QueryType: scheduler.QueryNameDescribe},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var describe schedulespb.DescribeResponse
+	if err := payloads.Decode(res.GetResponse().GetQueryResult(), &describe); err != nil {
+		return nil, err
+	}
+	return &adminservice.DescribeScheduleResponse{Schedule: &describe}, nil
+}
+
+// SetScheduleDependencies replaces the upstream schedules of a schedule
+func (adh *AdminHandler) SetScheduleDependencies(
+	ctx context.Context,
+	request *adminservice.SetScheduleDependenciesRequest,
+) (_ *adminservice.SetScheduleDependenciesResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	for _, dep := range request.GetDependencies() {
+		if dep.GetScheduleId() == "" || dep.GetScheduleId() == request.GetScheduleId() {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Invalid schedule dependency: %q.", dep.GetScheduleId()))
+		}
+	}
+	if err := adh.signalSchedule(
+		ctx,
+		request.GetNamespace(),
+		request.GetScheduleId(),
+		scheduler.SignalNameSetDependencies,
+		&schedulespb.SetDependenciesRequest{
+			Dependencies:  request.GetDependencies(),
+			ConflictToken: request.GetConflictToken(),
+		},
+		request.GetIdentity(),
+		request.GetRequestId(),
+	); err != nil {
+		return nil, err
+	}
+	return &adminservice.SetScheduleDependenciesResponse{}, nil
+}
+
+// SetScheduleSharedCalendars replaces the shared calendars excluded by a schedule
+func (adh *AdminHandler) SetScheduleSharedCalendars(
+	ctx context.Context,
+	request *adminservice.SetScheduleSharedCalendarsRequest,
+) (_ *adminservice.SetScheduleSharedCalendarsResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	for _, name := range request.GetNames() {
+		if err := scheduler.ValidateSharedCalendarName(name); err != nil {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(errInvalidScheduleCalendarMessage, err))
+		}
+	}
+	if err := adh.signalSchedule(
+		ctx,
+		request.GetNamespace(),
+		request.GetScheduleId(),
+		scheduler.SignalNameSetSharedCalendars,
+		&schedulespb.SetSharedExcludeCalendarsRequest{
+			Names:         request.GetNames(),
+			ConflictToken: request.GetConflictToken(),
+		},
+		request.GetIdentity(),
+		request.GetRequestId(),
+	); err != nil {
+		return nil, err
+	}
+	return &adminservice.SetScheduleSharedCalendarsResponse{}, nil
+}
+
+func (adh *AdminHandler) SetScheduleSla(
+	ctx context.Context,
+	request *adminservice.SetScheduleSlaRequest,
+) (_ *adminservice.SetScheduleSlaResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := scheduler.ValidateSla(request.GetSla()); err != nil {
+		return nil, serviceerror.NewInvalidArgument(err.Error())
+	}
+	if webhookURL := request.GetSla().GetWebhookUrl(); webhookURL != "" {
+		if err := validateCallbackURL(adh.config, namespace.Name(request.GetNamespace()), webhookURL); err != nil {
+			return nil, err
+		}
+	}
+	if err := adh.signalSchedule(
+		ctx,
+		request.GetNamespace(),
+		request.GetScheduleId(),
+		scheduler.SignalNameSetSla,
+		&schedulespb.SetSlaRequest{
+			Sla:           request.GetSla(),
+			ConflictToken: request.GetConflictToken(),
+		},
+		request.GetIdentity(),
+		request.GetRequestId(),
+	); err != nil {
+		return nil, err
+	}
+	return &adminservice.SetScheduleSlaResponse{}, nil
+}
+
+func (adh *AdminHandler) SetScheduleConcurrencyGroup(
+	ctx context.Context,
+	request *adminservice.SetScheduleConcurrencyGroupRequest,
+) (_ *adminservice.SetScheduleConcurrencyGroupResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := scheduler.ValidateConcurrencyGroupName(request.GetGroup()); err != nil {
+		return nil, serviceerror.NewInvalidArgument(err.Error())
+	}
+	if err := adh.signalSchedule(
+		ctx,
+		request.GetNamespace(),
+		request.GetScheduleId(),
+		scheduler.SignalNameSetConcurrencyGroup,
+		&schedulespb.SetConcurrencyGroupRequest{
+			Group:         request.GetGroup(),
+			Priority:      request.GetPriority(),
+			ConflictToken: request.GetConflictToken(),
+		},
+		request.GetIdentity(),
+		request.GetRequestId(),
+	); err != nil {
+		return nil, err
+	}
+	return &adminservice.SetScheduleConcurrencyGroupResponse{}, nil
+}
+
+// signalSchedule sends a signal to the workflow of a schedule.
+func (adh *AdminHandler) signalSchedule(
+	ctx context.Context,
+	nsName string,
+	scheduleID string,
+	signalName string,
+	arg proto.Message,
+	identity string,
+	requestID string,
+) error {
+	if scheduleID == "" {
+		return errScheduleIDNotSet
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(nsName))
+	if err != nil {
+		return err
+	}
+	input, err := sdk.PreferProtoDataConverter.ToPayloads(arg)
+	if err != nil {
+		return err
+	}
+	if requestID == "" {
+		requestID = uuid.New()
+	}
+	_, err = adh.historyClient.SignalWorkflowExecution(ctx, &historyservice.SignalWorkflowExecutionRequest{
+		NamespaceId: namespaceID.String(),
+		SignalRequest: &workflowservice.SignalWorkflowExecutionRequest{
+			Namespace:         nsName,
+			WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: scheduler.WorkflowIDPrefix + scheduleID},
+			SignalName:        signalName,
+			Input:             input,
+			Identity:          identity,
+			RequestId:         requestID,
+		},
+	})
+	return err
+}
+
 func (adh *AdminHandler) ListHistoryTasks(
 	ctx context.Context,
 	request *adminservice.ListHistoryTasksRequest,
@@ -1755,6 +2000,361 @@ func (adh *AdminHandler) ForceUnloadTaskQueuePartition(
 	}, nil
 }
 
+// GetMatchingDLQTasks returns tasks that matching dropped without dispatching them and recorded in the
+// dead-letter queue of the given namespace.
+func (adh *AdminHandler) GetMatchingDLQTasks(
+	ctx context.Context,
+	request *adminservice.GetMatchingDLQTasksRequest,
+) (_ *adminservice.GetMatchingDLQTasksResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.Namespace) == 0 {
+		return nil, errNamespaceNotSet
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := adh.matchingClient.GetDeadLetteredTasks(ctx, &matchingservice.GetDeadLetteredTasksRequest{
+		NamespaceId:   namespaceID.String(),
+		PageSize:      request.GetPageSize(),
+		NextPageToken: request.GetNextPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.GetMatchingDLQTasksResponse{
+		Tasks:         resp.GetTasks(),
+		NextPageToken: resp.GetNextPageToken(),
+	}, nil
+}
+
+// ReenqueueMatchingDLQTasks adds tasks from the matching dead-letter queue of the given namespace back to
+// their task queues and removes them from the dead-letter queue.
+func (adh *AdminHandler) ReenqueueMatchingDLQTasks(
+	ctx context.Context,
+	request *adminservice.ReenqueueMatchingDLQTasksRequest,
+) (_ *adminservice.ReenqueueMatchingDLQTasksResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.Namespace) == 0 {
+		return nil, errNamespaceNotSet
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := adh.matchingClient.ReenqueueDeadLetteredTasks(ctx, &matchingservice.ReenqueueDeadLetteredTasksRequest{
+		NamespaceId:           namespaceID.String(),
+		InclusiveMaxMessageId: request.GetInclusiveMaxMessageId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.ReenqueueMatchingDLQTasksResponse{
+		ReenqueuedCount: resp.GetReenqueuedCount(),
+	}, nil
+}
+
+// PauseWorkflowExecution stops all task dispatch for a running workflow until it is unpaused.
+func (adh *AdminHandler) PauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.PauseWorkflowExecutionRequest,
+) (_ *adminservice.PauseWorkflowExecutionResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = adh.historyClient.PauseWorkflowExecution(ctx, &historyservice.PauseWorkflowExecutionRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   request.Execution,
+		Identity:    request.GetIdentity(),
+		Reason:      request.GetReason(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.PauseWorkflowExecutionResponse{}, nil
+}
+
+// UnpauseWorkflowExecution resumes a paused workflow and regenerates the tasks dropped while it was paused.
+func (adh *AdminHandler) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UnpauseWorkflowExecutionRequest,
+) (_ *adminservice.UnpauseWorkflowExecutionResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = adh.historyClient.UnpauseWorkflowExecution(ctx, &historyservice.UnpauseWorkflowExecutionRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   request.Execution,
+		Identity:    request.GetIdentity(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.UnpauseWorkflowExecutionResponse{}, nil
+}
+
+// DescribeWorkflowCallbacks returns the completion callbacks of a workflow execution with their recent attempts.
+func (adh *AdminHandler) DescribeWorkflowCallbacks(
+	ctx context.Context,
+	request *adminservice.DescribeWorkflowCallbacksRequest,
+) (_ *adminservice.DescribeWorkflowCallbacksResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := adh.historyClient.DescribeWorkflowCallbacks(ctx, &historyservice.DescribeWorkflowCallbacksRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   request.Execution,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.DescribeWorkflowCallbacksResponse{Callbacks: resp.GetCallbacks()}, nil
+}
+
+// UpdateWorkflowCallback retries, skips or retargets a single completion callback of a workflow execution.
+func (adh *AdminHandler) UpdateWorkflowCallback(
+	ctx context.Context,
+	request *adminservice.UpdateWorkflowCallbackRequest,
+) (_ *adminservice.UpdateWorkflowCallbackResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+	if request.GetCallbackId() == "" {
+		return nil, serviceerror.NewInvalidArgument("CallbackId is not set on request.")
+	}
+
+	namespaceName := namespace.Name(request.GetNamespace())
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	historyRequest := &historyservice.UpdateWorkflowCallbackRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   request.Execution,
+		CallbackId:  request.GetCallbackId(),
+		Identity:    request.GetIdentity(),
+	}
+	switch action := request.GetAction().(type) {
+	case *adminservice.UpdateWorkflowCallbackRequest_Retry:
+		if !action.Retry {
+			return nil, serviceerror.NewInvalidArgument("Retry must be true when set.")
+		}
+		historyRequest.Action = &historyservice.UpdateWorkflowCallbackRequest_Retry{Retry: true}
+	case *adminservice.UpdateWorkflowCallbackRequest_SkipReason:
+		historyRequest.Action = &historyservice.UpdateWorkflowCallbackRequest_SkipReason{SkipReason: action.SkipReason}
+	case *adminservice.UpdateWorkflowCallbackRequest_Url:
+		if err := validateCallbackURL(adh.config, namespaceName, action.Url); err != nil {
+			return nil, err
+		}
+		historyRequest.Action = &historyservice.UpdateWorkflowCallbackRequest_Url{Url: action.Url}
+	default:
+		return nil, serviceerror.NewInvalidArgument("Action is not set on request.")
+	}
+
+	resp, err := adh.historyClient.UpdateWorkflowCallback(ctx, historyRequest)
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.UpdateWorkflowCallbackResponse{Callback: resp.GetCallback()}, nil
+}
+
+// GetNexusEndpointPolicy returns a Nexus endpoint registry entry including its policy.
+func (adh *AdminHandler) GetNexusEndpointPolicy(
+	ctx context.Context,
+	request *adminservice.GetNexusEndpointPolicyRequest,
+) (_ *adminservice.GetNexusEndpointPolicyResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if !adh.config.EnableNexusAPIs() {
+		return nil, status.Error(codes.NotFound, "Nexus APIs are disabled")
+	}
+	return adh.nexusEndpointClient.GetPolicy(ctx, request)
+}
+
+// UpdateNexusEndpointPolicy replaces the policy of a Nexus endpoint.
+func (adh *AdminHandler) UpdateNexusEndpointPolicy(
+	ctx context.Context,
+	request *adminservice.UpdateNexusEndpointPolicyRequest,
+) (_ *adminservice.UpdateNexusEndpointPolicyResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if !adh.config.EnableNexusAPIs() {
+		return nil, status.Error(codes.NotFound, "Nexus APIs are disabled")
+	}
+	return adh.nexusEndpointClient.UpdatePolicy(ctx, request)
+}
+
+// ResetWorkflowExecution resets a workflow and reapplies only the signals and updates chosen by the caller.
+func (adh *AdminHandler) ResetWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ResetWorkflowExecutionRequest,
+) (_ *adminservice.ResetWorkflowExecutionResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil || request.GetResetRequest() == nil {
+		return nil, errRequestNotSet
+	}
+	resetRequest := request.GetResetRequest()
+	if err := validateResetWorkflowExecutionRequest(resetRequest, adh.config.MaxIDLengthLimit()); err != nil {
+		return nil, err
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(resetRequest.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	// Replacement signal inputs end up in the history of the new run, they are subject to the same limit as the input
+	// of a signal.
+	sizeLimitError := adh.config.BlobSizeLimitError(resetRequest.GetNamespace())
+	sizeLimitWarn := adh.config.BlobSizeLimitWarn(resetRequest.GetNamespace())
+	for _, reapplyEvent := range request.GetReapplyEvents() {
+		if err := common.CheckEventBlobSizeLimit(
+			reapplyEvent.GetSignalInput().Size(),
+			sizeLimitWarn,
+			sizeLimitError,
+			namespaceID.String(),
+			resetRequest.GetWorkflowExecution().GetWorkflowId(),
+			resetRequest.GetWorkflowExecution().GetRunId(),
+			interceptor.GetMetricsHandlerFromContext(ctx, adh.logger).WithTags(metrics.CommandTypeTag(enumspb.COMMAND_TYPE_UNSPECIFIED.String())),
+			adh.logger,
+			tag.BlobSizeViolationOperation("ResetWorkflowExecution"),
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := adh.historyClient.ResetWorkflowExecution(ctx, &historyservice.ResetWorkflowExecutionRequest{
+		NamespaceId:   namespaceID.String(),
+		ResetRequest:  resetRequest,
+		ReapplyEvents: request.GetReapplyEvents(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.ResetWorkflowExecutionResponse{RunId: resp.GetRunId()}, nil
+}
+
+// DiffWorkflowExecutionHistories compares the histories of two executions, or of two branches of one execution.
+func (adh *AdminHandler) DiffWorkflowExecutionHistories(
+	ctx context.Context,
+	request *adminservice.DiffWorkflowExecutionHistoriesRequest,
+) (_ *adminservice.DiffWorkflowExecutionHistoriesResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+	if err := validateExecution(request.OtherExecution); err != nil {
+		return nil, err
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	versionHistory, runID, err := adh.getVersionHistoryForDiff(ctx, namespaceID, request.Execution, request.GetBranchToken())
+	if err != nil {
+		return nil, err
+	}
+	execution := &commonpb.WorkflowExecution{WorkflowId: request.Execution.GetWorkflowId(), RunId: runID}
+	otherVersionHistory, otherRunID, err := adh.getVersionHistoryForDiff(ctx, namespaceID, request.OtherExecution, request.GetOtherBranchToken())
+	if err != nil {
+		return nil, err
+	}
+	otherExecution := &commonpb.WorkflowExecution{WorkflowId: request.OtherExecution.GetWorkflowId(), RunId: otherRunID}
+
+	// Branches of the same execution share all events up to their lowest common ancestor.
+	var sharedLastEventID int64
+	if proto.Equal(execution, otherExecution) {
+		lcaItem, err := versionhistory.FindLCAVersionHistoryItem(versionHistory, otherVersionHistory)
+		if err != nil {
+			return nil, err
+		}
+		sharedLastEventID = lcaItem.GetEventId()
+	}
+
+	events, err := adh.readHistoryForDiff(ctx, namespaceID, execution, versionHistory)
+	if err != nil {
+		return nil, err
+	}
+	otherEvents, err := adh.readHistoryForDiff(ctx, namespaceID, otherExecution, otherVersionHistory)
+	if err != nil {
+		return nil, err
+	}
+
+	maximumEventDiffs := int(request.GetMaximumEventDiffs())
+	if maximumEventDiffs <= 0 {
+		maximumEventDiffs = defaultMaximumEventDiffs
+	}
+	return diffHistories(events, otherEvents, sharedLastEventID, maximumEventDiffs), nil
+}
+
 func (adh *AdminHandler) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
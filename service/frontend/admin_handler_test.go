@@ -29,9 +29,11 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"regexp"
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/assert"
@@ -49,11 +51,13 @@ import (
 	"go.temporal.io/server/api/adminservicemock/v1"
 	commonspb "go.temporal.io/server/api/common/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/api/matchingservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	clientmocks "go.temporal.io/server/client"
 	historyclient "go.temporal.io/server/client/history"
@@ -63,6 +67,7 @@ import (
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/visibility/manager"
@@ -77,13 +82,17 @@ import (
 	"go.temporal.io/server/common/testing/mocksdk"
 	"go.temporal.io/server/common/testing/protorequire"
 	"go.temporal.io/server/common/testing/testvars"
+	"go.temporal.io/server/components/callbacks"
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/worker/dlq"
+	"go.temporal.io/server/service/worker/scheduler"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 type (
@@ -107,6 +116,7 @@ type (
 		mockProducer               *persistence.MockNamespaceReplicationQueue
 		mockMatchingClient         *matchingservicemock.MockMatchingServiceClient
 		mockSaMapper               *searchattribute.MockMapper
+		mockNexusEndpointMgr       *persistence.MockNexusEndpointManager
 
 		namespace      namespace.Name
 		namespaceID    namespace.ID
@@ -151,6 +161,7 @@ func (s *adminHandlerSuite) SetupTest() {
 	s.mockVisibilityMgr = s.mockResource.VisibilityManager
 	s.mockProducer = persistence.NewMockNamespaceReplicationQueue(s.controller)
 	s.mockMatchingClient = s.mockResource.MatchingClient
+	s.mockNexusEndpointMgr = persistence.NewMockNexusEndpointManager(s.controller)
 
 	mockSaMapperProvider := searchattribute.NewMockMapperProvider(s.controller)
 	s.mockSaMapper = searchattribute.NewMockMapper(s.controller)
@@ -162,12 +173,17 @@ func (s *adminHandlerSuite) SetupTest() {
 
 	cfg := &Config{
 		NumHistoryShards: 4,
+		MaxIDLengthLimit: dynamicconfig.GetIntPropertyFn(1000),
 
 		SearchAttributesNumberOfKeysLimit:     dynamicconfig.GetIntPropertyFnFilteredByNamespace(10),
 		SearchAttributesSizeOfValueLimit:      dynamicconfig.GetIntPropertyFnFilteredByNamespace(10),
 		SearchAttributesTotalSizeLimit:        dynamicconfig.GetIntPropertyFnFilteredByNamespace(10),
 		VisibilityAllowList:                   dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
 		SuppressErrorSetSystemSearchAttribute: dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
+		CallbackURLMaxLength:                  dynamicconfig.GetIntPropertyFnFilteredByNamespace(1000),
+		CallbackEndpointConfigs:               func(string) []callbacks.AddressMatchRule { return nil },
+		BlobSizeLimitError:                    dynamicconfig.GetIntPropertyFnFilteredByNamespace(1024),
+		BlobSizeLimitWarn:                     dynamicconfig.GetIntPropertyFnFilteredByNamespace(512),
 	}
 	args := NewAdminHandlerArgs{
 		persistenceConfig,
@@ -198,6 +214,13 @@ func (s *adminHandlerSuite) SetupTest() {
 		clock.NewRealTimeSource(),
 		tasks.NewDefaultTaskCategoryRegistry(),
 		s.mockResource.GetMatchingClient(),
+		newNexusEndpointClient(
+			newNexusEndpointClientConfig(dynamicconfig.NewNoopCollection()),
+			s.mockNamespaceCache,
+			s.mockMatchingClient,
+			s.mockNexusEndpointMgr,
+			s.mockResource.GetLogger(),
+		),
 	}
 	s.mockMetadata.EXPECT().GetCurrentClusterName().Return(uuid.New()).AnyTimes()
 	s.mockExecutionMgr.EXPECT().GetName().Return("mock-execution-manager").AnyTimes()
@@ -1745,6 +1768,486 @@ func (s *adminHandlerSuite) TestForceUnloadTaskQueuePartition() {
 	s.True(resp.WasLoaded)
 }
 
+func (s *adminHandlerSuite) TestGetMatchingDLQTasks() {
+	ctx := context.Background()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+
+	_, err := s.handler.GetMatchingDLQTasks(ctx, &adminservice.GetMatchingDLQTasksRequest{})
+	s.Equal(errNamespaceNotSet, err)
+
+	tasks := []*commonspb.MatchingDLQTask{{MessageId: 3}}
+	s.mockMatchingClient.EXPECT().GetDeadLetteredTasks(ctx, &matchingservice.GetDeadLetteredTasksRequest{
+		NamespaceId:   s.namespaceID.String(),
+		PageSize:      10,
+		NextPageToken: []byte("token"),
+	}).Return(&matchingservice.GetDeadLetteredTasksResponse{Tasks: tasks}, nil)
+
+	resp, err := s.handler.GetMatchingDLQTasks(ctx, &adminservice.GetMatchingDLQTasksRequest{
+		Namespace:     s.namespace.String(),
+		PageSize:      10,
+		NextPageToken: []byte("token"),
+	})
+	s.NoError(err)
+	s.Equal(tasks, resp.GetTasks())
+}
+
+func (s *adminHandlerSuite) TestReenqueueMatchingDLQTasks() {
+	ctx := context.Background()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+
+	_, err := s.handler.ReenqueueMatchingDLQTasks(ctx, nil)
+	s.Equal(errRequestNotSet, err)
+
+	s.mockMatchingClient.EXPECT().ReenqueueDeadLetteredTasks(ctx, &matchingservice.ReenqueueDeadLetteredTasksRequest{
+		NamespaceId:           s.namespaceID.String(),
+		InclusiveMaxMessageId: 7,
+	}).Return(&matchingservice.ReenqueueDeadLetteredTasksResponse{ReenqueuedCount: 4}, nil)
+
+	resp, err := s.handler.ReenqueueMatchingDLQTasks(ctx, &adminservice.ReenqueueMatchingDLQTasksRequest{
+		Namespace:             s.namespace.String(),
+		InclusiveMaxMessageId: 7,
+	})
+	s.NoError(err)
+	s.Equal(int64(4), resp.GetReenqueuedCount())
+}
+
+func (s *adminHandlerSuite) TestPauseWorkflowExecution() {
+	ctx := context.Background()
+	execution := &commonpb.WorkflowExecution{WorkflowId: "workflow-id", RunId: uuid.New()}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+
+	_, err := s.handler.PauseWorkflowExecution(ctx, nil)
+	s.Equal(errRequestNotSet, err)
+
+	s.mockHistoryClient.EXPECT().PauseWorkflowExecution(ctx, &historyservice.PauseWorkflowExecutionRequest{
+		NamespaceId: s.namespaceID.String(),
+		Execution:   execution,
+		Identity:    "operator",
+		Reason:      "investigating",
+	}).Return(&historyservice.PauseWorkflowExecutionResponse{}, nil)
+
+	_, err = s.handler.PauseWorkflowExecution(ctx, &adminservice.PauseWorkflowExecutionRequest{
+		Namespace: s.namespace.String(),
+		Execution: execution,
+		Identity:  "operator",
+		Reason:    "investigating",
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestUnpauseWorkflowExecution() {
+	ctx := context.Background()
+	execution := &commonpb.WorkflowExecution{WorkflowId: "workflow-id", RunId: uuid.New()}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+
+	_, err := s.handler.UnpauseWorkflowExecution(ctx, &adminservice.UnpauseWorkflowExecutionRequest{
+		Namespace: s.namespace.String(),
+	})
+	s.Error(err)
+
+	s.mockHistoryClient.EXPECT().UnpauseWorkflowExecution(ctx, &historyservice.UnpauseWorkflowExecutionRequest{
+		NamespaceId: s.namespaceID.String(),
+		Execution:   execution,
+		Identity:    "operator",
+	}).Return(&historyservice.UnpauseWorkflowExecutionResponse{}, nil)
+
+	_, err = s.handler.UnpauseWorkflowExecution(ctx, &adminservice.UnpauseWorkflowExecutionRequest{
+		Namespace: s.namespace.String(),
+		Execution: execution,
+		Identity:  "operator",
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestDescribeWorkflowCallbacks() {
+	ctx := context.Background()
+	execution := &commonpb.WorkflowExecution{WorkflowId: "workflow-id", RunId: uuid.New()}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+
+	callbackInfos := map[string]*persistencespb.CallbackInfo{
+		"cb-id": {
+			State:   enumsspb.CALLBACK_STATE_BACKING_OFF,
+			Attempt: 1,
+			RecentAttempts: []*persistencespb.CallbackAttempt{
+				{StatusCode: 503, Latency: durationpb.New(time.Second)},
+			},
+		},
+	}
+	s.mockHistoryClient.EXPECT().DescribeWorkflowCallbacks(ctx, &historyservice.DescribeWorkflowCallbacksRequest{
+		NamespaceId: s.namespaceID.String(),
+		Execution:   execution,
+	}).Return(&historyservice.DescribeWorkflowCallbacksResponse{Callbacks: callbackInfos}, nil)
+
+	resp, err := s.handler.DescribeWorkflowCallbacks(ctx, &adminservice.DescribeWorkflowCallbacksRequest{
+		Namespace: s.namespace.String(),
+		Execution: execution,
+	})
+	s.NoError(err)
+	s.Equal(callbackInfos, resp.GetCallbacks())
+}
+
+func (s *adminHandlerSuite) TestUpdateWorkflowCallback() {
+	ctx := context.Background()
+	execution := &commonpb.WorkflowExecution{WorkflowId: "workflow-id", RunId: uuid.New()}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+	s.handler.config.CallbackURLMaxLength = dynamicconfig.GetIntPropertyFnFilteredByNamespace(1000)
+	s.handler.config.CallbackEndpointConfigs = dynamicconfig.GetTypedPropertyFnFilteredByNamespace(
+		[]callbacks.AddressMatchRule{{Regexp: regexp.MustCompile(`^allowed\.example\.com$`)}},
+	)
+
+	_, err := s.handler.UpdateWorkflowCallback(ctx, &adminservice.UpdateWorkflowCallbackRequest{
+		Namespace: s.namespace.String(),
+		Execution: execution,
+		Action:    &adminservice.UpdateWorkflowCallbackRequest_Retry{Retry: true},
+	})
+	s.Error(err)
+
+	_, err = s.handler.UpdateWorkflowCallback(ctx, &adminservice.UpdateWorkflowCallbackRequest{
+		Namespace:  s.namespace.String(),
+		Execution:  execution,
+		CallbackId: "cb-id",
+	})
+	s.Error(err)
+
+	_, err = s.handler.UpdateWorkflowCallback(ctx, &adminservice.UpdateWorkflowCallbackRequest{
+		Namespace:  s.namespace.String(),
+		Execution:  execution,
+		CallbackId: "cb-id",
+		Action:     &adminservice.UpdateWorkflowCallbackRequest_Url{Url: "https://other.example.com/hook"},
+	})
+	s.Error(err)
+
+	s.mockHistoryClient.EXPECT().UpdateWorkflowCallback(ctx, &historyservice.UpdateWorkflowCallbackRequest{
+		NamespaceId: s.namespaceID.String(),
+		Execution:   execution,
+		CallbackId:  "cb-id",
+		Identity:    "operator",
+		Action:      &historyservice.UpdateWorkflowCallbackRequest_Url{Url: "https://allowed.example.com/hook"},
+	}).Return(&historyservice.UpdateWorkflowCallbackResponse{}, nil)
+	_, err = s.handler.UpdateWorkflowCallback(ctx, &adminservice.UpdateWorkflowCallbackRequest{
+		Namespace:  s.namespace.String(),
+		Execution:  execution,
+		CallbackId: "cb-id",
+		Identity:   "operator",
+		Action:     &adminservice.UpdateWorkflowCallbackRequest_Url{Url: "https://allowed.example.com/hook"},
+	})
+	s.NoError(err)
+
+	s.mockHistoryClient.EXPECT().UpdateWorkflowCallback(ctx, &historyservice.UpdateWorkflowCallbackRequest{
+		NamespaceId: s.namespaceID.String(),
+		Execution:   execution,
+		CallbackId:  "cb-id",
+		Action:      &historyservice.UpdateWorkflowCallbackRequest_SkipReason{SkipReason: "endpoint decommissioned"},
+	}).Return(&historyservice.UpdateWorkflowCallbackResponse{}, nil)
+	_, err = s.handler.UpdateWorkflowCallback(ctx, &adminservice.UpdateWorkflowCallbackRequest{
+		Namespace:  s.namespace.String(),
+		Execution:  execution,
+		CallbackId: "cb-id",
+		Action:     &adminservice.UpdateWorkflowCallbackRequest_SkipReason{SkipReason: "endpoint decommissioned"},
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestUpdateNexusEndpointPolicy() {
+	ctx := context.Background()
+	s.handler.config.EnableNexusAPIs = dynamicconfig.GetBoolPropertyFn(true)
+	endpointID := uuid.New()
+	entry := &persistencespb.NexusEndpointEntry{
+		Id:      endpointID,
+		Version: 3,
+		Endpoint: &persistencespb.NexusEndpoint{
+			Spec: &persistencespb.NexusEndpointSpec{
+				Name: "endpoint",
+				Target: &persistencespb.NexusEndpointTarget{
+					Variant: &persistencespb.NexusEndpointTarget_External_{
+						External: &persistencespb.NexusEndpointTarget_External{Url: "https://example.com"},
+					},
+				},
+			},
+		},
+	}
+	policy := &persistencespb.NexusEndpointPolicy{
+		MaxOperationTimeout:             durationpb.New(time.Hour),
+		CallerCloseBehavior:             enumsspb.NEXUS_CALLER_CLOSE_BEHAVIOR_REQUEST_CANCEL,
+		MaxConcurrentOperationsPerCallerWorkflow: 10,
+	}
+
+	_, err := s.handler.UpdateNexusEndpointPolicy(ctx, &adminservice.UpdateNexusEndpointPolicyRequest{
+		Id:      endpointID,
+		Version: 3,
+		Policy:  &persistencespb.NexusEndpointPolicy{MaxOperationTimeout: durationpb.New(-time.Second)},
+	})
+	s.ErrorContains(err, "max operation timeout is negative")
+
+	_, err = s.handler.UpdateNexusEndpointPolicy(ctx, &adminservice.UpdateNexusEndpointPolicyRequest{
+		Id:      endpointID,
+		Version: 3,
+		Policy:  &persistencespb.NexusEndpointPolicy{CallerCloseBehavior: 42},
+	})
+	s.ErrorContains(err, "unknown caller close behavior")
+
+	_, err = s.handler.UpdateNexusEndpointPolicy(ctx, &adminservice.UpdateNexusEndpointPolicyRequest{
+		Id:      endpointID,
+		Version: 3,
+		Policy:  &persistencespb.NexusEndpointPolicy{ResultCacheTtl: durationpb.New(-time.Second)},
+	})
+	s.ErrorContains(err, "result cache TTL is negative")
+
+	_, err = s.handler.UpdateNexusEndpointPolicy(ctx, &adminservice.UpdateNexusEndpointPolicyRequest{
+		Id:      endpointID,
+		Version: 3,
+		Policy:  &persistencespb.NexusEndpointPolicy{ResultCacheKey: 42},
+	})
+	s.ErrorContains(err, "unknown result cache key")
+
+	s.mockNexusEndpointMgr.EXPECT().GetNexusEndpoint(ctx, &persistence.GetNexusEndpointRequest{ID: endpointID}).Return(entry, nil)
+	s.mockMatchingClient.EXPECT().UpdateNexusEndpoint(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *matchingservice.UpdateNexusEndpointRequest, _ ...grpc.CallOption) (*matchingservice.UpdateNexusEndpointResponse, error) {
+			s.Equal(endpointID, request.Id)
+			s.Equal(int64(3), request.Version)
+			s.Equal("endpoint", request.Spec.Name)
+			s.ProtoEqual(entry.Endpoint.Spec.Target, request.Spec.Target)
+			s.ProtoEqual(policy, request.Spec.Policy)
+			return &matchingservice.UpdateNexusEndpointResponse{
+				Entry: &persistencespb.NexusEndpointEntry{Id: endpointID, Version: 4, Endpoint: &persistencespb.NexusEndpoint{Spec: request.Spec}},
+			}, nil
+		})
+	resp, err := s.handler.UpdateNexusEndpointPolicy(ctx, &adminservice.UpdateNexusEndpointPolicyRequest{
+		Id:      endpointID,
+		Version: 3,
+		Policy:  policy,
+	})
+	s.NoError(err)
+	s.Equal(int64(4), resp.Entry.Version)
+	s.Nil(entry.Endpoint.Spec.Policy, "persisted entry must not be mutated")
+
+	// Clearing the policy sends an empty one, since matching keeps the current policy when none is set.
+	s.mockNexusEndpointMgr.EXPECT().GetNexusEndpoint(ctx, &persistence.GetNexusEndpointRequest{ID: endpointID}).Return(entry, nil)
+	s.mockMatchingClient.EXPECT().UpdateNexusEndpoint(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *matchingservice.UpdateNexusEndpointRequest, _ ...grpc.CallOption) (*matchingservice.UpdateNexusEndpointResponse, error) {
+			s.ProtoEqual(&persistencespb.NexusEndpointPolicy{}, request.Spec.Policy)
+			return &matchingservice.UpdateNexusEndpointResponse{Entry: entry}, nil
+		})
+	_, err = s.handler.UpdateNexusEndpointPolicy(ctx, &adminservice.UpdateNexusEndpointPolicyRequest{
+		Id:      endpointID,
+		Version: 3,
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestResetWorkflowExecution() {
+	ctx := context.Background()
+	resetRequest := &workflowservice.ResetWorkflowExecutionRequest{
+		Namespace:                 s.namespace.String(),
+		WorkflowExecution:         &commonpb.WorkflowExecution{WorkflowId: "workflow-id", RunId: uuid.New()},
+		WorkflowTaskFinishEventId: 4,
+		RequestId:                 uuid.New(),
+	}
+	reapplyEvents := []*historyspb.ReapplyEventSelection{{EventId: 7}}
+
+	_, err := s.handler.ResetWorkflowExecution(ctx, &adminservice.ResetWorkflowExecutionRequest{
+		ReapplyEvents: reapplyEvents,
+	})
+	s.Error(err)
+
+	// The reset request is validated like the one of the public API.
+	_, err = s.handler.ResetWorkflowExecution(ctx, &adminservice.ResetWorkflowExecutionRequest{
+		ResetRequest: &workflowservice.ResetWorkflowExecutionRequest{
+			Namespace:         s.namespace.String(),
+			WorkflowExecution: resetRequest.WorkflowExecution,
+			RequestId:         uuid.New(),
+			ResetReapplyType:  enumspb.ResetReapplyType(100),
+		},
+	})
+	s.ErrorAs(err, new(*serviceerror.Internal))
+
+	// Replacement signal inputs are subject to the blob size limit.
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	_, err = s.handler.ResetWorkflowExecution(ctx, &adminservice.ResetWorkflowExecutionRequest{
+		ResetRequest: resetRequest,
+		ReapplyEvents: []*historyspb.ReapplyEventSelection{{
+			EventId:     7,
+			SignalInput: payloads.EncodeBytes(make([]byte, 2048)),
+		}},
+	})
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockHistoryClient.EXPECT().ResetWorkflowExecution(ctx, &historyservice.ResetWorkflowExecutionRequest{
+		NamespaceId:   s.namespaceID.String(),
+		ResetRequest:  resetRequest,
+		ReapplyEvents: reapplyEvents,
+	}).Return(&historyservice.ResetWorkflowExecutionResponse{RunId: "new-run-id"}, nil)
+
+	resp, err := s.handler.ResetWorkflowExecution(ctx, &adminservice.ResetWorkflowExecutionRequest{
+		ResetRequest:  resetRequest,
+		ReapplyEvents: reapplyEvents,
+	})
+	s.NoError(err)
+	s.Equal("new-run-id", resp.GetRunId())
+	s.Equal(enumspb.RESET_REAPPLY_TYPE_ALL_ELIGIBLE, resetRequest.GetResetReapplyType())
+}
+
+func (s *adminHandlerSuite) TestDescribeMutableStateCache() {
+	ctx := context.Background()
+
+	_, err := s.handler.DescribeMutableStateCache(ctx, &adminservice.DescribeMutableStateCacheRequest{})
+	s.ErrorIs(err, errInvalidShardID)
+
+	entries := []*historyspb.CachedMutableStateInfo{
+		{NamespaceId: s.namespaceID.String(), WorkflowId: "workflow-id", RunId: uuid.New(), CacheSize: 2048},
+	}
+	s.mockHistoryClient.EXPECT().DescribeMutableStateCache(ctx, &historyservice.DescribeMutableStateCacheRequest{
+		ShardId:        3,
+		MaximumEntries: 10,
+	}).Return(&historyservice.DescribeMutableStateCacheResponse{
+		Entries:         entries,
+		ShardEntryCount: 1,
+		ShardSize:       2048,
+		CacheSize:       4096,
+	}, nil)
+
+	resp, err := s.handler.DescribeMutableStateCache(ctx, &adminservice.DescribeMutableStateCacheRequest{
+		ShardId:        3,
+		MaximumEntries: 10,
+	})
+	s.NoError(err)
+	s.Equal(entries, resp.GetEntries())
+	s.Equal(int64(1), resp.GetShardEntryCount())
+	s.Equal(int64(2048), resp.GetShardSize())
+	s.Equal(int64(4096), resp.GetCacheSize())
+}
+
+func (s *adminHandlerSuite) TestSetScheduleDependencies() {
+	ctx := context.Background()
+	deps := []*schedulespb.ScheduleDependency{{ScheduleId: "upstream"}}
+
+	_, err := s.handler.SetScheduleDependencies(ctx, &adminservice.SetScheduleDependenciesRequest{
+		Namespace:    s.namespace.String(),
+		ScheduleId:   "downstream",
+		Dependencies: []*schedulespb.ScheduleDependency{{ScheduleId: "downstream"}},
+	})
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockHistoryClient.EXPECT().SignalWorkflowExecution(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.SignalWorkflowExecutionRequest, _ ...grpc.CallOption) (*historyservice.SignalWorkflowExecutionResponse, error) {
+			s.Equal(s.namespaceID.String(), request.GetNamespaceId())
+			s.Equal(scheduler.WorkflowIDPrefix+"downstream", request.GetSignalRequest().GetWorkflowExecution().GetWorkflowId())
+			s.Equal(scheduler.SignalNameSetDependencies, request.GetSignalRequest().GetSignalName())
+			var signal schedulespb.SetDependenciesRequest
+			s.NoError(payloads.Decode(request.GetSignalRequest().GetInput(), &signal))
+			protorequire.ProtoSliceEqual(s.T(), deps, signal.GetDependencies())
+			s.Equal(int64(7), signal.GetConflictToken())
+			s.NotEmpty(request.GetSignalRequest().GetRequestId())
+			return &historyservice.SignalWorkflowExecutionResponse{}, nil
+		})
+	_, err = s.handler.SetScheduleDependencies(ctx, &adminservice.SetScheduleDependenciesRequest{
+		Namespace:     s.namespace.String(),
+		ScheduleId:    "downstream",
+		Dependencies:  deps,
+		ConflictToken: 7,
+		Identity:      "operator",
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestSetScheduleSharedCalendars() {
+	ctx := context.Background()
+
+	_, err := s.handler.SetScheduleSharedCalendars(ctx, &adminservice.SetScheduleSharedCalendarsRequest{
+		Namespace:  s.namespace.String(),
+		ScheduleId: "myschedule",
+		Names:      []string{"two words"},
+	})
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockHistoryClient.EXPECT().SignalWorkflowExecution(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.SignalWorkflowExecutionRequest, _ ...grpc.CallOption) (*historyservice.SignalWorkflowExecutionResponse, error) {
+			s.Equal(scheduler.WorkflowIDPrefix+"myschedule", request.GetSignalRequest().GetWorkflowExecution().GetWorkflowId())
+			s.Equal(scheduler.SignalNameSetSharedCalendars, request.GetSignalRequest().GetSignalName())
+			var signal schedulespb.SetSharedExcludeCalendarsRequest
+			s.NoError(payloads.Decode(request.GetSignalRequest().GetInput(), &signal))
+			s.Equal([]string{"holidays"}, signal.GetNames())
+			return &historyservice.SignalWorkflowExecutionResponse{}, nil
+		})
+	_, err = s.handler.SetScheduleSharedCalendars(ctx, &adminservice.SetScheduleSharedCalendarsRequest{
+		Namespace:  s.namespace.String(),
+		ScheduleId: "myschedule",
+		Names:      []string{"holidays"},
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestSetScheduleSla() {
+	ctx := context.Background()
+
+	_, err := s.handler.SetScheduleSla(ctx, &adminservice.SetScheduleSlaRequest{
+		Namespace:  s.namespace.String(),
+		ScheduleId: "myschedule",
+		Sla:        &schedulespb.ScheduleSla{NexusEndpoint: "sla-endpoint"},
+	})
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+
+	// no callback addresses are allowed by default
+	_, err = s.handler.SetScheduleSla(ctx, &adminservice.SetScheduleSlaRequest{
+		Namespace:  s.namespace.String(),
+		ScheduleId: "myschedule",
+		Sla:        &schedulespb.ScheduleSla{WebhookUrl: "https://example.com/sla"},
+	})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockHistoryClient.EXPECT().SignalWorkflowExecution(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.SignalWorkflowExecutionRequest, _ ...grpc.CallOption) (*historyservice.SignalWorkflowExecutionResponse, error) {
+			s.Equal(scheduler.WorkflowIDPrefix+"myschedule", request.GetSignalRequest().GetWorkflowExecution().GetWorkflowId())
+			s.Equal(scheduler.SignalNameSetSla, request.GetSignalRequest().GetSignalName())
+			var signal schedulespb.SetSlaRequest
+			s.NoError(payloads.Decode(request.GetSignalRequest().GetInput(), &signal))
+			s.Equal(int32(3), signal.GetSla().GetMaxConsecutiveFailures())
+			s.Equal(int64(7), signal.GetConflictToken())
+			return &historyservice.SignalWorkflowExecutionResponse{}, nil
+		})
+	_, err = s.handler.SetScheduleSla(ctx, &adminservice.SetScheduleSlaRequest{
+		Namespace:     s.namespace.String(),
+		ScheduleId:    "myschedule",
+		Sla:           &schedulespb.ScheduleSla{MaxConsecutiveFailures: 3},
+		ConflictToken: 7,
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestSetScheduleConcurrencyGroup() {
+	ctx := context.Background()
+
+	_, err := s.handler.SetScheduleConcurrencyGroup(ctx, &adminservice.SetScheduleConcurrencyGroupRequest{
+		Namespace:  s.namespace.String(),
+		ScheduleId: "myschedule",
+		Group:      "two words",
+	})
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockHistoryClient.EXPECT().SignalWorkflowExecution(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.SignalWorkflowExecutionRequest, _ ...grpc.CallOption) (*historyservice.SignalWorkflowExecutionResponse, error) {
+			s.Equal(scheduler.WorkflowIDPrefix+"myschedule", request.GetSignalRequest().GetWorkflowExecution().GetWorkflowId())
+			s.Equal(scheduler.SignalNameSetConcurrencyGroup, request.GetSignalRequest().GetSignalName())
+			var signal schedulespb.SetConcurrencyGroupRequest
+			s.NoError(payloads.Decode(request.GetSignalRequest().GetInput(), &signal))
+			s.Equal("nightly", signal.GetGroup())
+			s.Equal(int32(5), signal.GetPriority())
+			return &historyservice.SignalWorkflowExecutionResponse{}, nil
+		})
+	_, err = s.handler.SetScheduleConcurrencyGroup(ctx, &adminservice.SetScheduleConcurrencyGroupRequest{
+		Namespace:  s.namespace.String(),
+		ScheduleId: "myschedule",
+		Group:      "nightly",
+		Priority:   5,
+	})
+	s.NoError(err)
+}
+
 func (s *adminHandlerSuite) TestDescribeTaskQueuePartition() {
 	handler := s.handler
 	ctx := context.Background()
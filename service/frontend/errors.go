@@ -52,6 +52,7 @@ var (
 	errClusterNameNotSet                                  = serviceerror.NewInvalidArgument("Cluster name is not set.")
 	errEmptyReplicationInfo                               = serviceerror.NewInvalidArgument("Replication task info is not set.")
 	errTaskRangeNotSet                                    = serviceerror.NewInvalidArgument("Task range is not set")
+	errInvalidShardID                                     = serviceerror.NewInvalidArgument("ShardId is not valid.")
 	errHistoryNotFound                                    = serviceerror.NewInvalidArgument("Requested workflow history not found, may have passed retention period.")
 	errNamespaceTooLong                                   = serviceerror.NewInvalidArgument("Namespace length exceeds limit.")
 	errWorkflowTypeTooLong                                = serviceerror.NewInvalidArgument("WorkflowType length exceeds limit.")
@@ -61,6 +62,7 @@ var (
 	errRequestIDTooLong                                   = serviceerror.NewInvalidArgument("RequestId length exceeds limit.")
 	errIdentityTooLong                                    = serviceerror.NewInvalidArgument("Identity length exceeds limit.")
 	errNotesTooLong                                       = serviceerror.NewInvalidArgument("Schedule notes exceeds limit.")
+	errScheduleIDNotSet                                   = serviceerror.NewInvalidArgument("ScheduleId is not set on request.")
 	errEarliestTimeIsGreaterThanLatestTime                = serviceerror.NewInvalidArgument("EarliestTime in StartTimeFilter should not be larger than LatestTime.")
 	errClusterIsNotConfiguredForVisibilityArchival        = serviceerror.NewInvalidArgument("Cluster is not configured for visibility archival.")
 	errClusterIsNotConfiguredForReadingArchivalVisibility = serviceerror.NewInvalidArgument("Cluster is not configured for reading archived visibility records.")
@@ -115,6 +117,7 @@ var (
 	errSearchAttributeIsReservedMessage               = "Search attribute %s is reserved by system."
 	errSearchAttributeAlreadyExistsMessage            = "Search attribute %s already exists."
 	errSearchAttributeDoesntExistMessage              = "Search attribute %s doesn't exist."
+	errInvalidScheduleCalendarMessage                 = "Invalid schedule calendar: %v."
 	errUnknownSearchAttributeTypeMessage              = "Unknown search attribute type: %v."
 	errUnableToGetSearchAttributesMessage             = "Unable to get search attributes: %v."
 	errUnableToRemoveNonCustomSearchAttributesMessage = "Unable to remove non-custom search attributes: %v."
@@ -635,6 +635,7 @@ func AdminHandlerProvider(
 	timeSource clock.TimeSource,
 	taskCategoryRegistry tasks.TaskCategoryRegistry,
 	matchingClient resource.MatchingClient,
+	nexusEndpointClient *NexusEndpointClient,
 ) *AdminHandler {
 	args := NewAdminHandlerArgs{
 		persistenceConfig,
@@ -665,6 +666,7 @@ func AdminHandlerProvider(
 		timeSource,
 		taskCategoryRegistry,
 		matchingClient,
+		nexusEndpointClient,
 	}
 	return NewAdminHandler(args)
 }
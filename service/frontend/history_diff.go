@@ -0,0 +1,324 @@
+// The MIT License
+//
+// Copyright (c) 2025 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"strings"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/adminservice/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	defaultMaximumEventDiffs = 100
+	historyDiffPageSize      = 1000
+)
+
+var (
+	// historyDiffIgnoredFields are event attributes that are expected to differ between two runs of the same workflow
+	// code, so they are not reported as differences.
+	historyDiffIgnoredFields = map[protoreflect.Name]struct{}{
+		"identity":                   {},
+		"request_id":                 {},
+		"binary_checksum":            {},
+		"run_id":                     {},
+		"original_execution_run_id":  {},
+		"first_execution_run_id":     {},
+		"new_execution_run_id":       {},
+		"continued_execution_run_id": {},
+		"prev_auto_reset_points":     {},
+		"worker_version":             {},
+		"sdk_metadata":               {},
+		"history_size_bytes":         {},
+		"suggest_continue_as_new":    {},
+	}
+
+	payloadMessageNames = map[protoreflect.FullName]struct{}{
+		"temporal.api.common.v1.Payload":  {},
+		"temporal.api.common.v1.Payloads": {},
+	}
+
+	// commandEventTypes are the event types recorded for workflow task commands and protocol messages.
+	commandEventTypes = map[enumspb.EventType]struct{}{
+		enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED:                              {},
+		enumspb.EVENT_TYPE_ACTIVITY_TASK_CANCEL_REQUESTED:                       {},
+		enumspb.EVENT_TYPE_TIMER_STARTED:                                        {},
+		enumspb.EVENT_TYPE_TIMER_CANCELED:                                       {},
+		enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED:                         {},
+		enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_FAILED:                            {},
+		enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CANCELED:                          {},
+		enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW:                  {},
+		enumspb.EVENT_TYPE_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_INITIATED: {},
+		enumspb.EVENT_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_INITIATED:         {},
+		enumspb.EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_INITIATED:             {},
+		enumspb.EVENT_TYPE_MARKER_RECORDED:                                      {},
+		enumspb.EVENT_TYPE_UPSERT_WORKFLOW_SEARCH_ATTRIBUTES:                    {},
+		enumspb.EVENT_TYPE_WORKFLOW_PROPERTIES_MODIFIED:                         {},
+		enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_ACCEPTED:                   {},
+		enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_REJECTED:                   {},
+		enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_COMPLETED:                  {},
+		enumspb.EVENT_TYPE_NEXUS_OPERATION_SCHEDULED:                            {},
+		enumspb.EVENT_TYPE_NEXUS_OPERATION_CANCEL_REQUESTED:                     {},
+	}
+)
+
+// getVersionHistoryForDiff returns the version history of the branch identified by branchToken, or of the current
+// branch when branchToken is empty, along with the run ID of the execution.
+func (adh *AdminHandler) getVersionHistoryForDiff(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	execution *commonpb.WorkflowExecution,
+	branchToken []byte,
+) (*historyspb.VersionHistory, string, error) {
+	resp, err := adh.historyClient.DescribeMutableState(ctx, &historyservice.DescribeMutableStateRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   execution,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	runID := resp.GetDatabaseMutableState().GetExecutionState().GetRunId()
+	versionHistories := resp.GetDatabaseMutableState().GetExecutionInfo().GetVersionHistories()
+	if len(branchToken) == 0 {
+		versionHistory, err := versionhistory.GetCurrentVersionHistory(versionHistories)
+		return versionHistory, runID, err
+	}
+	for _, versionHistory := range versionHistories.GetHistories() {
+		if string(versionHistory.GetBranchToken()) == string(branchToken) {
+			return versionHistory, runID, nil
+		}
+	}
+	return nil, "", serviceerror.NewInvalidArgument("branch token does not belong to the workflow execution")
+}
+
+// readHistoryForDiff reads all events of the given branch of an execution.
+func (adh *AdminHandler) readHistoryForDiff(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	execution *commonpb.WorkflowExecution,
+	versionHistory *historyspb.VersionHistory,
+) ([]*historypb.HistoryEvent, error) {
+	firstItem, err := versionhistory.GetFirstVersionHistoryItem(versionHistory)
+	if err != nil {
+		return nil, err
+	}
+	lastItem, err := versionhistory.GetLastVersionHistoryItem(versionHistory)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []*historypb.HistoryEvent
+	var nextPageToken []byte
+	for {
+		resp, err := adh.historyClient.GetWorkflowExecutionRawHistory(ctx, &historyservice.GetWorkflowExecutionRawHistoryRequest{
+			NamespaceId: namespaceID.String(),
+			Request: &adminservice.GetWorkflowExecutionRawHistoryRequest{
+				NamespaceId:       namespaceID.String(),
+				Execution:         execution,
+				StartEventId:      common.FirstEventID,
+				StartEventVersion: firstItem.GetVersion(),
+				EndEventId:        lastItem.GetEventId(),
+				EndEventVersion:   lastItem.GetVersion(),
+				MaximumPageSize:   historyDiffPageSize,
+				NextPageToken:     nextPageToken,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, blob := range resp.GetResponse().GetHistoryBatches() {
+			batch, err := adh.eventSerializer.DeserializeEvents(blob)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, batch...)
+		}
+		nextPageToken = resp.GetResponse().GetNextPageToken()
+		if len(nextPageToken) == 0 {
+			return events, nil
+		}
+	}
+}
+
+// diffHistories compares two histories event by event. Events up to and including sharedLastEventID are known to be
+// the same and are skipped.
+func diffHistories(
+	events []*historypb.HistoryEvent,
+	otherEvents []*historypb.HistoryEvent,
+	sharedLastEventID int64,
+	maximumEventDiffs int,
+) *adminservice.DiffWorkflowExecutionHistoriesResponse {
+	resp := &adminservice.DiffWorkflowExecutionHistoriesResponse{
+		SharedLastEventId: sharedLastEventID,
+	}
+	for i := int(sharedLastEventID); i < max(len(events), len(otherEvents)); i++ {
+		diff := diffEvent(eventAt(events, i), eventAt(otherEvents, i))
+		if diff == nil {
+			continue
+		}
+		diff.EventId = int64(i) + common.FirstEventID
+		diff.SincePreviousEvent = sincePreviousEvent(events, i)
+		diff.OtherSincePreviousEvent = sincePreviousEvent(otherEvents, i)
+
+		if resp.DivergenceEventId == 0 {
+			resp.DivergenceEventId = diff.EventId
+			resp.Commands = commandsAround(events, resp.DivergenceEventId)
+			resp.OtherCommands = commandsAround(otherEvents, resp.DivergenceEventId)
+		}
+		if len(resp.EventDiffs) >= maximumEventDiffs {
+			resp.Truncated = true
+			break
+		}
+		resp.EventDiffs = append(resp.EventDiffs, diff)
+	}
+	return resp
+}
+
+func eventAt(events []*historypb.HistoryEvent, i int) *historypb.HistoryEvent {
+	if i < len(events) {
+		return events[i]
+	}
+	return nil
+}
+
+func sincePreviousEvent(events []*historypb.HistoryEvent, i int) *durationpb.Duration {
+	if i <= 0 || i >= len(events) {
+		return nil
+	}
+	return durationpb.New(events[i].GetEventTime().AsTime().Sub(events[i-1].GetEventTime().AsTime()))
+}
+
+// diffEvent returns nil when the two events are the same, ignoring timing and fields listed in
+// historyDiffIgnoredFields.
+func diffEvent(event *historypb.HistoryEvent, otherEvent *historypb.HistoryEvent) *adminservice.HistoryEventDiff {
+	diff := &adminservice.HistoryEventDiff{
+		EventType:      event.GetEventType(),
+		OtherEventType: otherEvent.GetEventType(),
+	}
+	if event == nil || otherEvent == nil || diff.EventType != diff.OtherEventType {
+		return diff
+	}
+
+	attributes := eventAttributes(event)
+	otherAttributes := eventAttributes(otherEvent)
+	if attributes == nil || otherAttributes == nil {
+		return nil
+	}
+	diffMessages("", attributes, otherAttributes, diff)
+	if len(diff.DifferingFields) == 0 && len(diff.DifferingPayloads) == 0 {
+		return nil
+	}
+	return diff
+}
+
+func eventAttributes(event *historypb.HistoryEvent) protoreflect.Message {
+	m := event.ProtoReflect()
+	fd := m.WhichOneof(m.Descriptor().Oneofs().ByName("attributes"))
+	if fd == nil {
+		return nil
+	}
+	return m.Get(fd).Message()
+}
+
+func diffMessages(path string, m protoreflect.Message, other protoreflect.Message, diff *adminservice.HistoryEventDiff) {
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if _, ok := historyDiffIgnoredFields[fd.Name()]; ok {
+			continue
+		}
+		if !m.Has(fd) && !other.Has(fd) {
+			continue
+		}
+		fieldPath := string(fd.Name())
+		if path != "" {
+			fieldPath = path + "." + fieldPath
+		}
+		value, otherValue := m.Get(fd), other.Get(fd)
+		switch {
+		case isPayloadField(fd):
+			if !value.Equal(otherValue) {
+				diff.DifferingPayloads = append(diff.DifferingPayloads, fieldPath)
+			}
+		case fd.Kind() == protoreflect.MessageKind && !fd.IsList() && !fd.IsMap() &&
+			!strings.HasPrefix(string(fd.Message().FullName()), "google.protobuf."):
+			diffMessages(fieldPath, value.Message(), otherValue.Message(), diff)
+		default:
+			if !value.Equal(otherValue) {
+				diff.DifferingFields = append(diff.DifferingFields, fieldPath)
+			}
+		}
+	}
+}
+
+func isPayloadField(fd protoreflect.FieldDescriptor) bool {
+	if fd.IsMap() {
+		fd = fd.MapValue()
+	}
+	if fd.Kind() != protoreflect.MessageKind {
+		return false
+	}
+	_, ok := payloadMessageNames[fd.Message().FullName()]
+	return ok
+}
+
+// commandsAround returns the commands of the last workflow task completed at or before eventID, or of the first one
+// completed after it if there is none.
+func commandsAround(events []*historypb.HistoryEvent, eventID int64) []enumspb.EventType {
+	completedIndex := -1
+	for i, event := range events {
+		if event.GetEventType() != enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED {
+			continue
+		}
+		if completedIndex >= 0 && event.GetEventId() > eventID {
+			break
+		}
+		completedIndex = i
+		if event.GetEventId() >= eventID {
+			break
+		}
+	}
+	if completedIndex < 0 {
+		return nil
+	}
+
+	var commands []enumspb.EventType
+	for _, event := range events[completedIndex+1:] {
+		if _, ok := commandEventTypes[event.GetEventType()]; !ok {
+			break
+		}
+		commands = append(commands, event.GetEventType())
+	}
+	return commands
+}
@@ -0,0 +1,148 @@
+// The MIT License
+//
+// Copyright (c) 2025 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/server/common/payloads"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestDiffHistories(t *testing.T) {
+	start := time.Now()
+	newHistory := func(identity string, activityInput string, secondCommand enumspb.EventType) []*historypb.HistoryEvent {
+		return []*historypb.HistoryEvent{
+			{
+				EventId:   1,
+				EventTime: timestamppb.New(start),
+				EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+			},
+			{
+				EventId:   2,
+				EventTime: timestamppb.New(start.Add(time.Second)),
+				EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED,
+				Attributes: &historypb.HistoryEvent_WorkflowTaskCompletedEventAttributes{
+					WorkflowTaskCompletedEventAttributes: &historypb.WorkflowTaskCompletedEventAttributes{
+						Identity: identity,
+					},
+				},
+			},
+			{
+				EventId:   3,
+				EventTime: timestamppb.New(start.Add(2 * time.Second)),
+				EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED,
+				Attributes: &historypb.HistoryEvent_ActivityTaskScheduledEventAttributes{
+					ActivityTaskScheduledEventAttributes: &historypb.ActivityTaskScheduledEventAttributes{
+						ActivityId:   "1",
+						ActivityType: &commonpb.ActivityType{Name: "activity"},
+						Input:        payloads.EncodeString(activityInput),
+					},
+				},
+			},
+			{
+				EventId:   4,
+				EventTime: timestamppb.New(start.Add(3 * time.Second)),
+				EventType: secondCommand,
+			},
+		}
+	}
+
+	t.Run("same histories", func(t *testing.T) {
+		resp := diffHistories(
+			newHistory("worker-1", "input", enumspb.EVENT_TYPE_TIMER_STARTED),
+			newHistory("worker-2", "input", enumspb.EVENT_TYPE_TIMER_STARTED),
+			0,
+			defaultMaximumEventDiffs,
+		)
+		require.Zero(t, resp.GetDivergenceEventId())
+		require.Empty(t, resp.GetEventDiffs())
+	})
+
+	t.Run("payload and command differences", func(t *testing.T) {
+		resp := diffHistories(
+			newHistory("worker", "input", enumspb.EVENT_TYPE_TIMER_STARTED),
+			newHistory("worker", "other input", enumspb.EVENT_TYPE_MARKER_RECORDED),
+			0,
+			defaultMaximumEventDiffs,
+		)
+		require.Equal(t, int64(3), resp.GetDivergenceEventId())
+		require.Equal(t, []enumspb.EventType{enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED, enumspb.EVENT_TYPE_TIMER_STARTED}, resp.GetCommands())
+		require.Equal(t, []enumspb.EventType{enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED, enumspb.EVENT_TYPE_MARKER_RECORDED}, resp.GetOtherCommands())
+		require.Len(t, resp.GetEventDiffs(), 2)
+
+		payloadDiff := resp.GetEventDiffs()[0]
+		require.Equal(t, int64(3), payloadDiff.GetEventId())
+		require.Equal(t, []string{"input"}, payloadDiff.GetDifferingPayloads())
+		require.Empty(t, payloadDiff.GetDifferingFields())
+		require.Equal(t, time.Second, payloadDiff.GetSincePreviousEvent().AsDuration())
+
+		typeDiff := resp.GetEventDiffs()[1]
+		require.Equal(t, int64(4), typeDiff.GetEventId())
+		require.Equal(t, enumspb.EVENT_TYPE_TIMER_STARTED, typeDiff.GetEventType())
+		require.Equal(t, enumspb.EVENT_TYPE_MARKER_RECORDED, typeDiff.GetOtherEventType())
+	})
+
+	t.Run("shared prefix and truncation", func(t *testing.T) {
+		events := newHistory("worker", "input", enumspb.EVENT_TYPE_TIMER_STARTED)
+		otherEvents := newHistory("worker", "other input", enumspb.EVENT_TYPE_MARKER_RECORDED)
+		otherEvents[0].GetEventTime().Seconds++
+
+		resp := diffHistories(events, otherEvents[:3], 2, 1)
+		require.Equal(t, int64(2), resp.GetSharedLastEventId())
+		require.Equal(t, int64(3), resp.GetDivergenceEventId())
+		require.Len(t, resp.GetEventDiffs(), 1)
+		require.True(t, resp.GetTruncated())
+	})
+}
+
+func TestDiffEvent_NestedFields(t *testing.T) {
+	event := &historypb.HistoryEvent{
+		EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED,
+		Attributes: &historypb.HistoryEvent_ActivityTaskScheduledEventAttributes{
+			ActivityTaskScheduledEventAttributes: &historypb.ActivityTaskScheduledEventAttributes{
+				ActivityType: &commonpb.ActivityType{Name: "activity"},
+				Header: &commonpb.Header{Fields: map[string]*commonpb.Payload{
+					"key": payloads.EncodeString("value").GetPayloads()[0],
+				}},
+			},
+		},
+	}
+	otherEvent := &historypb.HistoryEvent{
+		EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED,
+		Attributes: &historypb.HistoryEvent_ActivityTaskScheduledEventAttributes{
+			ActivityTaskScheduledEventAttributes: &historypb.ActivityTaskScheduledEventAttributes{
+				ActivityType: &commonpb.ActivityType{Name: "other-activity"},
+			},
+		},
+	}
+
+	diff := diffEvent(event, otherEvent)
+	require.Equal(t, []string{"activity_type.name"}, diff.GetDifferingFields())
+	require.Equal(t, []string{"header.fields"}, diff.GetDifferingPayloads())
+}
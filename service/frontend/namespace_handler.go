@@ -29,6 +29,7 @@ package frontend
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/pborman/uuid"
@@ -53,6 +54,7 @@ import (
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/util"
+	"go.temporal.io/server/service/worker/scheduler"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -132,6 +134,9 @@ func (d *namespaceHandler) RegisterNamespace(
 		}
 	}
 
+	if err := validateScheduleCalendarData(registerRequest.Data); err != nil {
+		return nil, err
+	}
 	if err := validateRetentionDuration(
 		registerRequest.WorkflowExecutionRetentionPeriod,
 		registerRequest.IsGlobalNamespace,
@@ -448,6 +453,9 @@ func (d *namespaceHandler) UpdateNamespace(
 			info.Owner = updatedInfo.GetOwnerEmail()
 		}
 		if updatedInfo.Data != nil {
+			if err := validateScheduleCalendarData(updatedInfo.Data); err != nil {
+				return nil, err
+			}
 			configurationChanged = true
 			// only do merging
 			info.Data = d.mergeNamespaceData(info.Data, updatedInfo.Data)
@@ -765,6 +773,11 @@ func (d *namespaceHandler) mergeNamespaceData(
 		old = map[string]string{}
 	}
 	for k, v := range new {
+		// Shared schedule calendars are deleted by setting them to an empty value.
+		if v == "" && strings.HasPrefix(k, scheduler.SharedCalendarDataKeyPrefix) {
+			delete(old, k)
+			continue
+		}
 		old[k] = v
 	}
 	return old
@@ -906,6 +919,29 @@ func validateRetentionDuration(retention *durationpb.Duration, isGlobalNamespace
 	return nil
 }
 
+// validateScheduleCalendarData ensures that the shared schedule calendars in namespace data are valid, and stored
+// under the key of their name. An empty value deletes a calendar.
+func validateScheduleCalendarData(data map[string]string) error {
+	for key, encoded := range data {
+		name, ok := strings.CutPrefix(key, scheduler.SharedCalendarDataKeyPrefix)
+		if !ok || encoded == "" {
+			continue
+		}
+		cal, err := scheduler.DecodeSharedCalendar(encoded)
+		if err != nil {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf(errInvalidScheduleCalendarMessage, err))
+		}
+		if cal.GetName() != name {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf(errInvalidScheduleCalendarMessage,
+				fmt.Sprintf("name %q doesn't match data key %q", cal.GetName(), key)))
+		}
+		if err := scheduler.ValidateSharedCalendar(cal); err != nil {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf(errInvalidScheduleCalendarMessage, err))
+		}
+	}
+	return nil
+}
+
 func validateReplicationStateUpdate(existingNamespace *persistence.GetNamespaceResponse, nsUpdateRequest *workflowservice.UpdateNamespaceRequest) error {
 	if nsUpdateRequest.ReplicationConfig == nil ||
 		nsUpdateRequest.ReplicationConfig.State == enumspb.REPLICATION_STATE_UNSPECIFIED ||
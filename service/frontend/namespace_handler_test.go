@@ -36,9 +36,11 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	namespacepb "go.temporal.io/api/namespace/v1"
 	replicationpb "go.temporal.io/api/replication/v1"
+	schedulepb "go.temporal.io/api/schedule/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/clock"
@@ -49,6 +51,7 @@ import (
 	"go.temporal.io/server/common/namespace/nsreplication"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/testing/protoassert"
+	"go.temporal.io/server/service/worker/scheduler"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -186,6 +189,50 @@ func (s *namespaceHandlerCommonSuite) TestMergeNamespaceData_Nil() {
 	}, out)
 }
 
+func (s *namespaceHandlerCommonSuite) TestMergeNamespaceData_DeleteScheduleCalendar() {
+	out := s.handler.mergeNamespaceData(
+		map[string]string{
+			"k0": "v0",
+			scheduler.SharedCalendarDataKey("holidays"): "{}",
+		},
+		map[string]string{
+			"k0": "",
+			scheduler.SharedCalendarDataKey("holidays"): "",
+		},
+	)
+
+	assert.Equal(s.T(), map[string]string{
+		"k0": "",
+	}, out)
+}
+
+func (s *namespaceHandlerCommonSuite) TestValidateScheduleCalendarData() {
+	encoded, err := scheduler.EncodeSharedCalendar(&schedulespb.SharedCalendar{
+		Name: "holidays",
+		Calendars: []*schedulepb.StructuredCalendarSpec{{
+			Month:      []*schedulepb.Range{{Start: 12, End: 12}},
+			DayOfMonth: []*schedulepb.Range{{Start: 25, End: 25}},
+		}},
+	})
+	s.NoError(err)
+
+	s.NoError(validateScheduleCalendarData(map[string]string{
+		"k0": "not a calendar",
+		scheduler.SharedCalendarDataKey("holidays"): encoded,
+		scheduler.SharedCalendarDataKey("deleted"):  "",
+	}))
+
+	err = validateScheduleCalendarData(map[string]string{
+		scheduler.SharedCalendarDataKey("holidays"): "not a calendar",
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	err = validateScheduleCalendarData(map[string]string{
+		scheduler.SharedCalendarDataKey("other-name"): encoded,
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
 // test merging bad binaries
 func (s *namespaceHandlerCommonSuite) TestMergeBadBinaries_Overriding() {
 	out := s.handler.mergeBadBinaries(
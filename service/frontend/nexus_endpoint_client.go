@@ -33,8 +33,11 @@ import (
 	nexuspb "go.temporal.io/api/nexus/v1"
 	"go.temporal.io/api/operatorservice/v1"
 	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
 	hlc "go.temporal.io/server/common/clock/hybrid_logical_clock"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
@@ -249,6 +252,73 @@ func (c *NexusEndpointClient) List(
 	}, nil
 }
 
+// GetPolicy returns the persisted entry of an endpoint, including the policy that the operator API does not expose.
+func (c *NexusEndpointClient) GetPolicy(
+	ctx context.Context,
+	request *adminservice.GetNexusEndpointPolicyRequest,
+) (*adminservice.GetNexusEndpointPolicyResponse, error) {
+	issues := getEndpointIDIssues(request.GetId())
+	if err := issues.GetError(); err != nil {
+		return nil, err
+	}
+
+	entry, err := c.persistence.GetNexusEndpoint(ctx, &p.GetNexusEndpointRequest{
+		ID: request.Id,
+	})
+	if err != nil {
+		return nil, c.transformServiceError(err, fmt.Sprintf("error looking up Nexus endpoint with ID `%v`", request.Id))
+	}
+
+	return &adminservice.GetNexusEndpointPolicyResponse{
+		Entry: entry,
+	}, nil
+}
+
+// UpdatePolicy replaces the policy of an endpoint and keeps the rest of its spec.
+func (c *NexusEndpointClient) UpdatePolicy(
+	ctx context.Context,
+	request *adminservice.UpdateNexusEndpointPolicyRequest,
+) (*adminservice.UpdateNexusEndpointPolicyResponse, error) {
+	issues := getEndpointIDIssues(request.GetId())
+	if request.GetVersion() <= 0 {
+		issues.Append("endpoint version is non-positive")
+	}
+	appendEndpointPolicyIssues(&issues, request.GetPolicy())
+	if err := issues.GetError(); err != nil {
+		return nil, err
+	}
+
+	entry, err := c.persistence.GetNexusEndpoint(ctx, &p.GetNexusEndpointRequest{
+		ID: request.Id,
+	})
+	if err != nil {
+		return nil, c.transformServiceError(err, fmt.Sprintf("error looking up Nexus endpoint with ID `%v`", request.Id))
+	}
+
+	spec := common.CloneProto(entry.GetEndpoint().GetSpec())
+	if spec == nil {
+		return nil, serviceerror.NewInternal("empty endpoint spec")
+	}
+	spec.Policy = request.GetPolicy()
+	if spec.Policy == nil {
+		// Matching keeps the current policy when the spec has none, clear it explicitly.
+		spec.Policy = &persistencespb.NexusEndpointPolicy{}
+	}
+
+	resp, err := c.matchingClient.UpdateNexusEndpoint(ctx, &matchingservice.UpdateNexusEndpointRequest{
+		Id:      request.Id,
+		Version: request.Version,
+		Spec:    spec,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminservice.UpdateNexusEndpointPolicyResponse{
+		Entry: resp.Entry,
+	}, nil
+}
+
 func (c *NexusEndpointClient) apiSpecToPersistenceSpec(source *nexuspb.EndpointSpec) (*persistencespb.NexusEndpointSpec, error) {
 	target, err := c.apiTargetToPersistenceTarget(source.GetTarget())
 	if err != nil {
@@ -405,6 +475,35 @@ func getEndpointIDIssues(ID string) rpc.RequestIssues {
 	return issues
 }
 
+func appendEndpointPolicyIssues(issues *rpc.RequestIssues, policy *persistencespb.NexusEndpointPolicy) {
+	if policy == nil {
+		return
+	}
+	if timeout := policy.GetMaxOperationTimeout(); timeout != nil {
+		if err := timeout.CheckValid(); err != nil {
+			issues.Appendf("invalid max operation timeout: %v", err)
+		} else if timeout.AsDuration() < 0 {
+			issues.Append("max operation timeout is negative")
+		}
+	}
+	if _, ok := enumsspb.NexusCallerCloseBehavior_name[int32(policy.GetCallerCloseBehavior())]; !ok {
+		issues.Appendf("unknown caller close behavior: %v", policy.GetCallerCloseBehavior())
+	}
+	if policy.GetMaxConcurrentOperationsPerCallerWorkflow() < 0 {
+		issues.Append("max concurrent operations per caller workflow is negative")
+	}
+	if ttl := policy.GetResultCacheTtl(); ttl != nil {
+		if err := ttl.CheckValid(); err != nil {
+			issues.Appendf("invalid result cache TTL: %v", err)
+		} else if ttl.AsDuration() < 0 {
+			issues.Append("result cache TTL is negative")
+		}
+	}
+	if _, ok := enumsspb.NexusResultCacheKey_name[int32(policy.GetResultCacheKey())]; !ok {
+		issues.Appendf("unknown result cache key: %v", policy.GetResultCacheKey())
+	}
+}
+
 func validateDeleteRequest(request *operatorservice.DeleteNexusEndpointRequest) error {
 	issues := getEndpointIDIssues(request.GetId())
 
@@ -42,6 +42,7 @@ import (
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/cluster"
@@ -70,6 +71,7 @@ type nexusContext struct {
 	namespaceName                        string
 	taskQueue                            string
 	endpointName                         string
+	endpointPolicy                       *persistencespb.NexusEndpointPolicy
 	claims                               *authorization.Claims
 	namespaceValidationInterceptor       *interceptor.NamespaceValidatorInterceptor
 	namespaceRateLimitInterceptor        interceptor.NamespaceRateLimitInterceptor
@@ -323,6 +325,7 @@ type nexusHandler struct {
 	headersBlacklist              *dynamicconfig.GlobalCachedTypedValue[*regexp.Regexp]
 	metricTagConfig               *dynamicconfig.GlobalCachedTypedValue[*nexusoperations.NexusMetricTagConfig]
 	httpTraceProvider             commonnexus.HTTPClientTraceProvider
+	resultCache                   *nexusResultCache
 }
 
 // Extracts a nexusContext from the given ctx and returns an operationContext with tagged metrics and logging.
@@ -432,6 +435,23 @@ func (h *nexusHandler) StartOperation(
 		return nil, nexus.HandlerErrorf(nexus.HandlerErrorTypeBadRequest, "input exceeds size limit")
 	}
 
+	cacheKey, cacheable := h.resultCache.key(oc.nexusContext, oc.endpointPolicy, request.GetRequest())
+	if cacheable {
+		cacheMetricsHandler := h.metricsHandler.WithTags(
+			metrics.NamespaceTag(oc.namespaceName),
+			metrics.NexusEndpointTag(oc.endpointName),
+		)
+		if entry, ok := h.resultCache.get(cacheKey); ok {
+			metrics.NexusResultCacheHits.With(cacheMetricsHandler).Record(1)
+			oc.metricsHandler = oc.metricsHandler.WithTags(metrics.OutcomeTag("sync_success_cached"))
+			return &nexus.HandlerStartOperationResultSync[any]{
+				Value: entry.payload,
+				Links: parseLinks(entry.links, oc.logger),
+			}, nil
+		}
+		metrics.NexusResultCacheMisses.With(cacheMetricsHandler).Record(1)
+	}
+
 	// Dispatch the request to be sync matched with a worker polling on the nexusContext taskQueue.
 	// matchingClient sets a context timeout of 60 seconds for this request, this should be enough for any Nexus
 	// RPC.
@@ -457,6 +477,10 @@ func (h *nexusHandler) StartOperation(
 		switch t := t.Response.GetStartOperation().GetVariant().(type) {
 		case *nexuspb.StartOperationResponse_SyncSuccess:
 			oc.metricsHandler = oc.metricsHandler.WithTags(metrics.OutcomeTag("sync_success"))
+			if cacheable {
+				ttl := oc.endpointPolicy.GetResultCacheTtl().AsDuration()
+				h.resultCache.put(cacheKey, t.SyncSuccess.GetPayload(), t.SyncSuccess.GetLinks(), ttl)
+			}
 			return &nexus.HandlerStartOperationResultSync[any]{
 				Value: t.SyncSuccess.GetPayload(),
 				Links: parseLinks(t.SyncSuccess.GetLinks(), oc.logger),
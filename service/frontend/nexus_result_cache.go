@@ -0,0 +1,170 @@
+// The MIT License
+//
+// Copyright (c) 2025 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nexus-rpc/sdk-go/nexus"
+	commonpb "go.temporal.io/api/common/v1"
+	nexuspb "go.temporal.io/api/nexus/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/clock"
+	"google.golang.org/protobuf/proto"
+)
+
+// nexusResultCache holds synchronous StartOperation results for endpoints that opt in by setting a result cache TTL
+// in their policy. Repeated requests for a cached result are answered without dispatching a task to the endpoint's
+// task queue.
+// The cache is bounded by the total size of the cached results, each entry expires according to the TTL of the
+// endpoint it was cached for.
+type nexusResultCache struct {
+	cache      cache.Cache
+	timeSource clock.TimeSource
+}
+
+type nexusResultCacheKey struct {
+	namespaceName string
+	taskQueue     string
+	endpointName  string
+	service       string
+	operation     string
+	// Hex encoded hash of the caller and the request: the caller's identity, the callback URL, which identifies the
+	// caller namespace for requests from Temporal, the forwarded headers, and either the request ID or the input,
+	// depending on the endpoint's policy. Results are never shared between callers or requests with different headers.
+	requestKey string
+}
+
+type nexusResultCacheEntry struct {
+	payload   *commonpb.Payload
+	links     []*nexuspb.Link
+	expiresAt time.Time
+}
+
+func (e *nexusResultCacheEntry) CacheSize() int {
+	size := proto.Size(e.payload)
+	for _, link := range e.links {
+		size += proto.Size(link)
+	}
+	return size
+}
+
+func newNexusResultCache(maxSizeBytes int, timeSource clock.TimeSource) *nexusResultCache {
+	return &nexusResultCache{
+		cache:      cache.New(max(maxSizeBytes, 0), &cache.Options{TimeSource: timeSource}),
+		timeSource: timeSource,
+	}
+}
+
+// key returns the cache key for a StartOperation request, with the headers that are forwarded to the handler, and
+// whether results for the request may be cached according to the given endpoint policy.
+func (c *nexusResultCache) key(
+	nc *nexusContext,
+	policy *persistencespb.NexusEndpointPolicy,
+	request *nexuspb.Request,
+) (nexusResultCacheKey, bool) {
+	if policy.GetResultCacheTtl().AsDuration() <= 0 {
+		return nexusResultCacheKey{}, false
+	}
+	start := request.GetStartOperation()
+	key := nexusResultCacheKey{
+		namespaceName: nc.namespaceName,
+		taskQueue:     nc.taskQueue,
+		endpointName:  nc.endpointName,
+		service:       start.GetService(),
+		operation:     start.GetOperation(),
+	}
+
+	h := sha256.New()
+	writeField := func(s string) {
+		// Length prefixed so that different fields can't produce the same hash input.
+		_, _ = fmt.Fprintf(h, "%d:%s", len(s), s)
+	}
+	if nc.claims != nil {
+		writeField(nc.claims.Subject)
+	} else {
+		writeField("")
+	}
+	writeField(start.GetCallback())
+	headerNames := make([]string, 0, len(request.GetHeader()))
+	for name := range request.GetHeader() {
+		// Timeouts are set by the caller for each attempt.
+		if !strings.EqualFold(name, nexus.HeaderOperationTimeout) && !strings.EqualFold(name, nexus.HeaderRequestTimeout) {
+			headerNames = append(headerNames, name)
+		}
+	}
+	slices.Sort(headerNames)
+	writeField(strconv.Itoa(len(headerNames)))
+	for _, name := range headerNames {
+		writeField(name)
+		writeField(request.GetHeader()[name])
+	}
+
+	switch policy.GetResultCacheKey() {
+	case enumsspb.NEXUS_RESULT_CACHE_KEY_REQUEST_ID:
+		if start.GetRequestId() == "" {
+			return nexusResultCacheKey{}, false
+		}
+		writeField(start.GetRequestId())
+	default:
+		// Deterministic marshaling makes the hash stable for payloads with identical metadata maps.
+		data, err := proto.MarshalOptions{Deterministic: true}.Marshal(start.GetPayload())
+		if err != nil {
+			return nexusResultCacheKey{}, false
+		}
+		writeField(string(data))
+	}
+	key.requestKey = hex.EncodeToString(h.Sum(nil))
+	return key, true
+}
+
+// get returns the unexpired result cached under the given key.
+func (c *nexusResultCache) get(key nexusResultCacheKey) (*nexusResultCacheEntry, bool) {
+	entry, ok := c.cache.Get(key).(*nexusResultCacheEntry)
+	if !ok {
+		return nil, false
+	}
+	if !c.timeSource.Now().Before(entry.expiresAt) {
+		c.cache.Delete(key)
+		return nil, false
+	}
+	return entry, true
+}
+
+// put caches a result under the given key for the given TTL. Results that are larger than the whole cache are
+// ignored.
+func (c *nexusResultCache) put(key nexusResultCacheKey, payload *commonpb.Payload, links []*nexuspb.Link, ttl time.Duration) {
+	c.cache.Put(key, &nexusResultCacheEntry{
+		payload:   payload,
+		links:     links,
+		expiresAt: c.timeSource.Now().Add(ttl),
+	})
+}
@@ -0,0 +1,163 @@
+// The MIT License
+//
+// Copyright (c) 2025 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	nexuspb "go.temporal.io/api/nexus/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/testing/protorequire"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func newTestStartOperationRequest(requestID string, data string) *nexuspb.Request {
+	return &nexuspb.Request{
+		Header: map[string]string{"tenant": "t1", "operation-timeout": "59s"},
+		Variant: &nexuspb.Request_StartOperation{
+			StartOperation: &nexuspb.StartOperationRequest{
+				Service:   "service",
+				Operation: "operation",
+				RequestId: requestID,
+				Callback:  "http://localhost/namespaces/caller/nexus/callback",
+				Payload: &commonpb.Payload{
+					Metadata: map[string][]byte{"encoding": []byte("json/plain"), "type": []byte("lookup")},
+					Data:     []byte(data),
+				},
+			},
+		},
+	}
+}
+
+func TestNexusResultCache_Key(t *testing.T) {
+	c := newNexusResultCache(1024, clock.NewRealTimeSource())
+	nc := &nexusContext{namespaceName: "ns", taskQueue: "tq", endpointName: "endpoint"}
+
+	_, ok := c.key(nc, nil, newTestStartOperationRequest("id1", "input"))
+	require.False(t, ok, "caching is opt-in")
+	_, ok = c.key(nc, &persistencespb.NexusEndpointPolicy{ResultCacheTtl: durationpb.New(0)}, newTestStartOperationRequest("id1", "input"))
+	require.False(t, ok, "zero TTL disables caching")
+
+	byInput := &persistencespb.NexusEndpointPolicy{ResultCacheTtl: durationpb.New(time.Minute)}
+	k1, ok := c.key(nc, byInput, newTestStartOperationRequest("id1", "input"))
+	require.True(t, ok)
+	k2, ok := c.key(nc, byInput, newTestStartOperationRequest("id2", "input"))
+	require.True(t, ok)
+	require.Equal(t, k1, k2, "identical inputs share a key regardless of the request ID")
+	k3, ok := c.key(nc, byInput, newTestStartOperationRequest("id1", "other input"))
+	require.True(t, ok)
+	require.NotEqual(t, k1, k3)
+	otherOperation := newTestStartOperationRequest("id1", "input")
+	otherOperation.GetStartOperation().Operation = "other"
+	k4, ok := c.key(nc, byInput, otherOperation)
+	require.True(t, ok)
+	require.NotEqual(t, k1, k4)
+	k5, ok := c.key(&nexusContext{namespaceName: "ns", taskQueue: "tq", endpointName: "other"}, byInput, newTestStartOperationRequest("id1", "input"))
+	require.True(t, ok)
+	require.NotEqual(t, k1, k5)
+
+	// Results are not shared between callers, or requests with different forwarded headers.
+	otherCaller := newTestStartOperationRequest("id1", "input")
+	otherCaller.GetStartOperation().Callback = "http://localhost/namespaces/other-caller/nexus/callback"
+	k6, ok := c.key(nc, byInput, otherCaller)
+	require.True(t, ok)
+	require.NotEqual(t, k1, k6)
+	k7, ok := c.key(&nexusContext{
+		namespaceName: "ns",
+		taskQueue:     "tq",
+		endpointName:  "endpoint",
+		claims:        &authorization.Claims{Subject: "other-subject"},
+	}, byInput, newTestStartOperationRequest("id1", "input"))
+	require.True(t, ok)
+	require.NotEqual(t, k1, k7)
+	otherHeader := newTestStartOperationRequest("id1", "input")
+	otherHeader.Header["tenant"] = "t2"
+	k8, ok := c.key(nc, byInput, otherHeader)
+	require.True(t, ok)
+	require.NotEqual(t, k1, k8)
+	otherTimeout := newTestStartOperationRequest("id2", "input")
+	otherTimeout.Header["operation-timeout"] = "42s"
+	k9, ok := c.key(nc, byInput, otherTimeout)
+	require.True(t, ok)
+	require.Equal(t, k1, k9, "timeouts set for each attempt are not part of the key")
+
+	byRequestID := &persistencespb.NexusEndpointPolicy{
+		ResultCacheTtl: durationpb.New(time.Minute),
+		ResultCacheKey: enumsspb.NEXUS_RESULT_CACHE_KEY_REQUEST_ID,
+	}
+	k1, ok = c.key(nc, byRequestID, newTestStartOperationRequest("id1", "input"))
+	require.True(t, ok)
+	k2, ok = c.key(nc, byRequestID, newTestStartOperationRequest("id1", "other input"))
+	require.True(t, ok)
+	require.Equal(t, k1, k2)
+	k3, ok = c.key(nc, byRequestID, newTestStartOperationRequest("id2", "input"))
+	require.True(t, ok)
+	require.NotEqual(t, k1, k3)
+	_, ok = c.key(nc, byRequestID, newTestStartOperationRequest("", "input"))
+	require.False(t, ok, "requests without an ID cannot be cached by request ID")
+}
+
+func TestNexusResultCache_GetPut(t *testing.T) {
+	timeSource := clock.NewEventTimeSource()
+	timeSource.Update(time.Now())
+	c := newNexusResultCache(1024, timeSource)
+	nc := &nexusContext{namespaceName: "ns", taskQueue: "tq", endpointName: "endpoint"}
+	policy := &persistencespb.NexusEndpointPolicy{ResultCacheTtl: durationpb.New(time.Minute)}
+	key, ok := c.key(nc, policy, newTestStartOperationRequest("id", "input"))
+	require.True(t, ok)
+
+	_, ok = c.get(key)
+	require.False(t, ok)
+
+	payload := &commonpb.Payload{Data: []byte("result")}
+	links := []*nexuspb.Link{{Url: "temporal:///namespaces/ns/workflows/wf/run/events", Type: "temporal.api.common.v1.Link.WorkflowEvent"}}
+	c.put(key, payload, links, time.Minute)
+
+	entry, ok := c.get(key)
+	require.True(t, ok)
+	protorequire.ProtoEqual(t, payload, entry.payload)
+	require.Len(t, entry.links, 1)
+
+	timeSource.Update(timeSource.Now().Add(time.Minute))
+	_, ok = c.get(key)
+	require.False(t, ok, "entries expire after the endpoint's TTL")
+
+	// Results larger than the whole cache are not cached.
+	c.put(key, &commonpb.Payload{Data: make([]byte, 2048)}, nil, time.Minute)
+	_, ok = c.get(key)
+	require.False(t, ok)
+}
+
+func TestNexusResultCache_Disabled(t *testing.T) {
+	c := newNexusResultCache(0, clock.NewRealTimeSource())
+	key := nexusResultCacheKey{endpointName: "endpoint", requestKey: "id"}
+	c.put(key, &commonpb.Payload{Data: []byte("result")}, nil, time.Minute)
+	_, ok := c.get(key)
+	require.False(t, ok)
+}
@@ -40,6 +40,7 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payloadstore"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/retrypolicy"
 	"go.temporal.io/server/common/util"
@@ -217,6 +218,7 @@ type Config struct {
 	MaxNexusOperationTokenLength   dynamicconfig.IntPropertyFnWithNamespaceFilter
 	NexusRequestHeadersBlacklist   *dynamicconfig.GlobalCachedTypedValue[*regexp.Regexp]
 	NexusOperationsMetricTagConfig *dynamicconfig.GlobalCachedTypedValue[*nexusoperations.NexusMetricTagConfig]
+	NexusResultCacheMaxSizeBytes   dynamicconfig.IntPropertyFn
 
 	LinkMaxSize        dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MaxLinksPerRequest dynamicconfig.IntPropertyFnWithNamespaceFilter
@@ -294,7 +296,7 @@ func NewConfig(
 		ReachabilityQuerySetDurationSinceDefault:      dynamicconfig.ReachabilityQuerySetDurationSinceDefault.Get(dc),
 		MaxBadBinaries:                                dynamicconfig.FrontendMaxBadBinaries.Get(dc),
 		DisableListVisibilityByFilter:                 dynamicconfig.DisableListVisibilityByFilter.Get(dc),
-		BlobSizeLimitError:                            dynamicconfig.BlobSizeLimitError.Get(dc),
+		BlobSizeLimitError:                            payloadstore.BlobSizeLimitError(dc),
 		BlobSizeLimitWarn:                             dynamicconfig.BlobSizeLimitWarn.Get(dc),
 		ThrottledLogRPS:                               dynamicconfig.FrontendThrottledLogRPS.Get(dc),
 		ShutdownDrainDuration:                         dynamicconfig.FrontendShutdownDrainDuration.Get(dc),
@@ -366,6 +368,7 @@ func NewConfig(
 				return &config, nil
 			},
 		),
+		NexusResultCacheMaxSizeBytes: dynamicconfig.FrontendNexusResultCacheMaxSizeBytes.Get(dc),
 
 		LinkMaxSize:        dynamicconfig.FrontendLinkMaxSize.Get(dc),
 		MaxLinksPerRequest: dynamicconfig.FrontendMaxLinksPerRequest.Get(dc),
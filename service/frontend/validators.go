@@ -25,8 +25,14 @@
 package frontend
 
 import (
+	"fmt"
+
 	"github.com/pborman/uuid"
 	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/common/enums"
 )
 
 func validateExecution(w *commonpb.WorkflowExecution) error {
@@ -41,3 +47,24 @@ func validateExecution(w *commonpb.WorkflowExecution) error {
 	}
 	return nil
 }
+
+// validateResetWorkflowExecutionRequest validates the reset request and sets the default reset reapply type. It is
+// shared by the public and the admin reset APIs.
+func validateResetWorkflowExecutionRequest(request *workflowservice.ResetWorkflowExecutionRequest, maxIDLength int) error {
+	if request.GetRequestId() == "" {
+		return errRequestIDNotSet
+	}
+	if len(request.GetRequestId()) > maxIDLength {
+		return errRequestIDTooLong
+	}
+
+	if err := validateExecution(request.WorkflowExecution); err != nil {
+		return err
+	}
+
+	enums.SetDefaultResetReapplyType(&request.ResetReapplyType)
+	if _, validType := enumspb.ResetReapplyType_name[int32(request.GetResetReapplyType())]; !validType {
+		return serviceerror.NewInternal(fmt.Sprintf("unknown reset reapply type: %v", request.GetResetReapplyType()))
+	}
+	return nil
+}
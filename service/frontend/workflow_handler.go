@@ -51,6 +51,7 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	schedulespb "go.temporal.io/server/api/schedule/v1"
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/client/frontend"
@@ -58,6 +59,7 @@ import (
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/collection"
@@ -112,6 +114,12 @@ var (
 	// Tail room for context deadline to bail out from retry for long poll.
 	longPollTailRoom = time.Second
 
+	// Sticky handoffs made by this frontend are not repeated for this long, so that workers naming their predecessor
+	// on every sticky poll only cause a matching call per handoff, not per poll. Matching extends the handoff when it
+	// is repeated.
+	stickyHandoffCacheTTL  = time.Minute
+	stickyHandoffCacheSize = 10000
+
 	errWaitForRefresh = serviceerror.NewDeadlineExceeded("waiting for schedule to refresh status of completed workflows")
 )
 
@@ -150,6 +158,7 @@ type (
 		healthInterceptor                             *interceptor.HealthInterceptor
 		scheduleSpecBuilder                           *scheduler.SpecBuilder
 		outstandingPollers                            collection.SyncMap[string, collection.SyncMap[string, context.CancelFunc]]
+		stickyHandoffs                                cache.Cache
 		httpEnabled                                   bool
 	}
 )
@@ -234,6 +243,7 @@ func NewWorkflowHandler(
 		healthInterceptor:   healthInterceptor,
 		scheduleSpecBuilder: scheduleSpecBuilder,
 		outstandingPollers:  collection.NewSyncMap[string, collection.SyncMap[string, context.CancelFunc]](),
+		stickyHandoffs:      cache.New(stickyHandoffCacheSize, &cache.Options{TTL: stickyHandoffCacheTTL}),
 		httpEnabled:         httpEnabled,
 	}
 
@@ -880,6 +890,10 @@ func (wh *WorkflowHandler) PollWorkflowTaskQueue(ctx context.Context, request *w
 		return &workflowservice.PollWorkflowTaskQueueResponse{}, nil
 	}
 
+	if request.TaskQueue.GetKind() == enumspb.TASK_QUEUE_KIND_STICKY {
+		wh.handoffStickyTaskQueue(ctx, namespaceID, request)
+	}
+
 	pollerID := uuid.New()
 	childCtx := wh.registerOutstandingPollContext(ctx, pollerID, namespaceID.String())
 	defer wh.unregisterOutstandingPollContext(pollerID, namespaceID.String())
@@ -1063,6 +1077,42 @@ func (wh *WorkflowHandler) RespondWorkflowTaskFailed(
 	return &workflowservice.RespondWorkflowTaskFailedResponse{}, nil
 }
 
+// handoffStickyTaskQueue hands off the sticky queue of a restarted worker's previous process to the polled sticky
+// queue, if the worker named it in the sticky predecessor header. Failures are logged and don't fail the poll: the
+// worker then falls back to the normal queue as it would without the handoff.
+func (wh *WorkflowHandler) handoffStickyTaskQueue(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	request *workflowservice.PollWorkflowTaskQueueRequest,
+) {
+	predecessor := headers.GetValues(ctx, headers.StickyPredecessorHeaderName)[0]
+	if predecessor == "" {
+		return
+	}
+	handoffKey := namespaceID.String() + "/" + predecessor + "/" + request.TaskQueue.GetName()
+	if wh.stickyHandoffs.Get(handoffKey) != nil {
+		return
+	}
+	_, err := wh.matchingClient.HandoffStickyTaskQueue(ctx, &matchingservice.HandoffStickyTaskQueueRequest{
+		NamespaceId: namespaceID.String(),
+		TaskQueue: &taskqueuepb.TaskQueue{
+			Name:       predecessor,
+			Kind:       enumspb.TASK_QUEUE_KIND_STICKY,
+			NormalName: request.TaskQueue.GetNormalName(),
+		},
+		SuccessorTaskQueue: request.TaskQueue,
+		Identity:           request.GetIdentity(),
+	})
+	if err != nil {
+		wh.throttledLogger.Warn("Failed to hand off sticky task queue.",
+			tag.WorkflowNamespaceID(namespaceID.String()),
+			tag.WorkflowTaskQueueName(predecessor),
+			tag.Error(err))
+		return
+	}
+	wh.stickyHandoffs.Put(handoffKey, struct{}{})
+}
+
 // PollActivityTaskQueue is called by application worker to process ActivityTask from a specific task queue.  ActivityTask
 // is dispatched to callers whenever a ScheduleTask command is made for a workflow execution.
 // Application is expected to call 'RespondActivityTaskCompleted' or 'RespondActivityTaskFailed' once it is done
@@ -2075,22 +2125,10 @@ func (wh *WorkflowHandler) ResetWorkflowExecution(ctx context.Context, request *
 	if request == nil {
 		return nil, errRequestNotSet
 	}
-	if request.GetRequestId() == "" {
-		return nil, errRequestIDNotSet
-	}
-	if len(request.GetRequestId()) > wh.config.MaxIDLengthLimit() {
-		return nil, errRequestIDTooLong
-	}
-
-	if err := validateExecution(request.WorkflowExecution); err != nil {
+	if err := validateResetWorkflowExecutionRequest(request, wh.config.MaxIDLengthLimit()); err != nil {
 		return nil, err
 	}
 
-	enums.SetDefaultResetReapplyType(&request.ResetReapplyType)
-	if _, validType := enumspb.ResetReapplyType_name[int32(request.GetResetReapplyType())]; !validType {
-		return nil, serviceerror.NewInternal(fmt.Sprintf("unknown reset reapply type: %v", request.GetResetReapplyType()))
-	}
-
 	namespaceID, err := wh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
 	if err != nil {
 		return nil, err
@@ -5203,26 +5241,27 @@ func (wh *WorkflowHandler) validateWorkflowCompletionCallbacks(
 	for _, callback := range callbacks {
 		switch cb := callback.GetVariant().(type) {
 		case *commonpb.Callback_Nexus_:
-			if err := wh.validateCallbackURL(ns, cb.Nexus.GetUrl()); err != nil {
+			if err := validateCallbackURL(wh.config, ns, cb.Nexus.GetUrl()); err != nil {
 				return err
 			}
-
-			headerSize := 0
-			for k, v := range cb.Nexus.GetHeader() {
-				headerSize += len(k) + len(v)
-			}
-			if headerSize > wh.config.CallbackHeaderMaxSize(ns.String()) {
-				return status.Error(
-					codes.InvalidArgument,
-					fmt.Sprintf(
-						"invalid header: header size longer than max allowed size of %d",
-						wh.config.CallbackHeaderMaxSize(ns.String()),
-					),
-				)
+			if err := wh.validateCallbackHeader(ns, cb.Nexus.GetHeader()); err != nil {
+				return err
 			}
 		case *commonpb.Callback_Internal_:
-			// TODO(Tianyu): For now, there is nothing to validate given that this is an internal field.
-			continue
+			persistenceCB := &persistencespb.Callback{}
+			if err := proto.Unmarshal(cb.Internal.GetData(), persistenceCB); err != nil {
+				return status.Error(codes.InvalidArgument, "invalid internal callback data")
+			}
+			// Webhooks reach external addresses and are subject to the same rules as Nexus callbacks.
+			// TODO(Tianyu): For now, there is nothing to validate for other internal variants.
+			if webhook := persistenceCB.GetWebhook(); webhook != nil {
+				if err := validateCallbackURL(wh.config, ns, webhook.GetUrl()); err != nil {
+					return err
+				}
+				if err := wh.validateCallbackHeader(ns, webhook.GetHeader()); err != nil {
+					return err
+				}
+			}
 		default:
 			return status.Error(codes.Unimplemented, fmt.Sprintf("unknown callback variant: %T", cb))
 		}
@@ -5230,9 +5269,26 @@ func (wh *WorkflowHandler) validateWorkflowCompletionCallbacks(
 	return nil
 }
 
-func (wh *WorkflowHandler) validateCallbackURL(ns namespace.Name, rawURL string) error {
-	if len(rawURL) > wh.config.CallbackURLMaxLength(ns.String()) {
-		return status.Errorf(codes.InvalidArgument, "invalid url: url length longer than max length allowed of %d", wh.config.CallbackURLMaxLength(ns.String()))
+func (wh *WorkflowHandler) validateCallbackHeader(ns namespace.Name, header map[string]string) error {
+	headerSize := 0
+	for k, v := range header {
+		headerSize += len(k) + len(v)
+	}
+	if headerSize > wh.config.CallbackHeaderMaxSize(ns.String()) {
+		return status.Error(
+			codes.InvalidArgument,
+			fmt.Sprintf(
+				"invalid header: header size longer than max allowed size of %d",
+				wh.config.CallbackHeaderMaxSize(ns.String()),
+			),
+		)
+	}
+	return nil
+}
+
+func validateCallbackURL(config *Config, ns namespace.Name, rawURL string) error {
+	if len(rawURL) > config.CallbackURLMaxLength(ns.String()) {
+		return status.Errorf(codes.InvalidArgument, "invalid url: url length longer than max length allowed of %d", config.CallbackURLMaxLength(ns.String()))
 	}
 
 	u, err := url.Parse(rawURL)
@@ -5242,7 +5298,7 @@ func (wh *WorkflowHandler) validateCallbackURL(ns namespace.Name, rawURL string)
 	if !(u.Scheme == "http" || u.Scheme == "https") {
 		return status.Errorf(codes.InvalidArgument, "invalid url: unknown scheme: %v", u)
 	}
-	for _, cfg := range wh.config.CallbackEndpointConfigs(ns.String()) {
+	for _, cfg := range config.CallbackEndpointConfigs(ns.String()) {
 		if cfg.Regexp.MatchString(u.Host) {
 			if u.Scheme == "http" && !cfg.AllowInsecure {
 				return status.Errorf(codes.InvalidArgument, "invalid url: callback address does not allow insecure connections: %v", u)
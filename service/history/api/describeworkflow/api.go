@@ -28,6 +28,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 
 	"github.com/sony/gobreaker"
@@ -53,6 +54,7 @@ import (
 	historyi "go.temporal.io/server/service/history/interfaces"
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/history/workflow"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -84,6 +86,7 @@ func Invoke(
 	workflowConsistencyChecker api.WorkflowConsistencyChecker,
 	persistenceVisibilityMgr manager.VisibilityManager,
 	outboundQueueCBPool *circuitbreakerpool.OutboundQueueCircuitBreakerPool,
+	activityRetryCBPool *circuitbreakerpool.ActivityRetryCircuitBreakerPool,
 ) (_ *historyservice.DescribeWorkflowExecutionResponse, retError error) {
 	namespaceID := namespace.ID(req.GetNamespaceId())
 	err := api.ValidateNamespaceUUID(namespaceID)
@@ -168,6 +171,14 @@ func Invoke(
 		result.WorkflowExtendedInfo.LastResetTime = executionState.StartTime
 	}
 
+	result.SignalRequestIds = mutableState.GetPendingSignalRequestedIds()
+	for requestID := range executionInfo.GetChainSignalRequestIds() {
+		if mutableState.IsSignalRequested(requestID) && !slices.Contains(result.SignalRequestIds, requestID) {
+			result.SignalRequestIds = append(result.SignalRequestIds, requestID)
+		}
+	}
+	slices.Sort(result.SignalRequestIds)
+
 	if executionInfo.ParentRunId != "" {
 		result.WorkflowExecutionInfo.ParentExecution = &commonpb.WorkflowExecution{
 			WorkflowId: executionInfo.ParentWorkflowId,
@@ -197,6 +208,10 @@ func Invoke(
 		}
 
 		result.PendingActivities = append(result.PendingActivities, p)
+		result.PendingActivityRetryBudgets = append(
+			result.PendingActivityRetryBudgets,
+			buildActivityRetryBudgetInfo(mutableState.GetNamespaceEntry().Name(), ai, activityRetryCBPool),
+		)
 	}
 
 	for _, ch := range mutableState.GetPendingChildExecutionInfos() {
@@ -276,6 +291,9 @@ func Invoke(
 			continue
 		}
 		result.Callbacks = append(result.Callbacks, callbackInfo)
+		result.CallbackRecentAttempts = append(result.CallbackRecentAttempts, &historyservice.CallbackRecentAttempts{
+			Attempts: callback.RecentAttempts,
+		})
 	}
 
 	opColl := nexusoperations.MachineCollection(mutableState.HSM())
@@ -315,6 +333,23 @@ func Invoke(
 	return result, nil
 }
 
+func buildActivityRetryBudgetInfo(
+	namespaceName namespace.Name,
+	ai *persistencespb.ActivityInfo,
+	activityRetryCBPool *circuitbreakerpool.ActivityRetryCircuitBreakerPool,
+) *historyservice.ActivityRetryBudgetInfo {
+	info := &historyservice.ActivityRetryBudgetInfo{Deferrals: ai.GetRetryBudgetDeferrals()}
+	state, enabled := activityRetryCBPool.State(circuitbreakerpool.ActivityRetryBudgetKey{
+		Namespace:    namespaceName.String(),
+		TaskQueue:    ai.GetTaskQueue(),
+		ActivityType: ai.GetActivityType().GetName(),
+	})
+	if enabled {
+		info.CircuitBreakerState = state.String()
+	}
+	return info
+}
+
 func buildCallbackInfo(
 	namespaceID namespace.ID,
 	callback callbacks.Callback,
@@ -331,8 +366,20 @@ func buildCallbackInfo(
 			},
 		}
 		destination = variant.Nexus.GetUrl()
+	case *persistencespb.Callback_Webhook_:
+		// Webhooks aren't part of the public API, they're attached as internal callbacks.
+		data, err := proto.Marshal(callback.Callback)
+		if err != nil {
+			return nil, err
+		}
+		cbSpec.Variant = &commonpb.Callback_Internal_{
+			Internal: &commonpb.Callback_Internal{
+				Data: data,
+			},
+		}
+		destination = variant.Webhook.GetUrl()
 	default:
-		// Ignore non-nexus callbacks for now (there aren't any just yet).
+		// Ignore HSM callbacks, they are an implementation detail.
 		return nil, nil
 	}
 
@@ -0,0 +1,76 @@
+// The MIT License
+//
+// Copyright (c) 2025 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package describeworkflowcallbacks
+
+import (
+	"context"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/locks"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/components/callbacks"
+	"go.temporal.io/server/service/history/api"
+	historyi "go.temporal.io/server/service/history/interfaces"
+)
+
+func Invoke(
+	ctx context.Context,
+	request *historyservice.DescribeWorkflowCallbacksRequest,
+	shardContext historyi.ShardContext,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+) (_ *historyservice.DescribeWorkflowCallbacksResponse, retError error) {
+	if err := api.ValidateNamespaceUUID(namespace.ID(request.GetNamespaceId())); err != nil {
+		return nil, err
+	}
+
+	workflowLease, err := workflowConsistencyChecker.GetWorkflowLease(
+		ctx,
+		nil,
+		definition.NewWorkflowKey(
+			request.GetNamespaceId(),
+			request.GetExecution().GetWorkflowId(),
+			request.GetExecution().GetRunId(),
+		),
+		locks.PriorityHigh,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { workflowLease.GetReleaseFn()(retError) }()
+
+	coll := callbacks.MachineCollection(workflowLease.GetMutableState().HSM())
+	nodes := coll.List()
+	result := make(map[string]*persistencespb.CallbackInfo, len(nodes))
+	for _, node := range nodes {
+		callback, err := coll.Data(node.Key.ID)
+		if err != nil {
+			return nil, err
+		}
+		// Clone since mutable state may be mutated once the lease is released, before the response is marshaled.
+		result[node.Key.ID] = common.CloneProto(callback.CallbackInfo)
+	}
+	return &historyservice.DescribeWorkflowCallbacksResponse{Callbacks: result}, nil
+}
@@ -244,6 +244,9 @@ func GetHistory(
 	executionHistory := &historypb.History{
 		Events: historyEvents,
 	}
+	if err := shardContext.GetPayloadOffloader().Rehydrate(ctx, namespaceID, executionHistory); err != nil {
+		return nil, nil, err
+	}
 	return executionHistory, nextPageToken, nil
 }
 
@@ -304,6 +307,9 @@ func GetHistoryReverse(
 	executionHistory := &historypb.History{
 		Events: historyEvents,
 	}
+	if err := shardContext.GetPayloadOffloader().Rehydrate(ctx, namespaceID, executionHistory); err != nil {
+		return nil, nil, 0, err
+	}
 
 	var newNextEventID int64
 	if len(historyEvents) > 0 {
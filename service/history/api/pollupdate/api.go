@@ -33,6 +33,7 @@ import (
 	updatepb "go.temporal.io/api/update/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/locks"
 	"go.temporal.io/server/common/namespace"
@@ -90,10 +91,18 @@ func Invoke(
 	if err != nil {
 		return nil, err
 	}
+	outcome := status.Outcome
+	if offloader := shardContext.GetPayloadOffloader(); offloader.Enabled() && outcome != nil {
+		// The outcome is shared with the update registry, re-hydrate a copy of it.
+		outcome = common.CloneProto(outcome)
+		if err := offloader.Rehydrate(ctx, namespaceID, outcome); err != nil {
+			return nil, err
+		}
+	}
 
 	return &historyservice.PollWorkflowExecutionUpdateResponse{
 		Response: &workflowservice.PollWorkflowExecutionUpdateResponse{
-			Outcome: status.Outcome,
+			Outcome: outcome,
 			Stage:   status.Stage,
 			UpdateRef: &updatepb.UpdateRef{
 				WorkflowExecution: &commonpb.WorkflowExecution{
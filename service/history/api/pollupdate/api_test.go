@@ -142,6 +142,7 @@ func TestPollOutcome(t *testing.T) {
 	mockConfig.LongPollExpirationInterval = func(_ string) time.Duration { return serverImposedTimeout }
 	shardContext.EXPECT().GetConfig().Return(mockConfig).AnyTimes()
 	shardContext.EXPECT().GetNamespaceRegistry().Return(mockNamespaceRegistry).AnyTimes()
+	shardContext.EXPECT().GetPayloadOffloader().Return(nil).AnyTimes()
 
 	req := historyservice.PollWorkflowExecutionUpdateRequest{
 		Request: &workflowservice.PollWorkflowExecutionUpdateRequest{
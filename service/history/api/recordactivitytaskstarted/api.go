@@ -73,6 +73,10 @@ func Invoke(
 			if !mutableState.IsWorkflowExecutionRunning() {
 				return nil, consts.ErrWorkflowCompleted
 			}
+			if mutableState.IsWorkflowExecutionPaused() {
+				// activity task will be regenerated when the workflow is unpaused
+				return nil, serviceerrors.NewObsoleteMatchingTask("workflow is paused")
+			}
 
 			response, startedTransition, err = recordActivityTaskStarted(ctx, shardContext, mutableState, request, matchingClient)
 			if err != nil {
@@ -101,6 +105,14 @@ func Invoke(
 		// the task, new activity task will be scheduled after transition completion.
 		return nil, serviceerrors.NewActivityStartDuringTransition()
 	}
+
+	if offloader := shardContext.GetPayloadOffloader(); offloader.Enabled() && response.ScheduledEvent != nil {
+		// The scheduled event is shared with the events cache, re-hydrate a copy of it.
+		response.ScheduledEvent = common.CloneProto(response.ScheduledEvent)
+		if err := offloader.Rehydrate(ctx, namespace.ID(request.NamespaceId), response.ScheduledEvent); err != nil {
+			return nil, err
+		}
+	}
 	return response, err
 }
 
@@ -0,0 +1,143 @@
+// The MIT License
+//
+// Copyright (c) 2025 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package recordchildworkflowprogress
+
+import (
+	"context"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/consts"
+	historyi "go.temporal.io/server/service/history/interfaces"
+)
+
+// Invoke delivers the latest progress reported by a child execution to the parent as a signal sent by the child.
+// Duplicate reports of the same child run are dropped. Reports for a parent that is no longer running, or for a child
+// that the parent no longer considers pending, are rejected.
+func Invoke(
+	ctx context.Context,
+	request *historyservice.RecordChildExecutionProgressRequest,
+	shardContext historyi.ShardContext,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+) (resp *historyservice.RecordChildExecutionProgressResponse, retError error) {
+	namespaceEntry, err := api.GetActiveNamespace(shardContext, namespace.ID(request.GetNamespaceId()))
+	if err != nil {
+		return nil, err
+	}
+
+	config := shardContext.GetConfig()
+	namespaceName := namespaceEntry.Name().String()
+	if err := common.CheckEventBlobSizeLimit(
+		request.GetProgress().Size(),
+		config.BlobSizeLimitWarn(namespaceName),
+		config.BlobSizeLimitError(namespaceName),
+		namespaceName,
+		request.GetChildExecution().GetWorkflowId(),
+		request.GetChildExecution().GetRunId(),
+		shardContext.GetMetricsHandler(),
+		shardContext.GetThrottledLogger(),
+		tag.BlobSizeViolationOperation("RecordChildExecutionProgress"),
+	); err != nil {
+		return nil, err
+	}
+
+	parentInitiatedID := request.GetParentInitiatedId()
+	parentInitiatedVersion := request.GetParentInitiatedVersion()
+	err = api.GetAndUpdateWorkflowWithConsistencyCheck(
+		ctx,
+		request.GetClock(),
+		func(mutableState historyi.MutableState) bool {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return true
+			}
+			onCurrentBranch, err := api.IsHistoryEventOnCurrentBranch(mutableState, parentInitiatedID, parentInitiatedVersion)
+			if err != nil {
+				// can't find initiated event, potential stale mutable, fail the predicate check
+				return false
+			}
+			if !onCurrentBranch {
+				return true
+			}
+			_, childInitEventFound := mutableState.GetChildExecutionInfo(parentInitiatedID)
+			return childInitEventFound
+		},
+		definition.NewWorkflowKey(
+			request.GetNamespaceId(),
+			request.GetParentExecution().GetWorkflowId(),
+			request.GetParentExecution().GetRunId(),
+		),
+		func(workflowLease api.WorkflowLease) (*api.UpdateWorkflowAction, error) {
+			mutableState := workflowLease.GetMutableState()
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, consts.ErrWorkflowCompleted
+			}
+
+			onCurrentBranch, err := api.IsHistoryEventOnCurrentBranch(mutableState, parentInitiatedID, parentInitiatedVersion)
+			if err != nil || !onCurrentBranch {
+				return nil, consts.ErrChildExecutionNotFound
+			}
+
+			ci, isRunning := mutableState.GetChildExecutionInfo(parentInitiatedID)
+			if !isRunning || ci.GetStartedWorkflowId() != request.GetChildExecution().GetWorkflowId() {
+				return nil, consts.ErrChildExecutionNotFound
+			}
+			// the started run ID is only known once the parent recorded the child started event
+			if ci.GetStartedRunId() != "" &&
+				request.GetChildFirstExecutionRunId() != "" &&
+				ci.GetStartedRunId() != request.GetChildFirstExecutionRunId() {
+				return nil, consts.ErrChildExecutionNotFound
+			}
+
+			event, err := mutableState.AddChildExecutionProgressSignaledEvent(
+				parentInitiatedID,
+				request.GetChildExecution(),
+				request.GetSequence(),
+				request.GetProgress(),
+			)
+			if err != nil {
+				return nil, err
+			}
+			if event == nil {
+				// duplicate or out of order report
+				return &api.UpdateWorkflowAction{
+					Noop: true,
+				}, nil
+			}
+			return &api.UpdateWorkflowAction{
+				Noop:               false,
+				CreateWorkflowTask: true,
+			}, nil
+		},
+		nil,
+		shardContext,
+		workflowConsistencyChecker,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &historyservice.RecordChildExecutionProgressResponse{}, nil
+}
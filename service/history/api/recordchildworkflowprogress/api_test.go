@@ -0,0 +1,135 @@
+// The MIT License
+//
+// Copyright (c) 2025 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package recordchildworkflowprogress
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/consts"
+	historyi "go.temporal.io/server/service/history/interfaces"
+	"go.temporal.io/server/service/history/ndc"
+	"go.temporal.io/server/service/history/tests"
+	"go.uber.org/mock/gomock"
+)
+
+func setupShardContext(ctrl *gomock.Controller) *historyi.MockShardContext {
+	mockRegistry := namespace.NewMockRegistry(ctrl)
+	mockRegistry.EXPECT().GetNamespaceByID(tests.NamespaceID).Return(tests.LocalNamespaceEntry, nil)
+	mockClusterMetadata := cluster.NewMockMetadata(ctrl)
+	mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	shardContext := historyi.NewMockShardContext(ctrl)
+	shardContext.EXPECT().GetNamespaceRegistry().Return(mockRegistry)
+	shardContext.EXPECT().GetClusterMetadata().Return(mockClusterMetadata).AnyTimes()
+	shardContext.EXPECT().GetConfig().Return(tests.NewDynamicConfig()).AnyTimes()
+	shardContext.EXPECT().GetMetricsHandler().Return(metrics.NoopMetricsHandler).AnyTimes()
+	shardContext.EXPECT().GetThrottledLogger().Return(log.NewNoopLogger()).AnyTimes()
+	return shardContext
+}
+
+func newRequest(parentExecution *commonpb.WorkflowExecution, childWorkflowID string) *historyservice.RecordChildExecutionProgressRequest {
+	return &historyservice.RecordChildExecutionProgressRequest{
+		NamespaceId:       tests.NamespaceID.String(),
+		ParentExecution:   parentExecution,
+		ParentInitiatedId: 5,
+		ChildExecution:    &commonpb.WorkflowExecution{WorkflowId: childWorkflowID, RunId: uuid.NewString()},
+		Sequence:          1,
+		Progress:          payloads.EncodeString("50%"),
+	}
+}
+
+func Test_RecordChildWorkflowProgress(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	anyArg := gomock.Any()
+
+	childWorkflowID := uuid.NewString()
+	parentExecution := &commonpb.WorkflowExecution{WorkflowId: uuid.NewString(), RunId: uuid.NewString()}
+	request := newRequest(parentExecution, childWorkflowID)
+
+	mutableState := historyi.NewMockMutableState(ctrl)
+	mutableState.EXPECT().IsWorkflowExecutionRunning().Return(true).AnyTimes()
+	mutableState.EXPECT().GetNextEventID().Return(int64(10)).AnyTimes()
+	mutableState.EXPECT().GetChildExecutionInfo(request.ParentInitiatedId).Return(&persistencespb.ChildExecutionInfo{
+		StartedWorkflowId: childWorkflowID,
+	}, true).AnyTimes()
+	mutableState.EXPECT().AddChildExecutionProgressSignaledEvent(
+		request.ParentInitiatedId,
+		request.ChildExecution,
+		request.Sequence,
+		request.Progress,
+	).Return(&historypb.HistoryEvent{}, nil)
+	mutableState.EXPECT().HasPendingWorkflowTask().Return(true)
+
+	wfContext := historyi.NewMockWorkflowContext(ctrl)
+	wfContext.EXPECT().UpdateWorkflowExecutionAsActive(anyArg, anyArg).Return(nil)
+
+	lease := ndc.NewMockWorkflow(ctrl)
+	lease.EXPECT().GetMutableState().Return(mutableState).AnyTimes()
+	lease.EXPECT().GetReleaseFn().Return(func(_ error) {})
+	lease.EXPECT().GetContext().Return(wfContext)
+
+	consistencyChecker := api.NewMockWorkflowConsistencyChecker(ctrl)
+	consistencyChecker.EXPECT().GetWorkflowLeaseWithConsistencyCheck(anyArg, anyArg, anyArg, anyArg, anyArg).Return(lease, nil)
+
+	resp, err := Invoke(context.Background(), request, setupShardContext(ctrl), consistencyChecker)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+}
+
+func Test_RecordChildWorkflowProgress_ChildNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	anyArg := gomock.Any()
+
+	parentExecution := &commonpb.WorkflowExecution{WorkflowId: uuid.NewString(), RunId: uuid.NewString()}
+	request := newRequest(parentExecution, uuid.NewString())
+
+	mutableState := historyi.NewMockMutableState(ctrl)
+	mutableState.EXPECT().IsWorkflowExecutionRunning().Return(true).AnyTimes()
+	mutableState.EXPECT().GetNextEventID().Return(int64(10)).AnyTimes()
+	mutableState.EXPECT().GetChildExecutionInfo(request.ParentInitiatedId).Return(&persistencespb.ChildExecutionInfo{
+		StartedWorkflowId: "some other child",
+	}, true).AnyTimes()
+
+	lease := ndc.NewMockWorkflow(ctrl)
+	lease.EXPECT().GetMutableState().Return(mutableState).AnyTimes()
+	lease.EXPECT().GetReleaseFn().Return(func(_ error) {})
+
+	consistencyChecker := api.NewMockWorkflowConsistencyChecker(ctrl)
+	consistencyChecker.EXPECT().GetWorkflowLeaseWithConsistencyCheck(anyArg, anyArg, anyArg, anyArg, anyArg).Return(lease, nil)
+
+	resp, err := Invoke(context.Background(), request, setupShardContext(ctrl), consistencyChecker)
+	require.ErrorIs(t, err, consts.ErrChildExecutionNotFound)
+	require.Nil(t, resp)
+}
@@ -90,6 +90,10 @@ func Invoke(
 			if !mutableState.IsWorkflowExecutionRunning() {
 				return nil, consts.ErrWorkflowCompleted
 			}
+			if mutableState.IsWorkflowExecutionPaused() {
+				// workflow task will be regenerated when the workflow is unpaused
+				return nil, serviceerrors.NewObsoleteMatchingTask("workflow is paused")
+			}
 
 			workflowTask := mutableState.GetWorkflowTaskByID(scheduledEventID)
 			if workflowTask == nil {
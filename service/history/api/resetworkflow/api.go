@@ -29,6 +29,7 @@ import (
 
 	"github.com/google/uuid"
 	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/common"
@@ -36,12 +37,15 @@ import (
 	"go.temporal.io/server/common/locks"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/service/history/api"
 	historyi "go.temporal.io/server/service/history/interfaces"
 	"go.temporal.io/server/service/history/ndc"
 )
 
+const readEventsPageSize = 256
+
 func Invoke(
 	ctx context.Context,
 	resetRequest *historyservice.ResetWorkflowExecutionRequest,
@@ -149,6 +153,22 @@ func Invoke(
 		return nil, err
 	}
 	allowResetWithPendingChildren := shardContext.GetConfig().AllowResetWithPendingChildren(namespaceEntry.Name().String())
+
+	resetReapplyExcludeTypes := GetResetReapplyExcludeTypes(request.GetResetReapplyExcludeTypes(), request.GetResetReapplyType())
+	var additionalReapplyEvents []*historypb.HistoryEvent
+	if len(resetRequest.GetReapplyEvents()) > 0 {
+		// Only the selected events are reapplied: exclude everything from the regular reapply and pass the
+		// selection as additional events instead.
+		events, err := readEventsAfterResetPoint(ctx, shardContext, baseCurrentBranchToken, baseRebuildLastEventID+1, baseNextEventID)
+		if err != nil {
+			return nil, err
+		}
+		additionalReapplyEvents, err = ndc.SelectReapplyEvents(events, resetRequest.GetReapplyEvents())
+		if err != nil {
+			return nil, err
+		}
+		resetReapplyExcludeTypes = allResetReapplyExcludeTypes()
+	}
 	if err := ndc.NewWorkflowResetter(
 		shardContext,
 		workflowConsistencyChecker.GetWorkflowCache(),
@@ -172,8 +192,8 @@ func Invoke(
 			currentWorkflowLease.GetReleaseFn(),
 		),
 		request.GetReason(),
-		nil,
-		GetResetReapplyExcludeTypes(request.GetResetReapplyExcludeTypes(), request.GetResetReapplyType()),
+		additionalReapplyEvents,
+		resetReapplyExcludeTypes,
 		allowResetWithPendingChildren,
 	); err != nil {
 		return nil, err
@@ -210,3 +230,42 @@ func GetResetReapplyExcludeTypes(
 	}
 	return exclude
 }
+
+func allResetReapplyExcludeTypes() map[enumspb.ResetReapplyExcludeType]struct{} {
+	exclude := map[enumspb.ResetReapplyExcludeType]struct{}{}
+	for value := range enumspb.ResetReapplyExcludeType_name {
+		if excludeType := enumspb.ResetReapplyExcludeType(value); excludeType != enumspb.RESET_REAPPLY_EXCLUDE_TYPE_UNSPECIFIED {
+			exclude[excludeType] = struct{}{}
+		}
+	}
+	return exclude
+}
+
+func readEventsAfterResetPoint(
+	ctx context.Context,
+	shardContext historyi.ShardContext,
+	branchToken []byte,
+	firstEventID int64,
+	nextEventID int64,
+) ([]*historypb.HistoryEvent, error) {
+	var events []*historypb.HistoryEvent
+	var nextPageToken []byte
+	for {
+		page, _, token, err := persistence.ReadFullPageEvents(ctx, shardContext.GetExecutionManager(), &persistence.ReadHistoryBranchRequest{
+			BranchToken:   branchToken,
+			MinEventID:    firstEventID,
+			MaxEventID:    nextEventID,
+			PageSize:      readEventsPageSize,
+			NextPageToken: nextPageToken,
+			ShardID:       shardContext.GetShardID(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, page...)
+		if len(token) == 0 {
+			return events, nil
+		}
+		nextPageToken = token
+	}
+}
@@ -35,6 +35,7 @@ import (
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/tasktoken"
 	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/circuitbreakerpool"
 	"go.temporal.io/server/service/history/consts"
 	historyi "go.temporal.io/server/service/history/interfaces"
 	"go.temporal.io/server/service/history/workflow"
@@ -45,6 +46,7 @@ func Invoke(
 	req *historyservice.RespondActivityTaskCompletedRequest,
 	shard historyi.ShardContext,
 	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+	activityRetryCBPool *circuitbreakerpool.ActivityRetryCircuitBreakerPool,
 ) (resp *historyservice.RespondActivityTaskCompletedResponse, retError error) {
 	namespaceEntry, err := api.GetActiveNamespace(shard, namespace.ID(req.GetNamespaceId()))
 	if err != nil {
@@ -145,6 +147,13 @@ func Invoke(
 		workflowConsistencyChecker,
 	)
 
+	if err == nil && !fabricateStartedEvent {
+		activityRetryCBPool.RecordAttempt(circuitbreakerpool.ActivityRetryBudgetKey{
+			Namespace:    namespace.String(),
+			TaskQueue:    taskQueue,
+			ActivityType: token.ActivityType,
+		}, true)
+	}
 	if err == nil && !activityStartedTime.IsZero() && !fabricateStartedEvent {
 		metrics.ActivityE2ELatency.With(
 			workflow.GetPerTaskQueueFamilyScope(
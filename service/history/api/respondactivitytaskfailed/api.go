@@ -37,6 +37,7 @@ import (
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/tasktoken"
 	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/circuitbreakerpool"
 	"go.temporal.io/server/service/history/consts"
 	historyi "go.temporal.io/server/service/history/interfaces"
 	"go.temporal.io/server/service/history/workflow"
@@ -47,6 +48,7 @@ func Invoke(
 	req *historyservice.RespondActivityTaskFailedRequest,
 	shard historyi.ShardContext,
 	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+	activityRetryCBPool *circuitbreakerpool.ActivityRetryCircuitBreakerPool,
 ) (resp *historyservice.RespondActivityTaskFailedResponse, retError error) {
 	namespaceEntry, err := api.GetActiveNamespace(shard, namespace.ID(req.GetNamespaceId()))
 	if err != nil {
@@ -143,6 +145,13 @@ func Invoke(
 		shard,
 		workflowConsistencyChecker,
 	)
+	if err == nil {
+		activityRetryCBPool.RecordAttempt(circuitbreakerpool.ActivityRetryBudgetKey{
+			Namespace:    namespace.String(),
+			TaskQueue:    taskQueue,
+			ActivityType: token.ActivityType,
+		}, false)
+	}
 	if err == nil && !activityStartedTime.IsZero() {
 		metrics.ActivityE2ELatency.With(
 			workflow.GetPerTaskQueueFamilyScope(
@@ -134,7 +134,7 @@ func (s *workflowSuite) Test_NormalFlowShouldRescheduleActivity_UpdatesWorkflowE
 	s.expectTimerMetricsRecorded(uc, s.shardContext)
 	s.workflowContext.EXPECT().UpdateWorkflowExecutionAsActive(ctx, s.shardContext).Return(nil)
 
-	_, err := Invoke(ctx, request, s.shardContext, s.workflowConsistencyChecker)
+	_, err := Invoke(ctx, request, s.shardContext, s.workflowConsistencyChecker, nil)
 	s.NoError(err)
 }
 
@@ -152,6 +152,7 @@ func (s *workflowSuite) Test_WorkflowExecutionIsNotRunning_ReturnWorkflowNotRunn
 		request,
 		s.shardContext,
 		s.workflowConsistencyChecker,
+		nil,
 	)
 	s.Error(err)
 	s.EqualValues(consts.ErrWorkflowCompleted, err)
@@ -175,6 +176,7 @@ func (s *workflowSuite) Test_CacheRefreshRequired_ReturnCacheStaleError() {
 		request,
 		s.shardContext,
 		s.workflowConsistencyChecker,
+		nil,
 	)
 	s.Error(err)
 	s.EqualValues(consts.ErrStaleState, err)
@@ -196,6 +198,7 @@ func (s *workflowSuite) Test_ActivityTaskDoesNotExist_ActivityNotRunning() {
 		request,
 		s.shardContext,
 		s.workflowConsistencyChecker,
+		nil,
 	)
 	s.Error(err)
 	s.EqualValues(consts.ErrActivityTaskNotFound, err)
@@ -218,6 +221,7 @@ func (s *workflowSuite) Test_ActivityTaskDoesNotExist_TokenVersionDoesNotMatchAc
 		request,
 		s.shardContext,
 		s.workflowConsistencyChecker,
+		nil,
 	)
 	s.Error(err)
 	s.EqualValues(consts.ErrActivityTaskNotFound, err)
@@ -241,6 +245,7 @@ func (s *workflowSuite) Test_ActivityTaskDoesNotExist_TokenVersionNonZeroAndAtte
 		request,
 		s.shardContext,
 		s.workflowConsistencyChecker,
+		nil,
 	)
 	s.Error(err)
 	s.EqualValues(consts.ErrActivityTaskNotFound, err)
@@ -276,6 +281,7 @@ func (s *workflowSuite) Test_LastHeartBeatDetailsExist_UpdatesMutableState() {
 		request,
 		s.shardContext,
 		s.workflowConsistencyChecker,
+		nil,
 	)
 
 	s.NoError(err)
@@ -299,6 +305,7 @@ func (s *workflowSuite) Test_RetryActivityFailsWithAnError_WillReturnTheError()
 		request,
 		s.shardContext,
 		s.workflowConsistencyChecker,
+		nil,
 	)
 
 	s.Error(err)
@@ -329,7 +336,7 @@ func (s *workflowSuite) Test_NoMoreRetriesAndMutableStateHasNoPendingTasks_WillR
 	s.currentMutableState.EXPECT().AddWorkflowTaskScheduledEvent(false, enumsspb.WORKFLOW_TASK_TYPE_NORMAL)
 	s.workflowContext.EXPECT().UpdateWorkflowExecutionAsActive(ctx, s.shardContext).Return(nil)
 
-	_, err := Invoke(ctx, request, s.shardContext, s.workflowConsistencyChecker)
+	_, err := Invoke(ctx, request, s.shardContext, s.workflowConsistencyChecker, nil)
 
 	s.NoError(err)
 }
@@ -360,6 +367,7 @@ func (s *workflowSuite) Test_AttemptToAddActivityTaskFailedEventFails_ReturnErro
 		request,
 		s.shardContext,
 		s.workflowConsistencyChecker,
+		nil,
 	)
 
 	s.Error(err)
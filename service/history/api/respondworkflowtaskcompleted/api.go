@@ -43,7 +43,6 @@ import (
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/collection"
 	"go.temporal.io/server/common/definition"
-	"go.temporal.io/server/common/failure"
 	"go.temporal.io/server/common/locks"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -424,6 +423,12 @@ func (handler *WorkflowTaskCompletedHandler) Invoke(
 			request.GetIdentity(),
 		)
 
+		// Workflows whose history is getting close to its limits are flagged, have their workflow task failed,
+		// or are continued-as-new by the server, according to the namespace continue-as-new policy.
+		if err := workflowTaskHandler.enforceContinueAsNewPolicy(ctx); err != nil {
+			return nil, err
+		}
+
 		// If the Workflow completed itself, but there are still accepted
 		// (but not completed) Updates, they need to be aborted.
 		// Reason is always "WorkflowCompleted" because accepted Updates
@@ -461,7 +466,8 @@ func (handler *WorkflowTaskCompletedHandler) Invoke(
 			tag.WorkflowID(token.GetWorkflowId()),
 			tag.WorkflowRunID(token.GetRunId()),
 			tag.WorkflowNamespaceID(namespaceEntry.ID().String()))
-		if currentWorkflowTask.Attempt > 1 && wtFailedCause.failedCause != enumspb.WORKFLOW_TASK_FAILED_CAUSE_UNHANDLED_COMMAND {
+		if currentWorkflowTask.Attempt > 1 &&
+			wtFailedCause.failedCause != enumspb.WORKFLOW_TASK_FAILED_CAUSE_UNHANDLED_COMMAND {
 			// drop this workflow task if it keeps failing. This will cause the workflow task to timeout and get retried after timeout.
 			return nil, serviceerror.NewInvalidArgument(wtFailedCause.Message())
 		}
@@ -518,6 +524,15 @@ func (handler *WorkflowTaskCompletedHandler) Invoke(
 	//  which means that server always bypass task generation if WFT didn't fail.
 	//  ReturnNewWorkflowTask flag needs to be removed.
 
+	if ms.IsWorkflowExecutionPaused() {
+		// A paused workflow gets no new workflow task until it is unpaused. Admitted updates wait in the registry,
+		// and a normal workflow task goes through a transfer task, which is regenerated on unpause.
+		bypassTaskGeneration = false
+		if newWorkflowTaskType == enumsspb.WORKFLOW_TASK_TYPE_SPECULATIVE {
+			newWorkflowTaskType = enumsspb.WORKFLOW_TASK_TYPE_UNSPECIFIED
+		}
+	}
+
 	if newWorkflowTaskType == enumsspb.WORKFLOW_TASK_TYPE_SPECULATIVE && !bypassTaskGeneration {
 		// If task generation can't be bypassed (i.e. WFT has failed),
 		// WFT must be created as Normal because speculative WFT by nature skips task generation.
@@ -1021,7 +1036,7 @@ func failWorkflowTask(
 	wtFailedEvent, err := mutableState.AddWorkflowTaskFailedEvent(
 		workflowTask,
 		wtFailedCause.failedCause,
-		failure.NewServerFailure(wtFailedCause.Message(), false),
+		wtFailedCause.Failure(),
 		request.GetIdentity(),
 		nil,
 		request.GetBinaryChecksum(),
@@ -48,12 +48,16 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/failure"
 	"go.temporal.io/server/common/locks"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/common/tasktoken"
 	"go.temporal.io/server/common/testing/historyrequire"
 	"go.temporal.io/server/common/testing/protorequire"
@@ -532,6 +536,124 @@ func (s *WorkflowTaskCompletedHandlerSuite) TestHandleBufferedQueries() {
 	})
 }
 
+func (s *WorkflowTaskCompletedHandlerSuite) TestContinueAsNewPolicy() {
+	const saName = "CustomBoolField"
+
+	setPolicy := func(tv *testvars.TestVars, autoContinueAsNew bool) {
+		config := s.mockShard.GetConfig()
+		// The workflow has at least 4 events when its workflow task completes, well above all thresholds.
+		config.HistoryCountLimitError = dynamicconfig.GetIntPropertyFnFilteredByNamespace(10)
+		config.ContinueAsNewPolicyWarnThreshold = dynamicconfig.GetFloatPropertyFnFilteredByNamespace(0.1)
+		config.ContinueAsNewPolicyWarnSearchAttribute = dynamicconfig.GetStringPropertyFnFilteredByNamespace(saName)
+		config.ContinueAsNewPolicyFailWorkflowTaskThreshold = dynamicconfig.GetFloatPropertyFnFilteredByNamespace(0.2)
+		if autoContinueAsNew {
+			config.ContinueAsNewPolicyAutoContinueAsNewThreshold = dynamicconfig.GetFloatPropertyFnFilteredByNamespace(0.2)
+			config.ContinueAsNewPolicyAutoContinueAsNewWorkflowTypes = dynamicconfig.GetTypedPropertyFnFilteredByNamespace(
+				[]string{tv.WorkflowType().GetName()},
+			)
+		}
+		s.mockShard.Resource.SearchAttributesMapperProvider.EXPECT().GetMapper(gomock.Any()).Return(nil, nil).AnyTimes()
+	}
+	captureUpdateRequest := func() *persistence.UpdateWorkflowExecutionRequest {
+		var captured *persistence.UpdateWorkflowExecutionRequest
+		s.mockExecutionMgr.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error) {
+			*captured = *request
+			return tests.UpdateWorkflowExecutionResponse, nil
+		}).MaxTimes(1)
+		captured = &persistence.UpdateWorkflowExecutionRequest{}
+		return captured
+	}
+	assertSearchAttribute := func(request *persistence.UpdateWorkflowExecutionRequest) {
+		value, err := searchattribute.EncodeValue(true, enumspb.INDEXED_VALUE_TYPE_BOOL)
+		s.NoError(err)
+		s.ProtoEqual(value, request.UpdateWorkflowMutation.ExecutionInfo.SearchAttributes[saName])
+	}
+
+	s.Run("Fail workflow task", func() {
+		tv := testvars.New(s.T())
+		tv = tv.WithRunID(tv.Any().RunID())
+		s.mockNamespaceCache.EXPECT().GetNamespaceByID(tv.NamespaceID()).Return(tv.Namespace(), nil).AnyTimes()
+		_, serializedTaskToken := s.createStartedWorkflowWithWorkflowTask(tv, 1)
+		setPolicy(tv, false)
+		request := captureUpdateRequest()
+
+		_, err := s.workflowTaskCompletedHandler.Invoke(context.Background(), &historyservice.RespondWorkflowTaskCompletedRequest{
+			NamespaceId: tv.NamespaceID().String(),
+			CompleteRequest: &workflowservice.RespondWorkflowTaskCompletedRequest{
+				TaskToken: serializedTaskToken,
+				Identity:  tv.Any().String(),
+			},
+		})
+		var invalidArgument *serviceerror.InvalidArgument
+		s.ErrorAs(err, &invalidArgument)
+
+		s.NotNil(request.UpdateWorkflowMutation.ExecutionInfo, "workflow must be updated")
+		events := request.UpdateWorkflowEvents[0].Events
+		// The next attempt is transient and has no events.
+		s.EqualHistoryEvents(`
+  4 WorkflowTaskFailed`, events)
+		s.Equal(
+			enumspb.WORKFLOW_TASK_FAILED_CAUSE_FORCE_CLOSE_COMMAND,
+			events[0].GetWorkflowTaskFailedEventAttributes().GetCause(),
+		)
+		s.Equal(
+			failure.ContinueAsNewRequiredFailureType,
+			events[0].GetWorkflowTaskFailedEventAttributes().GetFailure().GetApplicationFailureInfo().GetType(),
+		)
+		// The search attribute survives the reload of the mutable state on the fail path.
+		assertSearchAttribute(request)
+	})
+
+	s.Run("Fail workflow task on a later attempt", func() {
+		tv := testvars.New(s.T())
+		tv = tv.WithRunID(tv.Any().RunID())
+		s.mockNamespaceCache.EXPECT().GetNamespaceByID(tv.NamespaceID()).Return(tv.Namespace(), nil).AnyTimes()
+		_, serializedTaskToken := s.createStartedWorkflowWithWorkflowTask(tv, 2)
+		setPolicy(tv, false)
+		request := captureUpdateRequest()
+
+		_, err := s.workflowTaskCompletedHandler.Invoke(context.Background(), &historyservice.RespondWorkflowTaskCompletedRequest{
+			NamespaceId: tv.NamespaceID().String(),
+			CompleteRequest: &workflowservice.RespondWorkflowTaskCompletedRequest{
+				TaskToken: serializedTaskToken,
+				Identity:  tv.Any().String(),
+			},
+		})
+		// The workflow task is dropped and retried after it times out, no failure is recorded.
+		var invalidArgument *serviceerror.InvalidArgument
+		s.ErrorAs(err, &invalidArgument)
+
+		s.Nil(request.UpdateWorkflowMutation.ExecutionInfo, "workflow must not be updated")
+	})
+
+	s.Run("Auto continue-as-new", func() {
+		tv := testvars.New(s.T())
+		tv = tv.WithRunID(tv.Any().RunID())
+		s.mockNamespaceCache.EXPECT().GetNamespaceByID(tv.NamespaceID()).Return(tv.Namespace(), nil).AnyTimes()
+		s.mockNamespaceCache.EXPECT().GetNamespace(tv.NamespaceName()).Return(tv.Namespace(), nil).AnyTimes()
+		_, serializedTaskToken := s.createStartedWorkflowWithWorkflowTask(tv, 1)
+		setPolicy(tv, true)
+		request := captureUpdateRequest()
+
+		_, err := s.workflowTaskCompletedHandler.Invoke(context.Background(), &historyservice.RespondWorkflowTaskCompletedRequest{
+			NamespaceId: tv.NamespaceID().String(),
+			CompleteRequest: &workflowservice.RespondWorkflowTaskCompletedRequest{
+				TaskToken: serializedTaskToken,
+				Identity:  tv.Any().String(),
+			},
+		})
+		s.NoError(err)
+
+		s.NotNil(request.UpdateWorkflowMutation.ExecutionInfo, "workflow must be updated")
+		s.EqualHistoryEvents(`
+  4 WorkflowTaskCompleted
+  5 WorkflowExecutionContinuedAsNew`, request.UpdateWorkflowEvents[0].Events)
+		s.NotNil(request.NewWorkflowSnapshot)
+		s.EqualHistoryEvents(`
+  1 WorkflowExecutionStarted`, request.NewWorkflowEvents[0].Events)
+	})
+}
+
 func (s *WorkflowTaskCompletedHandlerSuite) createStartedWorkflow(tv *testvars.TestVars) historyi.WorkflowContext {
 	ms := workflow.TestLocalMutableState(s.workflowTaskCompletedHandler.shardContext, s.mockEventsCache, tv.Namespace(),
 		tv.WorkflowID(), tv.RunID(), log.NewTestLogger())
@@ -557,9 +679,10 @@ func (s *WorkflowTaskCompletedHandlerSuite) createStartedWorkflow(tv *testvars.T
 		},
 	)
 
+	state := workflow.TestCloneToProto(ms)
 	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
 		func(ctx context.Context, request *persistence.GetWorkflowExecutionRequest) (*persistence.GetWorkflowExecutionResponse, error) {
-			return &persistence.GetWorkflowExecutionResponse{State: workflow.TestCloneToProto(ms)}, nil
+			return &persistence.GetWorkflowExecutionResponse{State: common.CloneProto(state)}, nil
 		}).AnyTimes()
 
 	// Create WF context in the cache and load MS for it.
@@ -581,6 +704,101 @@ func (s *WorkflowTaskCompletedHandlerSuite) createStartedWorkflow(tv *testvars.T
 	return wfContext
 }
 
+// createStartedWorkflowWithWorkflowTask creates a workflow with a started workflow task at the given attempt. Earlier
+// attempts are recorded as failed.
+func (s *WorkflowTaskCompletedHandlerSuite) createStartedWorkflowWithWorkflowTask(
+	tv *testvars.TestVars,
+	attempt int32,
+) (historyi.WorkflowContext, []byte) {
+	ms := workflow.TestLocalMutableState(s.workflowTaskCompletedHandler.shardContext, s.mockEventsCache, tv.Namespace(),
+		tv.WorkflowID(), tv.RunID(), log.NewTestLogger())
+
+	startEvent, err := ms.AddWorkflowExecutionStartedEvent(
+		tv.WorkflowExecution(),
+		&historyservice.StartWorkflowExecutionRequest{
+			Attempt:     1,
+			NamespaceId: tv.NamespaceID().String(),
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+				WorkflowId:               tv.WorkflowID(),
+				WorkflowType:             tv.WorkflowType(),
+				TaskQueue:                tv.TaskQueue(),
+				Input:                    tv.Any().Payloads(),
+				WorkflowExecutionTimeout: tv.Any().InfiniteTimeout(),
+				WorkflowRunTimeout:       tv.Any().InfiniteTimeout(),
+				WorkflowTaskTimeout:      tv.Any().InfiniteTimeout(),
+				Identity:                 tv.ClientIdentity(),
+			},
+		},
+	)
+	s.NoError(err)
+	s.mockEventsCache.EXPECT().GetEvent(gomock.Any(), gomock.Any(), gomock.Any(), common.FirstEventID, gomock.Any()).Return(startEvent, nil).AnyTimes()
+
+	var wt *historyi.WorkflowTaskInfo
+	for range attempt {
+		wt, err = ms.AddWorkflowTaskScheduledEvent(false, enumsspb.WORKFLOW_TASK_TYPE_NORMAL)
+		s.NoError(err)
+		_, wt, err = ms.AddWorkflowTaskStartedEvent(
+			wt.ScheduledEventID,
+			tv.RunID(),
+			tv.TaskQueue(),
+			tv.Any().String(),
+			nil,
+			nil,
+			nil,
+			false,
+		)
+		s.NoError(err)
+		if wt.Attempt < attempt {
+			_, err = ms.AddWorkflowTaskFailedEvent(
+				wt,
+				enumspb.WORKFLOW_TASK_FAILED_CAUSE_UNHANDLED_COMMAND,
+				nil,
+				tv.Any().String(),
+				nil,
+				"",
+				"",
+				"",
+				0,
+			)
+			s.NoError(err)
+			// Normally done when the transaction closes, scheduling a transient workflow task needs it.
+			currentVersionHistory, err := versionhistory.GetCurrentVersionHistory(ms.GetExecutionInfo().GetVersionHistories())
+			s.NoError(err)
+			s.NoError(versionhistory.AddOrUpdateVersionHistoryItem(
+				currentVersionHistory,
+				versionhistory.NewVersionHistoryItem(ms.GetNextEventID()-1, ms.GetCurrentVersion()),
+			))
+		}
+	}
+
+	state := workflow.TestCloneToProto(ms)
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *persistence.GetWorkflowExecutionRequest) (*persistence.GetWorkflowExecutionResponse, error) {
+			return &persistence.GetWorkflowExecutionResponse{State: common.CloneProto(state)}, nil
+		}).AnyTimes()
+
+	wfContext, release, err := s.workflowCache.GetOrCreateWorkflowExecution(
+		metrics.AddMetricsContext(context.Background()),
+		s.mockShard,
+		tv.NamespaceID(),
+		tv.WorkflowExecution(),
+		locks.PriorityHigh,
+	)
+	s.NoError(err)
+	release(nil)
+
+	taskToken := &tokenspb.Task{
+		Attempt:          wt.Attempt,
+		NamespaceId:      tv.NamespaceID().String(),
+		WorkflowId:       tv.WorkflowID(),
+		RunId:            tv.RunID(),
+		ScheduledEventId: wt.ScheduledEventID,
+	}
+	serializedTaskToken, err := taskToken.Marshal()
+	s.NoError(err)
+	return wfContext, serializedTaskToken
+}
+
 func (s *WorkflowTaskCompletedHandlerSuite) createSentUpdate(tv *testvars.TestVars, wfContext historyi.WorkflowContext) (*protocolpb.Message, *update.Update, []byte) {
 	ctx := context.Background()
 
@@ -0,0 +1,182 @@
+// The MIT License
+//
+// Copyright (c) 2025 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package respondworkflowtaskcompleted
+
+import (
+	"context"
+	"slices"
+
+	commandpb "go.temporal.io/api/command/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/failure"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/service/history/workflow"
+)
+
+type continueAsNewPolicyAction int
+
+const (
+	continueAsNewPolicyActionNone continueAsNewPolicyAction = iota
+	continueAsNewPolicyActionWarn
+	continueAsNewPolicyActionFailWorkflowTask
+	continueAsNewPolicyActionContinueAsNew
+)
+
+func (a continueAsNewPolicyAction) String() string {
+	switch a {
+	case continueAsNewPolicyActionWarn:
+		return "warn"
+	case continueAsNewPolicyActionFailWorkflowTask:
+		return "fail_workflow_task"
+	case continueAsNewPolicyActionContinueAsNew:
+		return "continue_as_new"
+	default:
+		return "none"
+	}
+}
+
+type continueAsNewPolicyThresholds struct {
+	warn                  float64
+	failWorkflowTask      float64
+	autoContinueAsNew     float64
+	autoContinueAsNewType bool
+}
+
+// decide returns the strongest action of the policy that applies at the given usage. A warn threshold of 0 disables
+// the whole policy, other thresholds of 0 disable only their own action.
+func (t continueAsNewPolicyThresholds) decide(usage float64) continueAsNewPolicyAction {
+	if t.warn <= 0 || usage < t.warn {
+		return continueAsNewPolicyActionNone
+	}
+	if t.autoContinueAsNewType && t.autoContinueAsNew > 0 && usage >= t.autoContinueAsNew {
+		return continueAsNewPolicyActionContinueAsNew
+	}
+	if t.failWorkflowTask > 0 && usage >= t.failWorkflowTask {
+		return continueAsNewPolicyActionFailWorkflowTask
+	}
+	return continueAsNewPolicyActionWarn
+}
+
+// enforceContinueAsNewPolicy applies the namespace continue-as-new policy to a workflow task that completed without
+// closing the workflow. Depending on how close the history is to its hard limits it fails the workflow task or
+// continues-as-new the workflow with the input of the current run. The search attribute of the warn stage is set by
+// the mutable state itself when the transaction closes.
+func (handler *workflowTaskCompletedHandler) enforceContinueAsNewPolicy(ctx context.Context) error {
+	if handler.stopProcessing || handler.workflowTaskCompletedID == 0 || !handler.mutableState.IsWorkflowExecutionRunning() {
+		return nil
+	}
+
+	namespaceName := handler.mutableState.GetNamespaceEntry().Name().String()
+	executionInfo := handler.mutableState.GetExecutionInfo()
+	thresholds := continueAsNewPolicyThresholds{
+		warn:              handler.config.ContinueAsNewPolicyWarnThreshold(namespaceName),
+		failWorkflowTask:  handler.config.ContinueAsNewPolicyFailWorkflowTaskThreshold(namespaceName),
+		autoContinueAsNew: handler.config.ContinueAsNewPolicyAutoContinueAsNewThreshold(namespaceName),
+		autoContinueAsNewType: slices.Contains(
+			handler.config.ContinueAsNewPolicyAutoContinueAsNewWorkflowTypes(namespaceName),
+			executionInfo.GetWorkflowTypeName(),
+		),
+	}
+	usage := workflow.HistoryLimitUsage(
+		handler.mutableState.GetNextEventID()-1,
+		handler.mutableState.GetHistorySize(),
+		handler.config.HistoryCountLimitError(namespaceName),
+		handler.config.HistorySizeLimitError(namespaceName),
+	)
+
+	action := thresholds.decide(usage)
+	if action == continueAsNewPolicyActionNone {
+		return nil
+	}
+	if action == continueAsNewPolicyActionContinueAsNew && handler.hasBufferedEventsOrMessages {
+		// Buffered events would be lost by continuing-as-new now, the next workflow task will do it.
+		action = continueAsNewPolicyActionWarn
+	}
+
+	metrics.ContinueAsNewPolicyCounter.With(handler.metricsHandler).Record(
+		1,
+		metrics.NamespaceTag(namespaceName),
+		metrics.StringTag("action", action.String()),
+	)
+
+	switch action {
+	case continueAsNewPolicyActionFailWorkflowTask:
+		// The server forces the failure, the failure type tells it apart from other forced failures. Like any
+		// other failure, later attempts are dropped and retried after the workflow task timeout.
+		handler.workflowTaskFailedCause = &workflowTaskFailedCause{
+			failedCause: enumspb.WORKFLOW_TASK_FAILED_CAUSE_FORCE_CLOSE_COMMAND,
+			causeErr:    serviceerror.NewFailedPrecondition(common.FailureReasonHistoryContinueAsNewRequired),
+			failure:     failure.NewContinueAsNewRequiredFailure(common.FailureReasonHistoryContinueAsNewRequired),
+		}
+		handler.stopProcessing = true
+		return nil
+	case continueAsNewPolicyActionContinueAsNew:
+		return handler.autoContinueAsNew(ctx)
+	default:
+		return nil
+	}
+}
+
+func (handler *workflowTaskCompletedHandler) autoContinueAsNew(ctx context.Context) error {
+	// The new run starts from the input, memo and search attributes the current run was started with.
+	startEvent, err := handler.mutableState.GetStartEvent(ctx)
+	if err != nil {
+		return err
+	}
+	startAttr := startEvent.GetWorkflowExecutionStartedEventAttributes()
+
+	var parentNamespace namespace.Name
+	if handler.mutableState.HasParentExecution() {
+		parentNamespaceID := namespace.ID(handler.mutableState.GetExecutionInfo().ParentNamespaceId)
+		parentNamespaceEntry, err := handler.namespaceRegistry.GetNamespaceByID(parentNamespaceID)
+		if err == nil {
+			parentNamespace = parentNamespaceEntry.Name()
+		}
+	}
+
+	attr := &commandpb.ContinueAsNewWorkflowExecutionCommandAttributes{
+		WorkflowType:        startAttr.GetWorkflowType(),
+		TaskQueue:           startAttr.GetTaskQueue(),
+		Input:               startAttr.GetInput(),
+		WorkflowRunTimeout:  startAttr.GetWorkflowRunTimeout(),
+		WorkflowTaskTimeout: startAttr.GetWorkflowTaskTimeout(),
+		Header:              startAttr.GetHeader(),
+		Memo:                startAttr.GetMemo(),
+		SearchAttributes:    startAttr.GetSearchAttributes(),
+	}
+	_, newMutableState, err := handler.mutableState.AddContinueAsNewEvent(
+		ctx,
+		handler.workflowTaskCompletedID,
+		handler.workflowTaskCompletedID,
+		parentNamespace,
+		attr,
+	)
+	if err != nil {
+		return err
+	}
+	handler.newMutableState = newMutableState
+	return nil
+}
@@ -0,0 +1,85 @@
+// The MIT License
+//
+// Copyright (c) 2025 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package respondworkflowtaskcompleted
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContinueAsNewPolicyThresholds_Decide(t *testing.T) {
+	for _, c := range []struct {
+		Name       string
+		Thresholds continueAsNewPolicyThresholds
+		Usage      float64
+		Expected   continueAsNewPolicyAction
+	}{
+		{
+			Name:       "Policy disabled",
+			Thresholds: continueAsNewPolicyThresholds{failWorkflowTask: 0.5},
+			Usage:      0.9,
+			Expected:   continueAsNewPolicyActionNone,
+		},
+		{
+			Name:       "Below warn threshold",
+			Thresholds: continueAsNewPolicyThresholds{warn: 0.5, failWorkflowTask: 0.8},
+			Usage:      0.4,
+			Expected:   continueAsNewPolicyActionNone,
+		},
+		{
+			Name:       "Warn",
+			Thresholds: continueAsNewPolicyThresholds{warn: 0.5, failWorkflowTask: 0.8},
+			Usage:      0.5,
+			Expected:   continueAsNewPolicyActionWarn,
+		},
+		{
+			Name:       "Fail workflow task",
+			Thresholds: continueAsNewPolicyThresholds{warn: 0.5, failWorkflowTask: 0.8},
+			Usage:      0.9,
+			Expected:   continueAsNewPolicyActionFailWorkflowTask,
+		},
+		{
+			Name:       "Auto continue-as-new not opted in",
+			Thresholds: continueAsNewPolicyThresholds{warn: 0.5, failWorkflowTask: 0.8, autoContinueAsNew: 0.7},
+			Usage:      0.9,
+			Expected:   continueAsNewPolicyActionFailWorkflowTask,
+		},
+		{
+			Name:       "Auto continue-as-new",
+			Thresholds: continueAsNewPolicyThresholds{warn: 0.5, failWorkflowTask: 0.8, autoContinueAsNew: 0.7, autoContinueAsNewType: true},
+			Usage:      0.9,
+			Expected:   continueAsNewPolicyActionContinueAsNew,
+		},
+		{
+			Name:       "Auto continue-as-new disabled",
+			Thresholds: continueAsNewPolicyThresholds{warn: 0.5, autoContinueAsNewType: true},
+			Usage:      0.9,
+			Expected:   continueAsNewPolicyActionWarn,
+		},
+	} {
+		t.Run(c.Name, func(t *testing.T) {
+			assert.Equal(t, c.Expected, c.Thresholds.decide(c.Usage))
+		})
+	}
+}
@@ -45,6 +45,7 @@ import (
 	"go.temporal.io/server/common/collection"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/enums"
+	"go.temporal.io/server/common/failure"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
@@ -103,6 +104,9 @@ type (
 		failedCause       enumspb.WorkflowTaskFailedCause
 		causeErr          error
 		terminateWorkflow bool // when true, this task failure should be considered terminal to its workflow
+		// failure, when set, is recorded in the WorkflowTaskFailed event instead of a server failure. Such failures
+		// are recorded on every attempt instead of letting the workflow task time out.
+		failure *failurepb.Failure
 	}
 
 	workflowTaskResponseMutation func(
@@ -1473,6 +1477,14 @@ func newWorkflowTaskFailedCause(failedCause enumspb.WorkflowTaskFailedCause, cau
 	}
 }
 
+// Failure returns the failure to record in the WorkflowTaskFailed event.
+func (c *workflowTaskFailedCause) Failure() *failurepb.Failure {
+	if c.failure != nil {
+		return c.failure
+	}
+	return failure.NewServerFailure(c.Message(), false)
+}
+
 func (c *workflowTaskFailedCause) Message() string {
 
 	if c.causeErr == nil {
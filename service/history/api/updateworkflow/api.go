@@ -192,6 +192,14 @@ func (u *Updater) ApplyRequest(
 			CreateWorkflowTask: false,
 		}, nil
 	}
+	// While the workflow is paused, no workflow task is dispatched. The update stays admitted in the registry and
+	// unpausing schedules a workflow task for it.
+	if ms.IsWorkflowExecutionPaused() {
+		return &api.UpdateWorkflowAction{
+			Noop:               true,
+			CreateWorkflowTask: false,
+		}, nil
+	}
 
 	// This will try not to add an event but will create speculative WT in mutable state.
 	newWorkflowTask, err := ms.AddWorkflowTaskScheduledEvent(false, enumsspb.WORKFLOW_TASK_TYPE_SPECULATIVE)
@@ -277,7 +285,15 @@ func (u *Updater) OnSuccess(
 	if err != nil {
 		return nil, err
 	}
-	resp := u.createResponse(u.wfKey, status.Outcome, status.Stage)
+	outcome := status.Outcome
+	if offloader := u.shardCtx.GetPayloadOffloader(); offloader.Enabled() && outcome != nil {
+		// The outcome is shared with the update registry, re-hydrate a copy of it.
+		outcome = common.CloneProto(outcome)
+		if err := offloader.Rehydrate(ctx, namespaceID, outcome); err != nil {
+			return nil, err
+		}
+	}
+	resp := u.createResponse(u.wfKey, outcome, status.Stage)
 	return resp, nil
 }
 
@@ -0,0 +1,123 @@
+// The MIT License
+//
+// Copyright (c) 2025 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package updateworkflowcallback
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.temporal.io/api/serviceerror"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/locks"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/components/callbacks"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/hsm"
+	historyi "go.temporal.io/server/service/history/interfaces"
+)
+
+func Invoke(
+	ctx context.Context,
+	request *historyservice.UpdateWorkflowCallbackRequest,
+	shardContext historyi.ShardContext,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+) (_ *historyservice.UpdateWorkflowCallbackResponse, retError error) {
+	if _, err := api.GetActiveNamespace(shardContext, namespace.ID(request.GetNamespaceId())); err != nil {
+		return nil, err
+	}
+	if request.GetCallbackId() == "" {
+		return nil, serviceerror.NewInvalidArgument("callback ID is not set on request.")
+	}
+	if request.GetAction() == nil {
+		return nil, serviceerror.NewInvalidArgument("action is not set on request.")
+	}
+
+	workflowLease, err := workflowConsistencyChecker.GetWorkflowLease(
+		ctx,
+		nil,
+		definition.NewWorkflowKey(
+			request.GetNamespaceId(),
+			request.GetExecution().GetWorkflowId(),
+			request.GetExecution().GetRunId(),
+		),
+		locks.PriorityHigh,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { workflowLease.GetReleaseFn()(retError) }()
+
+	mutableState := workflowLease.GetMutableState()
+	coll := callbacks.MachineCollection(mutableState.HSM())
+	node, err := coll.Node(request.GetCallbackId())
+	if err != nil {
+		if errors.Is(err, hsm.ErrStateMachineNotFound) {
+			return nil, serviceerror.NewNotFound(fmt.Sprintf("callback %q not found", request.GetCallbackId()))
+		}
+		return nil, err
+	}
+
+	switch action := request.GetAction().(type) {
+	case *historyservice.UpdateWorkflowCallbackRequest_Retry:
+		err = hsm.MachineTransition(node, func(callback callbacks.Callback) (hsm.TransitionOutput, error) {
+			return callbacks.TransitionRetried.Apply(callback, callbacks.EventRetried{})
+		})
+	case *historyservice.UpdateWorkflowCallbackRequest_SkipReason:
+		err = hsm.MachineTransition(node, func(callback callbacks.Callback) (hsm.TransitionOutput, error) {
+			return callbacks.TransitionSkipped.Apply(callback, callbacks.EventSkipped{Reason: action.SkipReason})
+		})
+	case *historyservice.UpdateWorkflowCallbackRequest_Url:
+		err = callbacks.UpdateURL(node, action.Url)
+	default:
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("unknown callback action: %T", action))
+	}
+	if err != nil {
+		if errors.Is(err, hsm.ErrInvalidTransition) {
+			return nil, serviceerror.NewFailedPrecondition(err.Error())
+		}
+		return nil, err
+	}
+
+	callback, err := coll.Data(request.GetCallbackId())
+	if err != nil {
+		return nil, err
+	}
+	// Clone since mutable state may be mutated once the lease is released, before the response is marshaled.
+	info := common.CloneProto(callback.CallbackInfo)
+
+	if mutableState.GetExecutionState().GetState() == enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED {
+		// Callbacks are typically delivered after the workflow is closed, UpdateWorkflowExecutionAsActive only
+		// updates the current run.
+		err = workflowLease.GetContext().SubmitClosedWorkflowSnapshot(ctx, shardContext, historyi.TransactionPolicyActive)
+	} else {
+		err = workflowLease.GetContext().UpdateWorkflowExecutionAsActive(ctx, shardContext)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &historyservice.UpdateWorkflowCallbackResponse{Callback: info}, nil
+}
@@ -0,0 +1,64 @@
+// The MIT License
+//
+// Copyright (c) 2025 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package updateworkflowcallback
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/service/history/api"
+	historyi "go.temporal.io/server/service/history/interfaces"
+	"go.temporal.io/server/service/history/tests"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_UpdateWorkflowCallback_NamespaceNotActive(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockRegistry := namespace.NewMockRegistry(ctrl)
+	mockRegistry.EXPECT().GetNamespaceByID(tests.NamespaceID).Return(tests.GlobalStandbyNamespaceEntry, nil)
+	mockClusterMetadata := cluster.NewMockMetadata(ctrl)
+	mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	shardContext := historyi.NewMockShardContext(ctrl)
+	shardContext.EXPECT().GetNamespaceRegistry().Return(mockRegistry)
+	shardContext.EXPECT().GetClusterMetadata().Return(mockClusterMetadata).AnyTimes()
+
+	// the workflow must not be loaded or updated in the standby cluster
+	consistencyChecker := api.NewMockWorkflowConsistencyChecker(ctrl)
+
+	_, err := Invoke(context.Background(), &historyservice.UpdateWorkflowCallbackRequest{
+		NamespaceId: tests.NamespaceID.String(),
+		Execution:   &commonpb.WorkflowExecution{WorkflowId: uuid.NewString(), RunId: uuid.NewString()},
+		CallbackId:  "callback",
+		Action:      &historyservice.UpdateWorkflowCallbackRequest_Url{Url: "http://example.com"},
+	}, shardContext, consistencyChecker)
+	var notActive *serviceerror.NamespaceNotActive
+	require.ErrorAs(t, err, &notActive)
+}
@@ -0,0 +1,151 @@
+// The MIT License
+//
+// Copyright (c) 2025 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package circuitbreakerpool
+
+import (
+	"fmt"
+
+	"github.com/sony/gobreaker"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/circuitbreaker"
+	"go.temporal.io/server/service/history/configs"
+)
+
+type (
+	// ActivityRetryBudgetKey identifies an activity retry budget. Budgets are shared by all workflows of a
+	// namespace that run the same activity type on the same task queue.
+	ActivityRetryBudgetKey struct {
+		Namespace    string
+		TaskQueue    string
+		ActivityType string
+	}
+
+	// ActivityRetryCircuitBreakerPool tracks the outcome of activity attempts per ActivityRetryBudgetKey and
+	// trips once too many consecutive attempts failed, so that retries of a failing activity type can be
+	// deferred instead of hammering an unavailable dependency. Budgets are tracked per history host in a
+	// bounded cache; a budget that is evicted or expires starts over from a closed circuit breaker.
+	// A nil pool disables retry budgets.
+	ActivityRetryCircuitBreakerPool struct {
+		breakers cache.Cache
+		config   *configs.Config
+	}
+
+	activityRetryCircuitBreaker struct {
+		circuitbreaker.TwoStepCircuitBreaker
+		cancel func()
+	}
+)
+
+func ActivityRetryCircuitBreakerPoolProvider(
+	config *configs.Config,
+) *ActivityRetryCircuitBreakerPool {
+	return &ActivityRetryCircuitBreakerPool{
+		breakers: cache.New(config.ActivityRetryBudgetCacheMaxSize(), &cache.Options{
+			TTL: config.ActivityRetryBudgetCacheTTL(),
+			OnEvict: func(val any) {
+				val.(*activityRetryCircuitBreaker).cancel()
+			},
+		}),
+		config: config,
+	}
+}
+
+// RecordAttempt records the outcome of a single activity attempt against the budget of the given key.
+// Outcomes are not recorded while the circuit breaker is open.
+func (p *ActivityRetryCircuitBreakerPool) RecordAttempt(key ActivityRetryBudgetKey, success bool) {
+	if !p.enabled(key) {
+		return
+	}
+	cb := p.getOrCreate(key)
+	// Put refreshes the TTL of the entry. It does not evict the value it replaces, so a circuit breaker
+	// created concurrently for the same key has to release its subscription here.
+	if existing := p.breakers.Put(key, cb); existing != nil && existing != cb {
+		existing.(*activityRetryCircuitBreaker).cancel()
+	}
+	done, err := cb.Allow()
+	if err != nil {
+		return
+	}
+	done(success)
+}
+
+// IsOpen returns true if retries for the given key should be deferred.
+func (p *ActivityRetryCircuitBreakerPool) IsOpen(key ActivityRetryBudgetKey) bool {
+	if !p.enabled(key) {
+		return false
+	}
+	cb, ok := p.breakers.Get(key).(*activityRetryCircuitBreaker)
+	return ok && cb.State() == gobreaker.StateOpen
+}
+
+// State returns the state of the circuit breaker of the given key, or false if retry budgets are disabled for its
+// namespace. A key without a tracked circuit breaker is closed.
+func (p *ActivityRetryCircuitBreakerPool) State(key ActivityRetryBudgetKey) (gobreaker.State, bool) {
+	if !p.enabled(key) {
+		return gobreaker.StateClosed, false
+	}
+	if cb, ok := p.breakers.Get(key).(*activityRetryCircuitBreaker); ok {
+		return cb.State(), true
+	}
+	return gobreaker.StateClosed, true
+}
+
+func (p *ActivityRetryCircuitBreakerPool) enabled(key ActivityRetryBudgetKey) bool {
+	return p != nil && p.config.ActivityRetryBudgetFailureThreshold(key.Namespace) > 0
+}
+
+func (p *ActivityRetryCircuitBreakerPool) getOrCreate(key ActivityRetryBudgetKey) *activityRetryCircuitBreaker {
+	if cb, ok := p.breakers.Get(key).(*activityRetryCircuitBreaker); ok {
+		return cb
+	}
+	cb := p.newCircuitBreaker(key)
+	existing, err := p.breakers.PutIfNotExist(key, cb)
+	if err != nil || existing != cb {
+		cb.cancel()
+	}
+	if err != nil {
+		return cb
+	}
+	return existing.(*activityRetryCircuitBreaker)
+}
+
+func (p *ActivityRetryCircuitBreakerPool) newCircuitBreaker(key ActivityRetryBudgetKey) *activityRetryCircuitBreaker {
+	cb := circuitbreaker.NewTwoStepCircuitBreakerWithDynamicSettings(circuitbreaker.Settings{
+		Name: fmt.Sprintf(
+			"activity_retry_circuit_breaker:%s:%s:%s",
+			key.Namespace,
+			key.TaskQueue,
+			key.ActivityType,
+		),
+		ReadyToTrip: func(counts gobreaker.Counts) bool {
+			threshold := p.config.ActivityRetryBudgetFailureThreshold(key.Namespace)
+			return threshold > 0 && counts.ConsecutiveFailures >= uint32(threshold)
+		},
+	})
+	initial, cancel := p.config.ActivityRetryBudgetCircuitBreakerSettings(key.Namespace, cb.UpdateSettings)
+	cb.UpdateSettings(initial)
+	return &activityRetryCircuitBreaker{
+		TwoStepCircuitBreaker: cb,
+		cancel:                cancel,
+	}
+}
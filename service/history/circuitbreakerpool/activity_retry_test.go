@@ -0,0 +1,105 @@
+// The MIT License
+//
+// Copyright (c) 2025 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package circuitbreakerpool
+
+import (
+	"testing"
+
+	"github.com/sony/gobreaker"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/service/history/tests"
+)
+
+func TestActivityRetryCircuitBreakerPool(t *testing.T) {
+	config := tests.NewDynamicConfig()
+	pool := ActivityRetryCircuitBreakerPoolProvider(config)
+	key := ActivityRetryBudgetKey{
+		Namespace:    "test-namespace",
+		TaskQueue:    "test-task-queue",
+		ActivityType: "test-activity-type",
+	}
+	otherKey := ActivityRetryBudgetKey{
+		Namespace:    "test-namespace",
+		TaskQueue:    "test-task-queue",
+		ActivityType: "other-activity-type",
+	}
+
+	// disabled by default
+	for i := 0; i < 10; i++ {
+		pool.RecordAttempt(key, false)
+	}
+	require.False(t, pool.IsOpen(key))
+	_, enabled := pool.State(key)
+	require.False(t, enabled)
+
+	config.ActivityRetryBudgetFailureThreshold = dynamicconfig.GetIntPropertyFnFilteredByNamespace(3)
+	pool.RecordAttempt(key, false)
+	pool.RecordAttempt(key, false)
+	pool.RecordAttempt(key, true)
+	pool.RecordAttempt(key, false)
+	pool.RecordAttempt(key, false)
+	require.False(t, pool.IsOpen(key), "a successful attempt resets consecutive failures")
+	pool.RecordAttempt(key, false)
+	require.True(t, pool.IsOpen(key))
+	require.False(t, pool.IsOpen(otherKey))
+	state, enabled := pool.State(key)
+	require.True(t, enabled)
+	require.Equal(t, gobreaker.StateOpen, state)
+	state, enabled = pool.State(otherKey)
+	require.True(t, enabled)
+	require.Equal(t, gobreaker.StateClosed, state)
+
+	config.ActivityRetryBudgetFailureThreshold = dynamicconfig.GetIntPropertyFnFilteredByNamespace(0)
+	require.False(t, pool.IsOpen(key), "disabling retry budgets stops deferring retries")
+
+	var nilPool *ActivityRetryCircuitBreakerPool
+	nilPool.RecordAttempt(key, false)
+	require.False(t, nilPool.IsOpen(key))
+}
+
+func TestActivityRetryCircuitBreakerPool_Eviction(t *testing.T) {
+	config := tests.NewDynamicConfig()
+	config.ActivityRetryBudgetFailureThreshold = dynamicconfig.GetIntPropertyFnFilteredByNamespace(1)
+	config.ActivityRetryBudgetCacheMaxSize = dynamicconfig.GetIntPropertyFn(1)
+	pool := ActivityRetryCircuitBreakerPoolProvider(config)
+	key := ActivityRetryBudgetKey{
+		Namespace:    "test-namespace",
+		TaskQueue:    "test-task-queue",
+		ActivityType: "test-activity-type",
+	}
+	otherKey := ActivityRetryBudgetKey{
+		Namespace:    "test-namespace",
+		TaskQueue:    "test-task-queue",
+		ActivityType: "other-activity-type",
+	}
+
+	pool.RecordAttempt(key, false)
+	require.True(t, pool.IsOpen(key))
+	require.Equal(t, 1, pool.breakers.Size())
+
+	pool.RecordAttempt(otherKey, false)
+	require.True(t, pool.IsOpen(otherKey))
+	require.False(t, pool.IsOpen(key), "evicted budgets start over")
+	require.Equal(t, 1, pool.breakers.Size())
+}
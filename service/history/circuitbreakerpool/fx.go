@@ -34,6 +34,7 @@ import (
 
 var Module = fx.Options(
 	fx.Provide(OutboundQueueCircuitBreakerPoolProvider),
+	fx.Provide(ActivityRetryCircuitBreakerPoolProvider),
 )
 
 type OutboundQueueCircuitBreakerPool struct {
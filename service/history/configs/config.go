@@ -28,6 +28,7 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadstore"
 	"go.temporal.io/server/common/retrypolicy"
 )
 
@@ -70,6 +71,7 @@ type Config struct {
 	// Workflow reset related settings.
 	AllowResetWithPendingChildren dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	MaxAutoResetPoints            dynamicconfig.IntPropertyFnWithNamespaceFilter
+	EnableChildWorkflowProgress   dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
 	// HistoryCache settings
 	// Change of these configs require shard restart
@@ -81,6 +83,7 @@ type Config struct {
 	HistoryHostLevelCacheMaxSizeBytes     dynamicconfig.IntPropertyFn
 	HistoryCacheTTL                       dynamicconfig.DurationPropertyFn
 	HistoryCacheNonUserContextLockTimeout dynamicconfig.DurationPropertyFn
+	HistoryCacheNamespaceMaxSizeRatio     dynamicconfig.FloatPropertyFnWithNamespaceIDFilter
 	EnableHostLevelHistoryCache           dynamicconfig.BoolPropertyFn
 	EnableNexus                           dynamicconfig.BoolPropertyFn
 	EnableWorkflowExecutionTimeoutTimer   dynamicconfig.BoolPropertyFn
@@ -183,7 +186,15 @@ type Config struct {
 	OutboundQueueHostSchedulerMaxTaskRPS                dynamicconfig.FloatPropertyFnWithDestinationFilter
 	OutboundQueueCircuitBreakerSettings                 dynamicconfig.TypedSubscribableWithDestinationFilter[dynamicconfig.CircuitBreakerSettings]
 	OutboundStandbyTaskMissingEventsDiscardDelay        dynamicconfig.DurationPropertyFnWithDestinationFilter
-	OutboundStandbyTaskMissingEventsDestinationDownErr  dynamicconfig.BoolPropertyFnWithDestinationFilter
+
+	// Activity retry budget settings
+	ActivityRetryBudgetFailureThreshold                dynamicconfig.IntPropertyFnWithNamespaceFilter
+	ActivityRetryBudgetCircuitBreakerSettings          dynamicconfig.TypedSubscribableWithNamespaceFilter[dynamicconfig.CircuitBreakerSettings]
+	ActivityRetryBudgetDeferralInterval                dynamicconfig.DurationPropertyFnWithNamespaceFilter
+	ActivityRetryBudgetMaxDeferralInterval             dynamicconfig.DurationPropertyFnWithNamespaceFilter
+	ActivityRetryBudgetCacheMaxSize                    dynamicconfig.IntPropertyFn
+	ActivityRetryBudgetCacheTTL                        dynamicconfig.DurationPropertyFn
+	OutboundStandbyTaskMissingEventsDestinationDownErr dynamicconfig.BoolPropertyFnWithDestinationFilter
 
 	// ReplicatorQueueProcessor settings
 	ReplicatorProcessorMaxPollInterval                  dynamicconfig.DurationPropertyFn
@@ -195,6 +206,8 @@ type Config struct {
 	MaximumBufferedEventsBatch       dynamicconfig.IntPropertyFn
 	MaximumBufferedEventsSizeInBytes dynamicconfig.IntPropertyFn
 	MaximumSignalsPerExecution       dynamicconfig.IntPropertyFnWithNamespaceFilter
+	SignalRequestIDDedupWindow       dynamicconfig.DurationPropertyFnWithNamespaceFilter
+	SignalRequestIDDedupMaxIDs       dynamicconfig.IntPropertyFnWithNamespaceFilter
 
 	// ShardUpdateMinInterval is the minimum time interval within which the shard info can be updated.
 	ShardUpdateMinInterval dynamicconfig.DurationPropertyFn
@@ -228,6 +241,7 @@ type Config struct {
 	// Size limit related settings
 	BlobSizeLimitError                        dynamicconfig.IntPropertyFnWithNamespaceFilter
 	BlobSizeLimitWarn                         dynamicconfig.IntPropertyFnWithNamespaceFilter
+	PayloadOffloadThreshold                   dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MemoSizeLimitError                        dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MemoSizeLimitWarn                         dynamicconfig.IntPropertyFnWithNamespaceFilter
 	HistorySizeLimitError                     dynamicconfig.IntPropertyFnWithNamespaceFilter
@@ -372,6 +386,12 @@ type Config struct {
 	WorkflowExecutionMaxTotalUpdates                              dynamicconfig.IntPropertyFnWithNamespaceFilter
 	WorkflowExecutionMaxTotalUpdatesSuggestContinueAsNewThreshold dynamicconfig.FloatPropertyFnWithNamespaceFilter
 
+	ContinueAsNewPolicyWarnThreshold                  dynamicconfig.FloatPropertyFnWithNamespaceFilter
+	ContinueAsNewPolicyWarnSearchAttribute            dynamicconfig.StringPropertyFnWithNamespaceFilter
+	ContinueAsNewPolicyFailWorkflowTaskThreshold      dynamicconfig.FloatPropertyFnWithNamespaceFilter
+	ContinueAsNewPolicyAutoContinueAsNewThreshold     dynamicconfig.FloatPropertyFnWithNamespaceFilter
+	ContinueAsNewPolicyAutoContinueAsNewWorkflowTypes dynamicconfig.TypedPropertyFnWithNamespaceFilter[[]string]
+
 	SendRawHistoryBetweenInternalServices dynamicconfig.BoolPropertyFn
 	SendRawWorkflowHistory                dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
@@ -412,6 +432,7 @@ func NewConfig(
 		StartupMembershipJoinDelay:           dynamicconfig.HistoryStartupMembershipJoinDelay.Get(dc),
 		AllowResetWithPendingChildren:        dynamicconfig.AllowResetWithPendingChildren.Get(dc),
 		MaxAutoResetPoints:                   dynamicconfig.HistoryMaxAutoResetPoints.Get(dc),
+		EnableChildWorkflowProgress:          dynamicconfig.EnableChildWorkflowProgress.Get(dc),
 		DefaultWorkflowTaskTimeout:           dynamicconfig.DefaultWorkflowTaskTimeout.Get(dc),
 
 		VisibilityPersistenceMaxReadQPS:         dynamicconfig.VisibilityPersistenceMaxReadQPS.Get(dc),
@@ -435,6 +456,7 @@ func NewConfig(
 		HistoryHostLevelCacheMaxSizeBytes:     dynamicconfig.HistoryCacheHostLevelMaxSizeBytes.Get(dc),
 		HistoryCacheTTL:                       dynamicconfig.HistoryCacheTTL.Get(dc),
 		HistoryCacheNonUserContextLockTimeout: dynamicconfig.HistoryCacheNonUserContextLockTimeout.Get(dc),
+		HistoryCacheNamespaceMaxSizeRatio:     dynamicconfig.HistoryCacheNamespaceMaxSizeRatio.Get(dc),
 		EnableHostLevelHistoryCache:           dynamicconfig.EnableHostHistoryCache.Get(dc),
 		EnableNexus:                           dynamicconfig.EnableNexus.Get(dc),
 		EnableWorkflowExecutionTimeoutTimer:   dynamicconfig.EnableWorkflowExecutionTimeoutTimer.Get(dc),
@@ -532,6 +554,13 @@ func NewConfig(
 		OutboundStandbyTaskMissingEventsDestinationDownErr:  dynamicconfig.OutboundStandbyTaskMissingEventsDestinationDownErr.Get(dc),
 		OutboundStandbyTaskMissingEventsDiscardDelay:        dynamicconfig.OutboundStandbyTaskMissingEventsDiscardDelay.Get(dc),
 
+		ActivityRetryBudgetFailureThreshold:       dynamicconfig.ActivityRetryBudgetFailureThreshold.Get(dc),
+		ActivityRetryBudgetCircuitBreakerSettings: dynamicconfig.ActivityRetryBudgetCircuitBreakerSettings.Subscribe(dc),
+		ActivityRetryBudgetDeferralInterval:       dynamicconfig.ActivityRetryBudgetDeferralInterval.Get(dc),
+		ActivityRetryBudgetMaxDeferralInterval:    dynamicconfig.ActivityRetryBudgetMaxDeferralInterval.Get(dc),
+		ActivityRetryBudgetCacheMaxSize:           dynamicconfig.ActivityRetryBudgetCacheMaxSize.Get(dc),
+		ActivityRetryBudgetCacheTTL:               dynamicconfig.ActivityRetryBudgetCacheTTL.Get(dc),
+
 		ReplicatorProcessorMaxPollInterval:                  dynamicconfig.ReplicatorProcessorMaxPollInterval.Get(dc),
 		ReplicatorProcessorMaxPollIntervalJitterCoefficient: dynamicconfig.ReplicatorProcessorMaxPollIntervalJitterCoefficient.Get(dc),
 		ReplicatorProcessorFetchTasksBatchSize:              dynamicconfig.ReplicatorTaskBatchSize.Get(dc),
@@ -561,6 +590,8 @@ func NewConfig(
 		MaximumBufferedEventsBatch:       dynamicconfig.MaximumBufferedEventsBatch.Get(dc),
 		MaximumBufferedEventsSizeInBytes: dynamicconfig.MaximumBufferedEventsSizeInBytes.Get(dc),
 		MaximumSignalsPerExecution:       dynamicconfig.MaximumSignalsPerExecution.Get(dc),
+		SignalRequestIDDedupWindow:       dynamicconfig.SignalRequestIDDedupWindow.Get(dc),
+		SignalRequestIDDedupMaxIDs:       dynamicconfig.SignalRequestIDDedupMaxIDs.Get(dc),
 		ShardUpdateMinInterval:           dynamicconfig.ShardUpdateMinInterval.Get(dc),
 		ShardFirstUpdateInterval:         dynamicconfig.ShardFirstUpdateInterval.Get(dc),
 		ShardUpdateMinTasksCompleted:     dynamicconfig.ShardUpdateMinTasksCompleted.Get(dc),
@@ -576,8 +607,9 @@ func NewConfig(
 		EnableParentClosePolicyWorker:       dynamicconfig.EnableParentClosePolicyWorker.Get(dc),
 		ParentClosePolicyThreshold:          dynamicconfig.ParentClosePolicyThreshold.Get(dc),
 
-		BlobSizeLimitError:                        dynamicconfig.BlobSizeLimitError.Get(dc),
+		BlobSizeLimitError:                        payloadstore.BlobSizeLimitError(dc),
 		BlobSizeLimitWarn:                         dynamicconfig.BlobSizeLimitWarn.Get(dc),
+		PayloadOffloadThreshold:                   dynamicconfig.PayloadOffloadThreshold.Get(dc),
 		MemoSizeLimitError:                        dynamicconfig.MemoSizeLimitError.Get(dc),
 		MemoSizeLimitWarn:                         dynamicconfig.MemoSizeLimitWarn.Get(dc),
 		NumPendingChildExecutionsLimit:            dynamicconfig.NumPendingChildExecutionsLimitError.Get(dc),
@@ -690,6 +722,12 @@ func NewConfig(
 		WorkflowExecutionMaxTotalUpdates:                              dynamicconfig.WorkflowExecutionMaxTotalUpdates.Get(dc),
 		WorkflowExecutionMaxTotalUpdatesSuggestContinueAsNewThreshold: dynamicconfig.WorkflowExecutionMaxTotalUpdatesSuggestContinueAsNewThreshold.Get(dc),
 
+		ContinueAsNewPolicyWarnThreshold:                  dynamicconfig.ContinueAsNewPolicyWarnThreshold.Get(dc),
+		ContinueAsNewPolicyWarnSearchAttribute:            dynamicconfig.ContinueAsNewPolicyWarnSearchAttribute.Get(dc),
+		ContinueAsNewPolicyFailWorkflowTaskThreshold:      dynamicconfig.ContinueAsNewPolicyFailWorkflowTaskThreshold.Get(dc),
+		ContinueAsNewPolicyAutoContinueAsNewThreshold:     dynamicconfig.ContinueAsNewPolicyAutoContinueAsNewThreshold.Get(dc),
+		ContinueAsNewPolicyAutoContinueAsNewWorkflowTypes: dynamicconfig.ContinueAsNewPolicyAutoContinueAsNewWorkflowTypes.Get(dc),
+
 		SendRawHistoryBetweenInternalServices:    dynamicconfig.SendRawHistoryBetweenInternalServices.Get(dc),
 		SendRawWorkflowHistory:                   dynamicconfig.SendRawWorkflowHistory.Get(dc),
 		WorkflowIdReuseMinimalInterval:           dynamicconfig.WorkflowIdReuseMinimalInterval.Get(dc),
@@ -32,6 +32,7 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
@@ -188,6 +189,17 @@ func (m *DeleteManagerImpl) deleteWorkflowExecutionInternal(
 	// Clear workflow execution context here to prevent further readers to get stale copy of non-exiting workflow execution.
 	weCtx.Clear()
 
+	// The history is gone, payloads offloaded from it are no longer readable. Failing to delete them only leaks blobs,
+	// retrying the task would not find the execution anymore.
+	if err := m.shardContext.GetPayloadOffloader().Release(ctx, namespaceID, we.GetRunId(), currentBranchToken); err != nil {
+		m.shardContext.GetLogger().Warn("Unable to delete offloaded payloads of deleted workflow execution.",
+			tag.WorkflowNamespaceID(namespaceID.String()),
+			tag.WorkflowID(we.GetWorkflowId()),
+			tag.WorkflowRunID(we.GetRunId()),
+			tag.Error(err),
+		)
+	}
+
 	metrics.WorkflowCleanupDeleteCount.With(metricsHandler).Record(1)
 	return nil
 }
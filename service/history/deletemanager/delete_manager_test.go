@@ -93,6 +93,7 @@ func (s *deleteManagerWorkflowSuite) SetupTest() {
 	s.mockShardContext.EXPECT().GetMetricsHandler().Return(metrics.NoopMetricsHandler).AnyTimes()
 	s.mockShardContext.EXPECT().GetNamespaceRegistry().Return(s.mockNamespaceRegistry).AnyTimes()
 	s.mockShardContext.EXPECT().GetClusterMetadata().Return(s.mockMetadata).AnyTimes()
+	s.mockShardContext.EXPECT().GetPayloadOffloader().Return(nil).AnyTimes()
 
 	s.deleteManager = NewDeleteManager(
 		s.mockShardContext,
@@ -36,6 +36,8 @@ import (
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadstore"
+	"go.temporal.io/server/common/persistence"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/persistence/visibility/manager"
@@ -95,6 +97,7 @@ var Module = fx.Options(
 	fx.Provide(ServerProvider),
 	fx.Provide(NewService),
 	fx.Provide(ReplicationProgressCacheProvider),
+	fx.Provide(PayloadOffloaderProvider),
 	fx.Invoke(ServiceLifetimeHooks),
 
 	callbacks.Module,
@@ -313,3 +316,21 @@ func ReplicationProgressCacheProvider(
 ) replication.ProgressCache {
 	return replication.NewProgressCache(serviceConfig, logger, handler)
 }
+
+func PayloadOffloaderProvider(
+	store payloadstore.Store,
+	namespaceRegistry namespace.Registry,
+	executionManager persistence.ExecutionManager,
+	serviceConfig *configs.Config,
+	historyServiceResolver membership.ServiceResolver,
+	logger log.Logger,
+) *payloadstore.Offloader {
+	return payloadstore.NewOffloader(
+		store,
+		namespaceRegistry,
+		executionManager.GetHistoryBranchUtil(),
+		serviceConfig.PayloadOffloadThreshold,
+		historyServiceResolver.MemberCount,
+		logger,
+	)
+}
@@ -62,6 +62,7 @@ import (
 	"go.temporal.io/server/service/history/api/deleteworkflow"
 	"go.temporal.io/server/service/history/api/describemutablestate"
 	"go.temporal.io/server/service/history/api/describeworkflow"
+	"go.temporal.io/server/service/history/api/describeworkflowcallbacks"
 	"go.temporal.io/server/service/history/api/getworkflowexecutionhistory"
 	"go.temporal.io/server/service/history/api/getworkflowexecutionhistoryreverse"
 	"go.temporal.io/server/service/history/api/getworkflowexecutionrawhistory"
@@ -71,12 +72,14 @@ import (
 	"go.temporal.io/server/service/history/api/listtasks"
 	"go.temporal.io/server/service/history/api/multioperation"
 	"go.temporal.io/server/service/history/api/pauseactivity"
+	"go.temporal.io/server/service/history/api/pauseworkflow"
 	"go.temporal.io/server/service/history/api/pollupdate"
 	"go.temporal.io/server/service/history/api/queryworkflow"
 	"go.temporal.io/server/service/history/api/reapplyevents"
 	"go.temporal.io/server/service/history/api/recordactivitytaskheartbeat"
 	"go.temporal.io/server/service/history/api/recordactivitytaskstarted"
 	"go.temporal.io/server/service/history/api/recordchildworkflowcompleted"
+	"go.temporal.io/server/service/history/api/recordchildworkflowprogress"
 	"go.temporal.io/server/service/history/api/recordworkflowtaskstarted"
 	"go.temporal.io/server/service/history/api/refreshworkflow"
 	"go.temporal.io/server/service/history/api/removesignalmutablestate"
@@ -97,8 +100,10 @@ import (
 	"go.temporal.io/server/service/history/api/startworkflow"
 	"go.temporal.io/server/service/history/api/terminateworkflow"
 	"go.temporal.io/server/service/history/api/unpauseactivity"
+	"go.temporal.io/server/service/history/api/unpauseworkflow"
 	"go.temporal.io/server/service/history/api/updateactivityoptions"
 	"go.temporal.io/server/service/history/api/updateworkflow"
+	"go.temporal.io/server/service/history/api/updateworkflowcallback"
 	"go.temporal.io/server/service/history/api/updateworkflowoptions"
 	"go.temporal.io/server/service/history/api/verifychildworkflowcompletionrecorded"
 	"go.temporal.io/server/service/history/api/verifyfirstworkflowtaskscheduled"
@@ -117,6 +122,10 @@ import (
 	wcache "go.temporal.io/server/service/history/workflow/cache"
 )
 
+const (
+	defaultMutableStateCacheEntries = 100
+)
+
 type (
 	historyEngineImpl struct {
 		status                     int32
@@ -159,6 +168,7 @@ type (
 		replicationProgressCache   replication.ProgressCache
 		syncStateRetriever         replication.SyncStateRetriever
 		outboundQueueCBPool        *circuitbreakerpool.OutboundQueueCircuitBreakerPool
+		activityRetryCBPool        *circuitbreakerpool.ActivityRetryCircuitBreakerPool
 		testHooks                  testhooks.TestHooks
 	}
 )
@@ -186,6 +196,7 @@ func NewEngineWithShardContext(
 	dlqWriter replication.DLQWriter,
 	commandHandlerRegistry *workflow.CommandHandlerRegistry,
 	outboundQueueCBPool *circuitbreakerpool.OutboundQueueCircuitBreakerPool,
+	activityRetryCBPool *circuitbreakerpool.ActivityRetryCircuitBreakerPool,
 	testHooks testhooks.TestHooks,
 ) historyi.Engine {
 	currentClusterName := shard.GetClusterMetadata().GetCurrentClusterName()
@@ -236,6 +247,7 @@ func NewEngineWithShardContext(
 		replicationProgressCache:   replicationProgressCache,
 		syncStateRetriever:         syncStateRetriever,
 		outboundQueueCBPool:        outboundQueueCBPool,
+		activityRetryCBPool:        activityRetryCBPool,
 		testHooks:                  testHooks,
 	}
 
@@ -524,6 +536,7 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 		e.workflowConsistencyChecker,
 		e.persistenceVisibilityMgr,
 		e.outboundQueueCBPool,
+		e.activityRetryCBPool,
 	)
 }
 
@@ -595,7 +608,7 @@ func (e *historyEngineImpl) RespondActivityTaskCompleted(
 	ctx context.Context,
 	req *historyservice.RespondActivityTaskCompletedRequest,
 ) (*historyservice.RespondActivityTaskCompletedResponse, error) {
-	return respondactivitytaskcompleted.Invoke(ctx, req, e.shardContext, e.workflowConsistencyChecker)
+	return respondactivitytaskcompleted.Invoke(ctx, req, e.shardContext, e.workflowConsistencyChecker, e.activityRetryCBPool)
 }
 
 // RespondActivityTaskFailed completes an activity task failure.
@@ -603,7 +616,7 @@ func (e *historyEngineImpl) RespondActivityTaskFailed(
 	ctx context.Context,
 	req *historyservice.RespondActivityTaskFailedRequest,
 ) (*historyservice.RespondActivityTaskFailedResponse, error) {
-	return respondactivitytaskfailed.Invoke(ctx, req, e.shardContext, e.workflowConsistencyChecker)
+	return respondactivitytaskfailed.Invoke(ctx, req, e.shardContext, e.workflowConsistencyChecker, e.activityRetryCBPool)
 }
 
 // RespondActivityTaskCanceled completes an activity task failure.
@@ -699,6 +712,14 @@ func (e *historyEngineImpl) RecordChildExecutionCompleted(
 	return recordchildworkflowcompleted.Invoke(ctx, req, e.shardContext, e.workflowConsistencyChecker)
 }
 
+// RecordChildExecutionProgress records the latest progress of a child execution with its parent execution
+func (e *historyEngineImpl) RecordChildExecutionProgress(
+	ctx context.Context,
+	req *historyservice.RecordChildExecutionProgressRequest,
+) (*historyservice.RecordChildExecutionProgressResponse, error) {
+	return recordchildworkflowprogress.Invoke(ctx, req, e.shardContext, e.workflowConsistencyChecker)
+}
+
 // IsActivityTaskValid - whether activity task is still valid
 func (e *historyEngineImpl) IsActivityTaskValid(
 	ctx context.Context,
@@ -1010,6 +1031,35 @@ func (e *historyEngineImpl) GetReplicationStatus(
 	return replicationapi.GetStatus(ctx, request, e.shardContext, e.replicationAckMgr)
 }
 
+// DescribeMutableStateCache returns the largest entries of this shard in the workflow cache.
+func (e *historyEngineImpl) DescribeMutableStateCache(
+	_ context.Context,
+	request *historyservice.DescribeMutableStateCacheRequest,
+) (*historyservice.DescribeMutableStateCacheResponse, error) {
+	maxEntries := int(request.GetMaximumEntries())
+	if maxEntries <= 0 {
+		maxEntries = defaultMutableStateCacheEntries
+	}
+
+	info := e.workflowCache.DescribeShardEntries(e.shardContext, maxEntries)
+	entries := make([]*historyspb.CachedMutableStateInfo, 0, len(info.Largest))
+	for _, entry := range info.Largest {
+		entries = append(entries, &historyspb.CachedMutableStateInfo{
+			NamespaceId: entry.WorkflowKey.NamespaceID,
+			WorkflowId:  entry.WorkflowKey.WorkflowID,
+			RunId:       entry.WorkflowKey.RunID,
+			CacheSize:   int64(entry.Size),
+		})
+	}
+	return &historyservice.DescribeMutableStateCacheResponse{
+		Entries:         entries,
+		ShardEntryCount: int64(info.EntryCount),
+		ShardSize:       int64(info.Size),
+		CacheSize:       int64(info.CacheSize),
+		Truncated:       info.Truncated,
+	}, nil
+}
+
 func (e *historyEngineImpl) GetWorkflowExecutionHistory(
 	ctx context.Context,
 	request *historyservice.GetWorkflowExecutionHistoryRequest,
@@ -1104,3 +1154,31 @@ func (e *historyEngineImpl) ResetActivity(
 ) (*historyservice.ResetActivityResponse, error) {
 	return resetactivity.Invoke(ctx, request, e.shardContext, e.workflowConsistencyChecker)
 }
+
+func (e *historyEngineImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.PauseWorkflowExecutionRequest,
+) (*historyservice.PauseWorkflowExecutionResponse, error) {
+	return pauseworkflow.Invoke(ctx, request, e.shardContext, e.workflowConsistencyChecker)
+}
+
+func (e *historyEngineImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.UnpauseWorkflowExecutionRequest,
+) (*historyservice.UnpauseWorkflowExecutionResponse, error) {
+	return unpauseworkflow.Invoke(ctx, request, e.shardContext, e.workflowConsistencyChecker)
+}
+
+func (e *historyEngineImpl) DescribeWorkflowCallbacks(
+	ctx context.Context,
+	request *historyservice.DescribeWorkflowCallbacksRequest,
+) (*historyservice.DescribeWorkflowCallbacksResponse, error) {
+	return describeworkflowcallbacks.Invoke(ctx, request, e.shardContext, e.workflowConsistencyChecker)
+}
+
+func (e *historyEngineImpl) UpdateWorkflowCallback(
+	ctx context.Context,
+	request *historyservice.UpdateWorkflowCallbackRequest,
+) (*historyservice.UpdateWorkflowCallbackResponse, error) {
+	return updateworkflowcallback.Invoke(ctx, request, e.shardContext, e.workflowConsistencyChecker)
+}
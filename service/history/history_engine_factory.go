@@ -69,6 +69,7 @@ type (
 		ReplicationDLQWriter            replication.DLQWriter
 		CommandHandlerRegistry          *workflow.CommandHandlerRegistry
 		OutboundQueueCBPool             *circuitbreakerpool.OutboundQueueCircuitBreakerPool
+		ActivityRetryCBPool             *circuitbreakerpool.ActivityRetryCircuitBreakerPool
 		TestHooks                       testhooks.TestHooks
 	}
 
@@ -110,6 +111,7 @@ func (f *historyEngineFactory) CreateEngine(
 		f.ReplicationDLQWriter,
 		f.CommandHandlerRegistry,
 		f.OutboundQueueCBPool,
+		f.ActivityRetryCBPool,
 		f.TestHooks,
 	)
 }
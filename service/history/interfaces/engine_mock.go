@@ -151,6 +151,36 @@ func (mr *MockEngineMockRecorder) DescribeMutableState(ctx, request any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockEngine)(nil).DescribeMutableState), ctx, request)
 }
 
+// DescribeMutableStateCache mocks base method.
+func (m *MockEngine) DescribeMutableStateCache(ctx context.Context, request *historyservice.DescribeMutableStateCacheRequest) (*historyservice.DescribeMutableStateCacheResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeMutableStateCache", ctx, request)
+	ret0, _ := ret[0].(*historyservice.DescribeMutableStateCacheResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeMutableStateCache indicates an expected call of DescribeMutableStateCache.
+func (mr *MockEngineMockRecorder) DescribeMutableStateCache(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableStateCache", reflect.TypeOf((*MockEngine)(nil).DescribeMutableStateCache), ctx, request)
+}
+
+// DescribeWorkflowCallbacks mocks base method.
+func (m *MockEngine) DescribeWorkflowCallbacks(ctx context.Context, request *historyservice.DescribeWorkflowCallbacksRequest) (*historyservice.DescribeWorkflowCallbacksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeWorkflowCallbacks", ctx, request)
+	ret0, _ := ret[0].(*historyservice.DescribeWorkflowCallbacksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeWorkflowCallbacks indicates an expected call of DescribeWorkflowCallbacks.
+func (mr *MockEngineMockRecorder) DescribeWorkflowCallbacks(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowCallbacks", reflect.TypeOf((*MockEngine)(nil).DescribeWorkflowCallbacks), ctx, request)
+}
+
 // DescribeWorkflowExecution mocks base method.
 func (m *MockEngine) DescribeWorkflowExecution(ctx context.Context, request *historyservice.DescribeWorkflowExecutionRequest) (*historyservice.DescribeWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -475,6 +505,21 @@ func (mr *MockEngineMockRecorder) PauseActivity(ctx, request any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseActivity", reflect.TypeOf((*MockEngine)(nil).PauseActivity), ctx, request)
 }
 
+// PauseWorkflowExecution mocks base method.
+func (m *MockEngine) PauseWorkflowExecution(ctx context.Context, request *historyservice.PauseWorkflowExecutionRequest) (*historyservice.PauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(*historyservice.PauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution.
+func (mr *MockEngineMockRecorder) PauseWorkflowExecution(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).PauseWorkflowExecution), ctx, request)
+}
+
 // PollMutableState mocks base method.
 func (m *MockEngine) PollMutableState(ctx context.Context, request *historyservice.PollMutableStateRequest) (*historyservice.PollMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -608,6 +653,21 @@ func (mr *MockEngineMockRecorder) RecordChildExecutionCompleted(ctx, request any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordChildExecutionCompleted", reflect.TypeOf((*MockEngine)(nil).RecordChildExecutionCompleted), ctx, request)
 }
 
+// RecordChildExecutionProgress mocks base method.
+func (m *MockEngine) RecordChildExecutionProgress(ctx context.Context, request *historyservice.RecordChildExecutionProgressRequest) (*historyservice.RecordChildExecutionProgressResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordChildExecutionProgress", ctx, request)
+	ret0, _ := ret[0].(*historyservice.RecordChildExecutionProgressResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordChildExecutionProgress indicates an expected call of RecordChildExecutionProgress.
+func (mr *MockEngineMockRecorder) RecordChildExecutionProgress(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordChildExecutionProgress", reflect.TypeOf((*MockEngine)(nil).RecordChildExecutionProgress), ctx, request)
+}
+
 // RecordWorkflowTaskStarted mocks base method.
 func (m *MockEngine) RecordWorkflowTaskStarted(ctx context.Context, request *historyservice.RecordWorkflowTaskStartedRequest) (*historyservice.RecordWorkflowTaskStartedResponseWithRawHistory, error) {
 	m.ctrl.T.Helper()
@@ -1055,6 +1115,21 @@ func (mr *MockEngineMockRecorder) UnpauseActivity(ctx, request any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseActivity", reflect.TypeOf((*MockEngine)(nil).UnpauseActivity), ctx, request)
 }
 
+// UnpauseWorkflowExecution mocks base method.
+func (m *MockEngine) UnpauseWorkflowExecution(ctx context.Context, request *historyservice.UnpauseWorkflowExecutionRequest) (*historyservice.UnpauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(*historyservice.UnpauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution.
+func (mr *MockEngineMockRecorder) UnpauseWorkflowExecution(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).UnpauseWorkflowExecution), ctx, request)
+}
+
 // UnsubscribeReplicationNotification mocks base method.
 func (m *MockEngine) UnsubscribeReplicationNotification(arg0 string) {
 	m.ctrl.T.Helper()
@@ -1082,6 +1157,21 @@ func (mr *MockEngineMockRecorder) UpdateActivityOptions(ctx, request any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivityOptions", reflect.TypeOf((*MockEngine)(nil).UpdateActivityOptions), ctx, request)
 }
 
+// UpdateWorkflowCallback mocks base method.
+func (m *MockEngine) UpdateWorkflowCallback(ctx context.Context, request *historyservice.UpdateWorkflowCallbackRequest) (*historyservice.UpdateWorkflowCallbackResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkflowCallback", ctx, request)
+	ret0, _ := ret[0].(*historyservice.UpdateWorkflowCallbackResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkflowCallback indicates an expected call of UpdateWorkflowCallback.
+func (mr *MockEngineMockRecorder) UpdateWorkflowCallback(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowCallback", reflect.TypeOf((*MockEngine)(nil).UpdateWorkflowCallback), ctx, request)
+}
+
 // UpdateWorkflowExecution mocks base method.
 func (m *MockEngine) UpdateWorkflowExecution(ctx context.Context, request *historyservice.UpdateWorkflowExecutionRequest) (*historyservice.UpdateWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
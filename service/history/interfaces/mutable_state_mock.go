@@ -197,6 +197,21 @@ func (mr *MockMutableStateMockRecorder) AddActivityTaskTimedOutEvent(arg0, arg1,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddActivityTaskTimedOutEvent", reflect.TypeOf((*MockMutableState)(nil).AddActivityTaskTimedOutEvent), arg0, arg1, arg2, arg3)
 }
 
+// AddChildExecutionProgressSignaledEvent mocks base method.
+func (m *MockMutableState) AddChildExecutionProgressSignaledEvent(arg0 int64, arg1 *common.WorkflowExecution, arg2 int64, arg3 *common.Payloads) (*history.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddChildExecutionProgressSignaledEvent", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*history.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddChildExecutionProgressSignaledEvent indicates an expected call of AddChildExecutionProgressSignaledEvent.
+func (mr *MockMutableStateMockRecorder) AddChildExecutionProgressSignaledEvent(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddChildExecutionProgressSignaledEvent", reflect.TypeOf((*MockMutableState)(nil).AddChildExecutionProgressSignaledEvent), arg0, arg1, arg2, arg3)
+}
+
 // AddChildWorkflowExecutionCanceledEvent mocks base method.
 func (m *MockMutableState) AddChildWorkflowExecutionCanceledEvent(arg0 int64, arg1 *common.WorkflowExecution, arg2 *history.WorkflowExecutionCanceledEventAttributes) (*history.HistoryEvent, error) {
 	m.ctrl.T.Helper()
@@ -1746,6 +1761,20 @@ func (mr *MockMutableStateMockRecorder) CurrentVersionedTransition() *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CurrentVersionedTransition", reflect.TypeOf((*MockMutableState)(nil).CurrentVersionedTransition))
 }
 
+// DeferActivityRetry mocks base method.
+func (m *MockMutableState) DeferActivityRetry(ai *persistence.ActivityInfo, nextScheduledTime time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeferActivityRetry", ai, nextScheduledTime)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeferActivityRetry indicates an expected call of DeferActivityRetry.
+func (mr *MockMutableStateMockRecorder) DeferActivityRetry(ai, nextScheduledTime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeferActivityRetry", reflect.TypeOf((*MockMutableState)(nil).DeferActivityRetry), ai, nextScheduledTime)
+}
+
 // DeleteSignalRequested mocks base method.
 func (m *MockMutableState) DeleteSignalRequested(requestID string) {
 	m.ctrl.T.Helper()
@@ -2933,6 +2962,20 @@ func (mr *MockMutableStateMockRecorder) IsWorkflowCloseAttempted() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowCloseAttempted", reflect.TypeOf((*MockMutableState)(nil).IsWorkflowCloseAttempted))
 }
 
+// IsWorkflowExecutionPaused mocks base method.
+func (m *MockMutableState) IsWorkflowExecutionPaused() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsWorkflowExecutionPaused")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsWorkflowExecutionPaused indicates an expected call of IsWorkflowExecutionPaused.
+func (mr *MockMutableStateMockRecorder) IsWorkflowExecutionPaused() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowExecutionPaused", reflect.TypeOf((*MockMutableState)(nil).IsWorkflowExecutionPaused))
+}
+
 // IsWorkflowExecutionRunning mocks base method.
 func (m *MockMutableState) IsWorkflowExecutionRunning() bool {
 	m.ctrl.T.Helper()
@@ -2990,6 +3033,20 @@ func (mr *MockMutableStateMockRecorder) NextTransitionCount() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextTransitionCount", reflect.TypeOf((*MockMutableState)(nil).NextTransitionCount))
 }
 
+// PauseWorkflowExecution mocks base method.
+func (m *MockMutableState) PauseWorkflowExecution(identity, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", identity, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution.
+func (mr *MockMutableStateMockRecorder) PauseWorkflowExecution(identity, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockMutableState)(nil).PauseWorkflowExecution), identity, reason)
+}
+
 // PopTasks mocks base method.
 func (m *MockMutableState) PopTasks() map[tasks.Category][]tasks.Task {
 	m.ctrl.T.Helper()
@@ -3245,6 +3302,20 @@ func (mr *MockMutableStateMockRecorder) TaskQueueScheduleToStartTimeout(name any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskQueueScheduleToStartTimeout", reflect.TypeOf((*MockMutableState)(nil).TaskQueueScheduleToStartTimeout), name)
 }
 
+// UnpauseWorkflowExecution mocks base method.
+func (m *MockMutableState) UnpauseWorkflowExecution() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution.
+func (mr *MockMutableStateMockRecorder) UnpauseWorkflowExecution() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockMutableState)(nil).UnpauseWorkflowExecution))
+}
+
 // UpdateActivity mocks base method.
 func (m *MockMutableState) UpdateActivity(arg0 int64, arg1 ActivityUpdater) error {
 	m.ctrl.T.Helper()
@@ -3393,6 +3464,20 @@ func (mr *MockMutableStateMockRecorder) UpdateWorkflowStateStatus(state, status
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowStateStatus", reflect.TypeOf((*MockMutableState)(nil).UpdateWorkflowStateStatus), state, status)
 }
 
+// UpsertSearchAttributesWithoutEvent mocks base method.
+func (m *MockMutableState) UpsertSearchAttributesWithoutEvent(arg0 map[string]*common.Payload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertSearchAttributesWithoutEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertSearchAttributesWithoutEvent indicates an expected call of UpsertSearchAttributesWithoutEvent.
+func (mr *MockMutableStateMockRecorder) UpsertSearchAttributesWithoutEvent(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertSearchAttributesWithoutEvent", reflect.TypeOf((*MockMutableState)(nil).UpsertSearchAttributesWithoutEvent), arg0)
+}
+
 // VisitUpdates mocks base method.
 func (m *MockMutableState) VisitUpdates(visitor func(string, *persistence.UpdateInfo)) {
 	m.ctrl.T.Helper()
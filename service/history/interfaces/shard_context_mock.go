@@ -53,6 +53,7 @@ import (
 	log "go.temporal.io/server/common/log"
 	metrics "go.temporal.io/server/common/metrics"
 	namespace "go.temporal.io/server/common/namespace"
+	payloadstore "go.temporal.io/server/common/payloadstore"
 	persistence0 "go.temporal.io/server/common/persistence"
 	serialization "go.temporal.io/server/common/persistence/serialization"
 	pingable "go.temporal.io/server/common/pingable"
@@ -490,6 +491,20 @@ func (mr *MockShardContextMockRecorder) GetOwner() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOwner", reflect.TypeOf((*MockShardContext)(nil).GetOwner))
 }
 
+// GetPayloadOffloader mocks base method.
+func (m *MockShardContext) GetPayloadOffloader() *payloadstore.Offloader {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayloadOffloader")
+	ret0, _ := ret[0].(*payloadstore.Offloader)
+	return ret0
+}
+
+// GetPayloadOffloader indicates an expected call of GetPayloadOffloader.
+func (mr *MockShardContextMockRecorder) GetPayloadOffloader() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayloadOffloader", reflect.TypeOf((*MockShardContext)(nil).GetPayloadOffloader))
+}
+
 // GetPayloadSerializer mocks base method.
 func (m *MockShardContext) GetPayloadSerializer() serialization.Serializer {
 	m.ctrl.T.Helper()
@@ -1278,6 +1293,20 @@ func (mr *MockControllableContextMockRecorder) GetOwner() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOwner", reflect.TypeOf((*MockControllableContext)(nil).GetOwner))
 }
 
+// GetPayloadOffloader mocks base method.
+func (m *MockControllableContext) GetPayloadOffloader() *payloadstore.Offloader {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayloadOffloader")
+	ret0, _ := ret[0].(*payloadstore.Offloader)
+	return ret0
+}
+
+// GetPayloadOffloader indicates an expected call of GetPayloadOffloader.
+func (mr *MockControllableContextMockRecorder) GetPayloadOffloader() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayloadOffloader", reflect.TypeOf((*MockControllableContext)(nil).GetPayloadOffloader))
+}
+
 // GetPayloadSerializer mocks base method.
 func (m *MockControllableContext) GetPayloadSerializer() serialization.Serializer {
 	m.ctrl.T.Helper()
@@ -28,10 +28,14 @@ package ndc
 
 import (
 	"context"
+	"fmt"
 
+	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/service/history/hsm"
@@ -110,3 +114,62 @@ func (r *EventsReapplierImpl) ReapplyEvents(
 	}
 	return reappliedEvents, nil
 }
+
+// SelectReapplyEvents returns the events picked by selections, in history order, for a reset that reapplies an
+// explicit set of events. events are the events of the base run recorded after the reset point. Only signals and
+// updates can be selected, each at most once, and only signals can have their input replaced. The returned events
+// are copies, so the given events are never modified.
+func SelectReapplyEvents(
+	events []*historypb.HistoryEvent,
+	selections []*historyspb.ReapplyEventSelection,
+) ([]*historypb.HistoryEvent, error) {
+	selectionByID := make(map[int64]*historyspb.ReapplyEventSelection, len(selections))
+	for _, selection := range selections {
+		if _, ok := selectionByID[selection.GetEventId()]; ok {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("event %d is selected more than once for reapply", selection.GetEventId()))
+		}
+		selectionByID[selection.GetEventId()] = selection
+	}
+
+	selected := make([]*historypb.HistoryEvent, 0, len(selections))
+	for _, event := range events {
+		selection, ok := selectionByID[event.GetEventId()]
+		if !ok {
+			continue
+		}
+		delete(selectionByID, event.GetEventId())
+
+		switch event.GetEventType() {
+		case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED:
+		case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_ADMITTED:
+		case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_ACCEPTED:
+			if event.GetWorkflowExecutionUpdateAcceptedEventAttributes().GetAcceptedRequest() == nil {
+				return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(
+					"event %d is an update accepted event without request, select the matching update admitted event instead",
+					event.GetEventId(),
+				))
+			}
+		default:
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(
+				"event %d of type %v cannot be reapplied, only signals and updates can be selected",
+				event.GetEventId(),
+				event.GetEventType(),
+			))
+		}
+
+		event = common.CloneProto(event)
+		if selection.GetSignalInput() != nil {
+			attr := event.GetWorkflowExecutionSignaledEventAttributes()
+			if attr == nil {
+				return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("event %d is not a signal, its input cannot be replaced", event.GetEventId()))
+			}
+			attr.Input = selection.GetSignalInput()
+		}
+		selected = append(selected, event)
+	}
+
+	for eventID := range selectionByID {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("event %d is not an event of the base run after the reset point", eventID))
+	}
+	return selected, nil
+}
@@ -38,6 +38,7 @@ import (
 	"go.temporal.io/api/serviceerror"
 	updatepb "go.temporal.io/api/update/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/log"
@@ -499,3 +500,82 @@ func (s *nDCEventReapplicationSuite) TestReapplyEvents_AppliedEvent_NoPendingWor
 	s.NoError(err)
 	s.Equal(1, len(appliedEvent))
 }
+
+func TestSelectReapplyEvents(t *testing.T) {
+	originalInput := payloads.EncodeString("original")
+	events := []*historypb.HistoryEvent{
+		{
+			EventId:   5,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{
+				WorkflowExecutionSignaledEventAttributes: &historypb.WorkflowExecutionSignaledEventAttributes{
+					SignalName: "first",
+					Input:      originalInput,
+				},
+			},
+		},
+		{
+			EventId:   6,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED,
+		},
+		{
+			EventId:   7,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_ADMITTED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionUpdateAdmittedEventAttributes{
+				WorkflowExecutionUpdateAdmittedEventAttributes: &historypb.WorkflowExecutionUpdateAdmittedEventAttributes{},
+			},
+		},
+		{
+			EventId:   8,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{
+				WorkflowExecutionSignaledEventAttributes: &historypb.WorkflowExecutionSignaledEventAttributes{
+					SignalName: "second",
+				},
+			},
+		},
+	}
+
+	t.Run("selected events in history order", func(t *testing.T) {
+		replacedInput := payloads.EncodeString("replaced")
+		selected, err := SelectReapplyEvents(events, []*historyspb.ReapplyEventSelection{
+			{EventId: 7},
+			{EventId: 5, SignalInput: replacedInput},
+		})
+		require.NoError(t, err)
+		require.Len(t, selected, 2)
+		require.Equal(t, int64(5), selected[0].GetEventId())
+		require.Equal(t, int64(7), selected[1].GetEventId())
+		require.Equal(t, replacedInput, selected[0].GetWorkflowExecutionSignaledEventAttributes().GetInput())
+		// The events read from history must be left untouched.
+		require.Equal(t, originalInput, events[0].GetWorkflowExecutionSignaledEventAttributes().GetInput())
+	})
+
+	for _, tc := range []struct {
+		name       string
+		selections []*historyspb.ReapplyEventSelection
+	}{
+		{
+			name:       "duplicate selection",
+			selections: []*historyspb.ReapplyEventSelection{{EventId: 5}, {EventId: 5}},
+		},
+		{
+			name:       "event before reset point",
+			selections: []*historyspb.ReapplyEventSelection{{EventId: 3}},
+		},
+		{
+			name:       "event type not reapplicable",
+			selections: []*historyspb.ReapplyEventSelection{{EventId: 6}},
+		},
+		{
+			name:       "input replaced on update",
+			selections: []*historyspb.ReapplyEventSelection{{EventId: 7, SignalInput: payloads.EncodeString("replaced")}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := SelectReapplyEvents(events, tc.selections)
+			var invalidArgument *serviceerror.InvalidArgument
+			require.ErrorAs(t, err, &invalidArgument)
+		})
+	}
+}
@@ -56,6 +56,8 @@ func GetActiveTransferTaskTypeTagValue(
 		return metrics.TaskTypeTransferActiveTaskResetWorkflow
 	case *tasks.DeleteExecutionTask:
 		return metrics.TaskTypeTransferActiveTaskDeleteExecution
+	case *tasks.ChildExecutionProgressTask:
+		return metrics.TaskTypeTransferActiveTaskChildExecutionProgress
 	default:
 		return "TransferActive" + task.GetType().String()
 	}
@@ -81,6 +83,8 @@ func GetStandbyTransferTaskTypeTagValue(
 		return metrics.TaskTypeTransferStandbyTaskResetWorkflow
 	case *tasks.DeleteExecutionTask:
 		return metrics.TaskTypeTransferStandbyTaskDeleteExecution
+	case *tasks.ChildExecutionProgressTask:
+		return metrics.TaskTypeTransferStandbyTaskChildExecutionProgress
 	default:
 		return "TransferStandby" + task.GetType().String()
 	}
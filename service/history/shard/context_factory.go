@@ -35,6 +35,7 @@ import (
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadstore"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/resource"
@@ -80,6 +81,7 @@ type (
 		TimeSource                  clock.TimeSource
 		TaskCategoryRegistry        tasks.TaskCategoryRegistry
 		EventsCache                 events.Cache
+		PayloadOffloader            *payloadstore.Offloader
 
 		StateMachineRegistry *hsm.Registry
 		ChasmRegistry        *chasm.Registry
@@ -123,6 +125,7 @@ func (c *contextFactoryImpl) CreateContext(
 		c.HostInfoProvider,
 		c.TaskCategoryRegistry,
 		c.EventsCache,
+		c.PayloadOffloader,
 		c.StateMachineRegistry,
 		c.ChasmRegistry,
 	)
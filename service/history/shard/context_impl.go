@@ -64,6 +64,7 @@ import (
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadstore"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/pingable"
@@ -133,6 +134,7 @@ type (
 		clusterMetadata         cluster.Metadata
 		archivalMetadata        archiver.ArchivalMetadata
 		hostInfoProvider        membership.HostInfoProvider
+		payloadOffloader        *payloadstore.Offloader
 		taskCategoryRegistry    tasks.TaskCategoryRegistry
 
 		// Context that lives for the lifetime of the shard context
@@ -1136,6 +1138,11 @@ func (s *ContextImpl) GetEventsCache() events.Cache {
 	return s.eventsCache
 }
 
+func (s *ContextImpl) GetPayloadOffloader() *payloadstore.Offloader {
+	// constant from initialization, no need for locks
+	return s.payloadOffloader
+}
+
 func (s *ContextImpl) GetLogger() log.Logger {
 	// constant from initialization, no need for locks
 	return s.contextTaggedLogger
@@ -2091,6 +2098,7 @@ func newContext(
 	hostInfoProvider membership.HostInfoProvider,
 	taskCategoryRegistry tasks.TaskCategoryRegistry,
 	eventsCache events.Cache,
+	payloadOffloader *payloadstore.Offloader,
 	stateMachineRegistry *hsm.Registry,
 	chasmRegistry *chasm.Registry,
 ) (*ContextImpl, error) {
@@ -2134,6 +2142,7 @@ func newContext(
 		archivalMetadata:        archivalMetadata,
 		hostInfoProvider:        hostInfoProvider,
 		taskCategoryRegistry:    taskCategoryRegistry,
+		payloadOffloader:        payloadOffloader,
 		handoverNamespaces:      make(map[namespace.Name]*namespaceHandOverInfo),
 		lifecycleCtx:            lifecycleCtx,
 		lifecycleCancel:         lifecycleCancel,
@@ -39,6 +39,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadstore"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/resourcetest"
@@ -183,6 +184,7 @@ func newTestContext(t *resourcetest.Test, eventsCache events.Cache, config Conte
 		archivalMetadata:        t.GetArchivalMetadata(),
 		hostInfoProvider:        hostInfoProvider,
 		taskCategoryRegistry:    taskCategoryRegistry,
+		payloadOffloader:        payloadstore.NewOffloader(nil, registry, &persistence.HistoryBranchUtilImpl{}, config.Config.PayloadOffloadThreshold, nil, t.GetLogger()),
 		ioSemaphore:             locks.NewPrioritySemaphore(1),
 	}
 	ctx.taskKeyManager = newTaskKeyManager(
@@ -90,6 +90,8 @@ func GetTransferTaskEventID(
 		eventID = task.InitiatedEventID
 	case *ResetWorkflowTask:
 		eventID = common.FirstEventID
+	case *ChildExecutionProgressTask:
+		eventID = task.EventID
 	case *FakeTask:
 		// no-op
 	default:
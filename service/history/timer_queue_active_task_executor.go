@@ -47,6 +47,7 @@ import (
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/priorities"
 	"go.temporal.io/server/common/resource"
+	"go.temporal.io/server/service/history/circuitbreakerpool"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/deletemanager"
@@ -64,6 +65,8 @@ import (
 type (
 	timerQueueActiveTaskExecutor struct {
 		*timerQueueTaskExecutorBase
+
+		activityRetryCBPool *circuitbreakerpool.ActivityRetryCircuitBreakerPool
 	}
 )
 
@@ -75,6 +78,7 @@ func newTimerQueueActiveTaskExecutor(
 	metricProvider metrics.Handler,
 	config *configs.Config,
 	matchingRawClient resource.MatchingRawClient,
+	activityRetryCBPool *circuitbreakerpool.ActivityRetryCircuitBreakerPool,
 ) queues.Executor {
 	return &timerQueueActiveTaskExecutor{
 		timerQueueTaskExecutorBase: newTimerQueueTaskExecutorBase(
@@ -87,6 +91,7 @@ func newTimerQueueActiveTaskExecutor(
 			config,
 			true,
 		),
+		activityRetryCBPool: activityRetryCBPool,
 	}
 }
 
@@ -170,6 +175,11 @@ func (t *timerQueueActiveTaskExecutor) executeUserTimerTimeoutTask(
 		release(nil) // release(nil) so mutable state is not unloaded from cache
 		return consts.ErrWorkflowExecutionNotFound
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// user timers will be regenerated when the workflow is unpaused
+		release(nil) // release(nil) so mutable state is not unloaded from cache
+		return nil
+	}
 
 	timerSequence := t.getTimerSequence(mutableState)
 	referenceTime := t.Now()
@@ -229,6 +239,12 @@ func (t *timerQueueActiveTaskExecutor) executeActivityTimeoutTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// activity timers will be regenerated when the workflow is unpaused, timeouts that elapsed in the
+		// meantime fire right after that
+		release(nil) // release(nil) so mutable state is not unloaded from cache
+		return nil
+	}
 
 	timerSequence := t.getTimerSequence(mutableState)
 	referenceTime := t.Now()
@@ -306,6 +322,13 @@ func (t *timerQueueActiveTaskExecutor) processSingleActivityTimeoutTask(
 	// Note: we don't need to check activity Stamps.
 	// This is because for the same attempts calls are idempotent.
 
+	if timerSequenceID.TimerType == enumspb.TIMEOUT_TYPE_START_TO_CLOSE ||
+		timerSequenceID.TimerType == enumspb.TIMEOUT_TYPE_HEARTBEAT {
+		// Only timeouts of started attempts count against the retry budget; schedule-to-start timeouts
+		// indicate missing workers rather than a failing dependency.
+		t.activityRetryCBPool.RecordAttempt(activityRetryBudgetKey(mutableState, ai), false)
+	}
+
 	failureMsg := fmt.Sprintf("activity %v timeout", timerSequenceID.TimerType.String())
 	timeoutFailure := failure.NewTimeoutFailure(failureMsg, timerSequenceID.TimerType)
 	retryState, err := mutableState.RetryActivity(ai, timeoutFailure)
@@ -526,6 +549,12 @@ func (t *timerQueueActiveTaskExecutor) executeActivityRetryTimerTask(
 		return consts.ErrActivityTaskNotFound
 	}
 
+	if mutableState.IsWorkflowExecutionPaused() {
+		// activity retry timer will be regenerated when the workflow is unpaused
+		release(nil) // release(nil) so mutable state is not unloaded from cache
+		return nil
+	}
+
 	if task.Attempt < activityInfo.Attempt || activityInfo.StartedEventId != common.EmptyEventID {
 		t.logger.Info("Duplicate activity retry timer task",
 			tag.WorkflowID(mutableState.GetExecutionInfo().WorkflowId),
@@ -549,6 +578,10 @@ func (t *timerQueueActiveTaskExecutor) executeActivityRetryTimerTask(
 		return consts.ErrWorkflowCompleted
 	}
 
+	if t.activityRetryCBPool.IsOpen(activityRetryBudgetKey(mutableState, activityInfo)) {
+		return t.deferActivityRetry(ctx, weContext, mutableState, activityInfo)
+	}
+
 	taskQueue := &taskqueuepb.TaskQueue{
 		Name: activityInfo.TaskQueue,
 		Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
@@ -629,6 +662,11 @@ func (t *timerQueueActiveTaskExecutor) executeWorkflowRunTimeoutTask(
 	if !t.isValidWorkflowRunTimeoutTask(mutableState, task) {
 		return nil
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// the run timeout timer will be regenerated when the workflow is unpaused
+		release(nil) // release(nil) so mutable state is not unloaded from cache
+		return nil
+	}
 
 	timeoutFailure := failure.NewTimeoutFailure("workflow timeout", enumspb.TIMEOUT_TYPE_START_TO_CLOSE)
 	backoffInterval := backoff.NoBackoff
@@ -839,6 +877,45 @@ func (t *timerQueueActiveTaskExecutor) getTimerSequence(
 	return workflow.NewTimerSequence(mutableState)
 }
 
+// deferActivityRetry pushes the next attempt of an activity out while the retry budget of its activity type is
+// exhausted. Consecutive deferrals of the same attempt back off exponentially up to the configured maximum.
+func (t *timerQueueActiveTaskExecutor) deferActivityRetry(
+	ctx context.Context,
+	wfContext historyi.WorkflowContext,
+	mutableState historyi.MutableState,
+	activityInfo *persistencespb.ActivityInfo,
+) error {
+	nsName := mutableState.GetNamespaceEntry().Name().String()
+	interval := t.config.ActivityRetryBudgetDeferralInterval(nsName)
+	maxInterval := t.config.ActivityRetryBudgetMaxDeferralInterval(nsName)
+	for i := int32(0); i < activityInfo.RetryBudgetDeferrals && interval < maxInterval; i++ {
+		interval *= 2
+	}
+	interval = backoff.Jitter(min(interval, maxInterval), 0.2)
+
+	if err := mutableState.DeferActivityRetry(activityInfo, t.Now().Add(interval)); err != nil {
+		return err
+	}
+
+	metrics.ActivityRetryDeferred.With(t.metricsHandler).Record(
+		1,
+		metrics.NamespaceTag(nsName),
+		metrics.ActivityTypeTag(activityInfo.GetActivityType().GetName()),
+	)
+	return t.updateWorkflowExecution(ctx, wfContext, mutableState, false)
+}
+
+func activityRetryBudgetKey(
+	mutableState historyi.MutableState,
+	activityInfo *persistencespb.ActivityInfo,
+) circuitbreakerpool.ActivityRetryBudgetKey {
+	return circuitbreakerpool.ActivityRetryBudgetKey{
+		Namespace:    mutableState.GetNamespaceEntry().Name().String(),
+		TaskQueue:    activityInfo.TaskQueue,
+		ActivityType: activityInfo.GetActivityType().GetName(),
+	}
+}
+
 func (t *timerQueueActiveTaskExecutor) updateWorkflowExecution(
 	ctx context.Context,
 	wfContext historyi.WorkflowContext,
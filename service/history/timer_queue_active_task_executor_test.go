@@ -45,6 +45,8 @@ import (
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/failure"
 	"go.temporal.io/server/common/locks"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
@@ -57,6 +59,7 @@ import (
 	pm "go.temporal.io/server/common/testing/protomock"
 	"go.temporal.io/server/common/worker_versioning"
 	"go.temporal.io/server/components/dummy"
+	"go.temporal.io/server/service/history/circuitbreakerpool"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/deletemanager"
@@ -205,6 +208,7 @@ func (s *timerQueueActiveTaskExecutorSuite) SetupTest() {
 		metrics.NoopMetricsHandler,
 		s.config,
 		s.mockShard.Resource.GetMatchingClient(),
+		circuitbreakerpool.ActivityRetryCircuitBreakerPoolProvider(s.config),
 	).(*timerQueueActiveTaskExecutor)
 }
 
@@ -573,6 +577,86 @@ func (s *timerQueueActiveTaskExecutorSuite) TestProcessActivityTimeout_NoRetryPo
 	}
 }
 
+func (s *timerQueueActiveTaskExecutorSuite) TestProcessActivityTimeout_WorkflowPaused_Noop() {
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      uuid.New(),
+	}
+	workflowKey := definition.NewWorkflowKey(
+		s.namespaceID.String(),
+		execution.GetWorkflowId(),
+		execution.GetRunId(),
+	)
+	workflowType := "some random workflow type"
+	taskQueueName := "some random task queue"
+
+	mutableState := workflow.TestGlobalMutableState(s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetWorkflowId(), execution.GetRunId())
+	_, err := mutableState.AddWorkflowExecutionStartedEvent(
+		execution,
+		&historyservice.StartWorkflowExecutionRequest{
+			Attempt:     1,
+			NamespaceId: s.namespaceID.String(),
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+				WorkflowType:             &commonpb.WorkflowType{Name: workflowType},
+				TaskQueue:                &taskqueuepb.TaskQueue{Name: taskQueueName},
+				WorkflowExecutionTimeout: durationpb.New(200 * time.Second),
+				WorkflowTaskTimeout:      durationpb.New(1 * time.Second),
+			},
+		},
+	)
+	s.Nil(err)
+
+	wt := addWorkflowTaskScheduledEvent(mutableState)
+	event := addWorkflowTaskStartedEvent(mutableState, wt.ScheduledEventID, taskQueueName, uuid.New())
+	wt.StartedEventID = event.GetEventId()
+	event = addWorkflowTaskCompletedEvent(&s.Suite, mutableState, wt.ScheduledEventID, wt.StartedEventID, "some random identity")
+
+	taskqueue := "taskqueue"
+	activityID := "activity"
+	activityType := "activity type"
+	timerTimeout := 2 * time.Second
+	scheduledEvent, _ := addActivityTaskScheduledEvent(
+		mutableState,
+		event.GetEventId(),
+		activityID,
+		activityType,
+		taskqueue,
+		nil,
+		timerTimeout,
+		timerTimeout,
+		timerTimeout,
+		timerTimeout,
+	)
+	err = mutableState.PauseWorkflowExecution("some random identity", "some random reason")
+	s.NoError(err)
+
+	timerSequence := workflow.NewTimerSequence(mutableState)
+	mutableState.InsertTasks[tasks.CategoryTimer] = nil
+	modified, err := timerSequence.CreateNextActivityTimer()
+	s.NoError(err)
+	s.True(modified)
+	task := mutableState.InsertTasks[tasks.CategoryTimer][0]
+
+	timerTask := &tasks.ActivityTimeoutTask{
+		WorkflowKey:         workflowKey,
+		Attempt:             1,
+		TaskID:              s.mustGenerateTaskID(),
+		TimeoutType:         enumspb.TIMEOUT_TYPE_SCHEDULE_TO_CLOSE,
+		VisibilityTimestamp: task.(*tasks.ActivityTimeoutTask).VisibilityTimestamp,
+		EventID:             scheduledEvent.EventId,
+	}
+
+	persistenceMutableState := s.createPersistenceMutableState(mutableState, scheduledEvent.GetEventId(), scheduledEvent.GetVersion())
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+
+	s.timeSource.Update(s.now.Add(2 * timerTimeout))
+	resp := s.timerQueueActiveTaskExecutor.Execute(context.Background(), s.newTaskExecutable(timerTask))
+	s.NoError(resp.ExecutionErr)
+
+	_, ok := s.getMutableStateFromCache(workflowKey).GetActivityInfo(scheduledEvent.GetEventId())
+	s.True(ok)
+}
+
 func (s *timerQueueActiveTaskExecutorSuite) TestProcessActivityTimeout_NoRetryPolicy_Noop() {
 	execution := &commonpb.WorkflowExecution{
 		WorkflowId: "some random workflow ID",
@@ -1401,6 +1485,114 @@ func (s *timerQueueActiveTaskExecutorSuite) TestActivityRetryTimer_Fire() {
 	s.NoError(resp.ExecutionErr)
 }
 
+func (s *timerQueueActiveTaskExecutorSuite) TestActivityRetryTimer_RetryBudgetExhausted_Deferred() {
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      uuid.New(),
+	}
+	workflowKey := definition.NewWorkflowKey(
+		s.namespaceID.String(),
+		execution.GetWorkflowId(),
+		execution.GetRunId(),
+	)
+	workflowType := "some random workflow type"
+	taskQueueName := "some random task queue"
+
+	mutableState := workflow.TestGlobalMutableState(s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetWorkflowId(), execution.GetRunId())
+	_, err := mutableState.AddWorkflowExecutionStartedEvent(
+		execution,
+		&historyservice.StartWorkflowExecutionRequest{
+			Attempt:     1,
+			NamespaceId: s.namespaceID.String(),
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+				WorkflowType: &commonpb.WorkflowType{Name: workflowType},
+				TaskQueue: &taskqueuepb.TaskQueue{
+					Name: taskQueueName,
+					Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+				},
+				WorkflowRunTimeout:  durationpb.New(200 * time.Second),
+				WorkflowTaskTimeout: durationpb.New(1 * time.Second),
+			},
+		},
+	)
+	s.Nil(err)
+
+	wt := addWorkflowTaskScheduledEvent(mutableState)
+	event := addWorkflowTaskStartedEvent(mutableState, wt.ScheduledEventID, taskQueueName, uuid.New())
+	wt.StartedEventID = event.GetEventId()
+	event = addWorkflowTaskCompletedEvent(&s.Suite, mutableState, wt.ScheduledEventID, wt.StartedEventID, "some random identity")
+
+	activityType := "activity type"
+	timerTimeout := 2 * time.Second
+	scheduledEvent, activityInfo := addActivityTaskScheduledEventWithRetry(
+		mutableState,
+		event.GetEventId(),
+		"activity",
+		activityType,
+		taskQueueName,
+		nil,
+		timerTimeout,
+		timerTimeout,
+		timerTimeout,
+		timerTimeout,
+		&commonpb.RetryPolicy{
+			InitialInterval:    durationpb.New(1 * time.Second),
+			BackoffCoefficient: 1.2,
+			MaximumInterval:    durationpb.New(5 * time.Second),
+			MaximumAttempts:    5,
+		},
+	)
+	activityInfo.Attempt = 2
+	activityInfo.LastAttemptCompleteTime = timestamppb.New(s.now.Add(-time.Second))
+	activityInfo.RetryLastWorkerIdentity = "some random worker identity"
+	activityInfo.RetryLastFailure = failure.NewServerFailure("some random failure", false)
+
+	s.config.ActivityRetryBudgetFailureThreshold = dynamicconfig.GetIntPropertyFnFilteredByNamespace(2)
+	s.config.ActivityRetryBudgetDeferralInterval = dynamicconfig.GetDurationPropertyFnFilteredByNamespace(10 * time.Second)
+	s.config.ActivityRetryBudgetMaxDeferralInterval = dynamicconfig.GetDurationPropertyFnFilteredByNamespace(time.Minute)
+	budgetKey := circuitbreakerpool.ActivityRetryBudgetKey{
+		Namespace:    mutableState.GetNamespaceEntry().Name().String(),
+		TaskQueue:    taskQueueName,
+		ActivityType: activityType,
+	}
+	s.timerQueueActiveTaskExecutor.activityRetryCBPool.RecordAttempt(budgetKey, false)
+	s.False(s.timerQueueActiveTaskExecutor.activityRetryCBPool.IsOpen(budgetKey))
+	s.timerQueueActiveTaskExecutor.activityRetryCBPool.RecordAttempt(budgetKey, false)
+	s.True(s.timerQueueActiveTaskExecutor.activityRetryCBPool.IsOpen(budgetKey))
+
+	timerTask := &tasks.ActivityRetryTimerTask{
+		WorkflowKey:         workflowKey,
+		Version:             s.version,
+		TaskID:              s.mustGenerateTaskID(),
+		VisibilityTimestamp: s.now,
+		EventID:             activityInfo.ScheduledEventId,
+		Attempt:             activityInfo.Attempt,
+	}
+
+	persistenceMutableState := s.createPersistenceMutableState(mutableState, scheduledEvent.GetEventId(), scheduledEvent.GetVersion())
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockExecutionMgr.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).Return(tests.UpdateWorkflowExecutionResponse, nil)
+	// no activity task is dispatched to matching while the retry budget is exhausted
+
+	resp := s.timerQueueActiveTaskExecutor.Execute(context.Background(), s.newTaskExecutable(timerTask))
+	s.NoError(resp.ExecutionErr)
+
+	activityInfo, ok := s.getMutableStateFromCache(workflowKey).GetActivityInfo(scheduledEvent.GetEventId())
+	s.True(ok)
+	s.Equal(int32(2), activityInfo.Attempt)
+	s.Equal(int32(1), activityInfo.RetryBudgetDeferrals)
+	s.WithinRange(activityInfo.ScheduledTime.AsTime(), s.now.Add(8*time.Second), s.now.Add(12*time.Second))
+	s.Equal("some random worker identity", activityInfo.RetryLastWorkerIdentity)
+	s.Equal("some random failure", activityInfo.RetryLastFailure.GetMessage())
+
+	pendingActivityInfo, err := workflow.GetPendingActivityInfo(context.Background(), s.mockShard, s.getMutableStateFromCache(workflowKey), activityInfo)
+	s.NoError(err)
+	s.Equal(activityInfo.ScheduledTime.AsTime(), pendingActivityInfo.NextAttemptScheduleTime.AsTime())
+	s.Equal(activityInfo.ScheduledTime.AsTime().Sub(activityInfo.LastAttemptCompleteTime.AsTime()), pendingActivityInfo.CurrentRetryInterval.AsDuration())
+	s.Equal("some random worker identity", pendingActivityInfo.LastWorkerIdentity)
+	s.Equal("some random failure", pendingActivityInfo.LastFailure.GetMessage())
+}
+
 func (s *timerQueueActiveTaskExecutorSuite) TestActivityRetryTimer_Noop() {
 	execution := &commonpb.WorkflowExecution{
 		WorkflowId: "some random workflow ID",
@@ -1542,6 +1734,62 @@ func (s *timerQueueActiveTaskExecutorSuite) TestWorkflowRunTimeout_Fire() {
 	}
 }
 
+func (s *timerQueueActiveTaskExecutorSuite) TestWorkflowRunTimeout_WorkflowPaused_Noop() {
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      uuid.New(),
+	}
+	workflowKey := definition.NewWorkflowKey(
+		s.namespaceID.String(),
+		execution.GetWorkflowId(),
+		execution.GetRunId(),
+	)
+	workflowType := "some random workflow type"
+	taskQueueName := "some random task queue"
+
+	expirationTime := 10 * time.Second
+
+	mutableState := workflow.TestGlobalMutableState(s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetWorkflowId(), execution.GetRunId())
+	_, err := mutableState.AddWorkflowExecutionStartedEvent(
+		execution,
+		&historyservice.StartWorkflowExecutionRequest{
+			Attempt:     1,
+			NamespaceId: s.namespaceID.String(),
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+				WorkflowType:        &commonpb.WorkflowType{Name: workflowType},
+				TaskQueue:           &taskqueuepb.TaskQueue{Name: taskQueueName},
+				WorkflowRunTimeout:  durationpb.New(200 * time.Second),
+				WorkflowTaskTimeout: durationpb.New(1 * time.Second),
+			},
+			WorkflowExecutionExpirationTime: timestamppb.New(s.now.Add(expirationTime)),
+		},
+	)
+	s.Nil(err)
+
+	wt := addWorkflowTaskScheduledEvent(mutableState)
+	startEvent := addWorkflowTaskStartedEvent(mutableState, wt.ScheduledEventID, taskQueueName, uuid.New())
+	wt.StartedEventID = startEvent.GetEventId()
+	completionEvent := addWorkflowTaskCompletedEvent(&s.Suite, mutableState, wt.ScheduledEventID, wt.StartedEventID, "some random identity")
+	err = mutableState.PauseWorkflowExecution("some random identity", "some random reason")
+	s.NoError(err)
+
+	timerTask := &tasks.WorkflowRunTimeoutTask{
+		WorkflowKey:         workflowKey,
+		Version:             s.version,
+		TaskID:              s.mustGenerateTaskID(),
+		VisibilityTimestamp: s.now.Add(expirationTime),
+	}
+
+	persistenceMutableState := s.createPersistenceMutableState(mutableState, completionEvent.GetEventId(), completionEvent.GetVersion())
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+
+	s.timeSource.Update(s.now.Add(expirationTime + 1*time.Second))
+	resp := s.timerQueueActiveTaskExecutor.Execute(context.Background(), s.newTaskExecutable(timerTask))
+	s.NoError(resp.ExecutionErr)
+
+	s.True(s.getMutableStateFromCache(workflowKey).IsWorkflowExecutionRunning())
+}
+
 func (s *timerQueueActiveTaskExecutorSuite) TestWorkflowRunTimeout_Retry() {
 	execution := &commonpb.WorkflowExecution{
 		WorkflowId: "some random workflow ID",
@@ -1999,6 +2247,7 @@ func (s *timerQueueActiveTaskExecutorSuite) TestExecuteStateMachineTimerTask_Exe
 		metrics.NoopMetricsHandler,
 		s.config,
 		s.mockShard.Resource.GetMatchingClient(),
+		nil,
 	).(*timerQueueActiveTaskExecutor)
 
 	err = timerQueueActiveTaskExecutor.executeStateMachineTimerTask(context.Background(), task)
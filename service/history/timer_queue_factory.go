@@ -32,6 +32,7 @@ import (
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/telemetry"
+	"go.temporal.io/server/service/history/circuitbreakerpool"
 	"go.temporal.io/server/service/history/deletemanager"
 	historyi "go.temporal.io/server/service/history/interfaces"
 	"go.temporal.io/server/service/history/queues"
@@ -49,9 +50,10 @@ type (
 		fx.In
 
 		QueueFactoryBaseParams
-		ClientBean        client.Bean
-		MatchingRawClient resource.MatchingRawClient
-		VisibilityManager manager.VisibilityManager
+		ClientBean          client.Bean
+		MatchingRawClient   resource.MatchingRawClient
+		VisibilityManager   manager.VisibilityManager
+		ActivityRetryCBPool *circuitbreakerpool.ActivityRetryCircuitBreakerPool
 	}
 
 	timerQueueFactory struct {
@@ -139,6 +141,7 @@ func (f *timerQueueFactory) CreateQueue(
 		f.MetricsHandler,
 		f.Config,
 		f.MatchingRawClient,
+		f.ActivityRetryCBPool,
 	)
 
 	standbyExecutor := newTimerQueueStandbyTaskExecutor(
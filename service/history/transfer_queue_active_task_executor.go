@@ -160,6 +160,8 @@ func (t *transferQueueActiveTaskExecutor) Execute(
 		err = t.processResetWorkflow(ctx, task)
 	case *tasks.DeleteExecutionTask:
 		err = t.processDeleteExecutionTask(ctx, task)
+	case *tasks.ChildExecutionProgressTask:
+		err = t.processChildExecutionProgress(ctx, task)
 	default:
 		err = errUnknownTransferTask
 	}
@@ -215,6 +217,12 @@ func (t *transferQueueActiveTaskExecutor) processActivityTask(
 		return consts.ErrWorkflowCompleted
 	}
 
+	if mutableState.IsWorkflowExecutionPaused() {
+		// activity task will be regenerated when the workflow is unpaused
+		release(nil) // release(nil) so that the mutable state is not unloaded from cache
+		return nil
+	}
+
 	timeout := timestamp.DurationValue(ai.ScheduleToStartTimeout)
 	directive := MakeDirectiveForActivityTask(mutableState, ai)
 	priority := priorities.Merge(mutableState.GetExecutionInfo().Priority, ai.Priority)
@@ -247,6 +255,10 @@ func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// workflow task will be regenerated when the workflow is unpaused
+		return nil
+	}
 
 	workflowTask := mutableState.GetWorkflowTaskByID(transferTask.ScheduledEventID)
 	if workflowTask == nil {
@@ -388,6 +400,14 @@ func (t *transferQueueActiveTaskExecutor) processCloseExecution(
 
 	// Communicate the result to parent execution if this is Child Workflow execution
 	if replyToParentWorkflow {
+		if offloader := t.shardContext.GetPayloadOffloader(); offloader.Enabled() && parentNamespaceID != task.GetNamespaceID() {
+			// Offloaded payloads are only resolvable in the namespace that created them, hand the original payloads
+			// to a parent in another namespace. The completion event is shared with the events cache, use a copy.
+			completionEvent = common.CloneProto(completionEvent)
+			if err := offloader.Rehydrate(ctx, namespace.ID(task.GetNamespaceID()), completionEvent); err != nil {
+				return err
+			}
+		}
 		_, err := t.historyRawClient.RecordChildExecutionCompleted(ctx, &historyservice.RecordChildExecutionCompletedRequest{
 			NamespaceId: parentNamespaceID,
 			ParentExecution: &commonpb.WorkflowExecution{
@@ -449,6 +469,99 @@ func (t *transferQueueActiveTaskExecutor) processCloseExecution(
 	return err
 }
 
+func (t *transferQueueActiveTaskExecutor) processChildExecutionProgress(
+	ctx context.Context,
+	task *tasks.ChildExecutionProgressTask,
+) (retError error) {
+	ctx, cancel := context.WithTimeout(ctx, taskTimeout)
+	defer cancel()
+
+	weContext, release, err := getWorkflowExecutionContextForTask(ctx, t.shardContext, t.cache, task)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := loadMutableStateForTransferTask(ctx, t.shardContext, weContext, task, t.metricHandler, t.logger)
+	if err != nil {
+		return err
+	}
+	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
+		return nil
+	}
+
+	executionInfo := mutableState.GetExecutionInfo()
+	if task.EventID < executionInfo.ChildProgressEventId {
+		// newer progress was published since, its own task reports it
+		return nil
+	}
+	currentVersionHistory, err := versionhistory.GetCurrentVersionHistory(executionInfo.GetVersionHistories())
+	if err != nil {
+		return err
+	}
+	eventVersion, err := versionhistory.GetVersionHistoryEventVersion(currentVersionHistory, task.EventID)
+	if err != nil {
+		return err
+	}
+	err = CheckTaskVersion(t.shardContext, t.logger, mutableState.GetNamespaceEntry(), eventVersion, task.Version, task)
+	if err != nil {
+		return err
+	}
+
+	progress, ok := executionInfo.Memo[common.ChildProgressMemoKey]
+	if !ok || len(progress.GetData()) == 0 {
+		return nil
+	}
+	progress = common.CloneProto(progress)
+
+	parentNamespaceID := executionInfo.ParentNamespaceId
+	parentWorkflowID := executionInfo.ParentWorkflowId
+	parentRunID := executionInfo.ParentRunId
+	parentInitiatedID := executionInfo.ParentInitiatedId
+	parentInitiatedVersion := executionInfo.ParentInitiatedVersion
+	var parentClock *clockspb.VectorClock
+	if executionInfo.ParentClock != nil {
+		parentClock = vclock.NewVectorClock(
+			executionInfo.ParentClock.ClusterId,
+			executionInfo.ParentClock.ShardId,
+			executionInfo.ParentClock.Clock,
+		)
+	}
+
+	firstRunID, err := mutableState.GetFirstRunID(ctx)
+	if err != nil {
+		return err
+	}
+
+	// NOTE: do not access anything related mutable state after this lock release.
+	release(nil)
+
+	_, err = t.historyRawClient.RecordChildExecutionProgress(ctx, &historyservice.RecordChildExecutionProgressRequest{
+		NamespaceId: parentNamespaceID,
+		ParentExecution: &commonpb.WorkflowExecution{
+			WorkflowId: parentWorkflowID,
+			RunId:      parentRunID,
+		},
+		ParentInitiatedId:      parentInitiatedID,
+		ParentInitiatedVersion: parentInitiatedVersion,
+		ChildExecution: &commonpb.WorkflowExecution{
+			WorkflowId: task.GetWorkflowID(),
+			RunId:      task.GetRunID(),
+		},
+		Clock:                    parentClock,
+		ChildFirstExecutionRunId: firstRunID,
+		Sequence:                 task.EventID,
+		Progress:                 &commonpb.Payloads{Payloads: []*commonpb.Payload{progress}},
+	})
+	switch err.(type) {
+	case nil, *serviceerror.NotFound, *serviceerror.NamespaceNotFound:
+		// parent or child gone, noop
+		return nil
+	default:
+		return err
+	}
+}
+
 func (t *transferQueueActiveTaskExecutor) processCancelExecution(
 	ctx context.Context,
 	task *tasks.CancelExecutionTask,
@@ -779,6 +892,11 @@ func (t *transferQueueActiveTaskExecutor) processStartChildExecution(
 		return nil
 	}
 
+	if !childStarted && mutableState.IsWorkflowExecutionPaused() {
+		// start child task will be regenerated when the workflow is unpaused
+		return nil
+	}
+
 	// ChildExecution already started, just create WorkflowTask and complete transfer task
 	// If parent already closed, since child workflow started event already written to history,
 	// still schedule the workflowTask if the parent close policy is Abandon.
@@ -124,6 +124,9 @@ func (t *transferQueueStandbyTaskExecutor) Execute(
 		// no reset needed for standby
 		// TODO: add error logs
 		err = nil
+	case *tasks.ChildExecutionProgressTask:
+		// progress is best effort, the parent is notified by whichever cluster is active when the child publishes
+		err = nil
 	case *tasks.CloseExecutionTask:
 		err = t.processCloseExecution(ctx, task)
 	case *tasks.DeleteExecutionTask:
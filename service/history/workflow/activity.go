@@ -68,6 +68,9 @@ func UpdateActivityInfoForRetries(
 	failure *failurepb.Failure,
 	nextScheduledTime *timestamppb.Timestamp,
 ) *persistencespb.ActivityInfo {
+	if attempt != ai.Attempt {
+		ai.RetryBudgetDeferrals = 0
+	}
 	ai.Attempt = attempt
 	ai.Version = version
 	ai.ScheduledTime = nextScheduledTime
@@ -112,7 +115,7 @@ func GetPendingActivityInfo(
 		if p.State == enumspb.PENDING_ACTIVITY_STATE_SCHEDULED {
 			scheduledTime := ai.ScheduledTime.AsTime()
 			if now.Before(scheduledTime) {
-				// in this case activity is waiting for a retry
+				// in this case activity is waiting for a retry, which may have been deferred by an exhausted retry budget
 				p.NextAttemptScheduleTime = ai.ScheduledTime
 				currentRetryDuration := p.NextAttemptScheduleTime.AsTime().Sub(p.LastAttemptCompleteTime.AsTime())
 				p.CurrentRetryInterval = durationpb.New(currentRetryDuration)
@@ -27,7 +27,9 @@
 package cache
 
 import (
+	"cmp"
 	"context"
+	"slices"
 	"sync/atomic"
 	"time"
 	"unicode/utf8"
@@ -37,6 +39,7 @@ import (
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/finalizer"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/locks"
@@ -68,6 +71,12 @@ type (
 			execution *commonpb.WorkflowExecution,
 			lockPriority locks.Priority,
 		) (historyi.WorkflowContext, historyi.ReleaseWorkflowContextFunc, error)
+
+		// DescribeShardEntries returns the largest entries of the given shard in the cache, largest first.
+		DescribeShardEntries(
+			shardContext historyi.ShardContext,
+			maxEntries int,
+		) ShardEntriesInfo
 	}
 
 	cacheImpl struct {
@@ -85,6 +94,22 @@ type (
 
 	NewCacheFn func(config *configs.Config, logger log.Logger, handler metrics.Handler) Cache
 
+	// ShardEntriesInfo describes the entries of one shard in the cache. Sizes are in bytes when the cache is size
+	// based, and in number of entries otherwise. When Truncated is set, only part of the cache was scanned and the
+	// entries, count and size of the shard are for that part only.
+	ShardEntriesInfo struct {
+		Largest    []EntryInfo
+		EntryCount int
+		Size       int
+		CacheSize  int
+		Truncated  bool
+	}
+
+	EntryInfo struct {
+		WorkflowKey definition.WorkflowKey
+		Size        int
+	}
+
 	Key struct {
 		// Those are exported because some unit tests uses the cache directly.
 		// TODO: Update the unit tests and make those fields private.
@@ -102,6 +127,8 @@ const (
 
 const (
 	workflowLockTimeoutTailTime = 500 * time.Millisecond
+	// describeShardEntriesMaxScan bounds how many entries DescribeShardEntries visits while the cache is locked.
+	describeShardEntriesMaxScan = 10000
 )
 
 func NewHostLevelCache(
@@ -117,6 +144,7 @@ func NewHostLevelCache(
 		maxSize,
 		config.HistoryCacheTTL(),
 		config.HistoryCacheNonUserContextLockTimeout(),
+		config.HistoryCacheNamespaceMaxSizeRatio,
 		logger,
 		handler,
 	)
@@ -135,6 +163,7 @@ func NewShardLevelCache(
 		maxSize,
 		config.HistoryCacheTTL(),
 		config.HistoryCacheNonUserContextLockTimeout(),
+		config.HistoryCacheNamespaceMaxSizeRatio,
 		logger,
 		handler,
 	)
@@ -144,12 +173,23 @@ func newCache(
 	size int,
 	ttl time.Duration,
 	nonUserContextLockTimeout time.Duration,
+	namespaceMaxSizeRatio dynamicconfig.FloatPropertyFnWithNamespaceIDFilter,
 	logger log.Logger,
 	handler metrics.Handler,
 ) Cache {
 	opts := &cache.Options{
 		TTL: ttl,
 		Pin: true,
+		PartitionFn: func(key any) string {
+			//revive:disable-next-line:unchecked-type-assertion
+			return key.(Key).WorkflowKey.NamespaceID
+		},
+		PartitionMaxSize: func(namespaceID string) int {
+			if namespaceMaxSizeRatio == nil {
+				return 0 // should only happen in unit tests
+			}
+			return int(namespaceMaxSizeRatio(namespace.ID(namespaceID)) * float64(size))
+		},
 		OnPut: func(val any) {
 			//revive:disable-next-line:unchecked-type-assertion
 			item := val.(*cacheItem)
@@ -195,6 +235,58 @@ func newCache(
 	}
 }
 
+func (c *cacheImpl) DescribeShardEntries(
+	shardContext historyi.ShardContext,
+	maxEntries int,
+) ShardEntriesInfo {
+	info := ShardEntriesInfo{CacheSize: c.Size()}
+	info.Largest, info.Truncated = c.snapshotShardEntries(shardContext.GetOwner())
+	for _, entry := range info.Largest {
+		info.EntryCount++
+		info.Size += entry.Size
+	}
+
+	slices.SortFunc(info.Largest, func(a, b EntryInfo) int {
+		return cmp.Compare(b.Size, a.Size)
+	})
+	if len(info.Largest) > maxEntries {
+		info.Largest = info.Largest[:maxEntries]
+	}
+	return info
+}
+
+// snapshotShardEntries copies the entries of the given shard out of the cache. The cache is locked while it is
+// iterated, so at most describeShardEntriesMaxScan entries are visited, most recently used first.
+func (c *cacheImpl) snapshotShardEntries(shardUUID string) (_ []EntryInfo, truncated bool) {
+	var entries []EntryInfo
+	it := c.Iterator()
+	defer it.Close()
+	for scanned := 0; it.HasNext(); scanned++ {
+		if scanned == describeShardEntriesMaxScan {
+			return entries, true
+		}
+		entry := it.Next()
+		//revive:disable-next-line:unchecked-type-assertion
+		key := entry.Key().(Key)
+		if key.ShardUUID == shardUUID {
+			entries = append(entries, EntryInfo{WorkflowKey: key.WorkflowKey, Size: entrySize(entry)})
+		}
+	}
+	return entries, false
+}
+
+// entrySize returns the size the cache accounts for the entry, or the size of its value when the cache does not report
+// it.
+func entrySize(entry cache.Entry) int {
+	if sized, ok := entry.(cache.SizedEntry); ok {
+		return sized.Size()
+	}
+	if sizeGetter, ok := entry.Value().(cache.SizeGetter); ok {
+		return sizeGetter.CacheSize()
+	}
+	return 1
+}
+
 func (c *cacheImpl) GetOrCreateCurrentWorkflowExecution(
 	ctx context.Context,
 	shardContext historyi.ShardContext,
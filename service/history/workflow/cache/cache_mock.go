@@ -67,6 +67,20 @@ func (m *MockCache) EXPECT() *MockCacheMockRecorder {
 	return m.recorder
 }
 
+// DescribeShardEntries mocks base method.
+func (m *MockCache) DescribeShardEntries(shardContext interfaces.ShardContext, maxEntries int) ShardEntriesInfo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeShardEntries", shardContext, maxEntries)
+	ret0, _ := ret[0].(ShardEntriesInfo)
+	return ret0
+}
+
+// DescribeShardEntries indicates an expected call of DescribeShardEntries.
+func (mr *MockCacheMockRecorder) DescribeShardEntries(shardContext, maxEntries any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeShardEntries", reflect.TypeOf((*MockCache)(nil).DescribeShardEntries), shardContext, maxEntries)
+}
+
 // GetOrCreateCurrentWorkflowExecution mocks base method.
 func (m *MockCache) GetOrCreateCurrentWorkflowExecution(ctx context.Context, shardContext interfaces.ShardContext, namespaceID namespace.ID, workflowID string, lockPriority locks.Priority) (interfaces.ReleaseWorkflowContextFunc, error) {
 	m.ctrl.T.Helper()
@@ -27,6 +27,7 @@ package cache
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync"
 	"testing"
 	"time"
@@ -891,3 +892,44 @@ func (s *workflowCacheSuite) TestCacheImpl_GetCurrentRunID_NoCurrentRun() {
 	s.Nil(ctx)
 	s.Nil(release)
 }
+
+func (s *workflowCacheSuite) TestHistoryCacheNamespaceMaxSize() {
+	s.mockShard.GetConfig().HistoryHostLevelCacheMaxSize = dynamicconfig.GetIntPropertyFn(10)
+	s.mockShard.GetConfig().HistoryCacheNamespaceMaxSizeRatio = func(namespaceID namespace.ID) float64 {
+		if namespaceID == "noisy_namespace_id" {
+			return 0.2
+		}
+		return 0
+	}
+	s.cache = NewHostLevelCache(s.mockShard.GetConfig(), s.mockShard.GetLogger(), metrics.NoopMetricsHandler)
+
+	load := func(namespaceID namespace.ID, workflowID string) {
+		_, release, err := s.cache.GetOrCreateWorkflowExecution(
+			context.Background(),
+			s.mockShard,
+			namespaceID,
+			&commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: uuid.New()},
+			locks.PriorityHigh,
+		)
+		s.NoError(err)
+		release(nil)
+	}
+	for i := 0; i < 4; i++ {
+		load("noisy_namespace_id", fmt.Sprintf("noisy-%d", i))
+		load("quiet_namespace_id", fmt.Sprintf("quiet-%d", i))
+	}
+
+	info := s.cache.DescribeShardEntries(s.mockShard, 3)
+	s.Equal(6, info.EntryCount)
+	s.Equal(6, info.Size)
+	s.Equal(6, info.CacheSize)
+	s.Len(info.Largest, 3)
+
+	perNamespace := make(map[string]int)
+	it := s.cache.(*cacheImpl).Iterator()
+	for it.HasNext() {
+		perNamespace[it.Next().Key().(Key).WorkflowKey.NamespaceID]++
+	}
+	it.Close()
+	s.Equal(map[string]int{"noisy_namespace_id": 2, "quiet_namespace_id": 4}, perNamespace)
+}
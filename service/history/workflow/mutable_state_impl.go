@@ -29,6 +29,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"math/rand"
 	"reflect"
 	"slices"
@@ -81,6 +82,7 @@ import (
 	"go.temporal.io/server/common/util"
 	"go.temporal.io/server/common/worker_versioning"
 	"go.temporal.io/server/components/callbacks"
+	"go.temporal.io/server/components/nexusoperations"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/events"
@@ -563,6 +565,7 @@ func NewMutableStateInChain(
 	// carry over necessary fields from current mutable state
 	newMutableState.executionInfo.WorkflowExecutionTimerTaskStatus = currentMutableState.GetExecutionInfo().WorkflowExecutionTimerTaskStatus
 	newMutableState.executionInfo.ChildrenInitializedPostResetPoint = currentMutableState.GetExecutionInfo().ChildrenInitializedPostResetPoint
+	newMutableState.carryOverSignalRequestIDs(currentMutableState)
 
 	// TODO: Today other information like autoResetPoints, previousRunID, firstRunID, etc.
 	// are carried over in AddWorkflowExecutionStartedEventWithOptions. Ideally all information
@@ -591,7 +594,7 @@ func (ms *MutableStateImpl) ChasmTree() historyi.ChasmTree {
 // Completions may be sent to arbitrary third parties, we intentionally do not include any termination reasons, and
 // expose only failure messages.
 func (ms *MutableStateImpl) GetNexusCompletion(ctx context.Context) (nexus.OperationCompletion, error) {
-	ce, err := ms.GetCompletionEvent(ctx)
+	ce, err := ms.getRehydratedCompletionEvent(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -703,7 +706,7 @@ func (ms *MutableStateImpl) GetNexusCompletion(ctx context.Context) (nexus.Opera
 // GetHSMCallbackArg converts a workflow completion event into a [persistencespb.HSMCallbackArg].
 func (ms *MutableStateImpl) GetHSMCompletionCallbackArg(ctx context.Context) (*persistencespb.HSMCompletionCallbackArg, error) {
 	workflowKey := ms.GetWorkflowKey()
-	ce, err := ms.GetCompletionEvent(ctx)
+	ce, err := ms.getRehydratedCompletionEvent(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1520,6 +1523,25 @@ func (ms *MutableStateImpl) GetCompletionEvent(
 	return event, nil
 }
 
+// getRehydratedCompletionEvent returns the completion event with offloaded payloads replaced by the original ones.
+// It is used for completions delivered outside of the workflow's namespace, e.g. to completion callbacks.
+func (ms *MutableStateImpl) getRehydratedCompletionEvent(ctx context.Context) (*historypb.HistoryEvent, error) {
+	ce, err := ms.GetCompletionEvent(ctx)
+	if err != nil {
+		return nil, err
+	}
+	offloader := ms.shard.GetPayloadOffloader()
+	if !offloader.Enabled() {
+		return ce, nil
+	}
+	// The completion event is shared with the events cache, re-hydrate a copy of it.
+	ce = common.CloneProto(ce)
+	if err := offloader.Rehydrate(ctx, namespace.ID(ms.executionInfo.NamespaceId), ce); err != nil {
+		return nil, err
+	}
+	return ce, nil
+}
+
 // GetWorkflowCloseTime returns workflow closed time, returns a zero time for open workflow
 func (ms *MutableStateImpl) GetWorkflowCloseTime(ctx context.Context) (time.Time, error) {
 	if ms.executionState.GetState() == enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED && ms.executionInfo.CloseTime == nil {
@@ -2069,8 +2091,11 @@ func (ms *MutableStateImpl) IsWorkflowCloseAttempted() bool {
 func (ms *MutableStateImpl) IsSignalRequested(
 	requestID string,
 ) bool {
-	_, ok := ms.pendingSignalRequestedIDs[requestID]
-	return ok
+	if _, ok := ms.pendingSignalRequestedIDs[requestID]; ok {
+		return true
+	}
+	acceptedTime, ok := ms.executionInfo.ChainSignalRequestIds[requestID]
+	return ok && ms.timeSource.Now().Sub(acceptedTime.AsTime()) < ms.signalRequestIDDedupWindow()
 }
 
 func (ms *MutableStateImpl) IsWorkflowPendingOnWorkflowTaskBackoff() bool {
@@ -2105,6 +2130,49 @@ func (ms *MutableStateImpl) AddSignalRequested(
 	ms.approximateSize += len(requestID)
 }
 
+func (ms *MutableStateImpl) signalRequestIDDedupWindow() time.Duration {
+	return ms.config.SignalRequestIDDedupWindow(ms.namespaceEntry.Name().String())
+}
+
+// carryOverSignalRequestIDs records the signal request IDs of the previous run of the chain that are still within the
+// dedup window, so that retried signals are not delivered again to the new run. IDs accepted by the previous run
+// itself are stamped with the current time, the window of those starts when the run ends. Only the most recent IDs are
+// kept, up to the configured maximum. The IDs are written once with the new run and are not updated afterward.
+func (ms *MutableStateImpl) carryOverSignalRequestIDs(previousMutableState historyi.MutableState) {
+	namespaceName := ms.namespaceEntry.Name().String()
+	window := ms.config.SignalRequestIDDedupWindow(namespaceName)
+	maxIDs := ms.config.SignalRequestIDDedupMaxIDs(namespaceName)
+	if window <= 0 || maxIDs <= 0 {
+		return
+	}
+
+	now := ms.timeSource.Now()
+	carried := make(map[string]*timestamppb.Timestamp)
+	for requestID, acceptedTime := range previousMutableState.GetExecutionInfo().GetChainSignalRequestIds() {
+		if now.Sub(acceptedTime.AsTime()) < window {
+			carried[requestID] = acceptedTime
+		}
+	}
+	for _, requestID := range previousMutableState.GetPendingSignalRequestedIds() {
+		carried[requestID] = timestamppb.New(now)
+	}
+	if len(carried) == 0 {
+		return
+	}
+	if len(carried) > maxIDs {
+		requestIDs := slices.SortedFunc(maps.Keys(carried), func(a, b string) int {
+			return carried[b].AsTime().Compare(carried[a].AsTime())
+		})
+		for _, requestID := range requestIDs[maxIDs:] {
+			delete(carried, requestID)
+		}
+	}
+
+	ms.approximateSize -= ms.executionInfo.Size()
+	ms.executionInfo.ChainSignalRequestIds = carried
+	ms.approximateSize += ms.executionInfo.Size()
+}
+
 func (ms *MutableStateImpl) DeleteSignalRequested(
 	requestID string,
 ) {
@@ -2112,6 +2180,11 @@ func (ms *MutableStateImpl) DeleteSignalRequested(
 	delete(ms.updateSignalRequestedIDs, requestID)
 	ms.deleteSignalRequestedIDs[requestID] = struct{}{}
 	ms.approximateSize -= len(requestID)
+	if _, ok := ms.executionInfo.ChainSignalRequestIds[requestID]; ok {
+		ms.approximateSize -= ms.executionInfo.Size()
+		delete(ms.executionInfo.ChainSignalRequestIds, requestID)
+		ms.approximateSize += ms.executionInfo.Size()
+	}
 }
 
 func (ms *MutableStateImpl) attachRequestID(requestID string, eventType enumspb.EventType) {
@@ -3819,7 +3892,7 @@ func (ms *MutableStateImpl) ApplyWorkflowExecutionCompletedEvent(
 	ms.executionInfo.CloseTime = event.GetEventTime()
 	ms.ClearStickyTaskQueue()
 	ms.writeEventToCache(event)
-	return ms.processCloseCallbacks()
+	return ms.processCloseHooks()
 }
 
 func (ms *MutableStateImpl) AddFailWorkflowEvent(
@@ -3862,7 +3935,7 @@ func (ms *MutableStateImpl) ApplyWorkflowExecutionFailedEvent(
 	ms.executionInfo.CloseTime = event.GetEventTime()
 	ms.ClearStickyTaskQueue()
 	ms.writeEventToCache(event)
-	return ms.processCloseCallbacks()
+	return ms.processCloseHooks()
 }
 
 func (ms *MutableStateImpl) AddTimeoutWorkflowEvent(
@@ -3904,7 +3977,7 @@ func (ms *MutableStateImpl) ApplyWorkflowExecutionTimedoutEvent(
 	ms.executionInfo.CloseTime = event.GetEventTime()
 	ms.ClearStickyTaskQueue()
 	ms.writeEventToCache(event)
-	return ms.processCloseCallbacks()
+	return ms.processCloseHooks()
 }
 
 func (ms *MutableStateImpl) AddWorkflowExecutionCancelRequestedEvent(
@@ -3981,7 +4054,7 @@ func (ms *MutableStateImpl) ApplyWorkflowExecutionCanceledEvent(
 	ms.executionInfo.CloseTime = event.GetEventTime()
 	ms.ClearStickyTaskQueue()
 	ms.writeEventToCache(event)
-	return ms.processCloseCallbacks()
+	return ms.processCloseHooks()
 }
 
 func (ms *MutableStateImpl) AddRequestCancelExternalWorkflowExecutionInitiatedEvent(
@@ -4210,6 +4283,13 @@ func (ms *MutableStateImpl) AddWorkflowPropertiesModifiedEvent(
 	if err := ms.taskGenerator.GenerateUpsertVisibilityTask(); err != nil {
 		return nil, err
 	}
+	if ms.HasParentExecution() &&
+		ms.executionInfo.ChildProgressEventId == event.GetEventId() &&
+		ms.config.EnableChildWorkflowProgress(ms.namespaceEntry.Name().String()) {
+		if err := ms.taskGenerator.GenerateChildExecutionProgressTasks(event); err != nil {
+			return nil, err
+		}
+	}
 	return event, nil
 }
 
@@ -4221,6 +4301,10 @@ func (ms *MutableStateImpl) ApplyWorkflowPropertiesModifiedEvent(
 		upsertMemo := attr.GetUpsertedMemo().GetFields()
 		ms.approximateSize -= ms.executionInfo.Size()
 		ms.updateMemo(upsertMemo)
+		// progress published for the parent is the memo value written by the latest event
+		if len(upsertMemo[common.ChildProgressMemoKey].GetData()) > 0 {
+			ms.executionInfo.ChildProgressEventId = event.GetEventId()
+		}
 		ms.approximateSize += ms.executionInfo.Size()
 	}
 }
@@ -4818,7 +4902,7 @@ func (ms *MutableStateImpl) ApplyWorkflowExecutionTerminatedEvent(
 	ms.ClearStickyTaskQueue()
 	ms.writeEventToCache(event)
 
-	return ms.processCloseCallbacks()
+	return ms.processCloseHooks()
 }
 
 func (ms *MutableStateImpl) AddWorkflowExecutionSignaled(
@@ -5518,6 +5602,20 @@ func (ms *MutableStateImpl) RegenerateActivityRetryTask(ai *persistencespb.Activ
 	return ms.taskGenerator.GenerateActivityRetryTasks(ai)
 }
 
+// DeferActivityRetry moves the next attempt of an activity that is waiting for a retry to the given time, while
+// leaving its attempt and the failure and worker identity of the previous attempt untouched.
+func (ms *MutableStateImpl) DeferActivityRetry(ai *persistencespb.ActivityInfo, nextScheduledTime time.Time) error {
+	if err := ms.UpdateActivity(ai.ScheduledEventId, func(activityInfo *persistencespb.ActivityInfo, _ historyi.MutableState) error {
+		activityInfo.Version = ms.GetCurrentVersion()
+		activityInfo.ScheduledTime = timestamppb.New(nextScheduledTime)
+		activityInfo.RetryBudgetDeferrals++
+		return nil
+	}); err != nil {
+		return err
+	}
+	return ms.taskGenerator.GenerateActivityRetryTasks(ai)
+}
+
 func (ms *MutableStateImpl) updateActivityInfoForRetries(
 	ai *persistencespb.ActivityInfo,
 	nextScheduledTime time.Time,
@@ -5581,6 +5679,42 @@ func (ms *MutableStateImpl) UpdateActivity(scheduledEventId int64, updater histo
 	return nil
 }
 
+// IsWorkflowExecutionPaused returns true if the workflow execution was paused with PauseWorkflowExecution.
+func (ms *MutableStateImpl) IsWorkflowExecutionPaused() bool {
+	return ms.executionInfo.GetPauseInfo() != nil
+}
+
+// PauseWorkflowExecution records that the workflow execution is paused. Task executors check the pause state
+// before dispatching tasks, so tasks processed while the workflow is paused are dropped and must be regenerated
+// when it is unpaused.
+//
+// The public API has no pause event type, so the pause is kept in mutable state only. It is not written to
+// history, and a workflow rebuilt from its history is not paused.
+func (ms *MutableStateImpl) PauseWorkflowExecution(identity string, reason string) error {
+	if !ms.IsWorkflowExecutionRunning() {
+		return consts.ErrWorkflowCompleted
+	}
+	if ms.IsWorkflowExecutionPaused() {
+		return nil
+	}
+	ms.executionInfo.PauseInfo = &persistencespb.WorkflowPauseInfo{
+		PauseTime: timestamppb.New(ms.timeSource.Now()),
+		Identity:  identity,
+		Reason:    reason,
+	}
+	return ms.updatePauseInfoSearchAttribute()
+}
+
+// UnpauseWorkflowExecution clears the pause state of the workflow execution. The caller is responsible for
+// regenerating the tasks that were dropped while the workflow was paused.
+func (ms *MutableStateImpl) UnpauseWorkflowExecution() error {
+	if !ms.IsWorkflowExecutionPaused() {
+		return nil
+	}
+	ms.executionInfo.PauseInfo = nil
+	return ms.updatePauseInfoSearchAttribute()
+}
+
 func (ms *MutableStateImpl) updatePauseInfoSearchAttribute() error {
 	pausedInfoMap := make(map[string]struct{})
 
@@ -5590,7 +5724,10 @@ func (ms *MutableStateImpl) updatePauseInfoSearchAttribute() error {
 		}
 		pausedInfoMap[ai.ActivityType.Name] = struct{}{}
 	}
-	pausedInfo := make([]string, 0, len(pausedInfoMap))
+	pausedInfo := make([]string, 0, len(pausedInfoMap)+1)
+	if ms.IsWorkflowExecutionPaused() {
+		pausedInfo = append(pausedInfo, "property:workflowPaused=true")
+	}
 	for activityType := range pausedInfoMap {
 		pausedInfo = append(pausedInfo, fmt.Sprintf("property:activityType=%s", activityType))
 	}
@@ -5613,6 +5750,65 @@ func (ms *MutableStateImpl) updatePauseInfoSearchAttribute() error {
 	return ms.taskGenerator.GenerateUpsertVisibilityTask()
 }
 
+// UpsertSearchAttributesWithoutEvent merges the given search attributes into the workflow's
+// search attributes without recording a history event, and schedules a visibility update if
+// anything changed. It is used by server-side policies that must not alter workflow history.
+func (ms *MutableStateImpl) UpsertSearchAttributesWithoutEvent(
+	searchAttributes map[string]*commonpb.Payload,
+) error {
+	changed := false
+	for key, value := range searchAttributes {
+		if !proto.Equal(ms.executionInfo.SearchAttributes[key], value) {
+			changed = true
+			break
+		}
+	}
+	if !changed {
+		return nil // unchanged
+	}
+
+	ms.updateSearchAttributes(searchAttributes)
+	return ms.taskGenerator.GenerateUpsertVisibilityTask()
+}
+
+// AddChildExecutionProgressSignaledEvent delivers the progress reported by a pending child execution to the workflow
+// as a signal sent by the child execution. It returns a nil event if the report is not newer than the last one of the
+// same child run.
+func (ms *MutableStateImpl) AddChildExecutionProgressSignaledEvent(
+	initiatedID int64,
+	childExecution *commonpb.WorkflowExecution,
+	sequence int64,
+	progress *commonpb.Payloads,
+) (*historypb.HistoryEvent, error) {
+	opTag := tag.WorkflowActionChildWorkflowProgress
+	if err := ms.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	ci, ok := ms.GetChildExecutionInfo(initiatedID)
+	if !ok {
+		return nil, ErrMissingChildWorkflowInfo
+	}
+	if ci.ProgressRunId == childExecution.GetRunId() && sequence <= ci.ProgressSequence {
+		return nil, nil
+	}
+
+	ms.approximateSize -= ci.Size()
+	ci.ProgressRunId = childExecution.GetRunId()
+	ci.ProgressSequence = sequence
+	ms.updateChildExecutionInfos[ci.InitiatedEventId] = ci
+	ms.approximateSize += ci.Size()
+
+	return ms.AddWorkflowExecutionSignaledEvent(
+		common.ChildProgressSignalName,
+		progress,
+		"",
+		nil,
+		childExecution,
+		nil,
+	)
+}
+
 func (ms *MutableStateImpl) truncateRetryableActivityFailure(
 	activityFailure *failurepb.Failure,
 ) *failurepb.Failure {
@@ -5656,6 +5852,15 @@ func (ms *MutableStateImpl) AddHistorySize(size int64) {
 	ms.executionInfo.ExecutionStats.HistorySize += size
 }
 
+// processCloseHooks triggers "WorkflowClosed" callbacks and requests cancelation of the Nexus operations that should
+// be canceled when the caller closes.
+func (ms *MutableStateImpl) processCloseHooks() error {
+	if err := ms.processCloseCallbacks(); err != nil {
+		return err
+	}
+	return nexusoperations.CancelOnCallerClose(ms.HSM(), ms.executionInfo.CloseTime.AsTime())
+}
+
 // processCloseCallbacks triggers "WorkflowClosed" callbacks, applying the state machine transition that schedules
 // callback tasks.
 func (ms *MutableStateImpl) processCloseCallbacks() error {
@@ -5981,6 +6186,12 @@ func (ms *MutableStateImpl) closeTransaction(
 		return closeTransactionResult{}, err
 	}
 
+	if err := ms.closeTransactionUpdateContinueAsNewPolicySearchAttribute(
+		transactionPolicy,
+	); err != nil {
+		return closeTransactionResult{}, err
+	}
+
 	if ms.isStateDirty() {
 		if err := ms.closeTransactionUpdateTransitionHistory(
 			transactionPolicy,
@@ -6040,6 +6251,53 @@ func (ms *MutableStateImpl) closeTransaction(
 	}, nil
 }
 
+// closeTransactionUpdateContinueAsNewPolicySearchAttribute sets the search attribute of the namespace continue-as-new
+// policy once the history crosses the warn threshold. The attribute has no history event behind it, so it is
+// recomputed on every transaction instead: it survives reloads, resets and rebuilds of the mutable state.
+func (ms *MutableStateImpl) closeTransactionUpdateContinueAsNewPolicySearchAttribute(
+	transactionPolicy historyi.TransactionPolicy,
+) error {
+	if transactionPolicy != historyi.TransactionPolicyActive || !ms.IsWorkflowExecutionRunning() {
+		return nil
+	}
+
+	namespaceName := ms.GetNamespaceEntry().Name().String()
+	saName := ms.config.ContinueAsNewPolicyWarnSearchAttribute(namespaceName)
+	warnThreshold := ms.config.ContinueAsNewPolicyWarnThreshold(namespaceName)
+	if saName == "" || warnThreshold <= 0 {
+		return nil
+	}
+	usage := HistoryLimitUsage(
+		ms.GetNextEventID()-1,
+		ms.executionInfo.GetExecutionStats().GetHistorySize(),
+		ms.config.HistoryCountLimitError(namespaceName),
+		ms.config.HistorySizeLimitError(namespaceName),
+	)
+	if usage < warnThreshold {
+		return nil
+	}
+
+	value, err := searchattribute.EncodeValue(true, enumspb.INDEXED_VALUE_TYPE_BOOL)
+	if err != nil {
+		return err
+	}
+	unaliased, err := searchattribute.UnaliasFields(
+		ms.shard.GetSearchAttributesMapperProvider(),
+		&commonpb.SearchAttributes{IndexedFields: map[string]*commonpb.Payload{saName: value}},
+		namespaceName,
+	)
+	if err != nil {
+		// A misconfigured search attribute must not block the workflow.
+		ms.shard.GetThrottledLogger().Warn("Unable to set continue-as-new policy search attribute.",
+			tag.WorkflowNamespace(namespaceName),
+			tag.NewStringTag("search-attribute", saName),
+			tag.Error(err),
+		)
+		return nil
+	}
+	return ms.UpsertSearchAttributesWithoutEvent(unaliased.GetIndexedFields())
+}
+
 func (ms *MutableStateImpl) closeTransactionHandleWorkflowTask(
 	transactionPolicy historyi.TransactionPolicy,
 ) error {
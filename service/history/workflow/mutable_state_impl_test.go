@@ -27,7 +27,9 @@ package workflow
 import (
 	"context"
 	"fmt"
+	"maps"
 	"reflect"
+	"slices"
 	"sort"
 	"testing"
 	"time"
@@ -1347,6 +1349,107 @@ func (s *mutableStateSuite) TestNewMutableStateInChain() {
 	}
 }
 
+func (s *mutableStateSuite) TestSignalRequestIDDedupAcrossChain() {
+	s.mockConfig.SignalRequestIDDedupWindow = dynamicconfig.GetDurationPropertyFnFilteredByNamespace(time.Hour)
+	s.mockConfig.SignalRequestIDDedupMaxIDs = dynamicconfig.GetIntPropertyFnFilteredByNamespace(3)
+	now := s.mockShard.GetTimeSource().Now()
+	newMutableStateInChain := func(currentMutableState historyi.MutableState) historyi.MutableState {
+		newMutableState, err := NewMutableStateInChain(
+			s.mockShard,
+			s.mockEventsCache,
+			s.logger,
+			tests.GlobalNamespaceEntry,
+			tests.WorkflowID,
+			uuid.New(),
+			now,
+			currentMutableState,
+		)
+		s.NoError(err)
+		return newMutableState
+	}
+
+	firstMutableState := TestGlobalMutableState(s.mockShard, s.mockEventsCache, s.logger, 1000, tests.WorkflowID, uuid.New())
+	firstMutableState.AddSignalRequested("first-run")
+	s.Empty(firstMutableState.GetExecutionInfo().GetChainSignalRequestIds(), "accepting a signal must not rewrite the execution info")
+
+	secondMutableState := newMutableStateInChain(firstMutableState)
+	s.True(secondMutableState.IsSignalRequested("first-run"))
+	s.Empty(secondMutableState.GetPendingSignalRequestedIds())
+
+	secondMutableState.GetExecutionInfo().ChainSignalRequestIds["expired"] = timestamppb.New(now.Add(-2 * time.Hour))
+	secondMutableState.GetExecutionInfo().ChainSignalRequestIds["oldest"] = timestamppb.New(now.Add(-time.Minute))
+	s.False(secondMutableState.IsSignalRequested("expired"))
+	secondMutableState.AddSignalRequested("second-run")
+	secondMutableState.AddSignalRequested("deleted")
+	secondMutableState.DeleteSignalRequested("first-run")
+	secondMutableState.DeleteSignalRequested("deleted")
+	s.False(secondMutableState.IsSignalRequested("first-run"))
+
+	// Expired entries are dropped and only the most recent IDs are kept.
+	thirdMutableState := newMutableStateInChain(secondMutableState)
+	s.True(thirdMutableState.IsSignalRequested("second-run"))
+	s.True(thirdMutableState.IsSignalRequested("oldest"))
+	s.False(thirdMutableState.IsSignalRequested("first-run"))
+	s.False(thirdMutableState.IsSignalRequested("deleted"))
+	s.ElementsMatch([]string{"second-run", "oldest"}, slices.Collect(maps.Keys(thirdMutableState.GetExecutionInfo().GetChainSignalRequestIds())))
+
+	s.mockConfig.SignalRequestIDDedupMaxIDs = dynamicconfig.GetIntPropertyFnFilteredByNamespace(1)
+	thirdMutableState.AddSignalRequested("third-run")
+	fourthMutableState := newMutableStateInChain(thirdMutableState)
+	s.Len(fourthMutableState.GetExecutionInfo().GetChainSignalRequestIds(), 1)
+	s.False(fourthMutableState.IsSignalRequested("oldest"))
+}
+
+func (s *mutableStateSuite) TestAddChildExecutionProgressSignaledEvent() {
+	mutableState := TestGlobalMutableState(
+		s.mockShard,
+		s.mockEventsCache,
+		s.logger,
+		1000,
+		tests.WorkflowID,
+		uuid.New(),
+	)
+	ci := &persistencespb.ChildExecutionInfo{
+		InitiatedEventId:  5,
+		StartedEventId:    common.EmptyEventID,
+		StartedWorkflowId: "child-workflow-id",
+	}
+	mutableState.pendingChildExecutionInfoIDs[ci.InitiatedEventId] = ci
+
+	child := &commonpb.WorkflowExecution{WorkflowId: "child-workflow-id", RunId: uuid.New()}
+
+	_, err := mutableState.AddChildExecutionProgressSignaledEvent(6, child, 1, payloads.EncodeString("10%"))
+	s.ErrorIs(err, ErrMissingChildWorkflowInfo)
+
+	event, err := mutableState.AddChildExecutionProgressSignaledEvent(5, child, 2, payloads.EncodeString("20%"))
+	s.NoError(err)
+	s.NotNil(event)
+	attributes := event.GetWorkflowExecutionSignaledEventAttributes()
+	s.Equal(common.ChildProgressSignalName, attributes.GetSignalName())
+	protorequire.ProtoEqual(s.T(), child, attributes.GetExternalWorkflowExecution())
+	var progress string
+	s.NoError(payloads.Decode(attributes.GetInput(), &progress))
+	s.Equal("20%", progress)
+	s.Equal(child.RunId, ci.ProgressRunId)
+	s.Equal(int64(2), ci.ProgressSequence)
+	s.Contains(mutableState.updateChildExecutionInfos, ci.InitiatedEventId)
+
+	// duplicate and out of order reports of the same run are ignored
+	event, err = mutableState.AddChildExecutionProgressSignaledEvent(5, child, 1, payloads.EncodeString("10%"))
+	s.NoError(err)
+	s.Nil(event)
+	event, err = mutableState.AddChildExecutionProgressSignaledEvent(5, child, 2, payloads.EncodeString("20%"))
+	s.NoError(err)
+	s.Nil(event)
+
+	// the sequence restarts with each run of the child
+	continuedChild := &commonpb.WorkflowExecution{WorkflowId: child.WorkflowId, RunId: uuid.New()}
+	event, err = mutableState.AddChildExecutionProgressSignaledEvent(5, continuedChild, 1, payloads.EncodeString("30%"))
+	s.NoError(err)
+	s.NotNil(event)
+	s.Equal(continuedChild.RunId, ci.ProgressRunId)
+}
+
 func (s *mutableStateSuite) TestSanitizedMutableState() {
 	txnID := int64(2000)
 	runID := uuid.New()
@@ -4722,3 +4825,88 @@ func (s *mutableStateSuite) TestUpdateActivityTaskStatusWithTimerHeartbeat() {
 	s.Equal(status, dbState.ActivityInfos[scheduleEventId].TimerTaskStatus)
 	s.Equal(originalTime, mutableState.pendingActivityTimerHeartbeats[scheduleEventId])
 }
+
+func (s *mutableStateSuite) TestPauseAndUnpauseWorkflowExecution() {
+	dbState := s.buildWorkflowMutableState()
+	dbState.ActivityInfos = nil
+	mutableState, err := NewMutableStateFromDB(s.mockShard, s.mockEventsCache, s.logger, s.namespaceEntry, dbState, 123)
+	s.NoError(err)
+	s.False(mutableState.IsWorkflowExecutionPaused())
+	nextEventID := mutableState.GetNextEventID()
+
+	s.NoError(mutableState.PauseWorkflowExecution("operator", "investigating"))
+	s.True(mutableState.IsWorkflowExecutionPaused())
+	pauseInfo := mutableState.GetExecutionInfo().GetPauseInfo()
+	s.Equal("operator", pauseInfo.GetIdentity())
+	s.Equal("investigating", pauseInfo.GetReason())
+	s.NotNil(pauseInfo.GetPauseTime())
+	// pausing is not visible to workers
+	s.Equal(nextEventID, mutableState.GetNextEventID())
+
+	decoded, err := searchattribute.DecodeValue(
+		mutableState.GetExecutionInfo().SearchAttributes[searchattribute.TemporalPauseInfo],
+		enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST,
+		false,
+	)
+	s.NoError(err)
+	s.ElementsMatch([]string{"property:workflowPaused=true"}, decoded)
+
+	// pausing again keeps the original pause info
+	s.NoError(mutableState.PauseWorkflowExecution("other", "other reason"))
+	s.Equal("investigating", mutableState.GetExecutionInfo().GetPauseInfo().GetReason())
+
+	s.NoError(mutableState.UnpauseWorkflowExecution())
+	s.False(mutableState.IsWorkflowExecutionPaused())
+	s.Equal(nextEventID, mutableState.GetNextEventID())
+	decoded, err = searchattribute.DecodeValue(
+		mutableState.GetExecutionInfo().SearchAttributes[searchattribute.TemporalPauseInfo],
+		enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST,
+		false,
+	)
+	s.NoError(err)
+	s.Empty(decoded)
+}
+
+func (s *mutableStateSuite) TestCloseTransaction_ContinueAsNewPolicySearchAttribute() {
+	const saName = "CustomBoolField"
+	s.mockConfig.ContinueAsNewPolicyWarnThreshold = dynamicconfig.GetFloatPropertyFnFilteredByNamespace(0.5)
+	s.mockConfig.ContinueAsNewPolicyWarnSearchAttribute = dynamicconfig.GetStringPropertyFnFilteredByNamespace(saName)
+	s.mockShard.Resource.SearchAttributesMapperProvider.EXPECT().GetMapper(gomock.Any()).Return(nil, nil).AnyTimes()
+	s.mockShard.Resource.ClusterMetadata.EXPECT().ClusterNameForFailoverVersion(gomock.Any(), gomock.Any()).Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockShard.Resource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	newMutableState := func() historyi.MutableState {
+		dbState := s.buildWorkflowMutableState()
+		dbState.BufferedEvents = nil
+		ms, err := NewMutableStateFromDB(s.mockShard, s.mockEventsCache, s.logger, s.namespaceEntry, dbState, 123)
+		s.NoError(err)
+		return ms
+	}
+	expected, err := searchattribute.EncodeValue(true, enumspb.INDEXED_VALUE_TYPE_BOOL)
+	s.NoError(err)
+
+	// The history has 102 events.
+	s.mockConfig.HistoryCountLimitError = dynamicconfig.GetIntPropertyFnFilteredByNamespace(1000)
+	ms := newMutableState()
+	mutation, _, err := ms.CloseTransactionAsMutation(historyi.TransactionPolicyActive)
+	s.NoError(err)
+	s.NotContains(mutation.ExecutionInfo.SearchAttributes, saName)
+
+	// Recomputed on every transaction, a mutable state loaded or rebuilt without the attribute gets it back.
+	s.mockConfig.HistoryCountLimitError = dynamicconfig.GetIntPropertyFnFilteredByNamespace(200)
+	ms = newMutableState()
+	mutation, _, err = ms.CloseTransactionAsMutation(historyi.TransactionPolicyActive)
+	s.NoError(err)
+	protorequire.ProtoEqual(s.T(), expected, mutation.ExecutionInfo.SearchAttributes[saName])
+
+	ms = newMutableState()
+	mutation, _, err = ms.CloseTransactionAsMutation(historyi.TransactionPolicyPassive)
+	s.NoError(err)
+	s.NotContains(mutation.ExecutionInfo.SearchAttributes, saName)
+}
+
+func TestHistoryLimitUsage(t *testing.T) {
+	require.Zero(t, HistoryLimitUsage(100, 1000, 0, 0))
+	require.InDelta(t, 0.5, HistoryLimitUsage(50, 10, 100, 1000), 1e-9)
+	require.InDelta(t, 0.8, HistoryLimitUsage(50, 800, 100, 1000), 1e-9)
+	require.InDelta(t, 0.8, HistoryLimitUsage(50, 800, 0, 1000), 1e-9)
+}
@@ -88,6 +88,9 @@ type (
 		GenerateSignalExternalTasks(
 			event *historypb.HistoryEvent,
 		) error
+		GenerateChildExecutionProgressTasks(
+			event *historypb.HistoryEvent,
+		) error
 		GenerateUpsertVisibilityTask() error
 		GenerateWorkflowResetTasks() error
 
@@ -674,6 +677,18 @@ func (r *TaskGeneratorImpl) GenerateSignalExternalTasks(
 	return nil
 }
 
+func (r *TaskGeneratorImpl) GenerateChildExecutionProgressTasks(
+	event *historypb.HistoryEvent,
+) error {
+	r.mutableState.AddTasks(&tasks.ChildExecutionProgressTask{
+		// TaskID, VisibilityTimestamp is set by shard
+		WorkflowKey: r.mutableState.GetWorkflowKey(),
+		EventID:     event.GetEventId(),
+		Version:     event.GetVersion(),
+	})
+	return nil
+}
+
 func (r *TaskGeneratorImpl) GenerateUpsertVisibilityTask() error {
 	r.mutableState.AddTasks(&tasks.UpsertExecutionVisibilityTask{
 		// TaskID, VisibilityTimestamp is set by shard
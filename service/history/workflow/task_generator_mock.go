@@ -110,6 +110,20 @@ func (mr *MockTaskGeneratorMockRecorder) GenerateActivityTimerTasks() *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateActivityTimerTasks", reflect.TypeOf((*MockTaskGenerator)(nil).GenerateActivityTimerTasks))
 }
 
+// GenerateChildExecutionProgressTasks mocks base method.
+func (m *MockTaskGenerator) GenerateChildExecutionProgressTasks(event *history.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateChildExecutionProgressTasks", event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GenerateChildExecutionProgressTasks indicates an expected call of GenerateChildExecutionProgressTasks.
+func (mr *MockTaskGeneratorMockRecorder) GenerateChildExecutionProgressTasks(event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateChildExecutionProgressTasks", reflect.TypeOf((*MockTaskGenerator)(nil).GenerateChildExecutionProgressTasks), event)
+}
+
 // GenerateChildWorkflowTasks mocks base method.
 func (m *MockTaskGenerator) GenerateChildWorkflowTasks(event *history.HistoryEvent) error {
 	m.ctrl.T.Helper()
@@ -36,6 +36,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/service/history/events"
 	historyi "go.temporal.io/server/service/history/interfaces"
 )
@@ -76,6 +77,9 @@ func (t *TransactionImpl) CreateWorkflowExecution(
 	if err != nil {
 		return 0, err
 	}
+	if err := t.offloadPayloads(ctx, nil, newWorkflowEventsSeq); err != nil {
+		return 0, err
+	}
 
 	resp, err := createWorkflowExecution(
 		ctx,
@@ -121,6 +125,15 @@ func (t *TransactionImpl) ConflictResolveWorkflowExecution(
 	if err != nil {
 		return 0, 0, 0, err
 	}
+	if err := t.offloadPayloads(
+		ctx,
+		currentWorkflowMutation,
+		resetWorkflowEventsSeq,
+		newWorkflowEventsSeq,
+		currentWorkflowEventsSeq,
+	); err != nil {
+		return 0, 0, 0, err
+	}
 
 	resp, err := conflictResolveWorkflowExecution(
 		ctx,
@@ -185,6 +198,9 @@ func (t *TransactionImpl) UpdateWorkflowExecution(
 	if err != nil {
 		return 0, 0, err
 	}
+	if err := t.offloadPayloads(ctx, currentWorkflowMutation, currentWorkflowEventsSeq, newWorkflowEventsSeq); err != nil {
+		return 0, 0, err
+	}
 	resp, err := updateWorkflowExecution(
 		ctx,
 		t.shard,
@@ -246,6 +262,40 @@ func (t *TransactionImpl) SetWorkflowExecution(
 	return nil
 }
 
+// offloadPayloads moves large payloads of the events about to be persisted, including newly buffered events, to the
+// payload store. Events are updated in place so that the events cache only ever holds the offloaded version.
+func (t *TransactionImpl) offloadPayloads(
+	ctx context.Context,
+	workflowMutation *persistence.WorkflowMutation,
+	workflowEventsSeqs ...[]*persistence.WorkflowEvents,
+) error {
+	offloader := t.shard.GetPayloadOffloader()
+	if !offloader.Enabled() {
+		return nil
+	}
+	if workflowMutation != nil && len(workflowMutation.NewBufferedEvents) > 0 {
+		currentVersionHistory, err := versionhistory.GetCurrentVersionHistory(workflowMutation.ExecutionInfo.VersionHistories)
+		if err != nil {
+			return err
+		}
+		if err := offloader.OffloadEvents(
+			ctx,
+			namespace.ID(workflowMutation.ExecutionInfo.NamespaceId),
+			workflowMutation.ExecutionState.RunId,
+			currentVersionHistory.GetBranchToken(),
+			workflowMutation.NewBufferedEvents,
+		); err != nil {
+			return err
+		}
+	}
+	for _, workflowEventsSeq := range workflowEventsSeqs {
+		if err := offloader.OffloadWorkflowEvents(ctx, workflowEventsSeq...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func PersistWorkflowEvents(
 	ctx context.Context,
 	shardContext historyi.ShardContext,
@@ -33,8 +33,10 @@ import (
 	"github.com/stretchr/testify/suite"
 	"go.temporal.io/api/serviceerror"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadstore"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/util"
 	historyi "go.temporal.io/server/service/history/interfaces"
@@ -76,6 +78,9 @@ func (s *transactionSuite) SetupTest() {
 	s.mockShard.EXPECT().GetEngine(gomock.Any()).Return(s.mockEngine, nil).AnyTimes()
 	s.mockShard.EXPECT().GetNamespaceRegistry().Return(s.mockNamespaceCache).AnyTimes()
 	s.mockShard.EXPECT().GetLogger().Return(s.logger).AnyTimes()
+	s.mockShard.EXPECT().GetPayloadOffloader().Return(
+		payloadstore.NewOffloader(nil, s.mockNamespaceCache, &persistence.HistoryBranchUtilImpl{}, dynamicconfig.GetIntPropertyFnFilteredByNamespace(0), nil, s.logger),
+	).AnyTimes()
 	s.mockNamespaceCache.EXPECT().GetNamespaceByID(tests.NamespaceID).Return(tests.GlobalNamespaceEntry, nil).AnyTimes()
 
 	s.transaction = NewTransaction(s.mockShard)
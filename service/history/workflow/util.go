@@ -183,6 +183,19 @@ func FindAutoResetPoint(
 	return "", nil
 }
 
+// HistoryLimitUsage returns how close the history is to the closest of its hard limits, as a fraction of that limit.
+// Limits that are not positive are ignored.
+func HistoryLimitUsage(historyCount int64, historySize int64, countLimit int, sizeLimit int) float64 {
+	var usage float64
+	if countLimit > 0 {
+		usage = max(usage, float64(historyCount)/float64(countLimit))
+	}
+	if sizeLimit > 0 {
+		usage = max(usage, float64(historySize)/float64(sizeLimit))
+	}
+	return usage
+}
+
 func WithEffects(effects effect.Controller, ms historyi.MutableState) MutableStateWithEffects {
 	return MutableStateWithEffects{
 		MutableState: ms,
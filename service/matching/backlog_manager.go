@@ -36,6 +36,7 @@ import (
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/future"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -85,6 +86,7 @@ type (
 		throttledLogger  log.ThrottledLogger
 		matchingClient   matchingservice.MatchingServiceClient
 		metricsHandler   metrics.Handler
+		timeSource       clock.TimeSource
 		initializedError *future.FutureImpl[struct{}]
 		// skipFinalUpdate controls behavior on Stop: if it's false, we try to write one final
 		// update before unloading
@@ -103,18 +105,20 @@ func newBacklogManager(
 	throttledLogger log.ThrottledLogger,
 	matchingClient matchingservice.MatchingServiceClient,
 	metricsHandler metrics.Handler,
+	timeSource clock.TimeSource,
 ) *backlogManagerImpl {
 	bmg := &backlogManagerImpl{
 		pqMgr:            pqMgr,
 		tqCtx:            tqCtx,
 		matchingClient:   matchingClient,
 		metricsHandler:   metricsHandler,
+		timeSource:       timeSource,
 		logger:           logger,
 		throttledLogger:  throttledLogger,
 		config:           config,
 		initializedError: future.NewFuture[struct{}](),
 	}
-	bmg.db = newTaskQueueDB(config, taskManager, pqMgr.QueueKey(), logger, metricsHandler)
+	bmg.db = newTaskQueueDB(config, taskManager, pqMgr.QueueKey(), logger, metricsHandler, timeSource)
 	bmg.taskWriter = newTaskWriter(bmg)
 	bmg.taskReader = newTaskReader(bmg)
 	bmg.taskAckManager = newAckManager(bmg.db, logger)
@@ -53,6 +53,8 @@ type (
 		ShutdownDrainDuration                dynamicconfig.DurationPropertyFn
 		HistoryMaxPageSize                   dynamicconfig.IntPropertyFnWithNamespaceFilter
 		MatchingDropNonRetryableTasks        dynamicconfig.BoolPropertyFn
+		MaxActivityTasksPerPoll              dynamicconfig.IntPropertyFnWithTaskQueueFilter
+		ActivityPollBatchWaitDuration        dynamicconfig.DurationPropertyFnWithTaskQueueFilter
 		EnableDeployments                    dynamicconfig.BoolPropertyFnWithNamespaceFilter // [cleanup-wv-pre-release]
 		EnableDeploymentVersions             dynamicconfig.BoolPropertyFnWithNamespaceFilter
 		MaxTaskQueuesInDeployment            dynamicconfig.IntPropertyFnWithNamespaceFilter
@@ -68,6 +70,19 @@ type (
 		MaxTaskQueueIdleTime                     dynamicconfig.DurationPropertyFnWithTaskQueueFilter
 		NumTaskqueueWritePartitions              dynamicconfig.IntPropertyFnWithTaskQueueFilter
 		NumTaskqueueReadPartitions               dynamicconfig.IntPropertyFnWithTaskQueueFilter
+		EnableAdaptivePartitions                 dynamicconfig.BoolPropertyFnWithTaskQueueFilter
+		AdaptivePartitionsMin                    dynamicconfig.IntPropertyFnWithTaskQueueFilter
+		AdaptivePartitionsMax                    dynamicconfig.IntPropertyFnWithTaskQueueFilter
+		AdaptivePartitionsTargetRate             dynamicconfig.FloatPropertyFnWithTaskQueueFilter
+		AdaptivePartitionsBacklogAgeScaleUp      dynamicconfig.DurationPropertyFnWithTaskQueueFilter
+		AdaptivePartitionsEvaluationInterval     dynamicconfig.DurationPropertyFnWithTaskQueueFilter
+		AdaptivePartitionsScaleDownDelay         dynamicconfig.DurationPropertyFnWithTaskQueueFilter
+		EnableStickyHandoff                      dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		StickyHandoffTTL                         dynamicconfig.DurationPropertyFn
+		EnableDeadLetterQueue                    dynamicconfig.BoolPropertyFnWithNamespaceIDFilter
+		DeadLetterExpiredTasks                   dynamicconfig.BoolPropertyFnWithNamespaceIDFilter
+		DeadLetterExpiredTaskThreshold           dynamicconfig.IntPropertyFnWithNamespaceIDFilter
+		DeadLetterQueueBufferSize                dynamicconfig.IntPropertyFn
 		BreakdownMetricsByTaskQueue              dynamicconfig.BoolPropertyFnWithTaskQueueFilter
 		BreakdownMetricsByPartition              dynamicconfig.BoolPropertyFnWithTaskQueueFilter
 		BreakdownMetricsByBuildID                dynamicconfig.BoolPropertyFnWithTaskQueueFilter
@@ -171,6 +186,15 @@ type (
 		NumWritePartitions              func() int
 		NumReadPartitions               func() int
 
+		// Adaptive partitioning configuration
+		EnableAdaptivePartitions             func() bool
+		AdaptivePartitionsMin                func() int
+		AdaptivePartitionsMax                func() int
+		AdaptivePartitionsTargetRate         func() float64
+		AdaptivePartitionsBacklogAgeScaleUp  func() time.Duration
+		AdaptivePartitionsEvaluationInterval func() time.Duration
+		AdaptivePartitionsScaleDownDelay     func() time.Duration
+
 		// partition qps = AdminNamespaceToPartitionDispatchRate(namespace)
 		AdminNamespaceToPartitionDispatchRate func() float64
 		AdminNamespaceToPartitionRateSub      func(func(float64)) (float64, func())
@@ -262,6 +286,19 @@ func NewConfig(
 		ThrottledLogRPS:                          dynamicconfig.MatchingThrottledLogRPS.Get(dc),
 		NumTaskqueueWritePartitions:              dynamicconfig.MatchingNumTaskqueueWritePartitions.Get(dc),
 		NumTaskqueueReadPartitions:               dynamicconfig.MatchingNumTaskqueueReadPartitions.Get(dc),
+		EnableAdaptivePartitions:                 dynamicconfig.MatchingEnableAdaptivePartitions.Get(dc),
+		AdaptivePartitionsMin:                    dynamicconfig.MatchingAdaptivePartitionsMin.Get(dc),
+		AdaptivePartitionsMax:                    dynamicconfig.MatchingAdaptivePartitionsMax.Get(dc),
+		AdaptivePartitionsTargetRate:             dynamicconfig.MatchingAdaptivePartitionsTargetRatePerPartition.Get(dc),
+		AdaptivePartitionsBacklogAgeScaleUp:      dynamicconfig.MatchingAdaptivePartitionsBacklogAgeScaleUp.Get(dc),
+		AdaptivePartitionsEvaluationInterval:     dynamicconfig.MatchingAdaptivePartitionsEvaluationInterval.Get(dc),
+		AdaptivePartitionsScaleDownDelay:         dynamicconfig.MatchingAdaptivePartitionsScaleDownDelay.Get(dc),
+		EnableStickyHandoff:                      dynamicconfig.MatchingEnableStickyHandoff.Get(dc),
+		StickyHandoffTTL:                         dynamicconfig.MatchingStickyHandoffTTL.Get(dc),
+		EnableDeadLetterQueue:                    dynamicconfig.MatchingEnableDeadLetterQueue.Get(dc),
+		DeadLetterExpiredTasks:                   dynamicconfig.MatchingDeadLetterExpiredTasks.Get(dc),
+		DeadLetterExpiredTaskThreshold:           dynamicconfig.MatchingDeadLetterExpiredTaskThreshold.Get(dc),
+		DeadLetterQueueBufferSize:                dynamicconfig.MatchingDeadLetterQueueBufferSize.Get(dc),
 		BreakdownMetricsByTaskQueue:              dynamicconfig.MetricsBreakdownByTaskQueue.Get(dc),
 		BreakdownMetricsByPartition:              dynamicconfig.MetricsBreakdownByPartition.Get(dc),
 		BreakdownMetricsByBuildID:                dynamicconfig.MetricsBreakdownByBuildID.Get(dc),
@@ -292,6 +329,8 @@ func NewConfig(
 		TaskQueueInfoByBuildIdTTL:                dynamicconfig.TaskQueueInfoByBuildIdTTL.Get(dc),
 		PriorityLevels:                           dynamicconfig.MatchingPriorityLevels.Get(dc),
 		MatchingDropNonRetryableTasks:            dynamicconfig.MatchingDropNonRetryableTasks.Get(dc),
+		MaxActivityTasksPerPoll:                  dynamicconfig.MatchingMaxActivityTasksPerPoll.Get(dc),
+		ActivityPollBatchWaitDuration:            dynamicconfig.MatchingActivityPollBatchWaitDuration.Get(dc),
 		MaxIDLengthLimit:                         dynamicconfig.MaxIDLengthLimit.Get(dc),
 
 		AdminNamespaceToPartitionDispatchRate:          dynamicconfig.AdminMatchingNamespaceToPartitionDispatchRate.Get(dc),
@@ -384,6 +423,27 @@ func newTaskQueueConfig(tq *tqid.TaskQueue, config *Config, ns namespace.Name) *
 		NumReadPartitions: func() int {
 			return max(1, config.NumTaskqueueReadPartitions(ns.String(), taskQueueName, taskType))
 		},
+		EnableAdaptivePartitions: func() bool {
+			return config.EnableAdaptivePartitions(ns.String(), taskQueueName, taskType)
+		},
+		AdaptivePartitionsMin: func() int {
+			return max(1, config.AdaptivePartitionsMin(ns.String(), taskQueueName, taskType))
+		},
+		AdaptivePartitionsMax: func() int {
+			return max(1, config.AdaptivePartitionsMax(ns.String(), taskQueueName, taskType))
+		},
+		AdaptivePartitionsTargetRate: func() float64 {
+			return config.AdaptivePartitionsTargetRate(ns.String(), taskQueueName, taskType)
+		},
+		AdaptivePartitionsBacklogAgeScaleUp: func() time.Duration {
+			return config.AdaptivePartitionsBacklogAgeScaleUp(ns.String(), taskQueueName, taskType)
+		},
+		AdaptivePartitionsEvaluationInterval: func() time.Duration {
+			return config.AdaptivePartitionsEvaluationInterval(ns.String(), taskQueueName, taskType)
+		},
+		AdaptivePartitionsScaleDownDelay: func() time.Duration {
+			return config.AdaptivePartitionsScaleDownDelay(ns.String(), taskQueueName, taskType)
+		},
 		BreakdownMetricsByTaskQueue: func() bool {
 			return config.BreakdownMetricsByTaskQueue(ns.String(), taskQueueName, taskType)
 		},
@@ -54,6 +54,9 @@ var (
 		"/temporal.server.api.matchingservice.v1.MatchingService/ForceUnloadTaskQueuePartition":          1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/ForceUnloadTaskQueue":                   1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/ForceLoadTaskQueuePartition":            1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/HandoffStickyTaskQueue":                 1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/GetDeadLetteredTasks":                   1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/ReenqueueDeadLetteredTasks":             1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/UpdateTaskQueueUserData":                1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/ReplicateTaskQueueUserData":             1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/CheckTaskQueueUserDataPropagation":      1,
@@ -34,11 +34,11 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
-	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/softassert"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -62,8 +62,13 @@ type (
 		store          persistence.TaskManager
 		logger         log.Logger
 		metricsHandler metrics.Handler
+		timeSource     clock.TimeSource
 		subqueues      []*dbSubqueue
 
+		// only set on sticky queues, see persistencespb.TaskQueueInfo
+		stickyOwnerIdentity string
+		stickyHandoff       *persistencespb.StickyHandoff
+
 		// used to avoid unnecessary metadata writes:
 		lastChange time.Time // updated when metadata is changed in memory
 		lastWrite  time.Time // updated when metadata is successfully written to db
@@ -108,6 +113,7 @@ func newTaskQueueDB(
 	queue *PhysicalTaskQueueKey,
 	logger log.Logger,
 	metricsHandler metrics.Handler,
+	timeSource clock.TimeSource,
 ) *taskQueueDB {
 	return &taskQueueDB{
 		config:         config,
@@ -115,6 +121,7 @@ func newTaskQueueDB(
 		store:          store,
 		logger:         logger,
 		metricsHandler: metricsHandler,
+		timeSource:     timeSource,
 	}
 }
 
@@ -178,6 +185,12 @@ func (db *taskQueueDB) takeOverTaskQueueLocked(
 	switch err.(type) {
 	case nil:
 		db.rangeID = response.RangeID
+		if owner := response.TaskQueueInfo.GetStickyOwnerIdentity(); owner != "" {
+			// A poller may have been recorded while the lease was being taken, but the persisted owner
+			// came first.
+			db.stickyOwnerIdentity = owner
+		}
+		db.stickyHandoff = response.TaskQueueInfo.GetStickyHandoff()
 		db.subqueues = db.ensureDefaultSubqueuesLocked(
 			response.TaskQueueInfo.Subqueues,
 			response.TaskQueueInfo.AckLevel,
@@ -206,7 +219,7 @@ func (db *taskQueueDB) takeOverTaskQueueLocked(
 			db.rangeID = 0
 			return err
 		}
-		db.lastWrite = time.Now()
+		db.lastWrite = db.timeSource.Now()
 		// In this case, ensureDefaultSubqueuesLocked already initialized subqueue 0 to have
 		// ackLevel and maxReadLevel 0, so we don't need to initialize them.
 		softassert.That(db.logger, db.subqueues[0].maxReadLevel == 0, "should have maxReadLevel 0 here")
@@ -230,7 +243,7 @@ func (db *taskQueueDB) updateTaskQueueLocked(ctx context.Context, incrementRange
 	}); err != nil {
 		return err
 	}
-	db.lastWrite = time.Now()
+	db.lastWrite = db.timeSource.Now()
 	db.rangeID = newRangeID
 	return nil
 }
@@ -275,7 +288,7 @@ func (db *taskQueueDB) SyncState(ctx context.Context) error {
 	// We only need to write if something changed, or if we're past half of the sticky queue TTL.
 	// Note that we use the same threshold for non-sticky queues even though they don't have a
 	// persistence TTL, since the scavenger looks for metadata that hasn't been updated in 48 hours.
-	needWrite := db.lastChange.After(db.lastWrite) || time.Since(db.lastWrite) > stickyTaskQueueTTL/2
+	needWrite := db.lastChange.After(db.lastWrite) || db.timeSource.Since(db.lastWrite) > stickyTaskQueueTTL/2
 	if !needWrite {
 		return nil
 	}
@@ -287,7 +300,7 @@ func (db *taskQueueDB) updateAckLevelAndBacklogStats(subqueue int, newAckLevel i
 	db.Lock()
 	defer db.Unlock()
 
-	db.lastChange = time.Now()
+	db.lastChange = db.timeSource.Now()
 	dbQueue := db.subqueues[subqueue]
 	if newAckLevel < dbQueue.AckLevel {
 		softassert.Fail(db.logger,
@@ -310,7 +323,7 @@ func (db *taskQueueDB) updateAckLevelAndBacklogStats(subqueue int, newAckLevel i
 func (db *taskQueueDB) updateBacklogStats(countDelta int64, oldestTime time.Time) {
 	db.Lock()
 	defer db.Unlock()
-	db.lastChange = time.Now()
+	db.lastChange = db.timeSource.Now()
 	db.updateBacklogStatsLocked(0, countDelta, oldestTime)
 }
 
@@ -400,7 +413,7 @@ func (db *taskQueueDB) CreateTasks(
 	if err == nil {
 		// Only update lastWrite for persistence implementations that update metadata on CreateTasks.
 		if resp.UpdatedMetadata {
-			db.lastWrite = time.Now()
+			db.lastWrite = db.timeSource.Now()
 		}
 	} else if _, ok := err.(*persistence.ConditionFailedError); ok {
 		// tasks definitely were not created, restore the counter. For other errors tasks may or may not be created.
@@ -489,7 +502,7 @@ func (db *taskQueueDB) expiryTime() *timestamppb.Timestamp {
 	case enumspb.TASK_QUEUE_KIND_NORMAL:
 		return nil
 	case enumspb.TASK_QUEUE_KIND_STICKY:
-		return timestamppb.New(time.Now().Add(stickyTaskQueueTTL))
+		return timestamppb.New(db.timeSource.Now().Add(stickyTaskQueueTTL))
 	default:
 		panic(fmt.Sprintf("taskQueueDB encountered unknown task kind: %v", db.queue.Partition().Kind()))
 	}
@@ -507,10 +520,51 @@ func (db *taskQueueDB) cachedQueueInfo() *persistencespb.TaskQueueInfo {
 		Kind:                    db.queue.Partition().Kind(),
 		AckLevel:                db.subqueues[subqueueZero].AckLevel, // backwards compatibility
 		ExpiryTime:              db.expiryTime(),
-		LastUpdateTime:          timestamp.TimeNowPtrUtc(),
+		LastUpdateTime:          timestamppb.New(db.timeSource.Now().UTC()),
 		ApproximateBacklogCount: db.subqueues[subqueueZero].ApproximateBacklogCount, // backwards compatibility
 		Subqueues:               infos,
+		StickyOwnerIdentity:     db.stickyOwnerIdentity,
+		StickyHandoff:           db.stickyHandoff,
+	}
+}
+
+// StickyOwnerIdentity returns the identity of the worker that first polled this sticky queue.
+func (db *taskQueueDB) StickyOwnerIdentity() string {
+	db.Lock()
+	defer db.Unlock()
+	return db.stickyOwnerIdentity
+}
+
+// RecordStickyOwner records the identity of the first worker polling this sticky queue. It is
+// written with the next metadata update.
+func (db *taskQueueDB) RecordStickyOwner(identity string) {
+	db.Lock()
+	defer db.Unlock()
+	if db.stickyOwnerIdentity != "" || identity == "" {
+		return
 	}
+	db.stickyOwnerIdentity = identity
+	db.lastChange = db.timeSource.Now()
+}
+
+// StickyHandoff returns the handoff of this sticky queue, if any.
+func (db *taskQueueDB) StickyHandoff() *persistencespb.StickyHandoff {
+	db.Lock()
+	defer db.Unlock()
+	return db.stickyHandoff
+}
+
+// SetStickyHandoff persists the handoff of this sticky queue to its successor.
+func (db *taskQueueDB) SetStickyHandoff(ctx context.Context, handoff *persistencespb.StickyHandoff) error {
+	db.Lock()
+	defer db.Unlock()
+	prev := db.stickyHandoff
+	db.stickyHandoff = handoff
+	if err := db.updateTaskQueueLocked(ctx, false); err != nil {
+		db.stickyHandoff = prev
+		return err
+	}
+	return nil
 }
 
 // emitBacklogGaugesLocked emits the approximate_backlog_count, approximate_backlog_age_seconds, and the legacy
@@ -537,7 +591,7 @@ func (db *taskQueueDB) emitBacklogGaugesLocked() {
 	if oldestTime.IsZero() {
 		metrics.ApproximateBacklogAgeSeconds.With(db.metricsHandler).Record(0)
 	} else {
-		metrics.ApproximateBacklogAgeSeconds.With(db.metricsHandler).Record(time.Since(oldestTime).Seconds())
+		metrics.ApproximateBacklogAgeSeconds.With(db.metricsHandler).Record(db.timeSource.Since(oldestTime).Seconds())
 	}
 	metrics.TaskLagPerTaskQueueGauge.With(db.metricsHandler).Record(float64(totalLag))
 }
@@ -0,0 +1,361 @@
+// The MIT License
+//
+// Copyright (c) 2025 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	commonspb "go.temporal.io/server/api/common/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/tqid"
+	"go.temporal.io/server/common/util"
+	"go.temporal.io/server/internal/goro"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	deadLetterReenqueuePageSize = 100
+	// Bounds the number of workflow executions whose expired tasks are counted.
+	deadLetterExpiryCountsMaxSize = 10000
+	deadLetterExpiryCountsTTL     = time.Hour
+)
+
+type (
+	// deadLetterQueue records tasks that matching drops without delivering them to a worker in the dead-letter
+	// queue of their namespace, so that they can be inspected and re-enqueued later.
+	//
+	// Tasks are written asynchronously so that dropping a task does not usually block a poller or a task reader. If
+	// the writer falls behind and the buffer is full, the caller writes the task itself, which slows down whatever is
+	// dropping tasks until the writer catches up. A task is only lost if it can't be written to persistence, which is
+	// counted and logged for each task.
+	//
+	// Expired tasks are counted per workflow execution in memory of the host that owns the task queue, so the counts
+	// start over when the task queue moves to another host or is reloaded.
+	deadLetterQueue struct {
+		manager        persistence.MatchingTaskDLQManager
+		matchingClient matchingservice.MatchingServiceClient
+		config         *Config
+		logger         log.Logger
+		metricsHandler metrics.Handler
+		timeSource     clock.TimeSource
+		pending        chan *persistence.EnqueueMatchingDLQTaskRequest
+		goroGroup      goro.Group
+		// expiryCounts counts expired tasks by deadLetterExpiryKey.
+		expiryCounts cache.Cache
+	}
+
+	deadLetterExpiryKey struct {
+		namespaceID   string
+		taskQueue     string
+		taskQueueType enumspb.TaskQueueType
+		workflowID    string
+		runID         string
+	}
+)
+
+func newDeadLetterQueue(
+	manager persistence.MatchingTaskDLQManager,
+	matchingClient matchingservice.MatchingServiceClient,
+	config *Config,
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+	timeSource clock.TimeSource,
+) *deadLetterQueue {
+	return &deadLetterQueue{
+		manager:        manager,
+		matchingClient: matchingClient,
+		config:         config,
+		logger:         logger,
+		metricsHandler: metricsHandler,
+		timeSource:     timeSource,
+		pending:        make(chan *persistence.EnqueueMatchingDLQTaskRequest, max(1, config.DeadLetterQueueBufferSize())),
+		expiryCounts: cache.New(deadLetterExpiryCountsMaxSize, &cache.Options{
+			TTL:        deadLetterExpiryCountsTTL,
+			TimeSource: timeSource,
+		}),
+	}
+}
+
+func (q *deadLetterQueue) Start() {
+	q.goroGroup.Go(q.run)
+}
+
+func (q *deadLetterQueue) Stop() {
+	q.goroGroup.Cancel()
+	q.goroGroup.Wait()
+}
+
+// add dead-letters a task if the dead-letter queue is enabled for its namespace. Expired tasks are only
+// dead-lettered if that is enabled separately, and once enough tasks of their workflow execution expired.
+func (q *deadLetterQueue) add(
+	ctx context.Context,
+	taskQueue *tqid.TaskQueue,
+	taskInfo *persistencespb.TaskInfo,
+	reason enumsspb.DeadLetterReason,
+	cause error,
+) {
+	namespaceID := taskInfo.GetNamespaceId()
+	if !q.config.EnableDeadLetterQueue(namespace.ID(namespaceID)) {
+		return
+	}
+	var expiryCount int32
+	if reason == enumsspb.DEAD_LETTER_REASON_EXPIRED {
+		if !q.config.DeadLetterExpiredTasks(namespace.ID(namespaceID)) {
+			return
+		}
+		var reached bool
+		if expiryCount, reached = q.countExpiry(taskQueue, taskInfo); !reached {
+			return
+		}
+	}
+
+	task := &persistencespb.DeadLetteredTask{
+		TaskInfo:       taskInfo,
+		TaskQueue:      taskQueue.Name(),
+		TaskQueueType:  taskQueue.TaskType(),
+		Reason:         reason,
+		DeadLetterTime: timestamppb.New(q.timeSource.Now()),
+		ExpiryCount:    expiryCount,
+	}
+	if cause != nil {
+		task.ErrorMessage = cause.Error()
+		task.ErrorType = util.ErrorType(cause)
+	}
+
+	request := &persistence.EnqueueMatchingDLQTaskRequest{NamespaceID: namespaceID, Task: task}
+	select {
+	case q.pending <- request:
+	default:
+		// The writer is behind, write the task here instead of losing it.
+		metrics.DeadLetterQueueSyncWritesCounter.With(q.metricsHandler).Record(1)
+		q.write(ctx, request)
+	}
+}
+
+// countExpiry counts an expired task and returns the number of expired tasks of its workflow execution on the task
+// queue, and whether that reached the dead-letter threshold. The count starts over once the threshold is reached.
+func (q *deadLetterQueue) countExpiry(taskQueue *tqid.TaskQueue, taskInfo *persistencespb.TaskInfo) (int32, bool) {
+	key := deadLetterExpiryKey{
+		namespaceID:   taskInfo.GetNamespaceId(),
+		taskQueue:     taskQueue.Name(),
+		taskQueueType: taskQueue.TaskType(),
+		workflowID:    taskInfo.GetWorkflowId(),
+		runID:         taskInfo.GetRunId(),
+	}
+	threshold := int32(max(1, q.config.DeadLetterExpiredTaskThreshold(namespace.ID(key.namespaceID))))
+
+	// The cache only serializes single operations, so concurrent expiries of the same execution may both count
+	// from the same value. That only delays dead-lettering by one expiry.
+	count := int32(1)
+	if prev, ok := q.expiryCounts.Get(key).(int32); ok {
+		count = prev + 1
+	}
+	if count < threshold {
+		q.expiryCounts.Put(key, count)
+		return count, false
+	}
+	q.expiryCounts.Delete(key)
+	return count, true
+}
+
+func (q *deadLetterQueue) run(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case request := <-q.pending:
+			q.write(ctx, request)
+		}
+	}
+}
+
+func (q *deadLetterQueue) write(ctx context.Context, request *persistence.EnqueueMatchingDLQTaskRequest) {
+	writeCtx, cancel := context.WithTimeout(ctx, ioTimeout)
+	defer cancel()
+	if _, err := q.manager.EnqueueTask(writeCtx, request); err != nil {
+		q.recordFailure(request.Task, err)
+		return
+	}
+	metrics.DeadLetteredTasksCounter.With(q.metricsHandler).Record(1,
+		metrics.ReasonTag(metrics.ReasonString(request.Task.GetReason().String())))
+}
+
+func (q *deadLetterQueue) recordFailure(task *persistencespb.DeadLetteredTask, err error) {
+	metrics.DeadLetterQueueFailuresCounter.With(q.metricsHandler).Record(1)
+	q.logger.Error("failed to dead-letter matching task",
+		tag.WorkflowNamespaceID(task.GetTaskInfo().GetNamespaceId()),
+		tag.WorkflowID(task.GetTaskInfo().GetWorkflowId()),
+		tag.WorkflowRunID(task.GetTaskInfo().GetRunId()),
+		tag.WorkflowTaskQueueName(task.GetTaskQueue()),
+		tag.WorkflowEventID(task.GetTaskInfo().GetScheduledEventId()),
+		tag.Error(err),
+	)
+}
+
+func (q *deadLetterQueue) GetTasks(
+	ctx context.Context,
+	request *matchingservice.GetDeadLetteredTasksRequest,
+) (*matchingservice.GetDeadLetteredTasksResponse, error) {
+	resp, err := q.manager.ReadTasks(ctx, &persistence.ReadMatchingDLQTasksRequest{
+		NamespaceID:   request.GetNamespaceId(),
+		PageSize:      int(request.GetPageSize()),
+		NextPageToken: request.GetNextPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	tasks := make([]*commonspb.MatchingDLQTask, len(resp.Tasks))
+	for i, task := range resp.Tasks {
+		tasks[i] = &commonspb.MatchingDLQTask{
+			MessageId: task.MessageMetadata.ID,
+			Payload:   task.Task,
+		}
+	}
+	return &matchingservice.GetDeadLetteredTasksResponse{
+		Tasks:         tasks,
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
+
+// Reenqueue adds dead-lettered tasks up to the given message ID back to their task queues, then deletes them from
+// the dead-letter queue. If re-enqueueing a task fails, the tasks before it are still deleted and the error is
+// returned, so that the call can be retried.
+func (q *deadLetterQueue) Reenqueue(
+	ctx context.Context,
+	request *matchingservice.ReenqueueDeadLetteredTasksRequest,
+) (*matchingservice.ReenqueueDeadLetteredTasksResponse, error) {
+	namespaceID := request.GetNamespaceId()
+	maxMessageID := request.GetInclusiveMaxMessageId()
+	lastMessageID := int64(-1)
+	var count int64
+	var reenqueueErr error
+
+	var nextPageToken []byte
+readLoop:
+	for {
+		resp, err := q.manager.ReadTasks(ctx, &persistence.ReadMatchingDLQTasksRequest{
+			NamespaceID:   namespaceID,
+			PageSize:      deadLetterReenqueuePageSize,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			reenqueueErr = err
+			break
+		}
+		for _, task := range resp.Tasks {
+			if task.MessageMetadata.ID > maxMessageID {
+				break readLoop
+			}
+			if err := q.reenqueueTask(ctx, task.Task); err != nil {
+				reenqueueErr = err
+				break readLoop
+			}
+			lastMessageID = task.MessageMetadata.ID
+			count++
+		}
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+
+	if lastMessageID >= 0 {
+		_, err := q.manager.DeleteTasks(ctx, &persistence.DeleteMatchingDLQTasksRequest{
+			NamespaceID:                 namespaceID,
+			InclusiveMaxMessageMetadata: persistence.MessageMetadata{ID: lastMessageID},
+		})
+		if err != nil && reenqueueErr == nil {
+			reenqueueErr = err
+		}
+	}
+	if reenqueueErr != nil {
+		return nil, reenqueueErr
+	}
+	return &matchingservice.ReenqueueDeadLetteredTasksResponse{ReenqueuedCount: count}, nil
+}
+
+func (q *deadLetterQueue) reenqueueTask(ctx context.Context, task *persistencespb.DeadLetteredTask) error {
+	taskInfo := task.GetTaskInfo()
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: taskInfo.GetWorkflowId(),
+		RunId:      taskInfo.GetRunId(),
+	}
+	taskQueue := &taskqueuepb.TaskQueue{
+		Name: task.GetTaskQueue(),
+		Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+	}
+	// Keep the remaining schedule-to-start timeout, if any. A task that has expired already is re-enqueued
+	// without one: history has timed it out by now and will reject it when it is dispatched.
+	var scheduleToStartTimeout *durationpb.Duration
+	if expiry := taskInfo.GetExpiryTime(); expiry != nil {
+		if remaining := expiry.AsTime().Sub(q.timeSource.Now()); remaining > 0 {
+			scheduleToStartTimeout = durationpb.New(remaining)
+		}
+	}
+
+	var err error
+	switch task.GetTaskQueueType() {
+	case enumspb.TASK_QUEUE_TYPE_WORKFLOW:
+		_, err = q.matchingClient.AddWorkflowTask(ctx, &matchingservice.AddWorkflowTaskRequest{
+			NamespaceId:            taskInfo.GetNamespaceId(),
+			Execution:              execution,
+			TaskQueue:              taskQueue,
+			ScheduledEventId:       taskInfo.GetScheduledEventId(),
+			ScheduleToStartTimeout: scheduleToStartTimeout,
+			Clock:                  taskInfo.GetClock(),
+			VersionDirective:       taskInfo.GetVersionDirective(),
+			Priority:               taskInfo.GetPriority(),
+		})
+	case enumspb.TASK_QUEUE_TYPE_ACTIVITY:
+		_, err = q.matchingClient.AddActivityTask(ctx, &matchingservice.AddActivityTaskRequest{
+			NamespaceId:            taskInfo.GetNamespaceId(),
+			Execution:              execution,
+			TaskQueue:              taskQueue,
+			ScheduledEventId:       taskInfo.GetScheduledEventId(),
+			ScheduleToStartTimeout: scheduleToStartTimeout,
+			Clock:                  taskInfo.GetClock(),
+			VersionDirective:       taskInfo.GetVersionDirective(),
+			Stamp:                  taskInfo.GetStamp(),
+			Priority:               taskInfo.GetPriority(),
+		})
+	default:
+		// Nexus tasks are never dead-lettered since they are not persisted.
+		q.logger.Warn("skipping dead-lettered task of unsupported type",
+			tag.WorkflowTaskQueueType(task.GetTaskQueueType()))
+	}
+	return err
+}
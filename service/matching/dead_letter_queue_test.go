@@ -0,0 +1,248 @@
+// The MIT License
+//
+// Copyright (c) 2025 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	"go.temporal.io/server/api/matchingservicemock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.uber.org/mock/gomock"
+)
+
+func newTestDeadLetterQueue(
+	t *testing.T,
+	expiredEnabled bool,
+) (*deadLetterQueue, *persistence.MockMatchingTaskDLQManager, *matchingservicemock.MockMatchingServiceClient) {
+	ctrl := gomock.NewController(t)
+	manager := persistence.NewMockMatchingTaskDLQManager(ctrl)
+	matchingClient := matchingservicemock.NewMockMatchingServiceClient(ctrl)
+	config := NewConfig(dynamicconfig.NewNoopCollection())
+	config.EnableDeadLetterQueue = dynamicconfig.GetBoolPropertyFnFilteredByNamespaceID(true)
+	config.DeadLetterExpiredTasks = dynamicconfig.GetBoolPropertyFnFilteredByNamespaceID(expiredEnabled)
+	q := newDeadLetterQueue(manager, matchingClient, config, log.NewNoopLogger(), metrics.NoopMetricsHandler, clock.NewRealTimeSource())
+	return q, manager, matchingClient
+}
+
+func newTestDeadLetteredTask(taskType enumspb.TaskQueueType, scheduledEventID int64) *persistencespb.DeadLetteredTask {
+	return &persistencespb.DeadLetteredTask{
+		TaskInfo: &persistencespb.TaskInfo{
+			NamespaceId:      "ns-id",
+			WorkflowId:       "wf",
+			RunId:            "run",
+			ScheduledEventId: scheduledEventID,
+		},
+		TaskQueue:     "tq",
+		TaskQueueType: taskType,
+		Reason:        enumsspb.DEAD_LETTER_REASON_NON_RETRYABLE_ERROR,
+	}
+}
+
+func TestDeadLetterQueue_AddDisabled(t *testing.T) {
+	t.Parallel()
+
+	q, _, _ := newTestDeadLetterQueue(t, false)
+	q.config.EnableDeadLetterQueue = dynamicconfig.GetBoolPropertyFnFilteredByNamespaceID(false)
+	tq := newTestTaskQueue("ns-id", "tq", enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+
+	q.add(context.Background(), tq, &persistencespb.TaskInfo{NamespaceId: "ns-id"}, enumsspb.DEAD_LETTER_REASON_UNDISPATCHABLE, errors.New("boom"))
+	require.Empty(t, q.pending)
+}
+
+func TestDeadLetterQueue_AddExpired(t *testing.T) {
+	t.Parallel()
+
+	tq := newTestTaskQueue("ns-id", "tq", enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	taskInfo := &persistencespb.TaskInfo{NamespaceId: "ns-id"}
+
+	q, _, _ := newTestDeadLetterQueue(t, false)
+	q.add(context.Background(), tq, taskInfo, enumsspb.DEAD_LETTER_REASON_EXPIRED, nil)
+	require.Empty(t, q.pending)
+
+	q, _, _ = newTestDeadLetterQueue(t, true)
+	q.config.DeadLetterExpiredTaskThreshold = dynamicconfig.GetIntPropertyFnFilteredByNamespaceID(1)
+	q.add(context.Background(), tq, taskInfo, enumsspb.DEAD_LETTER_REASON_EXPIRED, nil)
+	require.Len(t, q.pending, 1)
+}
+
+func TestDeadLetterQueue_AddExpiredThreshold(t *testing.T) {
+	t.Parallel()
+
+	tq := newTestTaskQueue("ns-id", "tq", enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	taskInfo := &persistencespb.TaskInfo{NamespaceId: "ns-id", WorkflowId: "wf", RunId: "run"}
+	otherTaskInfo := &persistencespb.TaskInfo{NamespaceId: "ns-id", WorkflowId: "other-wf", RunId: "run"}
+
+	q, _, _ := newTestDeadLetterQueue(t, true)
+	q.config.DeadLetterExpiredTaskThreshold = dynamicconfig.GetIntPropertyFnFilteredByNamespaceID(3)
+
+	q.add(context.Background(), tq, taskInfo, enumsspb.DEAD_LETTER_REASON_EXPIRED, nil)
+	q.add(context.Background(), tq, taskInfo, enumsspb.DEAD_LETTER_REASON_EXPIRED, nil)
+	q.add(context.Background(), tq, otherTaskInfo, enumsspb.DEAD_LETTER_REASON_EXPIRED, nil)
+	require.Empty(t, q.pending)
+
+	q.add(context.Background(), tq, taskInfo, enumsspb.DEAD_LETTER_REASON_EXPIRED, nil)
+	require.Len(t, q.pending, 1)
+	request := <-q.pending
+	require.Equal(t, "wf", request.Task.GetTaskInfo().GetWorkflowId())
+	require.Equal(t, int32(3), request.Task.GetExpiryCount())
+
+	// the count starts over once a task was dead-lettered
+	q.add(context.Background(), tq, taskInfo, enumsspb.DEAD_LETTER_REASON_EXPIRED, nil)
+	require.Empty(t, q.pending)
+
+	// tasks dropped for other reasons are not counted
+	q.add(context.Background(), tq, otherTaskInfo, enumsspb.DEAD_LETTER_REASON_UNDISPATCHABLE, errors.New("boom"))
+	require.Len(t, q.pending, 1)
+}
+
+func TestDeadLetterQueue_AddWritesWhenBufferIsFull(t *testing.T) {
+	t.Parallel()
+
+	q, manager, _ := newTestDeadLetterQueue(t, false)
+	q.pending = make(chan *persistence.EnqueueMatchingDLQTaskRequest, 1)
+	tq := newTestTaskQueue("ns-id", "tq", enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+
+	q.add(context.Background(), tq, &persistencespb.TaskInfo{NamespaceId: "ns-id", WorkflowId: "wf1"}, enumsspb.DEAD_LETTER_REASON_UNDISPATCHABLE, errors.New("boom"))
+	require.Len(t, q.pending, 1)
+
+	// the writer is not running, so the next task is written by the caller instead of being lost
+	manager.EXPECT().EnqueueTask(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *persistence.EnqueueMatchingDLQTaskRequest) (*persistence.EnqueueMatchingDLQTaskResponse, error) {
+			require.Equal(t, "wf2", req.Task.GetTaskInfo().GetWorkflowId())
+			return &persistence.EnqueueMatchingDLQTaskResponse{}, nil
+		})
+	q.add(context.Background(), tq, &persistencespb.TaskInfo{NamespaceId: "ns-id", WorkflowId: "wf2"}, enumsspb.DEAD_LETTER_REASON_UNDISPATCHABLE, errors.New("boom"))
+	require.Len(t, q.pending, 1)
+}
+
+func TestDeadLetterQueue_AddWritesTask(t *testing.T) {
+	t.Parallel()
+
+	q, manager, _ := newTestDeadLetterQueue(t, false)
+	tq := newTestTaskQueue("ns-id", "tq", enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	cause := serviceerror.NewInvalidArgument("bad task")
+
+	written := make(chan *persistence.EnqueueMatchingDLQTaskRequest, 1)
+	manager.EXPECT().EnqueueTask(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *persistence.EnqueueMatchingDLQTaskRequest) (*persistence.EnqueueMatchingDLQTaskResponse, error) {
+			written <- req
+			return &persistence.EnqueueMatchingDLQTaskResponse{}, nil
+		})
+
+	q.Start()
+	defer q.Stop()
+	q.add(context.Background(), tq, &persistencespb.TaskInfo{NamespaceId: "ns-id", WorkflowId: "wf"}, enumsspb.DEAD_LETTER_REASON_NON_RETRYABLE_ERROR, cause)
+
+	select {
+	case req := <-written:
+		require.Equal(t, "ns-id", req.NamespaceID)
+		require.Equal(t, "wf", req.Task.GetTaskInfo().GetWorkflowId())
+		require.Equal(t, "tq", req.Task.GetTaskQueue())
+		require.Equal(t, enumspb.TASK_QUEUE_TYPE_ACTIVITY, req.Task.GetTaskQueueType())
+		require.Equal(t, enumsspb.DEAD_LETTER_REASON_NON_RETRYABLE_ERROR, req.Task.GetReason())
+		require.Equal(t, "bad task", req.Task.GetErrorMessage())
+		require.Equal(t, "serviceerror.InvalidArgument", req.Task.GetErrorType())
+		require.NotNil(t, req.Task.GetDeadLetterTime())
+	case <-time.After(5 * time.Second):
+		t.Fatal("task was not written to the dead-letter queue")
+	}
+}
+
+func TestDeadLetterQueue_Reenqueue(t *testing.T) {
+	t.Parallel()
+
+	q, manager, matchingClient := newTestDeadLetterQueue(t, false)
+
+	manager.EXPECT().ReadTasks(gomock.Any(), gomock.Any()).Return(&persistence.ReadMatchingDLQTasksResponse{
+		Tasks: []persistence.MatchingDLQTask{
+			{MessageMetadata: persistence.MessageMetadata{ID: 1}, Task: newTestDeadLetteredTask(enumspb.TASK_QUEUE_TYPE_WORKFLOW, 5)},
+			{MessageMetadata: persistence.MessageMetadata{ID: 2}, Task: newTestDeadLetteredTask(enumspb.TASK_QUEUE_TYPE_ACTIVITY, 7)},
+			{MessageMetadata: persistence.MessageMetadata{ID: 3}, Task: newTestDeadLetteredTask(enumspb.TASK_QUEUE_TYPE_ACTIVITY, 9)},
+		},
+	}, nil)
+	matchingClient.EXPECT().AddWorkflowTask(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *matchingservice.AddWorkflowTaskRequest, _ ...any) (*matchingservice.AddWorkflowTaskResponse, error) {
+			require.Equal(t, int64(5), req.GetScheduledEventId())
+			require.Equal(t, "tq", req.GetTaskQueue().GetName())
+			require.Equal(t, enumspb.TASK_QUEUE_KIND_NORMAL, req.GetTaskQueue().GetKind())
+			return &matchingservice.AddWorkflowTaskResponse{}, nil
+		})
+	matchingClient.EXPECT().AddActivityTask(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *matchingservice.AddActivityTaskRequest, _ ...any) (*matchingservice.AddActivityTaskResponse, error) {
+			require.Equal(t, int64(7), req.GetScheduledEventId())
+			return &matchingservice.AddActivityTaskResponse{}, nil
+		})
+	manager.EXPECT().DeleteTasks(gomock.Any(), &persistence.DeleteMatchingDLQTasksRequest{
+		NamespaceID:                 "ns-id",
+		InclusiveMaxMessageMetadata: persistence.MessageMetadata{ID: 2},
+	}).Return(&persistence.DeleteMatchingDLQTasksResponse{MessagesDeleted: 2}, nil)
+
+	resp, err := q.Reenqueue(context.Background(), &matchingservice.ReenqueueDeadLetteredTasksRequest{
+		NamespaceId:           "ns-id",
+		InclusiveMaxMessageId: 2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), resp.GetReenqueuedCount())
+}
+
+func TestDeadLetterQueue_ReenqueuePartialFailure(t *testing.T) {
+	t.Parallel()
+
+	q, manager, matchingClient := newTestDeadLetterQueue(t, false)
+	addErr := serviceerror.NewUnavailable("matching unavailable")
+
+	manager.EXPECT().ReadTasks(gomock.Any(), gomock.Any()).Return(&persistence.ReadMatchingDLQTasksResponse{
+		Tasks: []persistence.MatchingDLQTask{
+			{MessageMetadata: persistence.MessageMetadata{ID: 1}, Task: newTestDeadLetteredTask(enumspb.TASK_QUEUE_TYPE_WORKFLOW, 5)},
+			{MessageMetadata: persistence.MessageMetadata{ID: 2}, Task: newTestDeadLetteredTask(enumspb.TASK_QUEUE_TYPE_WORKFLOW, 7)},
+		},
+	}, nil)
+	gomock.InOrder(
+		matchingClient.EXPECT().AddWorkflowTask(gomock.Any(), gomock.Any()).Return(&matchingservice.AddWorkflowTaskResponse{}, nil),
+		matchingClient.EXPECT().AddWorkflowTask(gomock.Any(), gomock.Any()).Return(nil, addErr),
+	)
+	// The task that was re-enqueued is still deleted so that a retry doesn't add it twice.
+	manager.EXPECT().DeleteTasks(gomock.Any(), &persistence.DeleteMatchingDLQTasksRequest{
+		NamespaceID:                 "ns-id",
+		InclusiveMaxMessageMetadata: persistence.MessageMetadata{ID: 1},
+	}).Return(&persistence.DeleteMatchingDLQTasksResponse{MessagesDeleted: 1}, nil)
+
+	_, err := q.Reenqueue(context.Background(), &matchingservice.ReenqueueDeadLetteredTasksRequest{
+		NamespaceId:           "ns-id",
+		InclusiveMaxMessageId: 10,
+	})
+	require.ErrorIs(t, err, addErr)
+}
@@ -37,6 +37,7 @@ import (
 	"go.temporal.io/server/api/matchingservice/v1"
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/tqid"
 	"google.golang.org/protobuf/types/known/durationpb"
@@ -46,10 +47,11 @@ type (
 	// Forwarder is the type that contains state pertaining to
 	// the api call forwarder component
 	Forwarder struct {
-		cfg       *forwarderConfig
-		queue     *PhysicalTaskQueueKey
-		partition *tqid.NormalPartition
-		client    matchingservice.MatchingServiceClient
+		cfg        *forwarderConfig
+		queue      *PhysicalTaskQueueKey
+		partition  *tqid.NormalPartition
+		client     matchingservice.MatchingServiceClient
+		timeSource clock.TimeSource
 
 		// token channels that vend tokens necessary to make
 		// API calls exposed by forwarder. Tokens are used
@@ -94,6 +96,7 @@ func newForwarder(
 	cfg *forwarderConfig,
 	queue *PhysicalTaskQueueKey,
 	client matchingservice.MatchingServiceClient,
+	timeSource clock.TimeSource,
 ) (*Forwarder, error) {
 	partition, ok := queue.Partition().(*tqid.NormalPartition)
 	if !ok {
@@ -105,6 +108,7 @@ func newForwarder(
 		client:                client,
 		partition:             partition,
 		queue:                 queue,
+		timeSource:            timeSource,
 		outstandingTasksLimit: int32(cfg.ForwarderMaxOutstandingTasks()),
 		outstandingPollsLimit: int32(cfg.ForwarderMaxOutstandingPolls()),
 		limiter: quotas.NewDefaultOutgoingRateLimiter(
@@ -132,7 +136,7 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 	var expirationTime time.Time
 	if task.event.Data.ExpiryTime != nil {
 		expirationTime = task.event.Data.ExpiryTime.AsTime()
-		remaining := time.Until(expirationTime)
+		remaining := expirationTime.Sub(fwdr.timeSource.Now())
 		if remaining <= 0 {
 			return nil
 		}
@@ -292,6 +296,7 @@ func (fwdr *Forwarder) ForwardPoll(ctx context.Context, pollMetadata *pollMetada
 				DeploymentOptions:         pollMetadata.deploymentOptions,
 			},
 			ForwardedSource: fwdr.partition.RpcName(),
+			MaxTasks:        pollMetadata.maxTasks,
 		})
 		if err != nil {
 			return nil, fwdr.handleErr(err)
@@ -39,6 +39,7 @@ import (
 	"go.temporal.io/server/api/matchingservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/tqid"
 	"go.uber.org/mock/gomock"
@@ -87,10 +88,10 @@ func (t *ForwarderTestSuite) SetupTest() {
 	t.partition = tqFam.TaskQueue(enumspb.TASK_QUEUE_TYPE_WORKFLOW).RootPartition()
 
 	if t.newFwdr {
-		t.fwdr, err = newPriForwarder(t.cfg, UnversionedQueueKey(t.partition), t.client)
+		t.fwdr, err = newPriForwarder(t.cfg, UnversionedQueueKey(t.partition), t.client, clock.NewRealTimeSource())
 		t.NoError(err)
 	} else {
-		t.fwdr, err = newForwarder(t.cfg, UnversionedQueueKey(t.partition), t.client)
+		t.fwdr, err = newForwarder(t.cfg, UnversionedQueueKey(t.partition), t.client, clock.NewRealTimeSource())
 		t.NoError(err)
 	}
 }
@@ -430,7 +431,7 @@ func (t *ForwarderTestSuite) usingTaskqueuePartition(taskType enumspb.TaskQueueT
 	f, err := tqid.NewTaskQueueFamily("fwdr", "tl0")
 	t.NoError(err)
 	t.partition = f.TaskQueue(taskType).NormalPartition(1)
-	t.fwdr, err = newForwarder(t.cfg, UnversionedQueueKey(t.partition), t.client)
+	t.fwdr, err = newForwarder(t.cfg, UnversionedQueueKey(t.partition), t.client, clock.NewRealTimeSource())
 	t.Nil(err)
 }
 
@@ -438,7 +439,7 @@ func (t *ForwarderTestSuite) usingBuildIdQueue(taskType enumspb.TaskQueueType, b
 	f, err := tqid.NewTaskQueueFamily("fwdr", "tl0")
 	t.NoError(err)
 	t.partition = f.TaskQueue(taskType).NormalPartition(1)
-	t.fwdr, err = newForwarder(t.cfg, BuildIdQueueKey(t.partition, buildId), t.client)
+	t.fwdr, err = newForwarder(t.cfg, BuildIdQueueKey(t.partition, buildId), t.client, clock.NewRealTimeSource())
 	t.Nil(err)
 }
 
@@ -34,6 +34,7 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/membership"
@@ -92,9 +93,11 @@ func NewHandler(
 	namespaceReplicationQueue persistence.NamespaceReplicationQueue,
 	visibilityManager manager.VisibilityManager,
 	nexusEndpointManager persistence.NexusEndpointManager,
+	matchingTaskDLQManager persistence.MatchingTaskDLQManager,
 	testHooks testhooks.TestHooks,
 	saProvider searchattribute.Provider,
 	saMapperProvider searchattribute.MapperProvider,
+	timeSource clock.TimeSource,
 ) *Handler {
 	handler := &Handler{
 		config:          config,
@@ -118,9 +121,11 @@ func NewHandler(
 			namespaceReplicationQueue,
 			visibilityManager,
 			nexusEndpointManager,
+			matchingTaskDLQManager,
 			testHooks,
 			saProvider,
 			saMapperProvider,
+			timeSource,
 		),
 		namespaceRegistry: namespaceRegistry,
 	}
@@ -181,7 +186,11 @@ func (h *Handler) AddActivityTask(
 	if syncMatch {
 		metrics.SyncMatchLatencyPerTaskQueue.With(opMetrics).Record(time.Since(startT))
 	}
-	return &matchingservice.AddActivityTaskResponse{AssignedBuildId: assignedBuildId}, err
+	resp := &matchingservice.AddActivityTaskResponse{AssignedBuildId: assignedBuildId}
+	if err == nil && request.GetForwardInfo() == nil {
+		resp.PartitionCounts = h.engine.PartitionCounts(ctx, request.GetNamespaceId(), request.GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	}
+	return resp, err
 }
 
 // AddWorkflowTask - adds a workflow task.
@@ -206,7 +215,11 @@ func (h *Handler) AddWorkflowTask(
 	if syncMatch {
 		metrics.SyncMatchLatencyPerTaskQueue.With(opMetrics).Record(time.Since(startT))
 	}
-	return &matchingservice.AddWorkflowTaskResponse{AssignedBuildId: assignedBuildId}, err
+	resp := &matchingservice.AddWorkflowTaskResponse{AssignedBuildId: assignedBuildId}
+	if err == nil && request.GetForwardInfo() == nil {
+		resp.PartitionCounts = h.engine.PartitionCounts(ctx, request.GetNamespaceId(), request.GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	}
+	return resp, err
 }
 
 // PollActivityTaskQueue - long poll for an activity task.
@@ -234,7 +247,17 @@ func (h *Handler) PollActivityTaskQueue(
 		return nil, err
 	}
 
-	return h.engine.PollActivityTaskQueue(ctx, request, opMetrics)
+	resp, err := h.engine.PollActivityTaskQueue(ctx, request, opMetrics)
+	if err != nil || request.GetForwardedSource() != "" {
+		return resp, err
+	}
+	if counts := h.engine.PartitionCounts(ctx, request.GetNamespaceId(), request.GetPollRequest().GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_ACTIVITY); counts != nil {
+		if resp == emptyPollActivityTaskQueueResponse {
+			resp = &matchingservice.PollActivityTaskQueueResponse{}
+		}
+		resp.PartitionCounts = counts
+	}
+	return resp, nil
 }
 
 // PollWorkflowTaskQueue - long poll for a workflow task.
@@ -262,7 +285,17 @@ func (h *Handler) PollWorkflowTaskQueue(
 		return nil, err
 	}
 
-	return h.engine.PollWorkflowTaskQueue(ctx, request, opMetrics)
+	resp, err := h.engine.PollWorkflowTaskQueue(ctx, request, opMetrics)
+	if err != nil || request.GetForwardedSource() != "" {
+		return resp, err
+	}
+	if counts := h.engine.PartitionCounts(ctx, request.GetNamespaceId(), request.GetPollRequest().GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_WORKFLOW); counts != nil {
+		if resp == emptyPollWorkflowTaskQueueResponse {
+			resp = &matchingservice.PollWorkflowTaskQueueResponse{}
+		}
+		resp.PartitionCounts = counts
+	}
+	return resp, nil
 }
 
 // QueryWorkflow queries a given workflow synchronously and return the query result.
@@ -451,6 +484,30 @@ func (h *Handler) ForceLoadTaskQueuePartition(
 	return h.engine.ForceLoadTaskQueuePartition(ctx, request)
 }
 
+func (h *Handler) HandoffStickyTaskQueue(
+	ctx context.Context,
+	request *matchingservice.HandoffStickyTaskQueueRequest,
+) (_ *matchingservice.HandoffStickyTaskQueueResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.HandoffStickyTaskQueue(ctx, request)
+}
+
+func (h *Handler) GetDeadLetteredTasks(
+	ctx context.Context,
+	request *matchingservice.GetDeadLetteredTasksRequest,
+) (_ *matchingservice.GetDeadLetteredTasksResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.GetDeadLetteredTasks(ctx, request)
+}
+
+func (h *Handler) ReenqueueDeadLetteredTasks(
+	ctx context.Context,
+	request *matchingservice.ReenqueueDeadLetteredTasksRequest,
+) (_ *matchingservice.ReenqueueDeadLetteredTasksResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.ReenqueueDeadLetteredTasks(ctx, request)
+}
+
 func (h *Handler) UpdateTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.UpdateTaskQueueUserDataRequest,
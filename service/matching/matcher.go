@@ -35,6 +35,7 @@ import (
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/quotas"
@@ -69,8 +70,9 @@ type TaskMatcher struct {
 
 	fwdr                   *Forwarder
 	metricsHandler         metrics.Handler // namespace metric scope
-	numPartitions          func() int      // number of task queue partitions
-	backlogTasksCreateTime map[int64]int   // task creation time (unix nanos) -> number of tasks with that time
+	timeSource             clock.TimeSource
+	numPartitions          func() int    // number of task queue partitions
+	backlogTasksCreateTime map[int64]int // task creation time (unix nanos) -> number of tasks with that time
 	backlogTasksLock       sync.Mutex
 	lastPoller             atomic.Int64 // unix nanos of most recent poll start time
 }
@@ -88,7 +90,12 @@ var (
 
 // newTaskMatcher returns a task matcher instance. The returned instance can be used by task producers and consumers to
 // find a match. Both sync matches and non-sync matches should use this implementation
-func newTaskMatcher(config *taskQueueConfig, fwdr *Forwarder, metricsHandler metrics.Handler) *TaskMatcher {
+func newTaskMatcher(
+	config *taskQueueConfig,
+	fwdr *Forwarder,
+	metricsHandler metrics.Handler,
+	timeSource clock.TimeSource,
+) *TaskMatcher {
 	dynamicRateBurst := quotas.NewMutableRateBurst(
 		defaultTaskDispatchRPS,
 		int(defaultTaskDispatchRPS),
@@ -112,6 +119,7 @@ func newTaskMatcher(config *taskQueueConfig, fwdr *Forwarder, metricsHandler met
 		dynamicRateLimiter:     dynamicRateLimiter,
 		rateLimiter:            limiter,
 		metricsHandler:         metricsHandler,
+		timeSource:             timeSource,
 		fwdr:                   fwdr,
 		taskC:                  make(chan *internalTask),
 		queryTaskC:             make(chan *internalTask),
@@ -345,7 +353,7 @@ func (tm *TaskMatcher) MustOffer(ctx context.Context, task *internalTask, interr
 	default:
 	}
 
-	var reconsiderFwdTimer *time.Timer
+	var reconsiderFwdTimer clock.Timer
 	defer func() {
 		if reconsiderFwdTimer != nil {
 			reconsiderFwdTimer.Stop()
@@ -373,8 +381,7 @@ forLoop:
 			maxWaitForLocalPoller := tm.config.MaxWaitForPollerBeforeFwd()
 			if lp < maxWaitForLocalPoller {
 				fwdTokenC = nil
-				reconsiderFwdTimer = time.NewTimer(maxWaitForLocalPoller - lp)
-				reconsiderFwdTimerC = reconsiderFwdTimer.C
+				reconsiderFwdTimerC, reconsiderFwdTimer = tm.timeSource.NewTimer(maxWaitForLocalPoller - lp)
 			}
 		}
 
@@ -431,7 +438,7 @@ func (tm *TaskMatcher) emitDispatchLatency(task *internalTask, forwarded bool) {
 	}
 
 	metrics.TaskDispatchLatencyPerTaskQueue.With(tm.metricsHandler).Record(
-		time.Since(timestamp.TimeValue(task.event.Data.CreateTime)),
+		tm.timeSource.Since(timestamp.TimeValue(task.event.Data.CreateTime)),
 		metrics.StringTag("source", task.source.String()),
 		metrics.StringTag("forwarded", strconv.FormatBool(forwarded)),
 	)
@@ -493,14 +500,14 @@ func (tm *TaskMatcher) poll(
 		taskC = nil
 	}
 
-	start := time.Now()
+	start := tm.timeSource.Now()
 	tm.lastPoller.Store(start.UnixNano())
 
 	defer func() {
 		if pollMetadata.forwardedFrom == "" {
 			// Only recording for original polls
 			metrics.PollLatencyPerTaskQueue.With(tm.metricsHandler).Record(
-				time.Since(start), metrics.StringTag("forwarded", strconv.FormatBool(forwardedPoll)))
+				tm.timeSource.Since(start), metrics.StringTag("forwarded", strconv.FormatBool(forwardedPoll)))
 		}
 
 		if err == nil {
@@ -663,7 +670,7 @@ func (tm *TaskMatcher) getBacklogAge() time.Duration {
 		oldest = min(oldest, createTime)
 	}
 
-	return time.Since(time.Unix(0, oldest))
+	return tm.timeSource.Since(time.Unix(0, oldest))
 }
 
 func (tm *TaskMatcher) emitForwardedSourceStats(
@@ -691,7 +698,7 @@ func (tm *TaskMatcher) emitForwardedSourceStats(
 }
 
 func (tm *TaskMatcher) timeSinceLastPoll() time.Duration {
-	return time.Since(time.Unix(0, tm.lastPoller.Load()))
+	return tm.timeSource.Since(time.Unix(0, tm.lastPoller.Load()))
 }
 
 // contextWithCancelOnChannelClose returns a child Context and CancelFunc just like
@@ -464,7 +464,7 @@ func (d *matcherData) allowForwarding() (allowForwarding bool) {
 		d.reconsiderForwardTimer.unset()
 		return true
 	}
-	delayToForwardingAllowed := d.config.MaxWaitForPollerBeforeFwd() - time.Since(d.lastPoller)
+	delayToForwardingAllowed := d.config.MaxWaitForPollerBeforeFwd() - d.timeSource.Since(d.lastPoller)
 	d.reconsiderForwardTimer.set(d.timeSource, d.rematchAfterTimer, delayToForwardingAllowed)
 	return delayToForwardingAllowed <= 0
 }
@@ -542,13 +542,13 @@ func (d *matcherData) FinishMatchAfterPollForward(poller *waitingPoller, task *i
 // call with lock held.
 func (d *matcherData) isBacklogNegligible() bool {
 	t := d.tasks.ages.oldestTime()
-	return t.IsZero() || time.Since(t) < d.config.BacklogNegligibleAge()
+	return t.IsZero() || d.timeSource.Since(t) < d.config.BacklogNegligibleAge()
 }
 
 func (d *matcherData) TimeSinceLastPoll() time.Duration {
 	d.lock.Lock()
 	defer d.lock.Unlock()
-	return time.Since(d.lastPoller)
+	return d.timeSource.Since(d.lastPoller)
 }
 
 // waitable match result:
@@ -31,6 +31,7 @@ import (
 	"fmt"
 	"math"
 	"math/rand"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -112,6 +113,8 @@ type (
 		deploymentOptions         *deploymentpb.WorkerDeploymentOptions
 		forwardedFrom             string
 		localPollStartTime        time.Time
+		// maxTasks is the number of activity tasks the poller accepts in a single batched response.
+		maxTasks int32
 	}
 
 	userDataUpdate struct {
@@ -180,6 +183,8 @@ type (
 		userDataUpdateBatchers collection.SyncMap[namespace.ID, *stream_batcher.Batcher[*userDataUpdate, error]]
 		// Stores results of reachability queries to visibility
 		reachabilityCache reachabilityCache
+		// Records tasks that are dropped without being delivered to a worker.
+		deadLetterQueue *deadLetterQueue
 	}
 )
 
@@ -226,9 +231,11 @@ func NewEngine(
 	namespaceReplicationQueue persistence.NamespaceReplicationQueue,
 	visibilityManager manager.VisibilityManager,
 	nexusEndpointManager persistence.NexusEndpointManager,
+	matchingTaskDLQManager persistence.MatchingTaskDLQManager,
 	testHooks testhooks.TestHooks,
 	saProvider searchattribute.Provider,
 	saMapperProvider searchattribute.MapperProvider,
+	timeSource clock.TimeSource,
 ) Engine {
 	scopedMetricsHandler := metricsHandler.WithTags(metrics.OperationTag(metrics.MatchingEngineScope))
 	e := &matchingEngineImpl{
@@ -247,7 +254,7 @@ func NewEngine(
 		serviceResolver:               resolver,
 		membershipChangedCh:           make(chan *membership.ChangedEvent, 1), // allow one signal to be buffered while we're working
 		clusterMeta:                   clusterMeta,
-		timeSource:                    clock.NewRealTimeSource(), // No need to mock this at the moment
+		timeSource:                    timeSource,
 		visibilityManager:             visibilityManager,
 		nexusEndpointClient:           newEndpointClient(config.NexusEndpointsRefreshInterval, nexusEndpointManager),
 		nexusEndpointsOwnershipLostCh: make(chan struct{}),
@@ -270,6 +277,7 @@ func NewEngine(
 		namespaceReplicationQueue: namespaceReplicationQueue,
 		userDataUpdateBatchers:    collection.NewSyncMap[namespace.ID, *stream_batcher.Batcher[*userDataUpdate, error]](),
 	}
+	e.deadLetterQueue = newDeadLetterQueue(matchingTaskDLQManager, matchingRawClient, config, e.logger, scopedMetricsHandler, e.timeSource)
 	e.reachabilityCache = newReachabilityCache(
 		metrics.NoopMetricsHandler,
 		visibilityManager,
@@ -287,6 +295,7 @@ func (e *matchingEngineImpl) Start() {
 		return
 	}
 
+	e.deadLetterQueue.Start()
 	go e.watchMembership()
 	_ = e.serviceResolver.AddListener(e.listenerKey(), e.membershipChangedCh)
 }
@@ -308,6 +317,7 @@ func (e *matchingEngineImpl) Stop() {
 	for _, l := range e.getTaskQueuePartitions(math.MaxInt32) {
 		l.Stop(unloadCauseShuttingDown)
 	}
+	e.deadLetterQueue.Stop()
 }
 
 func (e *matchingEngineImpl) listenerKey() string {
@@ -504,6 +514,25 @@ func (e *matchingEngineImpl) loggerAndMetricsForPartition(
 	return logger, throttledLogger, metricsHandler
 }
 
+// PartitionCounts returns the adaptive partition counts of the given task queue partition, to be passed on to matching
+// clients, or nil if there are none or the partition is not loaded.
+func (e *matchingEngineImpl) PartitionCounts(
+	ctx context.Context,
+	namespaceID string,
+	taskQueue *taskqueuepb.TaskQueue,
+	taskType enumspb.TaskQueueType,
+) *taskqueuespb.PartitionCounts {
+	partition, err := tqid.PartitionFromProto(taskQueue, namespaceID, taskType)
+	if err != nil || partition.Kind() == enumspb.TASK_QUEUE_KIND_STICKY {
+		return nil
+	}
+	pm, _, err := e.getTaskQueuePartitionManager(ctx, partition, false, loadCauseOtherRead)
+	if err != nil || pm == nil {
+		return nil
+	}
+	return pm.PartitionCounts()
+}
+
 // For use in tests
 func (e *matchingEngineImpl) updateTaskQueue(partition tqid.Partition, mgr taskQueuePartitionManager) {
 	e.partitionsLock.Lock()
@@ -526,13 +555,23 @@ func (e *matchingEngineImpl) AddWorkflowTask(
 	pm, _, err := e.getTaskQueuePartitionManager(ctx, partition, !sticky, loadCauseTask)
 	if err != nil {
 		return "", false, err
-	} else if sticky && !stickyWorkerAvailable(pm) {
+	}
+	if sticky && !addRequest.GetStickyHandoffRedirected() && e.stickyHandoffEnabled(addRequest.NamespaceId) {
+		successor, err := e.stickySuccessor(ctx, partition, pm)
+		if err != nil {
+			return "", false, err
+		}
+		if successor != nil {
+			return e.redirectStickyWorkflowTask(ctx, addRequest, successor)
+		}
+	}
+	if sticky && !stickyWorkerAvailable(pm, e.timeSource.Now()) {
 		return "", false, serviceerrors.NewStickyWorkerUnavailable()
 	}
 
 	// This needs to move to history see - https://go.temporal.io/server/issues/181
 	var expirationTime *timestamppb.Timestamp
-	now := time.Now().UTC()
+	now := e.timeSource.Now()
 	expirationDuration := addRequest.GetScheduleToStartTimeout().AsDuration()
 	if expirationDuration != 0 {
 		expirationTime = timestamppb.New(now.Add(expirationDuration))
@@ -555,6 +594,53 @@ func (e *matchingEngineImpl) AddWorkflowTask(
 	})
 }
 
+// stickySuccessor returns the queue that a handed off sticky partition redirects to, or nil. A partition that is not
+// loaded is not loaded for this, since that would create and persist metadata for every abandoned sticky queue. Its
+// persisted metadata is read instead, and a partition without metadata has no handoff.
+func (e *matchingEngineImpl) stickySuccessor(
+	ctx context.Context,
+	partition tqid.Partition,
+	pm taskQueuePartitionManager,
+) (*taskqueuepb.TaskQueue, error) {
+	if pm != nil {
+		return pm.StickySuccessor(), nil
+	}
+	queue := UnversionedQueueKey(partition)
+	resp, err := e.taskManager.GetTaskQueue(ctx, &persistence.GetTaskQueueRequest{
+		NamespaceID: queue.NamespaceId(),
+		TaskQueue:   queue.PersistenceName(),
+		TaskType:    queue.TaskType(),
+	})
+	if common.IsNotFoundError(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	handoff := resp.TaskQueueInfo.GetStickyHandoff()
+	if handoff == nil || !e.timeSource.Now().Before(handoff.GetExpireTime().AsTime()) {
+		return nil, nil
+	}
+	return handoff.GetSuccessor(), nil
+}
+
+// redirectStickyWorkflowTask sends a workflow task for a handed off sticky queue to its successor. Tasks are only
+// redirected once so that handoffs can't form a loop.
+func (e *matchingEngineImpl) redirectStickyWorkflowTask(
+	ctx context.Context,
+	addRequest *matchingservice.AddWorkflowTaskRequest,
+	successor *taskqueuepb.TaskQueue,
+) (buildId string, syncMatch bool, err error) {
+	redirected := common.CloneProto(addRequest)
+	redirected.TaskQueue = successor
+	redirected.StickyHandoffRedirected = true
+	resp, err := e.matchingRawClient.AddWorkflowTask(ctx, redirected)
+	if err != nil {
+		return "", false, err
+	}
+	metrics.StickyHandoffRedirectedTasksCounter.With(e.metricsHandler).Record(1)
+	return resp.GetAssignedBuildId(), false, nil
+}
+
 // AddActivityTask either delivers task directly to waiting poller or save it into task queue persistence.
 func (e *matchingEngineImpl) AddActivityTask(
 	ctx context.Context,
@@ -570,7 +656,7 @@ func (e *matchingEngineImpl) AddActivityTask(
 	}
 
 	var expirationTime *timestamppb.Timestamp
-	now := time.Now().UTC()
+	now := e.timeSource.Now()
 	expirationDuration := timestamp.DurationValue(addRequest.GetScheduleToStartTimeout())
 	if expirationDuration != 0 {
 		expirationTime = timestamppb.New(now.Add(expirationDuration))
@@ -695,7 +781,7 @@ pollLoop:
 			switch err := err.(type) {
 			case *serviceerror.Internal, *serviceerror.DataLoss:
 				if e.config.MatchingDropNonRetryableTasks() {
-					e.nonRetryableErrorsDropTask(task, taskQueueName, err)
+					e.nonRetryableErrorsDropTask(ctx, task, partition, err)
 					// drop the task as otherwise task would be stuck in a retry-loop
 					task.finish(nil, false)
 				} else {
@@ -831,7 +917,13 @@ func (e *matchingEngineImpl) getHistoryForQueryTask(
 	return hist, resp.GetResponse().GetNextPageToken(), err
 }
 
-func (e *matchingEngineImpl) nonRetryableErrorsDropTask(task *internalTask, taskQueueName string, err error) {
+func (e *matchingEngineImpl) nonRetryableErrorsDropTask(
+	ctx context.Context,
+	task *internalTask,
+	partition tqid.Partition,
+	err error,
+) {
+	taskQueueName := partition.RpcName()
 	e.logger.Error("dropping task due to non-nonretryable errors",
 		tag.WorkflowNamespace(task.namespace.String()),
 		tag.WorkflowNamespaceID(task.event.Data.GetNamespaceId()),
@@ -845,6 +937,7 @@ func (e *matchingEngineImpl) nonRetryableErrorsDropTask(task *internalTask, task
 	)
 
 	metrics.NonRetryableTasks.With(e.metricsHandler).Record(1, metrics.ServiceErrorTypeTag(err))
+	e.deadLetterQueue.add(ctx, partition.TaskQueue(), task.event.GetData(), enumsspb.DEAD_LETTER_REASON_NON_RETRYABLE_ERROR, err)
 }
 
 // PollActivityTaskQueue takes one task from the task manager, update workflow execution history, mark task as
@@ -857,7 +950,6 @@ func (e *matchingEngineImpl) PollActivityTaskQueue(
 ) (*matchingservice.PollActivityTaskQueueResponse, error) {
 	pollerID := req.GetPollerId()
 	request := req.PollRequest
-	taskQueueName := request.TaskQueue.GetName()
 pollLoop:
 	for {
 		err := common.IsValidContext(ctx)
@@ -879,6 +971,7 @@ pollLoop:
 			workerVersionCapabilities: request.WorkerVersionCapabilities,
 			deploymentOptions:         request.DeploymentOptions,
 			forwardedFrom:             req.GetForwardedSource(),
+			maxTasks:                  int32(e.maxActivityTasksPerPoll(req, partition)),
 		}
 		task, versionSetUsed, err := e.pollTask(pollerCtx, partition, pollMetadata)
 		if err != nil {
@@ -902,97 +995,197 @@ pollLoop:
 		}
 		resp, err := e.recordActivityTaskStarted(ctx, requestClone, task)
 		if err != nil {
-			switch err := err.(type) {
-			case *serviceerror.Internal, *serviceerror.DataLoss:
-				if e.config.MatchingDropNonRetryableTasks() {
-					e.nonRetryableErrorsDropTask(task, taskQueueName, err)
-					// drop the task as otherwise task would be stuck in a retry-loop
-					task.finish(nil, false)
-				} else {
-					// default case
-					task.finish(err, false)
-				}
-			case *serviceerror.NotFound: // mutable state not found, workflow not running or activity info not found
-				e.logger.Info("Activity task not found",
-					tag.WorkflowNamespaceID(task.event.Data.GetNamespaceId()),
-					tag.WorkflowID(task.event.Data.GetWorkflowId()),
-					tag.WorkflowRunID(task.event.Data.GetRunId()),
-					tag.WorkflowTaskQueueName(taskQueueName),
-					tag.TaskID(task.event.GetTaskId()),
-					tag.TaskVisibilityTimestamp(timestamp.TimeValue(task.event.Data.GetCreateTime())),
-					tag.WorkflowEventID(task.event.Data.GetScheduledEventId()),
-					tag.Error(err),
-				)
-				task.finish(nil, false)
-			case *serviceerrors.TaskAlreadyStarted:
-				e.logger.Debug("Duplicated activity task", tag.WorkflowTaskQueueName(taskQueueName), tag.TaskID(task.event.GetTaskId()))
-				task.finish(nil, false)
-			case *serviceerrors.ObsoleteDispatchBuildId:
-				// history should've scheduled another task on the right build ID. dropping this one.
-				e.logger.Info("dropping activity task due to invalid build ID",
-					tag.WorkflowTaskQueueName(taskQueueName),
-					tag.WorkflowNamespaceID(task.event.Data.GetNamespaceId()),
-					tag.WorkflowID(task.event.Data.GetWorkflowId()),
-					tag.WorkflowRunID(task.event.Data.GetRunId()),
-					tag.TaskID(task.event.GetTaskId()),
-					tag.TaskVisibilityTimestamp(timestamp.TimeValue(task.event.Data.GetCreateTime())),
-					tag.BuildId(requestClone.WorkerVersionCapabilities.GetBuildId()),
-				)
-				task.finish(nil, false)
-			case *serviceerrors.ObsoleteMatchingTask:
-				// History should've scheduled another task on the right task queue and deployment.
-				// Dropping this one.
-				e.logger.Info("dropping obsolete activity task",
-					tag.WorkflowTaskQueueName(taskQueueName),
-					tag.WorkflowNamespaceID(task.event.Data.GetNamespaceId()),
-					tag.WorkflowID(task.event.Data.GetWorkflowId()),
-					tag.WorkflowRunID(task.event.Data.GetRunId()),
-					tag.TaskID(task.event.GetTaskId()),
-					tag.TaskVisibilityTimestamp(timestamp.TimeValue(task.event.Data.GetCreateTime())),
-					tag.VersioningBehavior(task.event.Data.VersionDirective.GetBehavior()),
-					//nolint:staticcheck // SA1019 deprecated WorkerVersionCapabilities will clean up later
-					tag.Deployment(worker_versioning.DeploymentFromCapabilities(requestClone.WorkerVersionCapabilities, requestClone.DeploymentOptions)),
-					tag.Error(err),
-				)
-				task.finish(nil, false)
-			case *serviceerrors.ActivityStartDuringTransition:
-				// History will schedule another task once transition ends. Dropping this one.
-				e.logger.Info("dropping activity task during transition",
-					tag.WorkflowTaskQueueName(taskQueueName),
-					tag.WorkflowNamespaceID(task.event.Data.GetNamespaceId()),
-					tag.WorkflowID(task.event.Data.GetWorkflowId()),
-					tag.WorkflowRunID(task.event.Data.GetRunId()),
-					tag.TaskID(task.event.GetTaskId()),
-					tag.TaskVisibilityTimestamp(timestamp.TimeValue(task.event.Data.GetCreateTime())),
-					tag.VersioningBehavior(task.event.Data.VersionDirective.GetBehavior()),
-					//nolint:staticcheck // SA1019 deprecated WorkerVersionCapabilities will clean up later
-					tag.Deployment(worker_versioning.DeploymentFromCapabilities(requestClone.WorkerVersionCapabilities, requestClone.DeploymentOptions)),
-				)
-				task.finish(nil, false)
-			case *serviceerror.ResourceExhausted:
-				// If history returns one ResourceExhausted, it's likely to return more if we retry
-				// immediately. Instead, return the error to the client which will back off.
-				// BUSY_WORKFLOW is limited to one workflow and is okay to retry.
-				task.finish(err, false)
-				if err.Cause != enumspb.RESOURCE_EXHAUSTED_CAUSE_BUSY_WORKFLOW {
-					return nil, err
-				}
-			default:
-				task.finish(err, false)
-				if err.Error() == common.ErrNamespaceHandover.Error() {
-					// do not keep polling new tasks when namespace is in handover state
-					// as record start request will be rejected by history service
-					return nil, err
-				}
+			if err := e.handleRecordActivityTaskStartedError(ctx, task, partition, requestClone, err); err != nil {
+				return nil, err
 			}
-
 			continue pollLoop
 		}
 		task.finish(nil, true)
-		return e.createPollActivityTaskQueueResponse(task, resp, opMetrics), nil
+		response := e.createPollActivityTaskQueueResponse(task, resp, opMetrics)
+		if maxTasks := int(pollMetadata.maxTasks); maxTasks > 1 {
+			response.BatchedTasks = e.pollBatchedActivityTasks(pollerCtx, partition, request, pollMetadata, maxTasks-1, opMetrics)
+		}
+		return response, nil
 	}
 }
 
+// handleRecordActivityTaskStartedError finishes a task whose RecordActivityTaskStarted call failed. It returns a
+// non-nil error if polling should stop and the error be returned to the poller, or nil if the poller may continue to
+// look for another task.
+func (e *matchingEngineImpl) handleRecordActivityTaskStartedError(
+	ctx context.Context,
+	task *internalTask,
+	partition tqid.Partition,
+	pollReq *workflowservice.PollActivityTaskQueueRequest,
+	err error,
+) error {
+	taskQueueName := partition.RpcName()
+	switch err := err.(type) {
+	case *serviceerror.Internal, *serviceerror.DataLoss:
+		if e.config.MatchingDropNonRetryableTasks() {
+			e.nonRetryableErrorsDropTask(ctx, task, partition, err)
+			// drop the task as otherwise task would be stuck in a retry-loop
+			task.finish(nil, false)
+		} else {
+			// default case
+			task.finish(err, false)
+		}
+	case *serviceerror.NotFound: // mutable state not found, workflow not running or activity info not found
+		e.logger.Info("Activity task not found",
+			tag.WorkflowNamespaceID(task.event.Data.GetNamespaceId()),
+			tag.WorkflowID(task.event.Data.GetWorkflowId()),
+			tag.WorkflowRunID(task.event.Data.GetRunId()),
+			tag.WorkflowTaskQueueName(taskQueueName),
+			tag.TaskID(task.event.GetTaskId()),
+			tag.TaskVisibilityTimestamp(timestamp.TimeValue(task.event.Data.GetCreateTime())),
+			tag.WorkflowEventID(task.event.Data.GetScheduledEventId()),
+			tag.Error(err),
+		)
+		task.finish(nil, false)
+	case *serviceerrors.TaskAlreadyStarted:
+		e.logger.Debug("Duplicated activity task", tag.WorkflowTaskQueueName(taskQueueName), tag.TaskID(task.event.GetTaskId()))
+		task.finish(nil, false)
+	case *serviceerrors.ObsoleteDispatchBuildId:
+		// history should've scheduled another task on the right build ID. dropping this one.
+		e.logger.Info("dropping activity task due to invalid build ID",
+			tag.WorkflowTaskQueueName(taskQueueName),
+			tag.WorkflowNamespaceID(task.event.Data.GetNamespaceId()),
+			tag.WorkflowID(task.event.Data.GetWorkflowId()),
+			tag.WorkflowRunID(task.event.Data.GetRunId()),
+			tag.TaskID(task.event.GetTaskId()),
+			tag.TaskVisibilityTimestamp(timestamp.TimeValue(task.event.Data.GetCreateTime())),
+			tag.BuildId(pollReq.WorkerVersionCapabilities.GetBuildId()),
+		)
+		task.finish(nil, false)
+	case *serviceerrors.ObsoleteMatchingTask:
+		// History should've scheduled another task on the right task queue and deployment.
+		// Dropping this one.
+		e.logger.Info("dropping obsolete activity task",
+			tag.WorkflowTaskQueueName(taskQueueName),
+			tag.WorkflowNamespaceID(task.event.Data.GetNamespaceId()),
+			tag.WorkflowID(task.event.Data.GetWorkflowId()),
+			tag.WorkflowRunID(task.event.Data.GetRunId()),
+			tag.TaskID(task.event.GetTaskId()),
+			tag.TaskVisibilityTimestamp(timestamp.TimeValue(task.event.Data.GetCreateTime())),
+			tag.VersioningBehavior(task.event.Data.VersionDirective.GetBehavior()),
+			//nolint:staticcheck // SA1019 deprecated WorkerVersionCapabilities will clean up later
+			tag.Deployment(worker_versioning.DeploymentFromCapabilities(pollReq.WorkerVersionCapabilities, pollReq.DeploymentOptions)),
+			tag.Error(err),
+		)
+		task.finish(nil, false)
+	case *serviceerrors.ActivityStartDuringTransition:
+		// History will schedule another task once transition ends. Dropping this one.
+		e.logger.Info("dropping activity task during transition",
+			tag.WorkflowTaskQueueName(taskQueueName),
+			tag.WorkflowNamespaceID(task.event.Data.GetNamespaceId()),
+			tag.WorkflowID(task.event.Data.GetWorkflowId()),
+			tag.WorkflowRunID(task.event.Data.GetRunId()),
+			tag.TaskID(task.event.GetTaskId()),
+			tag.TaskVisibilityTimestamp(timestamp.TimeValue(task.event.Data.GetCreateTime())),
+			tag.VersioningBehavior(task.event.Data.VersionDirective.GetBehavior()),
+			//nolint:staticcheck // SA1019 deprecated WorkerVersionCapabilities will clean up later
+			tag.Deployment(worker_versioning.DeploymentFromCapabilities(pollReq.WorkerVersionCapabilities, pollReq.DeploymentOptions)),
+		)
+		task.finish(nil, false)
+	case *serviceerror.ResourceExhausted:
+		// If history returns one ResourceExhausted, it's likely to return more if we retry
+		// immediately. Instead, return the error to the client which will back off.
+		// BUSY_WORKFLOW is limited to one workflow and is okay to retry.
+		task.finish(err, false)
+		if err.Cause != enumspb.RESOURCE_EXHAUSTED_CAUSE_BUSY_WORKFLOW {
+			return err
+		}
+	default:
+		task.finish(err, false)
+		if err.Error() == common.ErrNamespaceHandover.Error() {
+			// do not keep polling new tasks when namespace is in handover state
+			// as record start request will be rejected by history service
+			return err
+		}
+	}
+
+	return nil
+}
+
+// maxActivityTasksPerPoll returns the number of tasks a batched activity poll may return, taking into account what the
+// poller asked for and the limit configured for the task queue.
+func (e *matchingEngineImpl) maxActivityTasksPerPoll(
+	req *matchingservice.PollActivityTaskQueueRequest,
+	partition tqid.Partition,
+) int {
+	requested := int(req.GetMaxTasks())
+	if requested <= 1 {
+		return 1
+	}
+	tq := partition.TaskQueue()
+	limit := e.config.MaxActivityTasksPerPoll(tq.NamespaceId(), tq.Name(), tq.TaskType())
+	return max(1, min(requested, limit))
+}
+
+// pollBatchedActivityTasks collects up to maxTasks additional activity tasks for a poller that opted in to batched
+// polling. Unlike the initial poll it only waits for a short time for each task, so that a batch is only formed from
+// tasks that are already available. Each task is recorded as started and acked individually; failures for a single task
+// are handled the same way as for a regular poll and never fail tasks that were already collected.
+func (e *matchingEngineImpl) pollBatchedActivityTasks(
+	ctx context.Context,
+	partition tqid.Partition,
+	request *workflowservice.PollActivityTaskQueueRequest,
+	pollMetadata *pollMetadata,
+	maxTasks int,
+	opMetrics metrics.Handler,
+) []*matchingservice.PollActivityTaskQueueResponse {
+	tq := partition.TaskQueue()
+	wait := e.config.ActivityPollBatchWaitDuration(tq.NamespaceId(), tq.Name(), tq.TaskType())
+
+	var batch []*matchingservice.PollActivityTaskQueueResponse
+	for len(batch) < maxTasks && ctx.Err() == nil {
+		batchMetadata := *pollMetadata
+		batchMetadata.maxTasks = int32(maxTasks - len(batch))
+
+		// pollTask reserves returnEmptyTaskTimeBudget of the context for returning an empty response, which we don't
+		// need here, so add it back on top of the wait.
+		pollCtx, cancel := context.WithTimeout(ctx, wait+returnEmptyTaskTimeBudget)
+		task, versionSetUsed, err := e.pollTask(pollCtx, partition, &batchMetadata)
+		cancel()
+		if err != nil {
+			// errNoTasks simply means the batch is complete. Any other error is not worth failing the poll for since
+			// we already have tasks to return.
+			break
+		}
+
+		if task.isStarted() {
+			// Tasks received from remote are already started and may carry their own batch, which was capped to the
+			// number of tasks we asked for.
+			remote := task.pollActivityTaskQueueResponse()
+			nested := remote.BatchedTasks
+			remote.BatchedTasks = nil
+			batch = append(batch, remote)
+			batch = append(batch, nested...)
+			continue
+		}
+
+		requestClone := request
+		if versionSetUsed {
+			// TODO: remove this block after old versioning cleanup. [cleanup-old-wv]
+			requestClone = common.CloneProto(request)
+			requestClone.WorkerVersionCapabilities.BuildId = ""
+		}
+		resp, err := e.recordActivityTaskStarted(ctx, requestClone, task)
+		if err != nil {
+			if err := e.handleRecordActivityTaskStartedError(ctx, task, partition, requestClone, err); err != nil {
+				break
+			}
+			continue
+		}
+		task.finish(nil, true)
+		batch = append(batch, e.createPollActivityTaskQueueResponse(task, resp, opMetrics))
+	}
+	if len(batch) > 0 {
+		metrics.BatchedActivityTasksPerPoll.With(opMetrics).Record(int64(len(batch)))
+	}
+	return batch
+}
+
 type queryResult struct {
 	workerResponse *matchingservice.RespondQueryTaskCompletedRequest
 	internalError  error
@@ -1013,7 +1206,7 @@ func (e *matchingEngineImpl) QueryWorkflow(
 	pm, _, err := e.getTaskQueuePartitionManager(ctx, partition, !sticky, loadCauseQuery)
 	if err != nil {
 		return nil, err
-	} else if sticky && !stickyWorkerAvailable(pm) {
+	} else if sticky && !stickyWorkerAvailable(pm, e.timeSource.Now()) {
 		return nil, serviceerrors.NewStickyWorkerUnavailable()
 	}
 
@@ -1136,9 +1329,9 @@ func (e *matchingEngineImpl) DescribeTaskQueue(
 		physicalInfoByBuildId := make(map[string]map[enumspb.TaskQueueType]*taskqueuespb.PhysicalTaskQueueInfo)
 		if timeSinceLastFanOut > lastFanOutTTL {
 			// collect internal info
-			numPartitions := max(tqConfig.NumWritePartitions(), tqConfig.NumReadPartitions())
-
 			for _, taskQueueType := range req.TaskQueueTypes {
+				typeConfig := newTaskQueueConfig(rootPartition.TaskQueue().Family().TaskQueue(taskQueueType), e.config, namespace.Name(req.Namespace))
+				numPartitions := numPartitionsForFanOut(userData, taskQueueType, typeConfig)
 				for i := 0; i < numPartitions; i++ {
 					partitionResp, err := e.matchingRawClient.DescribeTaskQueuePartition(ctx, &matchingservice.DescribeTaskQueuePartitionRequest{
 						NamespaceId: request.GetNamespaceId(),
@@ -1430,7 +1623,7 @@ func (e *matchingEngineImpl) UpdateWorkerVersioningRules(
 				updatedClock,
 				data.GetVersioningData(),
 				req.GetCommitBuildId(),
-				tqMgr.HasPollerAfter(req.GetCommitBuildId().GetTargetBuildId(), time.Now().Add(-versioningPollerSeenWindow)),
+				tqMgr.HasPollerAfter(req.GetCommitBuildId().GetTargetBuildId(), e.timeSource.Now().Add(-versioningPollerSeenWindow)),
 				e.config.AssignmentRuleLimitPerQueue(ns.Name().String()),
 			)
 		}
@@ -1860,6 +2053,9 @@ func (e *matchingEngineImpl) ApplyTaskQueueUserDataReplicationEvent(
 			}
 		}
 
+		// Adaptive partition counts are local to each cluster, keep ours regardless of which side won.
+		mergedUserData.PerType = preserveAdaptivePartitions(mergedUserData.GetPerType(), current.GetPerType())
+
 		// No need to keep the tombstones around after replication.
 		mergedUserData.VersioningData = ClearTombstones(mergedData)
 		return mergedUserData, len(buildIdsToRevive) > 0, nil
@@ -1867,6 +2063,32 @@ func (e *matchingEngineImpl) ApplyTaskQueueUserDataReplicationEvent(
 	return &matchingservice.ApplyTaskQueueUserDataReplicationEventResponse{}, err
 }
 
+// preserveAdaptivePartitions returns a copy of merged with the adaptive partition counts taken from local.
+func preserveAdaptivePartitions(
+	merged map[int32]*persistencespb.TaskQueueTypeUserData,
+	local map[int32]*persistencespb.TaskQueueTypeUserData,
+) map[int32]*persistencespb.TaskQueueTypeUserData {
+	result := make(map[int32]*persistencespb.TaskQueueTypeUserData, len(merged))
+	for t, data := range merged {
+		if data.GetAdaptivePartitions() == nil && local[t].GetAdaptivePartitions() == nil {
+			result[t] = data
+			continue
+		}
+		data = common.CloneProto(data)
+		data.AdaptivePartitions = local[t].GetAdaptivePartitions()
+		result[t] = data
+	}
+	for t, data := range local {
+		if _, ok := result[t]; !ok && data.GetAdaptivePartitions() != nil {
+			result[t] = &persistencespb.TaskQueueTypeUserData{AdaptivePartitions: data.GetAdaptivePartitions()}
+		}
+	}
+	if len(result) == 0 {
+		return merged
+	}
+	return result
+}
+
 func (e *matchingEngineImpl) GetBuildIdTaskQueueMapping(
 	ctx context.Context,
 	req *matchingservice.GetBuildIdTaskQueueMappingRequest,
@@ -1918,6 +2140,96 @@ func (e *matchingEngineImpl) ForceUnloadTaskQueuePartition(
 	return &matchingservice.ForceUnloadTaskQueuePartitionResponse{WasLoaded: wasLoaded}, nil
 }
 
+// HandoffStickyTaskQueue records that the sticky queue of a worker's previous process is succeeded by the sticky
+// queue of its new process. Until the handoff expires, workflow tasks sent to the old queue and its backlog are
+// redirected to the successor, so that the new process can keep using the workflows cached by the old one.
+func (e *matchingEngineImpl) HandoffStickyTaskQueue(
+	ctx context.Context,
+	req *matchingservice.HandoffStickyTaskQueueRequest,
+) (*matchingservice.HandoffStickyTaskQueueResponse, error) {
+	ns, err := e.namespaceRegistry.GetNamespaceByID(namespace.ID(req.GetNamespaceId()))
+	if err != nil {
+		return nil, err
+	}
+	if !e.config.EnableStickyHandoff(ns.Name().String()) {
+		return nil, serviceerror.NewPermissionDenied("sticky task queue handoff is disabled for this namespace", "")
+	}
+
+	partition, err := tqid.PartitionFromProto(req.GetTaskQueue(), req.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+	successor, err := tqid.PartitionFromProto(req.GetSuccessorTaskQueue(), req.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+	if partition.Kind() != enumspb.TASK_QUEUE_KIND_STICKY || successor.Kind() != enumspb.TASK_QUEUE_KIND_STICKY {
+		return nil, serviceerror.NewInvalidArgument("only sticky task queues can be handed off")
+	}
+	if partition.TaskQueue().Name() != successor.TaskQueue().Name() {
+		return nil, serviceerror.NewInvalidArgument("sticky task queues must belong to the same normal task queue")
+	}
+	if partition.Key() == successor.Key() {
+		return nil, serviceerror.NewInvalidArgument("sticky task queue cannot be handed off to itself")
+	}
+
+	// Only the worker that owns the old queue may hand it off. The owner is the first poller of the queue and is
+	// stored in its metadata, so the queue is loaded even if nobody polls it anymore.
+	pm, _, err := e.getTaskQueuePartitionManager(ctx, partition, true, loadCauseOtherWrite)
+	if err != nil {
+		return nil, err
+	}
+	if err := pm.WaitUntilInitialized(ctx); err != nil {
+		return nil, err
+	}
+	if !stickyQueueOwnedBy(pm, req.GetIdentity()) {
+		return nil, serviceerror.NewPermissionDenied("sticky task queue is not owned by this identity", "")
+	}
+
+	expireTime := e.timeSource.Now().Add(e.config.StickyHandoffTTL())
+	if err := pm.HandoffSticky(ctx, common.CloneProto(req.GetSuccessorTaskQueue()), expireTime); err != nil {
+		return nil, err
+	}
+	return &matchingservice.HandoffStickyTaskQueueResponse{}, nil
+}
+
+// stickyQueueOwnedBy returns true if the given identity owns the sticky partition. Owners are recorded with the next
+// metadata write after their first poll, so a poller that is still in the in-memory poller history of a partition
+// without a recorded owner is accepted as well.
+func stickyQueueOwnedBy(pm taskQueuePartitionManager, identity string) bool {
+	if identity == "" {
+		return false
+	}
+	if owner := pm.StickyOwnerIdentity(); owner != "" {
+		return owner == identity
+	}
+	return slices.ContainsFunc(pm.GetAllPollerInfo(), func(p *taskqueuepb.PollerInfo) bool {
+		return p.GetIdentity() == identity
+	})
+}
+
+func (e *matchingEngineImpl) stickyHandoffEnabled(namespaceID string) bool {
+	ns, err := e.namespaceRegistry.GetNamespaceByID(namespace.ID(namespaceID))
+	if err != nil {
+		return false
+	}
+	return e.config.EnableStickyHandoff(ns.Name().String())
+}
+
+func (e *matchingEngineImpl) GetDeadLetteredTasks(
+	ctx context.Context,
+	req *matchingservice.GetDeadLetteredTasksRequest,
+) (*matchingservice.GetDeadLetteredTasksResponse, error) {
+	return e.deadLetterQueue.GetTasks(ctx, req)
+}
+
+func (e *matchingEngineImpl) ReenqueueDeadLetteredTasks(
+	ctx context.Context,
+	req *matchingservice.ReenqueueDeadLetteredTasksRequest,
+) (*matchingservice.ReenqueueDeadLetteredTasksResponse, error) {
+	return e.deadLetterQueue.Reenqueue(ctx, req)
+}
+
 func (e *matchingEngineImpl) UpdateTaskQueueUserData(ctx context.Context, request *matchingservice.UpdateTaskQueueUserDataRequest) (*matchingservice.UpdateTaskQueueUserDataResponse, error) {
 	namespaceId := namespace.ID(request.NamespaceId)
 	var applied, conflicting bool
@@ -2526,7 +2838,7 @@ func (e *matchingEngineImpl) createPollWorkflowTaskQueueResponse(
 		serializedToken, _ = e.tokenSerializer.Serialize(taskToken)
 		if task.responseC == nil {
 			ct := timestamp.TimeValue(task.event.Data.CreateTime)
-			metrics.AsyncMatchLatencyPerTaskQueue.With(metricsHandler).Record(time.Since(ct))
+			metrics.AsyncMatchLatencyPerTaskQueue.With(metricsHandler).Record(e.timeSource.Since(ct))
 		}
 	}
 
@@ -2562,7 +2874,7 @@ func (e *matchingEngineImpl) createPollActivityTaskQueueResponse(
 	}
 	if task.responseC == nil {
 		ct := timestamp.TimeValue(task.event.Data.CreateTime)
-		metrics.AsyncMatchLatencyPerTaskQueue.With(metricsHandler).Record(time.Since(ct))
+		metrics.AsyncMatchLatencyPerTaskQueue.With(metricsHandler).Record(e.timeSource.Since(ct))
 	}
 
 	taskToken := tasktoken.NewActivityTaskToken(
@@ -2709,8 +3021,8 @@ func (e *matchingEngineImpl) reviveBuildId(ns *namespace.Namespace, taskQueue st
 
 // We use a very short timeout for considering a sticky worker available, since tasks can also
 // be processed on the normal queue.
-func stickyWorkerAvailable(pm taskQueuePartitionManager) bool {
-	return pm != nil && pm.HasPollerAfter("", time.Now().Add(-stickyPollerUnavailableWindow))
+func stickyWorkerAvailable(pm taskQueuePartitionManager, now time.Time) bool {
+	return pm != nil && pm.HasPollerAfter("", now.Add(-stickyPollerUnavailableWindow))
 }
 
 // largerBacklogAge returns the larger BacklogAge
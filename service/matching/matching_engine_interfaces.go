@@ -27,7 +27,10 @@ package matching
 import (
 	"context"
 
+	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common/metrics"
 )
 
@@ -55,6 +58,9 @@ type (
 		ForceUnloadTaskQueuePartition(ctx context.Context, request *matchingservice.ForceUnloadTaskQueuePartitionRequest) (*matchingservice.ForceUnloadTaskQueuePartitionResponse, error)
 		ForceUnloadTaskQueue(ctx context.Context, request *matchingservice.ForceUnloadTaskQueueRequest) (*matchingservice.ForceUnloadTaskQueueResponse, error)
 		ForceLoadTaskQueuePartition(ctx context.Context, request *matchingservice.ForceLoadTaskQueuePartitionRequest) (*matchingservice.ForceLoadTaskQueuePartitionResponse, error)
+		HandoffStickyTaskQueue(ctx context.Context, request *matchingservice.HandoffStickyTaskQueueRequest) (*matchingservice.HandoffStickyTaskQueueResponse, error)
+		GetDeadLetteredTasks(ctx context.Context, request *matchingservice.GetDeadLetteredTasksRequest) (*matchingservice.GetDeadLetteredTasksResponse, error)
+		ReenqueueDeadLetteredTasks(ctx context.Context, request *matchingservice.ReenqueueDeadLetteredTasksRequest) (*matchingservice.ReenqueueDeadLetteredTasksResponse, error)
 		UpdateTaskQueueUserData(ctx context.Context, request *matchingservice.UpdateTaskQueueUserDataRequest) (*matchingservice.UpdateTaskQueueUserDataResponse, error)
 		ReplicateTaskQueueUserData(ctx context.Context, request *matchingservice.ReplicateTaskQueueUserDataRequest) (*matchingservice.ReplicateTaskQueueUserDataResponse, error)
 		CheckTaskQueueUserDataPropagation(ctx context.Context, request *matchingservice.CheckTaskQueueUserDataPropagationRequest) (*matchingservice.CheckTaskQueueUserDataPropagationResponse, error)
@@ -68,5 +74,6 @@ type (
 		ListNexusEndpoints(ctx context.Context, request *matchingservice.ListNexusEndpointsRequest) (*matchingservice.ListNexusEndpointsResponse, error)
 		UpdateWorkerVersioningRules(ctx context.Context, request *matchingservice.UpdateWorkerVersioningRulesRequest) (*matchingservice.UpdateWorkerVersioningRulesResponse, error)
 		GetWorkerVersioningRules(ctx context.Context, request *matchingservice.GetWorkerVersioningRulesRequest) (*matchingservice.GetWorkerVersioningRulesResponse, error)
+		PartitionCounts(ctx context.Context, namespaceID string, taskQueue *taskqueuepb.TaskQueue, taskType enumspb.TaskQueueType) *taskqueuespb.PartitionCounts
 	}
 )
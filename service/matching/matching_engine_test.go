@@ -84,6 +84,7 @@ import (
 	"go.temporal.io/server/common/worker_versioning"
 	"go.temporal.io/server/service/history/consts"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -253,6 +254,7 @@ func newMatchingEngine(
 		visibilityManager:             mockVisibilityManager,
 		nexusEndpointClient:           newEndpointClient(config.NexusEndpointsRefreshInterval, nexusEndpointManager),
 		nexusEndpointsOwnershipLostCh: make(chan struct{}),
+		deadLetterQueue:               newDeadLetterQueue(nil, mockMatchingClient, config, logger, metrics.NoopMetricsHandler, clock.NewRealTimeSource()),
 	}
 }
 
@@ -848,12 +850,114 @@ func (s *matchingEngineSuite) TestAddWorkflowTaskDoesNotLoadSticky() {
 	}
 	_, _, err := s.matchingEngine.AddWorkflowTask(context.Background(), &addRequest)
 	s.ErrorAs(err, new(*serviceerrors.StickyWorkerUnavailable))
+	// a sticky queue without a handoff is not loaded either when handoffs are enabled
+	s.matchingEngine.config.EnableStickyHandoff = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true)
+	_, _, err = s.matchingEngine.AddWorkflowTask(context.Background(), &addRequest)
+	s.ErrorAs(err, new(*serviceerrors.StickyWorkerUnavailable))
 	// check loaded queues
 	s.matchingEngine.partitionsLock.RLock()
 	defer s.matchingEngine.partitionsLock.RUnlock()
 	s.Equal(0, len(s.matchingEngine.partitions))
 }
 
+func (s *matchingEngineSuite) TestHandoffStickyTaskQueueValidation() {
+	sticky := func(name, normalName string) *taskqueuepb.TaskQueue {
+		return &taskqueuepb.TaskQueue{Name: name, Kind: enumspb.TASK_QUEUE_KIND_STICKY, NormalName: normalName}
+	}
+	handoff := func(from, to *taskqueuepb.TaskQueue) error {
+		_, err := s.matchingEngine.HandoffStickyTaskQueue(context.Background(), &matchingservice.HandoffStickyTaskQueueRequest{
+			NamespaceId:        uuid.New(),
+			TaskQueue:          from,
+			SuccessorTaskQueue: to,
+			Identity:           "worker",
+		})
+		return err
+	}
+
+	err := handoff(sticky("old", "tq"), sticky("new", "tq"))
+	s.ErrorAs(err, new(*serviceerror.PermissionDenied))
+
+	s.matchingEngine.config.EnableStickyHandoff = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true)
+	err = handoff(&taskqueuepb.TaskQueue{Name: "tq", Kind: enumspb.TASK_QUEUE_KIND_NORMAL}, sticky("new", "tq"))
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+	err = handoff(sticky("old", "tq"), sticky("new", "other-tq"))
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+	err = handoff(sticky("old", "tq"), sticky("old", "tq"))
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+	// nobody ever polled the old queue, so it has no owner that could hand it off
+	err = handoff(sticky("old", "tq"), sticky("new", "tq"))
+	s.ErrorAs(err, new(*serviceerror.PermissionDenied))
+}
+
+// recordStickyPoller makes the engine see a poll of a sticky queue by the given identity.
+func (s *matchingEngineSuite) recordStickyPoller(namespaceId string, taskQueue *taskqueuepb.TaskQueue, identity string) {
+	partition, err := tqid.PartitionFromProto(taskQueue, namespaceId, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	s.NoError(err)
+	pm, _, err := s.matchingEngine.getTaskQueuePartitionManager(context.Background(), partition, true, loadCausePoll)
+	s.NoError(err)
+	s.NoError(pm.WaitUntilInitialized(context.Background()))
+	pm.(*taskQueuePartitionManagerImpl).defaultQueue.UpdatePollerInfo(pollerIdentity(identity), &pollMetadata{})
+}
+
+func (s *matchingEngineSuite) TestAddWorkflowTaskRedirectedAfterStickyHandoff() {
+	s.matchingEngine.config.EnableStickyHandoff = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true)
+	namespaceId := uuid.New()
+	oldSticky := &taskqueuepb.TaskQueue{Name: "old-sticky", Kind: enumspb.TASK_QUEUE_KIND_STICKY, NormalName: "tq"}
+	newSticky := &taskqueuepb.TaskQueue{Name: "new-sticky", Kind: enumspb.TASK_QUEUE_KIND_STICKY, NormalName: "tq"}
+
+	s.recordStickyPoller(namespaceId, oldSticky, "worker")
+
+	// only the owner of the old sticky queue may hand it off
+	_, err := s.matchingEngine.HandoffStickyTaskQueue(context.Background(), &matchingservice.HandoffStickyTaskQueueRequest{
+		NamespaceId:        namespaceId,
+		TaskQueue:          oldSticky,
+		SuccessorTaskQueue: newSticky,
+		Identity:           "other-worker",
+	})
+	s.ErrorAs(err, new(*serviceerror.PermissionDenied))
+	_, err = s.matchingEngine.HandoffStickyTaskQueue(context.Background(), &matchingservice.HandoffStickyTaskQueueRequest{
+		NamespaceId:        namespaceId,
+		TaskQueue:          oldSticky,
+		SuccessorTaskQueue: newSticky,
+		Identity:           "worker",
+	})
+	s.NoError(err)
+
+	// the handoff is stored with the partition, so it survives unloading it
+	oldPartition, err := tqid.PartitionFromProto(oldSticky, namespaceId, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	s.NoError(err)
+	s.True(s.matchingEngine.unloadTaskQueuePartitionByKey(oldPartition, nil, unloadCauseForce))
+
+	addRequest := &matchingservice.AddWorkflowTaskRequest{
+		NamespaceId:            namespaceId,
+		Execution:              &commonpb.WorkflowExecution{RunId: uuid.New(), WorkflowId: "wf1"},
+		ScheduledEventId:       5,
+		TaskQueue:              oldSticky,
+		ScheduleToStartTimeout: timestamp.DurationFromSeconds(100),
+	}
+	s.mockMatchingClient.EXPECT().AddWorkflowTask(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *matchingservice.AddWorkflowTaskRequest, _ ...grpc.CallOption) (*matchingservice.AddWorkflowTaskResponse, error) {
+			s.Equal(newSticky.GetName(), req.GetTaskQueue().GetName())
+			s.Equal(enumspb.TASK_QUEUE_KIND_STICKY, req.GetTaskQueue().GetKind())
+			s.True(req.GetStickyHandoffRedirected())
+			s.Equal(addRequest.GetScheduledEventId(), req.GetScheduledEventId())
+			return &matchingservice.AddWorkflowTaskResponse{}, nil
+		})
+	_, _, err = s.matchingEngine.AddWorkflowTask(context.Background(), addRequest)
+	s.NoError(err)
+	// the original request is not modified
+	s.Equal(oldSticky.GetName(), addRequest.GetTaskQueue().GetName())
+	// the handoff is read from persistence without loading the old queue again
+	pm, _, err := s.matchingEngine.getTaskQueuePartitionManager(context.Background(), oldPartition, false, loadCauseTask)
+	s.NoError(err)
+	s.Nil(pm)
+
+	// redirected tasks are not redirected again
+	addRequest.StickyHandoffRedirected = true
+	_, _, err = s.matchingEngine.AddWorkflowTask(context.Background(), addRequest)
+	s.ErrorAs(err, new(*serviceerrors.StickyWorkerUnavailable))
+}
+
 func (s *matchingEngineSuite) TestQueryWorkflowDoesNotLoadSticky() {
 	query := matchingservice.QueryWorkflowRequest{
 		NamespaceId: uuid.New(),
@@ -995,6 +1099,69 @@ func (s *matchingEngineSuite) TestAddThenConsumeActivities() {
 	s.True(expectedRange <= s.taskManager.getQueueManagerByKey(tlID).rangeID)
 }
 
+func (s *matchingEngineSuite) TestPollActivityTaskQueues_Batched() {
+	s.matchingEngine.config.MaxActivityTasksPerPoll = dynamicconfig.GetIntPropertyFnFilteredByTaskQueue(3)
+	s.matchingEngine.config.ActivityPollBatchWaitDuration = dynamicconfig.GetDurationPropertyFnFilteredByTaskQueue(time.Second)
+
+	namespaceId := uuid.New()
+	tl := "batched"
+	tlID := newUnversionedRootQueueKey(namespaceId, tl, enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	taskQueue := &taskqueuepb.TaskQueue{Name: tl, Kind: enumspb.TASK_QUEUE_KIND_NORMAL}
+	workflowExecution := &commonpb.WorkflowExecution{WorkflowId: "workflowID", RunId: uuid.NewRandom().String()}
+
+	const taskCount = 5
+	for i := int64(1); i <= taskCount; i++ {
+		_, _, err := s.matchingEngine.AddActivityTask(context.Background(), &matchingservice.AddActivityTaskRequest{
+			NamespaceId:            namespaceId,
+			Execution:              workflowExecution,
+			ScheduledEventId:       i,
+			TaskQueue:              taskQueue,
+			ScheduleToStartTimeout: timestamp.DurationFromSeconds(100),
+		})
+		s.NoError(err)
+	}
+	s.EqualValues(taskCount, s.taskManager.getTaskCount(tlID))
+
+	s.mockHistoryClient.EXPECT().RecordActivityTaskStarted(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, taskRequest *historyservice.RecordActivityTaskStartedRequest, arg2 ...interface{}) (*historyservice.RecordActivityTaskStartedResponse, error) {
+			return &historyservice.RecordActivityTaskStartedResponse{
+				Attempt: 1,
+				ScheduledEvent: newActivityTaskScheduledEvent(taskRequest.ScheduledEventId, 0,
+					&commandpb.ScheduleActivityTaskCommandAttributes{
+						ActivityId:          fmt.Sprintf("activity-%d", taskRequest.ScheduledEventId),
+						TaskQueue:           taskQueue,
+						ActivityType:        &commonpb.ActivityType{Name: "activity"},
+						StartToCloseTimeout: durationpb.New(time.Duration(taskRequest.ScheduledEventId) * time.Second),
+					}),
+				StartedTime: timestamp.TimeNowPtrUtc(),
+			}, nil
+		}).Times(3)
+
+	pollReq := &matchingservice.PollActivityTaskQueueRequest{
+		NamespaceId: namespaceId,
+		PollRequest: &workflowservice.PollActivityTaskQueueRequest{
+			TaskQueue: taskQueue,
+			Identity:  "identity",
+		},
+		MaxTasks: 10,
+	}
+	resp, err := s.matchingEngine.PollActivityTaskQueue(context.Background(), pollReq, metrics.NoopMetricsHandler)
+	s.NoError(err)
+	s.NotEmpty(resp.TaskToken)
+	// capped by dynamic config
+	s.Len(resp.BatchedTasks, 2)
+
+	// every task in the batch is a distinct, independently started task with its own timeout
+	seen := map[string]bool{resp.ActivityId: true}
+	for _, batched := range resp.BatchedTasks {
+		s.NotEmpty(batched.TaskToken)
+		s.Empty(batched.BatchedTasks)
+		s.False(seen[batched.ActivityId])
+		seen[batched.ActivityId] = true
+		s.Equal(batched.ActivityId, fmt.Sprintf("activity-%d", int64(batched.StartToCloseTimeout.AsDuration()/time.Second)))
+	}
+}
+
 // TODO: this unit test does not seem to belong to matchingEngine, move it to the right place
 func (s *matchingEngineSuite) TestSyncMatchActivities() {
 	if s.newMatcher {
@@ -3444,6 +3611,8 @@ type testPhysicalTaskQueueManager struct {
 	updateCount             int
 	tasks                   *treemap.Map
 	userData                *persistencespb.VersionedTaskQueueUserData
+	stickyOwnerIdentity     string
+	stickyHandoff           *persistencespb.StickyHandoff
 }
 
 func (m *testPhysicalTaskQueueManager) RangeID() int64 {
@@ -3473,6 +3642,8 @@ func (m *testTaskManager) CreateTaskQueue(
 
 	tlm.rangeID = request.RangeID
 	tlm.ackLevel = tli.AckLevel
+	tlm.stickyOwnerIdentity = tli.StickyOwnerIdentity
+	tlm.stickyHandoff = tli.StickyHandoff
 	return &persistence.CreateTaskQueueResponse{}, nil
 }
 
@@ -3494,6 +3665,8 @@ func (m *testTaskManager) UpdateTaskQueue(
 	}
 	tlm.ackLevel = tli.AckLevel
 	tlm.ApproximateBacklogCount = tli.ApproximateBacklogCount
+	tlm.stickyOwnerIdentity = tli.StickyOwnerIdentity
+	tlm.stickyHandoff = tli.StickyHandoff
 	tlm.rangeID = request.RangeID
 	return &persistence.UpdateTaskQueueResponse{}, nil
 }
@@ -3519,6 +3692,8 @@ func (m *testTaskManager) GetTaskQueue(
 			ExpiryTime:              nil,
 			LastUpdateTime:          timestamp.TimeNowPtrUtc(),
 			ApproximateBacklogCount: tlm.ApproximateBacklogCount,
+			StickyOwnerIdentity:     tlm.stickyOwnerIdentity,
+			StickyHandoff:           tlm.stickyHandoff,
 		},
 		RangeID: tlm.rangeID,
 	}, nil
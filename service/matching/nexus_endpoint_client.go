@@ -178,6 +178,11 @@ func (m *nexusEndpointClient) UpdateNexusEndpoint(
 		return nil, serviceerror.NewFailedPrecondition(fmt.Sprintf("nexus endpoint version mismatch. received: %v expected %v", request.version, previous.Version))
 	}
 
+	if request.spec != nil && request.spec.Policy == nil {
+		// The operator API can't express endpoint policies, keep the one set through the admin API.
+		request.spec.Policy = previous.GetEndpoint().GetSpec().GetPolicy()
+	}
+
 	entry := &persistencespb.NexusEndpointEntry{
 		Version: previous.Version,
 		Id:      previous.Id,
@@ -0,0 +1,359 @@
+// The MIT License
+//
+// Copyright (c) 2025 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"math"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/internal/goro"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Task queue types whose partition counts are managed by adaptive partitioning. Nexus task queues are not partitioned
+// by load yet.
+var adaptivePartitionTaskTypes = []enumspb.TaskQueueType{
+	enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+	enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+}
+
+type (
+	// adaptivePartitionScaler periodically chooses the number of read and write partitions of each type of a task
+	// queue, based on the add and dispatch rates and backlog age reported by its partitions. It only runs in the
+	// partition that owns user data, and stores its decisions as cluster-local user data so that all partitions and,
+	// through them, matching clients pick them up.
+	//
+	// Scaling up is immediate. Scaling down happens one write partition at a time, at most once per scale-down delay.
+	// A partition that is no longer written to keeps being read from until its backlog is empty, and read partitions
+	// are never reduced below the highest partition that still has a backlog or receives tasks. Partitions that were
+	// removed from reads keep being checked up to the drain count, so that tasks written by clients with stale counts
+	// bring them back, until they have stayed empty for the scale-down delay.
+	adaptivePartitionScaler struct {
+		pm        *taskQueuePartitionManagerImpl
+		configs   map[enumspb.TaskQueueType]*taskQueueConfig
+		logger    log.Logger
+		goroGroup goro.Group
+	}
+
+	adaptivePartitionParams struct {
+		min               int
+		max               int
+		targetRate        float64
+		backlogAgeScaleUp time.Duration
+		scaleDownDelay    time.Duration
+	}
+
+	// partitionLoad is the load of a task queue type aggregated over its partitions.
+	partitionLoad struct {
+		addRate      float64
+		dispatchRate float64
+		backlogAge   time.Duration
+		// Index of the highest partition with a non-empty backlog or tasks being added, or -1 if there is none.
+		highestActive int
+	}
+)
+
+func newAdaptivePartitionScaler(pm *taskQueuePartitionManagerImpl) *adaptivePartitionScaler {
+	configs := make(map[enumspb.TaskQueueType]*taskQueueConfig, len(adaptivePartitionTaskTypes))
+	family := pm.partition.TaskQueue().Family()
+	for _, t := range adaptivePartitionTaskTypes {
+		if t == pm.partition.TaskType() {
+			configs[t] = pm.config
+		} else {
+			configs[t] = newTaskQueueConfig(family.TaskQueue(t), pm.engine.config, pm.ns.Name())
+		}
+	}
+	return &adaptivePartitionScaler{
+		pm:      pm,
+		configs: configs,
+		logger:  pm.logger,
+	}
+}
+
+func (s *adaptivePartitionScaler) Start() {
+	s.goroGroup.Go(s.run)
+}
+
+func (s *adaptivePartitionScaler) Stop() {
+	s.goroGroup.Cancel()
+	s.goroGroup.Wait()
+}
+
+func (s *adaptivePartitionScaler) run(ctx context.Context) error {
+	if err := s.pm.userDataManager.WaitUntilInitialized(ctx); err != nil {
+		return err
+	}
+	for {
+		timerC, timer := s.pm.engine.timeSource.NewTimer(s.pm.config.AdaptivePartitionsEvaluationInterval())
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-timerC:
+		}
+		for _, t := range adaptivePartitionTaskTypes {
+			if !s.configs[t].EnableAdaptivePartitions() {
+				continue
+			}
+			if err := s.evaluate(ctx, t); err != nil && ctx.Err() == nil {
+				s.logger.Warn("failed to evaluate adaptive partition counts",
+					tag.WorkflowTaskQueueType(t), tag.Error(err))
+			}
+		}
+	}
+}
+
+func (s *adaptivePartitionScaler) evaluate(ctx context.Context, taskType enumspb.TaskQueueType) error {
+	config := s.configs[taskType]
+	userData, _, err := s.pm.userDataManager.GetUserData()
+	if err != nil {
+		return err
+	}
+	current := adaptivePartitionsOrDefault(
+		userData.GetData().GetPerType()[int32(taskType)].GetAdaptivePartitions(),
+		config,
+	)
+
+	// Describe every partition that may have been written to, including the ones that are being drained and the ones
+	// that the static config would use, so that tasks written by clients with stale counts are never left behind.
+	numPartitions := max(
+		int(current.GetReadPartitions()),
+		int(current.GetDrainPartitions()),
+		config.NumReadPartitions(),
+		config.NumWritePartitions(),
+	)
+	load, err := s.collectLoad(ctx, taskType, numPartitions)
+	if err != nil {
+		return err
+	}
+
+	params := adaptivePartitionParams{
+		min:               config.AdaptivePartitionsMin(),
+		max:               config.AdaptivePartitionsMax(),
+		targetRate:        config.AdaptivePartitionsTargetRate(),
+		backlogAgeScaleUp: config.AdaptivePartitionsBacklogAgeScaleUp(),
+		scaleDownDelay:    config.AdaptivePartitionsScaleDownDelay(),
+	}
+	updated := decideAdaptivePartitions(current, load, params, s.pm.engine.timeSource.Now())
+
+	result := current
+	if updated != nil {
+		if err := s.store(ctx, taskType, updated); err != nil {
+			return err
+		}
+		s.logger.Info("adaptive partition counts changed",
+			tag.WorkflowTaskQueueType(taskType),
+			tag.NewInt32("old-read-partitions", current.GetReadPartitions()),
+			tag.NewInt32("old-write-partitions", current.GetWritePartitions()),
+			tag.NewInt32("read-partitions", updated.GetReadPartitions()),
+			tag.NewInt32("write-partitions", updated.GetWritePartitions()))
+		result = updated
+	}
+
+	metricsHandler := metrics.GetPerTaskQueueScope(
+		s.pm.engine.metricsHandler,
+		s.pm.ns.Name().String(),
+		s.pm.partition.TaskQueue().Family().TaskQueue(taskType),
+		config.BreakdownMetricsByTaskQueue(),
+		metrics.OperationTag(metrics.MatchingTaskQueuePartitionManagerScope),
+	)
+	metrics.AdaptiveWritePartitionsGauge.With(metricsHandler).Record(float64(result.GetWritePartitions()))
+	metrics.AdaptiveReadPartitionsGauge.With(metricsHandler).Record(float64(result.GetReadPartitions()))
+	return nil
+}
+
+func (s *adaptivePartitionScaler) collectLoad(
+	ctx context.Context,
+	taskType enumspb.TaskQueueType,
+	numPartitions int,
+) (partitionLoad, error) {
+	load := partitionLoad{highestActive: -1}
+	for i := 0; i < numPartitions; i++ {
+		resp, err := s.pm.matchingClient.DescribeTaskQueuePartition(ctx, &matchingservice.DescribeTaskQueuePartitionRequest{
+			NamespaceId: s.pm.partition.NamespaceId(),
+			TaskQueuePartition: &taskqueuespb.TaskQueuePartition{
+				TaskQueue:     s.pm.partition.TaskQueue().Name(),
+				TaskQueueType: taskType,
+				PartitionId:   &taskqueuespb.TaskQueuePartition_NormalPartitionId{NormalPartitionId: int32(i)},
+			},
+			Versions:    &taskqueuepb.TaskQueueVersionSelection{Unversioned: true, AllActive: true},
+			ReportStats: true,
+		})
+		if err != nil {
+			return load, err
+		}
+		for _, vii := range resp.GetVersionsInfoInternal() {
+			stats := vii.GetPhysicalTaskQueueInfo().GetTaskQueueStats()
+			load.addRate += float64(stats.GetTasksAddRate())
+			load.dispatchRate += float64(stats.GetTasksDispatchRate())
+			load.backlogAge = max(load.backlogAge, stats.GetApproximateBacklogAge().AsDuration())
+			if stats.GetApproximateBacklogCount() > 0 || stats.GetTasksAddRate() > 0 {
+				load.highestActive = i
+			}
+		}
+	}
+	return load, nil
+}
+
+func (s *adaptivePartitionScaler) store(
+	ctx context.Context,
+	taskType enumspb.TaskQueueType,
+	updated *persistencespb.AdaptivePartitions,
+) error {
+	_, err := s.pm.userDataManager.UpdateUserData(
+		ctx,
+		UserDataUpdateOptions{Source: "AdaptivePartitionScaler"},
+		func(data *persistencespb.TaskQueueUserData) (*persistencespb.TaskQueueUserData, bool, error) {
+			data = common.CloneProto(data)
+			if data.PerType == nil {
+				data.PerType = make(map[int32]*persistencespb.TaskQueueTypeUserData)
+			}
+			if data.PerType[int32(taskType)] == nil {
+				data.PerType[int32(taskType)] = &persistencespb.TaskQueueTypeUserData{}
+			}
+			data.PerType[int32(taskType)].AdaptivePartitions = updated
+			// Partition counts are local to this cluster and must not be replicated.
+			return data, false, nil
+		},
+	)
+	return err
+}
+
+// adaptivePartitionsOrDefault returns the stored partition counts, or the statically configured ones if adaptive
+// partitioning has not made a decision for this task queue type yet.
+func adaptivePartitionsOrDefault(
+	stored *persistencespb.AdaptivePartitions,
+	config *taskQueueConfig,
+) *persistencespb.AdaptivePartitions {
+	if stored.GetWritePartitions() > 0 && stored.GetReadPartitions() > 0 {
+		return stored
+	}
+	write := config.NumWritePartitions()
+	return &persistencespb.AdaptivePartitions{
+		WritePartitions: int32(write),
+		ReadPartitions:  int32(max(write, config.NumReadPartitions())),
+	}
+}
+
+// decideAdaptivePartitions returns the new partition counts for the given load, or nil if the current ones should be
+// kept.
+func decideAdaptivePartitions(
+	current *persistencespb.AdaptivePartitions,
+	load partitionLoad,
+	params adaptivePartitionParams,
+	now time.Time,
+) *persistencespb.AdaptivePartitions {
+	write := int(current.GetWritePartitions())
+	read := int(current.GetReadPartitions())
+	canScaleDown := current.GetUpdateTime() == nil || now.Sub(current.GetUpdateTime().AsTime()) >= params.scaleDownDelay
+
+	target := 1
+	if params.targetRate > 0 {
+		target = int(math.Ceil(max(load.addRate, load.dispatchRate) / params.targetRate))
+	}
+	if params.backlogAgeScaleUp > 0 && load.backlogAge > params.backlogAgeScaleUp {
+		target = max(target, write+1)
+	}
+	target = max(1, min(max(target, params.min), params.max))
+
+	newWrite := write
+	if target > write {
+		newWrite = target
+	} else if target < write && canScaleDown {
+		newWrite = write - 1
+	}
+
+	// Keep reading from every partition that was written to until its backlog has drained. Reads only shrink after
+	// the writes have been stable for the scale-down delay, so that in-flight adds have landed.
+	newRead := max(newWrite, load.highestActive+1)
+	if newRead < read && (newWrite != write || !canScaleDown) {
+		newRead = read
+	}
+
+	// Partitions removed from reads may still be written to by clients that have not seen the new counts yet. Keep
+	// checking them until they have stayed empty for the scale-down delay after the last change.
+	drain := max(int(current.GetDrainPartitions()), read)
+	newDrain := drain
+	if newRead == read && newWrite == write && canScaleDown {
+		newDrain = newRead
+	}
+	if newDrain <= newRead {
+		newDrain = 0
+	}
+	if drain <= read {
+		drain = 0
+	}
+
+	if newWrite == write && newRead == read && newDrain == drain {
+		return nil
+	}
+	return &persistencespb.AdaptivePartitions{
+		WritePartitions: int32(newWrite),
+		ReadPartitions:  int32(newRead),
+		DrainPartitions: int32(newDrain),
+		UpdateTime:      timestamppb.New(now),
+	}
+}
+
+// adaptivePartitionCounts returns the partition counts for the given task queue type to hint to matching clients, or
+// nil if adaptive partitioning is disabled or has not made a decision yet.
+func adaptivePartitionCounts(
+	userData *persistencespb.TaskQueueUserData,
+	taskType enumspb.TaskQueueType,
+	config *taskQueueConfig,
+) *taskqueuespb.PartitionCounts {
+	if !config.EnableAdaptivePartitions() {
+		return nil
+	}
+	stored := userData.GetPerType()[int32(taskType)].GetAdaptivePartitions()
+	if stored.GetWritePartitions() == 0 || stored.GetReadPartitions() == 0 {
+		return nil
+	}
+	return &taskqueuespb.PartitionCounts{
+		ReadPartitions:  stored.GetReadPartitions(),
+		WritePartitions: stored.GetWritePartitions(),
+	}
+}
+
+// numPartitionsForFanOut returns the number of partitions of the given task queue type that should be reached when
+// fanning out to all of them.
+func numPartitionsForFanOut(
+	userData *persistencespb.TaskQueueUserData,
+	taskType enumspb.TaskQueueType,
+	config *taskQueueConfig,
+) int {
+	if counts := adaptivePartitionCounts(userData, taskType, config); counts != nil {
+		return int(counts.GetReadPartitions())
+	}
+	return max(config.NumWritePartitions(), config.NumReadPartitions())
+}
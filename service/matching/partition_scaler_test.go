@@ -0,0 +1,194 @@
+// The MIT License
+//
+// Copyright (c) 2025 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestDecideAdaptivePartitions(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	params := adaptivePartitionParams{
+		min:               1,
+		max:               8,
+		targetRate:        100,
+		backlogAgeScaleUp: time.Minute,
+		scaleDownDelay:    10 * time.Minute,
+	}
+	counts := func(read, write int32, updated time.Duration) *persistencespb.AdaptivePartitions {
+		return &persistencespb.AdaptivePartitions{
+			ReadPartitions:  read,
+			WritePartitions: write,
+			UpdateTime:      timestamppb.New(now.Add(-updated)),
+		}
+	}
+	draining := func(read, write, drain int32, updated time.Duration) *persistencespb.AdaptivePartitions {
+		c := counts(read, write, updated)
+		c.DrainPartitions = drain
+		return c
+	}
+	idle := partitionLoad{highestActive: -1}
+
+	testCases := []struct {
+		name          string
+		current       *persistencespb.AdaptivePartitions
+		load          partitionLoad
+		expectedRead  int32
+		expectedWrite int32
+		expectedDrain int32
+		unchanged     bool
+	}{
+		{
+			name:          "scale up to target rate",
+			current:       counts(2, 2, 0),
+			load:          partitionLoad{addRate: 450, dispatchRate: 300, highestActive: -1},
+			expectedRead:  5,
+			expectedWrite: 5,
+		},
+		{
+			name:          "scale up capped at max",
+			current:       counts(2, 2, 0),
+			load:          partitionLoad{addRate: 5000, highestActive: -1},
+			expectedRead:  8,
+			expectedWrite: 8,
+		},
+		{
+			name:          "old backlog adds a partition",
+			current:       counts(2, 2, 0),
+			load:          partitionLoad{addRate: 10, backlogAge: 2 * time.Minute, highestActive: 1},
+			expectedRead:  3,
+			expectedWrite: 3,
+		},
+		{
+			name:      "no scale down before delay",
+			current:   counts(4, 4, time.Minute),
+			load:      idle,
+			unchanged: true,
+		},
+		{
+			name:          "scale down one write partition after delay",
+			current:       counts(4, 4, 11*time.Minute),
+			load:          idle,
+			expectedRead:  4,
+			expectedWrite: 3,
+		},
+		{
+			name:      "keep reading from partition with backlog",
+			current:   counts(4, 3, 11*time.Minute),
+			load:      partitionLoad{addRate: 250, highestActive: 3},
+			unchanged: true,
+		},
+		{
+			name:          "drain read partitions once backlog is empty",
+			current:       counts(4, 3, 11*time.Minute),
+			load:          partitionLoad{addRate: 250, highestActive: 1},
+			expectedRead:  3,
+			expectedWrite: 3,
+			expectedDrain: 4,
+		},
+		{
+			name:      "keep checking drained partitions before delay",
+			current:   draining(3, 3, 4, time.Minute),
+			load:      partitionLoad{addRate: 250, highestActive: 1},
+			unchanged: true,
+		},
+		{
+			name:          "stop checking drained partitions once they stayed empty",
+			current:       draining(3, 3, 4, 11*time.Minute),
+			load:          partitionLoad{addRate: 250, highestActive: 1},
+			expectedRead:  3,
+			expectedWrite: 3,
+		},
+		{
+			name:          "tasks written to a drained partition bring it back",
+			current:       draining(3, 3, 4, time.Minute),
+			load:          partitionLoad{addRate: 250, highestActive: 3},
+			expectedRead:  4,
+			expectedWrite: 3,
+		},
+		{
+			name:      "no drain before delay",
+			current:   counts(4, 3, time.Minute),
+			load:      partitionLoad{addRate: 250, highestActive: 1},
+			unchanged: true,
+		},
+		{
+			name:          "backlog beyond read partitions is picked up immediately",
+			current:       counts(2, 2, 0),
+			load:          partitionLoad{addRate: 150, highestActive: 5},
+			expectedRead:  6,
+			expectedWrite: 2,
+		},
+		{
+			name:      "min is respected",
+			current:   counts(1, 1, 0),
+			load:      idle,
+			unchanged: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			updated := decideAdaptivePartitions(tc.current, tc.load, params, now)
+			if tc.unchanged {
+				require.Nil(t, updated)
+				return
+			}
+			require.NotNil(t, updated)
+			require.Equal(t, tc.expectedRead, updated.GetReadPartitions())
+			require.Equal(t, tc.expectedWrite, updated.GetWritePartitions())
+			require.Equal(t, tc.expectedDrain, updated.GetDrainPartitions())
+			require.True(t, now.Equal(updated.GetUpdateTime().AsTime()))
+		})
+	}
+}
+
+func TestPreserveAdaptivePartitions(t *testing.T) {
+	t.Parallel()
+
+	local := map[int32]*persistencespb.TaskQueueTypeUserData{
+		1: {AdaptivePartitions: &persistencespb.AdaptivePartitions{ReadPartitions: 3, WritePartitions: 2}},
+		2: {AdaptivePartitions: &persistencespb.AdaptivePartitions{ReadPartitions: 5, WritePartitions: 5}},
+	}
+	remote := map[int32]*persistencespb.TaskQueueTypeUserData{
+		1: {
+			DeploymentData:     &persistencespb.DeploymentData{},
+			AdaptivePartitions: &persistencespb.AdaptivePartitions{ReadPartitions: 8, WritePartitions: 8},
+		},
+	}
+
+	merged := preserveAdaptivePartitions(remote, local)
+	require.Len(t, merged, 2)
+	require.NotNil(t, merged[1].GetDeploymentData())
+	require.Equal(t, int32(3), merged[1].GetAdaptivePartitions().GetReadPartitions())
+	require.Equal(t, int32(5), merged[2].GetAdaptivePartitions().GetReadPartitions())
+	// the input is not mutated
+	require.Equal(t, int32(8), remote[1].GetAdaptivePartitions().GetReadPartitions())
+}
@@ -38,11 +38,11 @@ import (
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common"
-	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/debug"
 	"go.temporal.io/server/common/log"
@@ -173,15 +173,15 @@ func newPhysicalTaskQueueManager(
 		logger:                     logger,
 		throttledLogger:            throttledLogger,
 		metricsHandler:             taggedMetricsHandler,
-		tasksAddedInIntervals:      newTaskTracker(clock.NewRealTimeSource()),
-		tasksDispatchedInIntervals: newTaskTracker(clock.NewRealTimeSource()),
+		tasksAddedInIntervals:      newTaskTracker(e.timeSource),
+		tasksDispatchedInIntervals: newTaskTracker(e.timeSource),
 		pollerScalingRateLimiter:   quotas.NewDefaultOutgoingRateLimiter(pollerScalingRateLimitFn),
 	}
 
-	pqMgr.pollerHistory = newPollerHistory(partitionMgr.config.PollerHistoryTTL())
+	pqMgr.pollerHistory = newPollerHistory(partitionMgr.config.PollerHistoryTTL(), e.timeSource)
 
 	pqMgr.liveness = newLiveness(
-		clock.NewRealTimeSource(),
+		e.timeSource,
 		config.MaxTaskQueueIdleTime,
 		func() { pqMgr.UnloadFromPartitionManager(unloadCauseIdle) },
 	)
@@ -191,6 +191,7 @@ func newPhysicalTaskQueueManager(
 		pqMgr.clusterMeta,
 		pqMgr.namespaceRegistry,
 		pqMgr.partitionMgr.engine.historyClient,
+		e.timeSource,
 	)
 
 	newMatcher, cancelSub := config.NewMatcher(func(bool) {
@@ -209,12 +210,13 @@ func newPhysicalTaskQueueManager(
 			throttledLogger,
 			e.matchingRawClient,
 			newPriMetricsHandler(taggedMetricsHandler),
+			e.timeSource,
 		)
 		var fwdr *priForwarder
 		var err error
 		if !queue.Partition().IsRoot() && queue.Partition().Kind() != enumspb.TASK_QUEUE_KIND_STICKY {
 			// Every DB Queue needs its own forwarder so that the throttles do not interfere
-			fwdr, err = newPriForwarder(&config.forwarderConfig, queue, e.matchingRawClient)
+			fwdr, err = newPriForwarder(&config.forwarderConfig, queue, e.matchingRawClient, e.timeSource)
 			if err != nil {
 				return nil, err
 			}
@@ -227,6 +229,7 @@ func newPhysicalTaskQueueManager(
 			pqMgr.taskValidator,
 			logger,
 			newPriMetricsHandler(taggedMetricsHandler),
+			e.timeSource,
 		)
 		pqMgr.matcher = pqMgr.priMatcher
 	} else {
@@ -239,17 +242,18 @@ func newPhysicalTaskQueueManager(
 			throttledLogger,
 			e.matchingRawClient,
 			taggedMetricsHandler,
+			e.timeSource,
 		)
 		var fwdr *Forwarder
 		var err error
 		if !queue.Partition().IsRoot() && queue.Partition().Kind() != enumspb.TASK_QUEUE_KIND_STICKY {
 			// Every DB Queue needs its own forwarder so that the throttles do not interfere
-			fwdr, err = newForwarder(&config.forwarderConfig, queue, e.matchingRawClient)
+			fwdr, err = newForwarder(&config.forwarderConfig, queue, e.matchingRawClient, e.timeSource)
 			if err != nil {
 				return nil, err
 			}
 		}
-		pqMgr.oldMatcher = newTaskMatcher(config, fwdr, taggedMetricsHandler)
+		pqMgr.oldMatcher = newTaskMatcher(config, fwdr, taggedMetricsHandler, e.timeSource)
 		pqMgr.matcher = pqMgr.oldMatcher
 	}
 	return pqMgr, nil
@@ -358,7 +362,7 @@ func (c *physicalTaskQueueManagerImpl) PollTask(
 		// there. In that case, go back for another task.
 		// If we didn't do this, the task would be rejected when we call RecordXTaskStarted on
 		// history, but this is more efficient.
-		if task.event != nil && IsTaskExpired(task.event.AllocatedTaskInfo) {
+		if task.event != nil && IsTaskExpired(task.event.AllocatedTaskInfo, c.partitionMgr.engine.timeSource.Now()) {
 			c.metricsHandler.Counter(metrics.ExpiredTasksPerTaskQueueCounter.Name()).Record(1)
 			task.finish(nil, false)
 			continue
@@ -417,6 +421,14 @@ func (c *physicalTaskQueueManagerImpl) AddSpooledTaskToMatcher(task *internalTas
 	c.priMatcher.AddTask(task)
 }
 
+func (c *physicalTaskQueueManagerImpl) DeadLetterTask(
+	taskInfo *persistencespb.TaskInfo,
+	reason enumsspb.DeadLetterReason,
+	cause error,
+) {
+	c.partitionMgr.engine.deadLetterQueue.add(c.tqCtx, c.queue.Partition().TaskQueue(), taskInfo, reason, cause)
+}
+
 func (c *physicalTaskQueueManagerImpl) UserDataChanged() {
 	c.matcher.ReprocessAllTasks()
 }
@@ -462,6 +474,21 @@ func (c *physicalTaskQueueManagerImpl) DispatchNexusTask(
 
 func (c *physicalTaskQueueManagerImpl) UpdatePollerInfo(id pollerIdentity, pollMetadata *pollMetadata) {
 	c.pollerHistory.updatePollerInfo(id, pollMetadata)
+	if c.queue.Partition().Kind() == enumspb.TASK_QUEUE_KIND_STICKY {
+		c.backlogMgr.getDB().RecordStickyOwner(string(id))
+	}
+}
+
+func (c *physicalTaskQueueManagerImpl) StickyOwnerIdentity() string {
+	return c.backlogMgr.getDB().StickyOwnerIdentity()
+}
+
+func (c *physicalTaskQueueManagerImpl) StickyHandoff() *persistencespb.StickyHandoff {
+	return c.backlogMgr.getDB().StickyHandoff()
+}
+
+func (c *physicalTaskQueueManagerImpl) SetStickyHandoff(ctx context.Context, handoff *persistencespb.StickyHandoff) error {
+	return c.backlogMgr.getDB().SetStickyHandoff(ctx, handoff)
 }
 
 // GetAllPollerInfo returns all pollers that polled from this taskqueue in last few minutes
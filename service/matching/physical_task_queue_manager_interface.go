@@ -31,6 +31,7 @@ import (
 	"time"
 
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
@@ -59,6 +60,9 @@ type (
 		DispatchSpooledTask(ctx context.Context, task *internalTask, userDataChanged <-chan struct{}) error
 		AddSpooledTask(task *internalTask) error
 		AddSpooledTaskToMatcher(task *internalTask)
+		// DeadLetterTask records a backlog task that is dropped without being dispatched in the
+		// dead-letter queue of its namespace, if enabled.
+		DeadLetterTask(taskInfo *persistencespb.TaskInfo, reason enumsspb.DeadLetterReason, cause error)
 		UserDataChanged()
 		// DispatchQueryTask will dispatch query to local or remote poller. If forwarded then result or error is returned,
 		// if dispatched to local poller then nil and nil is returned.
@@ -66,7 +70,14 @@ type (
 		// DispatchNexusTask dispatches a nexus task to a local or remote poller. If forwarded then result or
 		// error is returned, if dispatched to local poller then nil and nil is returned.
 		DispatchNexusTask(ctx context.Context, taskId string, request *matchingservice.DispatchNexusTaskRequest) (*matchingservice.DispatchNexusTaskResponse, error)
+		// UpdatePollerInfo records a poll. On sticky queues the first poller is recorded as the owner of the queue.
 		UpdatePollerInfo(pollerIdentity, *pollMetadata)
+		// StickyOwnerIdentity returns the identity of the worker that first polled this sticky queue.
+		StickyOwnerIdentity() string
+		// StickyHandoff returns the persisted handoff of this sticky queue, if any.
+		StickyHandoff() *persistencespb.StickyHandoff
+		// SetStickyHandoff persists the handoff of this sticky queue to its successor.
+		SetStickyHandoff(ctx context.Context, handoff *persistencespb.StickyHandoff) error
 		GetAllPollerInfo() []*taskqueuepb.PollerInfo
 		HasPollerAfter(accessTime time.Time) bool
 		// LegacyDescribeTaskQueue returns pollers info and legacy TaskQueueStatus for this physical queue
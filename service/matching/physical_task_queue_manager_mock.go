@@ -39,6 +39,7 @@ import (
 	time "time"
 
 	taskqueue "go.temporal.io/api/taskqueue/v1"
+	enums "go.temporal.io/server/api/enums/v1"
 	matchingservice "go.temporal.io/server/api/matchingservice/v1"
 	persistence "go.temporal.io/server/api/persistence/v1"
 	taskqueue0 "go.temporal.io/server/api/taskqueue/v1"
@@ -94,6 +95,18 @@ func (mr *MockphysicalTaskQueueManagerMockRecorder) AddSpooledTaskToMatcher(task
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSpooledTaskToMatcher", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).AddSpooledTaskToMatcher), task)
 }
 
+// DeadLetterTask mocks base method.
+func (m *MockphysicalTaskQueueManager) DeadLetterTask(taskInfo *persistence.TaskInfo, reason enums.DeadLetterReason, cause error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeadLetterTask", taskInfo, reason, cause)
+}
+
+// DeadLetterTask indicates an expected call of DeadLetterTask.
+func (mr *MockphysicalTaskQueueManagerMockRecorder) DeadLetterTask(taskInfo, reason, cause any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeadLetterTask", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).DeadLetterTask), taskInfo, reason, cause)
+}
+
 // DispatchNexusTask mocks base method.
 func (m *MockphysicalTaskQueueManager) DispatchNexusTask(ctx context.Context, taskId string, request *matchingservice.DispatchNexusTaskRequest) (*matchingservice.DispatchNexusTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -277,6 +290,20 @@ func (mr *MockphysicalTaskQueueManagerMockRecorder) QueueKey() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueueKey", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).QueueKey))
 }
 
+// SetStickyHandoff mocks base method.
+func (m *MockphysicalTaskQueueManager) SetStickyHandoff(ctx context.Context, handoff *persistence.StickyHandoff) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStickyHandoff", ctx, handoff)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetStickyHandoff indicates an expected call of SetStickyHandoff.
+func (mr *MockphysicalTaskQueueManagerMockRecorder) SetStickyHandoff(ctx, handoff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStickyHandoff", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).SetStickyHandoff), ctx, handoff)
+}
+
 // SpoolTask mocks base method.
 func (m *MockphysicalTaskQueueManager) SpoolTask(taskInfo *persistence.TaskInfo) error {
 	m.ctrl.T.Helper()
@@ -303,6 +330,34 @@ func (mr *MockphysicalTaskQueueManagerMockRecorder) Start() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).Start))
 }
 
+// StickyHandoff mocks base method.
+func (m *MockphysicalTaskQueueManager) StickyHandoff() *persistence.StickyHandoff {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StickyHandoff")
+	ret0, _ := ret[0].(*persistence.StickyHandoff)
+	return ret0
+}
+
+// StickyHandoff indicates an expected call of StickyHandoff.
+func (mr *MockphysicalTaskQueueManagerMockRecorder) StickyHandoff() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StickyHandoff", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).StickyHandoff))
+}
+
+// StickyOwnerIdentity mocks base method.
+func (m *MockphysicalTaskQueueManager) StickyOwnerIdentity() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StickyOwnerIdentity")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// StickyOwnerIdentity indicates an expected call of StickyOwnerIdentity.
+func (mr *MockphysicalTaskQueueManagerMockRecorder) StickyOwnerIdentity() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StickyOwnerIdentity", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).StickyOwnerIdentity))
+}
+
 // Stop mocks base method.
 func (m *MockphysicalTaskQueueManager) Stop(arg0 unloadCause) {
 	m.ctrl.T.Helper()
@@ -38,6 +38,7 @@ import (
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/future"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -81,6 +82,7 @@ type (
 		throttledLogger  log.ThrottledLogger
 		matchingClient   matchingservice.MatchingServiceClient
 		metricsHandler   metrics.Handler
+		timeSource       clock.TimeSource
 		initializedError *future.FutureImpl[struct{}]
 		// skipFinalUpdate controls behavior on Stop: if it's false, we try to write one final
 		// update before unloading
@@ -99,6 +101,7 @@ func newPriBacklogManager(
 	throttledLogger log.ThrottledLogger,
 	matchingClient matchingservice.MatchingServiceClient,
 	metricsHandler metrics.Handler,
+	timeSource clock.TimeSource,
 ) *priBacklogManagerImpl {
 	bmg := &priBacklogManagerImpl{
 		pqMgr:               pqMgr,
@@ -107,11 +110,12 @@ func newPriBacklogManager(
 		subqueuesByPriority: make(map[int32]int),
 		matchingClient:      matchingClient,
 		metricsHandler:      metricsHandler,
+		timeSource:          timeSource,
 		logger:              logger,
 		throttledLogger:     throttledLogger,
 		initializedError:    future.NewFuture[struct{}](),
 	}
-	bmg.db = newTaskQueueDB(config, taskManager, pqMgr.QueueKey(), logger, metricsHandler)
+	bmg.db = newTaskQueueDB(config, taskManager, pqMgr.QueueKey(), logger, metricsHandler, timeSource)
 	bmg.taskWriter = newPriTaskWriter(bmg)
 	return bmg
 }
@@ -232,10 +236,12 @@ func (c *priBacklogManagerImpl) getSubqueueForPriority(priority int32) int {
 
 func (c *priBacklogManagerImpl) periodicSync() {
 	for {
+		syncC, syncTimer := c.timeSource.NewTimer(c.config.UpdateAckInterval())
 		select {
 		case <-c.tqCtx.Done():
+			syncTimer.Stop()
 			return
-		case <-time.After(c.config.UpdateAckInterval()):
+		case <-syncC:
 			ctx, cancel := context.WithTimeout(c.tqCtx, ioTimeout)
 			err := c.db.SyncState(ctx)
 			cancel()
@@ -287,7 +293,7 @@ func (c *priBacklogManagerImpl) BacklogHeadAge() time.Duration {
 		// be more appropriate in the future.
 		return time.Duration(0)
 	}
-	return time.Since(oldestTime)
+	return c.timeSource.Since(oldestTime)
 }
 
 func (c *priBacklogManagerImpl) BacklogStatus() *taskqueuepb.TaskQueueStatus {
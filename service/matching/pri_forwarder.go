@@ -35,6 +35,7 @@ import (
 	"go.temporal.io/server/api/matchingservice/v1"
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/tqid"
 	"google.golang.org/protobuf/types/known/durationpb"
 )
@@ -43,10 +44,11 @@ type (
 	// priForwarder is the type that contains state pertaining to
 	// the api call forwarder component
 	priForwarder struct {
-		cfg       *forwarderConfig
-		queue     *PhysicalTaskQueueKey
-		partition *tqid.NormalPartition
-		client    matchingservice.MatchingServiceClient
+		cfg        *forwarderConfig
+		queue      *PhysicalTaskQueueKey
+		partition  *tqid.NormalPartition
+		client     matchingservice.MatchingServiceClient
+		timeSource clock.TimeSource
 	}
 )
 
@@ -64,16 +66,18 @@ func newPriForwarder(
 	cfg *forwarderConfig,
 	queue *PhysicalTaskQueueKey,
 	client matchingservice.MatchingServiceClient,
+	timeSource clock.TimeSource,
 ) (*priForwarder, error) {
 	partition, ok := queue.Partition().(*tqid.NormalPartition)
 	if !ok {
 		return nil, serviceerror.NewInvalidArgument("physical queue of normal partition expected")
 	}
 	return &priForwarder{
-		cfg:       cfg,
-		client:    client,
-		partition: partition,
-		queue:     queue,
+		cfg:        cfg,
+		client:     client,
+		partition:  partition,
+		queue:      queue,
+		timeSource: timeSource,
 	}, nil
 }
 
@@ -89,7 +93,7 @@ func (f *priForwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 	var expirationTime time.Time
 	if task.event.Data.ExpiryTime != nil {
 		expirationTime = task.event.Data.ExpiryTime.AsTime()
-		remaining := time.Until(expirationTime)
+		remaining := expirationTime.Sub(f.timeSource.Now())
 		if remaining <= 0 {
 			return nil
 		}
@@ -250,6 +254,7 @@ func (f *priForwarder) ForwardPoll(ctx context.Context, pollMetadata *pollMetada
 				DeploymentOptions:         pollMetadata.deploymentOptions,
 			},
 			ForwardedSource: f.partition.RpcName(),
+			MaxTasks:        pollMetadata.maxTasks,
 		})
 		if err != nil {
 			return nil, err
@@ -62,6 +62,7 @@ type priTaskMatcher struct {
 	validator      taskValidator
 	metricsHandler metrics.Handler // namespace metric scope
 	logger         log.Logger
+	timeSource     clock.TimeSource
 	numPartitions  func() int // number of task queue partitions
 
 	limiterLock sync.Mutex
@@ -121,13 +122,15 @@ func newPriTaskMatcher(
 	validator taskValidator,
 	logger log.Logger,
 	metricsHandler metrics.Handler,
+	timeSource clock.TimeSource,
 ) *priTaskMatcher {
 	tm := &priTaskMatcher{
 		config:         config,
-		data:           newMatcherData(config, logger, clock.NewRealTimeSource(), fwdr != nil),
+		data:           newMatcherData(config, logger, timeSource, fwdr != nil),
 		tqCtx:          tqCtx,
 		logger:         logger,
 		metricsHandler: metricsHandler,
+		timeSource:     timeSource,
 		partition:      partition,
 		fwdr:           fwdr,
 		validator:      validator,
@@ -146,7 +149,7 @@ func (tm *priTaskMatcher) Start() {
 	policy := backoff.NewExponentialRetryPolicy(time.Second).
 		WithMaximumInterval(tm.config.BacklogTaskForwardTimeout()).
 		WithExpirationInterval(backoff.NoInterval)
-	retrier := backoff.NewRetrier(policy, clock.NewRealTimeSource())
+	retrier := backoff.NewRetrier(policy, tm.timeSource)
 	lim := quotas.NewDefaultOutgoingRateLimiter(tm.config.ForwarderMaxRatePerSecond)
 
 	if tm.fwdr == nil {
@@ -465,7 +468,7 @@ func (tm *priTaskMatcher) emitDispatchLatency(task *internalTask, forwarded bool
 	}
 
 	metrics.TaskDispatchLatencyPerTaskQueue.With(tm.metricsHandler).Record(
-		time.Since(timestamp.TimeValue(task.event.Data.CreateTime)),
+		tm.timeSource.Since(timestamp.TimeValue(task.event.Data.CreateTime)),
 		metrics.StringTag("source", task.source.String()),
 		metrics.StringTag("forwarded", strconv.FormatBool(forwarded)),
 	)
@@ -547,7 +550,7 @@ func (tm *priTaskMatcher) Rate() float64 {
 func (tm *priTaskMatcher) poll(
 	ctx context.Context, pollMetadata *pollMetadata, queryOnly bool,
 ) (*internalTask, error) {
-	start := time.Now()
+	start := tm.timeSource.Now()
 	pollWasForwarded := false
 
 	defer func() {
@@ -555,7 +558,7 @@ func (tm *priTaskMatcher) poll(
 		if pollMetadata.forwardedFrom == "" {
 			// Only recording for original polls
 			metrics.PollLatencyPerTaskQueue.With(tm.metricsHandler).Record(
-				time.Since(start), metrics.StringTag("forwarded", strconv.FormatBool(pollWasForwarded)))
+				tm.timeSource.Since(start), metrics.StringTag("forwarded", strconv.FormatBool(pollWasForwarded)))
 		}
 	}()
 
@@ -34,6 +34,7 @@ import (
 	"github.com/emirpasic/gods/maps/treemap"
 	godsutils "github.com/emirpasic/gods/utils"
 	"go.temporal.io/api/serviceerror"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
@@ -64,7 +65,7 @@ type (
 
 		lock sync.Mutex
 
-		backoffTimer *time.Timer
+		backoffTimer clock.Timer
 		retrier      backoff.Retrier
 
 		backlogAge backlogAgeTracker
@@ -99,7 +100,7 @@ func newPriTaskReader(
 		logger:     backlogMgr.logger,
 		retrier: backoff.NewRetrier(
 			common.CreateReadTaskRetryPolicy(),
-			clock.NewRealTimeSource(),
+			backlogMgr.timeSource,
 		),
 		backlogAge: newBacklogAgeTracker(),
 		addRetries: semaphore.NewWeighted(concurrentAddRetries),
@@ -268,11 +269,13 @@ func (tr *priTaskReader) getTaskBatch(ctx context.Context) (getTasksBatchRespons
 func (tr *priTaskReader) processTaskBatch(tasks []*persistencespb.AllocatedTaskInfo) {
 	tr.lock.Lock()
 
+	var expired []*persistencespb.AllocatedTaskInfo
 	tasks = slices.DeleteFunc(tasks, func(t *persistencespb.AllocatedTaskInfo) bool {
 		tr.readLevel = max(tr.readLevel, t.TaskId)
 
-		if IsTaskExpired(t) {
+		if IsTaskExpired(t, tr.backlogMgr.timeSource.Now()) {
 			metrics.ExpiredTasksPerTaskQueueCounter.With(tr.backlogMgr.metricsHandler).Record(1)
+			expired = append(expired, t)
 			return true
 		}
 
@@ -287,6 +290,11 @@ func (tr *priTaskReader) processTaskBatch(tasks []*persistencespb.AllocatedTaskI
 
 	tr.lock.Unlock()
 
+	// Dead-lettering may have to wait for a write, so it is done without holding the lock.
+	for _, t := range expired {
+		tr.backlogMgr.pqMgr.DeadLetterTask(t.Data, enumsspb.DEAD_LETTER_REASON_EXPIRED, nil)
+	}
+
 	tr.addNewTasks(tasks)
 }
 
@@ -320,7 +328,7 @@ func (tr *priTaskReader) addTaskToMatcher(task *internalTask) {
 	}
 
 	if drop, retry := tr.addErrorBehavior(err); drop {
-		task.finish(nil, false)
+		tr.dropTask(task, err)
 	} else if retry {
 		// This should only be due to persistence problems. Retry in a new goroutine
 		// to not block other tasks, up to some concurrency limit.
@@ -356,6 +364,17 @@ func (tr *priTaskReader) addErrorBehavior(err error) (drop, retry bool) {
 	return false, true
 }
 
+// dropTask drops a task that can't be added to the matcher. Tasks for an unavailable sticky worker
+// are expected to be dropped: history will time out the sticky task and reschedule it on the normal
+// queue. Anything else is dead-lettered.
+func (tr *priTaskReader) dropTask(task *internalTask, err error) {
+	var stickyUnavailable *serviceerrors.StickyWorkerUnavailable
+	if !errors.As(err, &stickyUnavailable) {
+		tr.backlogMgr.pqMgr.DeadLetterTask(task.event.GetData(), enumsspb.DEAD_LETTER_REASON_UNDISPATCHABLE, err)
+	}
+	task.finish(nil, false)
+}
+
 func (tr *priTaskReader) retryAddAfterError(task *internalTask) {
 	defer tr.addRetries.Release(1)
 	metrics.BufferThrottlePerTaskQueueCounter.With(tr.backlogMgr.metricsHandler).Record(1)
@@ -366,13 +385,14 @@ func (tr *priTaskReader) retryAddAfterError(task *internalTask) {
 	_ = backoff.ThrottleRetryContext(
 		tr.backlogMgr.tqCtx,
 		func(context.Context) error {
-			if IsTaskExpired(task.event.AllocatedTaskInfo) {
+			if IsTaskExpired(task.event.AllocatedTaskInfo, tr.backlogMgr.timeSource.Now()) {
+				tr.backlogMgr.pqMgr.DeadLetterTask(task.event.GetData(), enumsspb.DEAD_LETTER_REASON_EXPIRED, nil)
 				task.finish(nil, false)
 				return nil
 			}
 			err := tr.backlogMgr.addSpooledTask(task)
 			if drop, retry := tr.addErrorBehavior(err); drop {
-				task.finish(nil, false)
+				tr.dropTask(task, err)
 			} else if retry {
 				metrics.BufferThrottlePerTaskQueueCounter.With(tr.backlogMgr.metricsHandler).Record(1)
 				return err
@@ -421,7 +441,7 @@ func (tr *priTaskReader) backoffSignal(duration time.Duration) {
 	defer tr.lock.Unlock()
 
 	if tr.backoffTimer == nil {
-		tr.backoffTimer = time.AfterFunc(duration, func() {
+		tr.backoffTimer = tr.backlogMgr.timeSource.AfterFunc(duration, func() {
 			tr.lock.Lock()
 			defer tr.lock.Unlock()
 
@@ -493,7 +513,7 @@ func (tr *priTaskReader) maybeGCLocked() {
 		return
 	}
 	tr.inGC = true
-	tr.lastGCTime = time.Now()
+	tr.lastGCTime = tr.backlogMgr.timeSource.Now()
 	// gc in new goroutine so poller doesn't have to wait
 	go tr.doGC(tr.ackLevel)
 }
@@ -506,7 +526,7 @@ func (tr *priTaskReader) shouldGCLocked() bool {
 	} else if gcGap >= tr.backlogMgr.config.MaxTaskDeleteBatchSize() {
 		return true
 	}
-	return time.Since(tr.lastGCTime) > tr.backlogMgr.config.TaskDeleteInterval()
+	return tr.backlogMgr.timeSource.Since(tr.lastGCTime) > tr.backlogMgr.config.TaskDeleteInterval()
 }
 
 // called in new goroutine
@@ -0,0 +1,622 @@
+// The MIT License
+//
+// Copyright (c) 2025 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:build matching_simulation
+
+package matching
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+	commandpb "go.temporal.io/api/command/v1"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/tqid"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+)
+
+// This file contains an in-process load simulation for matching. It drives a real matchingEngineImpl with
+// synthetic task producers and pollers against the in-memory task manager, and reports schedule-to-start latency
+// and backlog size, so that changes to matching (e.g. the priority matcher) can be evaluated without a cluster.
+//
+// Time is virtual: the simulation advances an EventTimeSource in fixed steps, which is used for task arrivals,
+// poller processing times, latency measurement, and the matcher's own timers. Matching itself still runs on real
+// goroutines, so the simulation sleeps for simConfig.realStep after every step to let it make progress. A smaller
+// ratio of real to virtual time runs faster but overstates latencies that are bound by real work.
+//
+// The simulation takes tens of seconds, so it is excluded from regular test runs by the matching_simulation build
+// tag. Run it with:
+//
+//	go test -tags matching_simulation -run TestMatchingSimulation -v ./service/matching/
+
+const simPollTimeout = 5 * time.Second
+
+type (
+	// simConfig describes a simulated load on a single activity task queue.
+	simConfig struct {
+		name       string
+		partitions int
+		newMatcher bool
+		// duration is the virtual time during which producers add tasks.
+		duration time.Duration
+		// drainTimeout is the virtual time to wait for the remaining tasks to be delivered once producers stop.
+		drainTimeout time.Duration
+		// step is the virtual time advanced in each step of the simulation.
+		step time.Duration
+		// realStep is the real time the simulation waits after each step.
+		realStep time.Duration
+		// sampleInterval is the virtual time between two backlog samples.
+		sampleInterval time.Duration
+		producers      []simProducer
+		pollers        []simPollers
+		seed           int64
+	}
+
+	simProducer struct {
+		// arrivals is the virtual time between two tasks added by this producer.
+		arrivals simDistribution
+		// priorities maps priority keys to their relative weight. Tasks have the default priority if empty.
+		priorities map[int32]int
+	}
+
+	simPollers struct {
+		count int
+		// start and stop are the virtual times, relative to the start of the simulation, during which the
+		// pollers poll. A zero stop time means they poll until the end.
+		start time.Duration
+		stop  time.Duration
+		// processing is the virtual time a poller spends on a task before it polls again.
+		processing simDistribution
+	}
+
+	simDistribution interface {
+		sample(r *rand.Rand) time.Duration
+	}
+
+	// simConstant always returns the same duration.
+	simConstant time.Duration
+	// simExponential returns exponentially distributed durations with the given mean, i.e. a Poisson process when
+	// used for arrivals.
+	simExponential time.Duration
+	// simBursty returns size-1 times the within duration, then the between duration, so that tasks arrive in bursts.
+	simBursty struct {
+		size    int
+		within  time.Duration
+		between time.Duration
+		count   int
+	}
+
+	simTask struct {
+		created  time.Time
+		priority int32
+	}
+
+	simBacklogSample struct {
+		at          time.Duration
+		outstanding int
+	}
+
+	// simReport is the outcome of a simulation run.
+	simReport struct {
+		name           string
+		produced       int64
+		delivered      int64
+		syncMatched    int64
+		forwardedAdds  int64
+		forwardedPolls int64
+		// latencies are the schedule-to-start latencies of delivered tasks by priority key.
+		latencies map[int32][]time.Duration
+		backlog   []simBacklogSample
+	}
+
+	simulation struct {
+		cfg         simConfig
+		rand        *rand.Rand
+		timeSource  *clock.EventTimeSource
+		start       time.Time
+		engine      *matchingEngineImpl
+		family      *tqid.TaskQueueFamily
+		namespaceID string
+		execution   *commonpb.WorkflowExecution
+
+		lock        sync.Mutex
+		outstanding map[int64]simTask
+		report      *simReport
+
+		nextEventID atomic.Int64
+		adds        sync.WaitGroup
+	}
+
+	// simHistoryClient starts every activity task it is asked to and records its delivery.
+	simHistoryClient struct {
+		historyservice.HistoryServiceClient
+		sim *simulation
+	}
+
+	// simMatchingClient routes forwarded calls back into the simulated engine.
+	simMatchingClient struct {
+		matchingservice.MatchingServiceClient
+		sim *simulation
+	}
+)
+
+func (d simConstant) sample(*rand.Rand) time.Duration {
+	return time.Duration(d)
+}
+
+func (d simExponential) sample(r *rand.Rand) time.Duration {
+	return time.Duration(r.ExpFloat64() * float64(d))
+}
+
+func (d *simBursty) sample(*rand.Rand) time.Duration {
+	d.count++
+	if d.count%d.size == 0 {
+		return d.between
+	}
+	return d.within
+}
+
+func newSimulation(t *testing.T, cfg simConfig) *simulation {
+	controller := gomock.NewController(t)
+	logger := log.NewNoopLogger()
+
+	namespaceID := uuid.New()
+	family, err := tqid.NewTaskQueueFamily(namespaceID, "sim-"+cfg.name)
+	require.NoError(t, err)
+
+	s := &simulation{
+		cfg:         cfg,
+		rand:        rand.New(rand.NewSource(cfg.seed)),
+		timeSource:  clock.NewEventTimeSource(),
+		family:      family,
+		namespaceID: namespaceID,
+		execution:   &commonpb.WorkflowExecution{WorkflowId: "sim-workflow", RunId: uuid.New()},
+		outstanding: make(map[int64]simTask),
+		report: &simReport{
+			name:      cfg.name,
+			latencies: make(map[int32][]time.Duration),
+		},
+	}
+	// Timers from the matcher take their own locks when they fire, so they must not run while the time source is
+	// locked by the simulation advancing it.
+	s.timeSource.UseAsyncTimers(true)
+	s.timeSource.Update(time.Now())
+	s.start = s.timeSource.Now()
+
+	config := defaultTestConfig()
+	if cfg.newMatcher {
+		useNewMatcher(config)
+	}
+	config.NumTaskqueueReadPartitions = dynamicconfig.GetIntPropertyFnFilteredByTaskQueue(cfg.partitions)
+	config.NumTaskqueueWritePartitions = dynamicconfig.GetIntPropertyFnFilteredByTaskQueue(cfg.partitions)
+
+	_, namespaceRegistry := createMockNamespaceCache(controller, matchingTestNamespace)
+	visibilityManager := manager.NewMockVisibilityManager(controller)
+	visibilityManager.EXPECT().Close().AnyTimes()
+	hostInfo := membership.NewHostInfoFromAddress("self")
+	hostInfoProvider := membership.NewMockHostInfoProvider(controller)
+	hostInfoProvider.EXPECT().HostInfo().Return(hostInfo).AnyTimes()
+	serviceResolver := membership.NewMockServiceResolver(controller)
+	serviceResolver.EXPECT().Lookup(gomock.Any()).Return(hostInfo, nil).AnyTimes()
+	serviceResolver.EXPECT().AddListener(gomock.Any(), gomock.Any()).AnyTimes()
+	serviceResolver.EXPECT().RemoveListener(gomock.Any()).AnyTimes()
+	nexusEndpointManager := persistence.NewMockNexusEndpointManager(controller)
+	nexusEndpointManager.EXPECT().ListNexusEndpoints(gomock.Any(), gomock.Any()).
+		Return(&persistence.ListNexusEndpointsResponse{}, nil).AnyTimes()
+
+	s.engine = newMatchingEngine(config, newTestTaskManager(logger), &simHistoryClient{sim: s}, logger, namespaceRegistry,
+		&simMatchingClient{sim: s}, visibilityManager, hostInfoProvider, serviceResolver, nexusEndpointManager)
+	s.engine.timeSource = s.timeSource
+	return s
+}
+
+// run runs the simulation to completion and returns its report.
+func (s *simulation) run(ctx context.Context) *simReport {
+	s.engine.Start()
+	defer s.engine.Stop()
+
+	pollCtx, cancelPolls := context.WithCancel(ctx)
+	defer cancelPolls()
+	var pollers sync.WaitGroup
+	for i, group := range s.cfg.pollers {
+		for j := 0; j < group.count; j++ {
+			pollers.Add(1)
+			r := rand.New(rand.NewSource(s.cfg.seed + int64(i*1000+j+1)))
+			go func() {
+				defer pollers.Done()
+				s.poll(pollCtx, group, r, fmt.Sprintf("poller-%d-%d", i, j))
+			}()
+		}
+	}
+
+	end := s.start.Add(s.cfg.duration)
+	drainEnd := end.Add(s.cfg.drainTimeout)
+	nextArrivals := make([]time.Time, len(s.cfg.producers))
+	for i, producer := range s.cfg.producers {
+		nextArrivals[i] = s.start.Add(producer.arrivals.sample(s.rand))
+	}
+	nextSample := s.start
+
+	for ctx.Err() == nil {
+		now := s.timeSource.Now()
+		if !now.Before(nextSample) {
+			s.recordBacklog(now)
+			nextSample = nextSample.Add(s.cfg.sampleInterval)
+		}
+		if now.Before(end) {
+			for i, producer := range s.cfg.producers {
+				for !nextArrivals[i].After(now) {
+					s.addTask(ctx, producer)
+					nextArrivals[i] = nextArrivals[i].Add(producer.arrivals.sample(s.rand))
+				}
+			}
+		} else if s.outstandingCount() == 0 || now.After(drainEnd) {
+			break
+		}
+		// Adds don't block on pollers, so wait for them before moving on to keep producers on schedule.
+		s.adds.Wait()
+		time.Sleep(s.cfg.realStep)
+		s.timeSource.Advance(s.cfg.step)
+	}
+	s.recordBacklog(s.timeSource.Now())
+
+	cancelPolls()
+	pollers.Wait()
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.report
+}
+
+func (s *simulation) addTask(ctx context.Context, producer simProducer) {
+	eventID := s.nextEventID.Add(1)
+	priority := s.samplePriority(producer.priorities)
+	partition := s.randomPartition(s.rand)
+
+	s.lock.Lock()
+	s.outstanding[eventID] = simTask{created: s.timeSource.Now(), priority: priority}
+	s.report.produced++
+	s.lock.Unlock()
+
+	request := &matchingservice.AddActivityTaskRequest{
+		NamespaceId:      s.namespaceID,
+		Execution:        s.execution,
+		TaskQueue:        &taskqueuepb.TaskQueue{Name: partition.RpcName(), Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+		ScheduledEventId: eventID,
+	}
+	if priority != 0 {
+		request.Priority = &commonpb.Priority{PriorityKey: priority}
+	}
+
+	s.adds.Add(1)
+	go func() {
+		defer s.adds.Done()
+		for ctx.Err() == nil {
+			_, syncMatch, err := s.engine.AddActivityTask(ctx, request)
+			if err == nil {
+				if syncMatch {
+					s.lock.Lock()
+					s.report.syncMatched++
+					s.lock.Unlock()
+				}
+				return
+			}
+		}
+	}()
+}
+
+func (s *simulation) poll(ctx context.Context, group simPollers, r *rand.Rand, identity string) {
+	if !s.sleep(ctx, group.start) {
+		return
+	}
+	for ctx.Err() == nil {
+		if group.stop > 0 && s.timeSource.Since(s.start) >= group.stop {
+			return
+		}
+		partition := s.randomPartition(r)
+		pollCtx, cancel := context.WithTimeout(ctx, simPollTimeout)
+		resp, err := s.engine.PollActivityTaskQueue(pollCtx, &matchingservice.PollActivityTaskQueueRequest{
+			NamespaceId: s.namespaceID,
+			PollRequest: &workflowservice.PollActivityTaskQueueRequest{
+				TaskQueue: &taskqueuepb.TaskQueue{Name: partition.RpcName(), Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+				Identity:  identity,
+			},
+		}, metrics.NoopMetricsHandler)
+		cancel()
+		if err != nil || len(resp.GetTaskToken()) == 0 {
+			continue
+		}
+		if !s.sleep(ctx, group.processing.sample(r)) {
+			return
+		}
+	}
+}
+
+// sleep waits for the given virtual time and returns false if the context is done first.
+func (s *simulation) sleep(ctx context.Context, d time.Duration) bool {
+	if d <= 0 {
+		return ctx.Err() == nil
+	}
+	ch, timer := s.timeSource.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ch:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func (s *simulation) recordDelivered(eventID int64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	task, ok := s.outstanding[eventID]
+	if !ok {
+		// The task was delivered before. This happens if matching dispatches a task more than once.
+		return
+	}
+	delete(s.outstanding, eventID)
+	s.report.delivered++
+	s.report.latencies[task.priority] = append(s.report.latencies[task.priority], s.timeSource.Since(task.created))
+}
+
+func (s *simulation) recordBacklog(now time.Time) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.report.backlog = append(s.report.backlog, simBacklogSample{at: now.Sub(s.start), outstanding: len(s.outstanding)})
+}
+
+func (s *simulation) outstandingCount() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return len(s.outstanding)
+}
+
+func (s *simulation) randomPartition(r *rand.Rand) tqid.Partition {
+	// The rand used by producers is only accessed by the simulation loop, and each poller has its own.
+	return s.family.TaskQueue(enumspb.TASK_QUEUE_TYPE_ACTIVITY).NormalPartition(r.Intn(s.cfg.partitions))
+}
+
+func (s *simulation) samplePriority(weights map[int32]int) int32 {
+	if len(weights) == 0 {
+		return 0
+	}
+	keys := make([]int32, 0, len(weights))
+	total := 0
+	for key, weight := range weights {
+		keys = append(keys, key)
+		total += weight
+	}
+	slices.Sort(keys)
+	n := s.rand.Intn(total)
+	for _, key := range keys {
+		n -= weights[key]
+		if n < 0 {
+			return key
+		}
+	}
+	return keys[len(keys)-1]
+}
+
+func (c *simHistoryClient) RecordActivityTaskStarted(
+	_ context.Context,
+	request *historyservice.RecordActivityTaskStartedRequest,
+	_ ...grpc.CallOption,
+) (*historyservice.RecordActivityTaskStartedResponse, error) {
+	c.sim.recordDelivered(request.GetScheduledEventId())
+	return &historyservice.RecordActivityTaskStartedResponse{
+		Attempt: 1,
+		ScheduledEvent: newActivityTaskScheduledEvent(request.GetScheduledEventId(), 0,
+			&commandpb.ScheduleActivityTaskCommandAttributes{
+				ActivityId:   fmt.Sprintf("activity-%d", request.GetScheduledEventId()),
+				ActivityType: &commonpb.ActivityType{Name: "sim-activity"},
+				TaskQueue:    &taskqueuepb.TaskQueue{Name: c.sim.family.Name(), Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+			}),
+	}, nil
+}
+
+func (c *simHistoryClient) IsActivityTaskValid(
+	context.Context,
+	*historyservice.IsActivityTaskValidRequest,
+	...grpc.CallOption,
+) (*historyservice.IsActivityTaskValidResponse, error) {
+	return &historyservice.IsActivityTaskValidResponse{IsValid: true}, nil
+}
+
+func (c *simMatchingClient) AddActivityTask(
+	ctx context.Context,
+	request *matchingservice.AddActivityTaskRequest,
+	_ ...grpc.CallOption,
+) (*matchingservice.AddActivityTaskResponse, error) {
+	c.sim.lock.Lock()
+	c.sim.report.forwardedAdds++
+	c.sim.lock.Unlock()
+	buildID, _, err := c.sim.engine.AddActivityTask(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return &matchingservice.AddActivityTaskResponse{AssignedBuildId: buildID}, nil
+}
+
+func (c *simMatchingClient) PollActivityTaskQueue(
+	ctx context.Context,
+	request *matchingservice.PollActivityTaskQueueRequest,
+	_ ...grpc.CallOption,
+) (*matchingservice.PollActivityTaskQueueResponse, error) {
+	c.sim.lock.Lock()
+	c.sim.report.forwardedPolls++
+	c.sim.lock.Unlock()
+	return c.sim.engine.PollActivityTaskQueue(ctx, request, metrics.NoopMetricsHandler)
+}
+
+func (c *simMatchingClient) GetTaskQueueUserData(
+	ctx context.Context,
+	request *matchingservice.GetTaskQueueUserDataRequest,
+	_ ...grpc.CallOption,
+) (*matchingservice.GetTaskQueueUserDataResponse, error) {
+	return c.sim.engine.GetTaskQueueUserData(ctx, request)
+}
+
+func (c *simMatchingClient) UpdateTaskQueueUserData(
+	context.Context,
+	*matchingservice.UpdateTaskQueueUserDataRequest,
+	...grpc.CallOption,
+) (*matchingservice.UpdateTaskQueueUserDataResponse, error) {
+	return &matchingservice.UpdateTaskQueueUserDataResponse{}, nil
+}
+
+func (c *simMatchingClient) ReplicateTaskQueueUserData(
+	context.Context,
+	*matchingservice.ReplicateTaskQueueUserDataRequest,
+	...grpc.CallOption,
+) (*matchingservice.ReplicateTaskQueueUserDataResponse, error) {
+	return &matchingservice.ReplicateTaskQueueUserDataResponse{}, nil
+}
+
+func (c *simMatchingClient) ForceLoadTaskQueuePartition(
+	context.Context,
+	*matchingservice.ForceLoadTaskQueuePartitionRequest,
+	...grpc.CallOption,
+) (*matchingservice.ForceLoadTaskQueuePartitionResponse, error) {
+	return &matchingservice.ForceLoadTaskQueuePartitionResponse{WasUnloaded: true}, nil
+}
+
+// latencyPercentile returns the p-th percentile of the sorted latencies.
+func latencyPercentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	i := int(math.Ceil(p/100*float64(len(sorted)))) - 1
+	return sorted[max(0, min(i, len(sorted)-1))]
+}
+
+func (r *simReport) maxBacklog() int {
+	m := 0
+	for _, sample := range r.backlog {
+		m = max(m, sample.outstanding)
+	}
+	return m
+}
+
+func (r *simReport) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "simulation %q: produced=%d delivered=%d sync-matched=%d forwarded-adds=%d forwarded-polls=%d\n",
+		r.name, r.produced, r.delivered, r.syncMatched, r.forwardedAdds, r.forwardedPolls)
+
+	priorities := make([]int32, 0, len(r.latencies))
+	for priority := range r.latencies {
+		priorities = append(priorities, priority)
+	}
+	slices.Sort(priorities)
+	fmt.Fprintf(&b, "  %-9s %8s %10s %10s %10s %10s\n", "priority", "tasks", "p50", "p90", "p99", "max")
+	for _, priority := range priorities {
+		latencies := slices.Clone(r.latencies[priority])
+		slices.Sort(latencies)
+		fmt.Fprintf(&b, "  %-9d %8d %10v %10v %10v %10v\n", priority, len(latencies),
+			latencyPercentile(latencies, 50), latencyPercentile(latencies, 90),
+			latencyPercentile(latencies, 99), latencyPercentile(latencies, 100))
+	}
+
+	fmt.Fprintf(&b, "  backlog: max=%d final=%d\n", r.maxBacklog(), r.backlog[len(r.backlog)-1].outstanding)
+	for _, sample := range r.backlog {
+		fmt.Fprintf(&b, "    %8v %6d\n", sample.at, sample.outstanding)
+	}
+	return b.String()
+}
+
+func TestMatchingSimulation(t *testing.T) {
+	t.Parallel()
+
+	for _, cfg := range []simConfig{
+		{
+			name:       "single-partition-steady",
+			partitions: 1,
+			producers:  []simProducer{{arrivals: simExponential(20 * time.Millisecond)}},
+			pollers:    []simPollers{{count: 4, processing: simConstant(50 * time.Millisecond)}},
+		},
+		{
+			name:       "forwarding-bursty",
+			partitions: 4,
+			producers: []simProducer{{
+				arrivals: &simBursty{size: 20, within: time.Millisecond, between: 500 * time.Millisecond},
+			}},
+			pollers: []simPollers{{count: 8, processing: simExponential(20 * time.Millisecond)}},
+		},
+		{
+			name:       "priority-backlog",
+			partitions: 1,
+			newMatcher: true,
+			producers: []simProducer{{
+				arrivals:   simConstant(5 * time.Millisecond),
+				priorities: map[int32]int{1: 1, 3: 3, 5: 1},
+			}},
+			// Pollers only show up after a backlog has built up, and can't keep up with the arrival rate at first.
+			pollers: []simPollers{
+				{count: 2, start: time.Second, processing: simConstant(10 * time.Millisecond)},
+				{count: 4, start: 2 * time.Second, processing: simConstant(10 * time.Millisecond)},
+			},
+		},
+	} {
+		t.Run(cfg.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg.duration = 3 * time.Second
+			cfg.drainTimeout = 10 * time.Second
+			cfg.step = 5 * time.Millisecond
+			cfg.realStep = time.Millisecond
+			cfg.sampleInterval = 500 * time.Millisecond
+			cfg.seed = 1
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			defer cancel()
+			report := newSimulation(t, cfg).run(ctx)
+			t.Log("\n" + report.String())
+
+			require.Positive(t, report.produced)
+			require.Equal(t, report.produced, report.delivered, "all tasks should be delivered")
+			require.Zero(t, report.backlog[len(report.backlog)-1].outstanding)
+		})
+	}
+}
@@ -47,6 +47,8 @@ import (
 	serviceerrors "go.temporal.io/server/common/serviceerror"
 	"go.temporal.io/server/common/tqid"
 	"go.temporal.io/server/common/worker_versioning"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type (
@@ -73,6 +75,7 @@ type (
 		versionedQueues                 map[PhysicalTaskQueueVersion]physicalTaskQueueManager
 		versionedQueuesLock             sync.RWMutex // locks mutation of versionedQueues
 		userDataManager                 userDataManager
+		partitionScaler                 *adaptivePartitionScaler // only set if this partition owns user data
 		logger                          log.Logger
 		throttledLogger                 log.ThrottledLogger
 		matchingClient                  matchingservice.MatchingServiceClient
@@ -114,6 +117,9 @@ func newTaskQueuePartitionManager(
 		return nil, err
 	}
 	pm.defaultQueue = defaultQ
+	if partition.IsRoot() && partition.TaskType() == enumspb.TASK_QUEUE_TYPE_WORKFLOW {
+		pm.partitionScaler = newAdaptivePartitionScaler(pm)
+	}
 	return pm, nil
 }
 
@@ -121,11 +127,18 @@ func (pm *taskQueuePartitionManagerImpl) Start() {
 	pm.engine.updateTaskQueuePartitionGauge(pm.Namespace(), pm.partition, 1)
 	pm.userDataManager.Start()
 	pm.defaultQueue.Start()
+	if pm.partitionScaler != nil {
+		pm.partitionScaler.Start()
+	}
 }
 
 // Stop does not unload the partition from matching engine. It is intended to be called by matching engine when
 // unloading the partition. For stopping and unloading a partition call unloadFromEngine instead.
 func (pm *taskQueuePartitionManagerImpl) Stop(unloadCause unloadCause) {
+	// The scaler describes this partition, so it has to be stopped before taking the lock.
+	if pm.partitionScaler != nil {
+		pm.partitionScaler.Stop()
+	}
 	pm.versionedQueuesLock.Lock()
 	defer pm.versionedQueuesLock.Unlock()
 	for _, vq := range pm.versionedQueues {
@@ -179,7 +192,7 @@ reredirectTask:
 		pm.defaultQueue.MarkAlive()
 	}
 
-	if pm.partition.IsRoot() && !pm.HasAnyPollerAfter(time.Now().Add(-noPollerThreshold)) {
+	if pm.partition.IsRoot() && !pm.HasAnyPollerAfter(pm.engine.timeSource.Now().Add(-noPollerThreshold)) {
 		// Only checks recent pollers in the root partition
 		pm.metricsHandler.Counter(metrics.NoRecentPollerTasksPerTaskQueueCounter.Name()).Record(1)
 	}
@@ -356,6 +369,11 @@ func (pm *taskQueuePartitionManagerImpl) ProcessSpooledTask(
 	}
 	// Redirect and re-resolve if we're blocked in matcher and user data changes.
 	for {
+		if successor := pm.StickySuccessor(); successor != nil {
+			// The old matcher is not interrupted by a handoff, so tasks blocked in it are redirected
+			// once the task reader's offer times out.
+			return pm.redirectStickyTask(ctx, task, successor)
+		}
 		newBacklogQueue, syncMatchQueue, userDataChanged, err := pm.getPhysicalQueuesForAdd(ctx,
 			directive,
 			nil,
@@ -401,6 +419,9 @@ func (pm *taskQueuePartitionManagerImpl) AddSpooledTask(
 	task *internalTask,
 	backlogQueue *PhysicalTaskQueueKey,
 ) error {
+	if successor := pm.StickySuccessor(); successor != nil {
+		return pm.redirectStickyTask(ctx, task, successor)
+	}
 	taskInfo := task.event.GetData()
 	// This task came from taskReader so task.event is always set here.
 	directive := taskInfo.GetVersionDirective()
@@ -448,6 +469,80 @@ func (pm *taskQueuePartitionManagerImpl) AddSpooledTask(
 	return nil
 }
 
+func (pm *taskQueuePartitionManagerImpl) HandoffSticky(
+	ctx context.Context,
+	successor *taskqueuepb.TaskQueue,
+	expireTime time.Time,
+) error {
+	// The handoff is stored with the partition metadata so that it survives reloads and ownership changes.
+	if err := pm.defaultQueue.SetStickyHandoff(ctx, &persistencespb.StickyHandoff{
+		Successor:  successor,
+		ExpireTime: timestamppb.New(expireTime),
+	}); err != nil {
+		return err
+	}
+	// Make the matcher pass its backlog through AddSpooledTask again so it gets redirected.
+	pm.defaultQueue.UserDataChanged()
+	return nil
+}
+
+func (pm *taskQueuePartitionManagerImpl) StickySuccessor() *taskqueuepb.TaskQueue {
+	if pm.partition.Kind() != enumspb.TASK_QUEUE_KIND_STICKY {
+		return nil
+	}
+	handoff := pm.defaultQueue.StickyHandoff()
+	if handoff == nil || !pm.engine.timeSource.Now().Before(handoff.GetExpireTime().AsTime()) {
+		return nil
+	}
+	return handoff.GetSuccessor()
+}
+
+func (pm *taskQueuePartitionManagerImpl) StickyOwnerIdentity() string {
+	return pm.defaultQueue.StickyOwnerIdentity()
+}
+
+// redirectStickyTask moves a backlog task of a handed off sticky partition to the successor sticky queue.
+func (pm *taskQueuePartitionManagerImpl) redirectStickyTask(
+	ctx context.Context,
+	task *internalTask,
+	successor *taskqueuepb.TaskQueue,
+) error {
+	taskInfo := task.event.GetData()
+	var expirationDuration *durationpb.Duration
+	if taskInfo.GetExpiryTime() != nil {
+		remaining := taskInfo.GetExpiryTime().AsTime().Sub(pm.engine.timeSource.Now())
+		if remaining <= 0 {
+			task.finish(nil, false)
+			return nil
+		}
+		expirationDuration = durationpb.New(remaining)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ioTimeout)
+	defer cancel()
+	_, err := pm.matchingClient.AddWorkflowTask(ctx, &matchingservice.AddWorkflowTaskRequest{
+		NamespaceId:             taskInfo.GetNamespaceId(),
+		Execution:               task.workflowExecution(),
+		TaskQueue:               successor,
+		ScheduledEventId:        taskInfo.GetScheduledEventId(),
+		Clock:                   taskInfo.GetClock(),
+		ScheduleToStartTimeout:  expirationDuration,
+		VersionDirective:        taskInfo.GetVersionDirective(),
+		Priority:                taskInfo.GetPriority(),
+		StickyHandoffRedirected: true,
+	})
+	if err != nil {
+		// StickyWorkerUnavailable makes the task reader drop the task. History then times out the
+		// sticky task and reschedules it on the normal queue, as if there was no handoff.
+		return err
+	}
+	metrics.StickyHandoffRedirectedTasksCounter.With(pm.metricsHandler).Record(1)
+	// Finish the task because now it is copied to the successor queue. It should be considered
+	// invalid because a poller did not receive the task.
+	task.finish(nil, false)
+	return nil
+}
+
 func (pm *taskQueuePartitionManagerImpl) DispatchQueryTask(
 	ctx context.Context,
 	taskID string,
@@ -699,7 +794,11 @@ func (pm *taskQueuePartitionManagerImpl) ForceLoadAllNonRootPartitions() {
 	namespaceId := partition.NamespaceId()
 	taskQueueName := taskQueue.Name()
 	taskQueueType := taskQueue.TaskType()
-	partitionTotal := pm.config.NumReadPartitions()
+	userData, _, err := pm.userDataManager.GetUserData()
+	if err != nil {
+		return
+	}
+	partitionTotal := numPartitionsForFanOut(userData.GetData(), taskQueueType, pm.config)
 
 	// record total - 1 as we won't try to forceLoad the Root partition.
 	pm.metricsHandler.Counter(metrics.ForceLoadedTaskQueuePartitions.Name()).Record(int64(partitionTotal) - 1)
@@ -734,18 +833,26 @@ func (pm *taskQueuePartitionManagerImpl) ForceLoadAllNonRootPartitions() {
 	}
 }
 
+func (pm *taskQueuePartitionManagerImpl) PartitionCounts() *taskqueuespb.PartitionCounts {
+	userData, _, err := pm.userDataManager.GetUserData()
+	if err != nil {
+		return nil
+	}
+	return adaptivePartitionCounts(userData.GetData(), pm.partition.TaskType(), pm.config)
+}
+
 func (pm *taskQueuePartitionManagerImpl) TimeSinceLastFanOut() time.Duration {
 	pm.cachedPhysicalInfoByBuildIdLock.RLock()
 	defer pm.cachedPhysicalInfoByBuildIdLock.RUnlock()
 
-	return time.Since(time.Unix(0, pm.lastFanOut))
+	return pm.engine.timeSource.Since(time.Unix(0, pm.lastFanOut))
 }
 
 func (pm *taskQueuePartitionManagerImpl) UpdateTimeSinceLastFanOutAndCache(physicalInfoByBuildId map[string]map[enumspb.TaskQueueType]*taskqueuespb.PhysicalTaskQueueInfo) {
 	pm.cachedPhysicalInfoByBuildIdLock.Lock()
 	defer pm.cachedPhysicalInfoByBuildIdLock.Unlock()
 
-	pm.lastFanOut = time.Now().UnixNano()
+	pm.lastFanOut = pm.engine.timeSource.Now().UnixNano()
 	pm.cachedPhysicalInfoByBuildId = physicalInfoByBuildId
 }
 
@@ -66,6 +66,13 @@ type (
 		// AddSpooledTask passes a task to the matcher to make it eligible for matching and
 		// returns immediately. (New matcher only)
 		AddSpooledTask(ctx context.Context, task *internalTask, backlogQueue *PhysicalTaskQueueKey) error
+		// HandoffSticky persists that a sticky partition is succeeded by the given sticky queue until expireTime, and
+		// makes it redirect its backlog there.
+		HandoffSticky(ctx context.Context, successor *taskqueuepb.TaskQueue, expireTime time.Time) error
+		// StickySuccessor returns the queue that a handed off sticky partition redirects to, or nil.
+		StickySuccessor() *taskqueuepb.TaskQueue
+		// StickyOwnerIdentity returns the identity of the worker that first polled a sticky partition.
+		StickyOwnerIdentity() string
 		// DispatchQueryTask will dispatch query to local or remote poller. If forwarded then result or error is returned,
 		// if dispatched to local poller then nil and nil is returned.
 		DispatchQueryTask(ctx context.Context, taskId string, request *matchingservice.QueryWorkflowRequest) (*matchingservice.QueryWorkflowResponse, error)
@@ -85,6 +92,9 @@ type (
 		Describe(ctx context.Context, buildIds map[string]bool, includeAllActive, reportStats, reportPollers, internalTaskQueueStatus bool) (*matchingservice.DescribeTaskQueuePartitionResponse, error)
 		Partition() tqid.Partition
 		LongPollExpirationInterval() time.Duration
+		// PartitionCounts returns the partition counts chosen by adaptive partitioning for this task queue type, to be
+		// passed on to matching clients, or nil if there are none.
+		PartitionCounts() *taskqueuespb.PartitionCounts
 		// TimeSinceLastFanOut returns the time since the last DescribeTaskQueuePartition fan out
 		TimeSinceLastFanOut() time.Duration
 		// UpdateTimeSinceLastFanOutAndCache updates the cache and it's TTL
@@ -187,6 +187,20 @@ func (mr *MocktaskQueuePartitionManagerMockRecorder) GetUserDataManager() *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserDataManager", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).GetUserDataManager))
 }
 
+// HandoffSticky mocks base method.
+func (m *MocktaskQueuePartitionManager) HandoffSticky(ctx context.Context, successor *taskqueue.TaskQueue, expireTime time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandoffSticky", ctx, successor, expireTime)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HandoffSticky indicates an expected call of HandoffSticky.
+func (mr *MocktaskQueuePartitionManagerMockRecorder) HandoffSticky(ctx, successor, expireTime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandoffSticky", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).HandoffSticky), ctx, successor, expireTime)
+}
+
 // HasAnyPollerAfter mocks base method.
 func (m *MocktaskQueuePartitionManager) HasAnyPollerAfter(accessTime time.Time) bool {
 	m.ctrl.T.Helper()
@@ -284,6 +298,20 @@ func (mr *MocktaskQueuePartitionManagerMockRecorder) Partition() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Partition", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).Partition))
 }
 
+// PartitionCounts mocks base method.
+func (m *MocktaskQueuePartitionManager) PartitionCounts() *taskqueue0.PartitionCounts {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PartitionCounts")
+	ret0, _ := ret[0].(*taskqueue0.PartitionCounts)
+	return ret0
+}
+
+// PartitionCounts indicates an expected call of PartitionCounts.
+func (mr *MocktaskQueuePartitionManagerMockRecorder) PartitionCounts() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PartitionCounts", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).PartitionCounts))
+}
+
 // PollTask mocks base method.
 func (m *MocktaskQueuePartitionManager) PollTask(ctx context.Context, pollMetadata *pollMetadata) (*internalTask, bool, error) {
 	m.ctrl.T.Helper()
@@ -326,6 +354,34 @@ func (mr *MocktaskQueuePartitionManagerMockRecorder) Start() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).Start))
 }
 
+// StickyOwnerIdentity mocks base method.
+func (m *MocktaskQueuePartitionManager) StickyOwnerIdentity() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StickyOwnerIdentity")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// StickyOwnerIdentity indicates an expected call of StickyOwnerIdentity.
+func (mr *MocktaskQueuePartitionManagerMockRecorder) StickyOwnerIdentity() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StickyOwnerIdentity", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).StickyOwnerIdentity))
+}
+
+// StickySuccessor mocks base method.
+func (m *MocktaskQueuePartitionManager) StickySuccessor() *taskqueue.TaskQueue {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StickySuccessor")
+	ret0, _ := ret[0].(*taskqueue.TaskQueue)
+	return ret0
+}
+
+// StickySuccessor indicates an expected call of StickySuccessor.
+func (mr *MocktaskQueuePartitionManagerMockRecorder) StickySuccessor() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StickySuccessor", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).StickySuccessor))
+}
+
 // Stop mocks base method.
 func (m *MocktaskQueuePartitionManager) Stop(arg0 unloadCause) {
 	m.ctrl.T.Helper()
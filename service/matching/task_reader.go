@@ -31,6 +31,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
@@ -55,7 +56,7 @@ type (
 		backlogMgr *backlogManagerImpl
 
 		backoffTimerLock      sync.Mutex
-		backoffTimer          *time.Timer
+		backoffTimer          clock.Timer
 		retrier               backoff.Retrier
 		backlogHeadCreateTime atomic.Int64
 	}
@@ -70,7 +71,7 @@ func newTaskReader(backlogMgr *backlogManagerImpl) *taskReader {
 		taskBuffer: make(chan *persistencespb.AllocatedTaskInfo, backlogMgr.config.GetTasksBatchSize()-1),
 		retrier: backoff.NewRetrier(
 			common.CreateReadTaskRetryPolicy(),
-			clock.NewRealTimeSource(),
+			backlogMgr.timeSource,
 		),
 	}
 	tr.backlogHeadCreateTime.Store(-1)
@@ -103,7 +104,7 @@ func (tr *taskReader) getBacklogHeadAge() time.Duration {
 	if tr.backlogHeadCreateTime.Load() == -1 {
 		return time.Duration(0)
 	}
-	return time.Since(time.Unix(0, tr.backlogHeadCreateTime.Load()))
+	return tr.backlogMgr.timeSource.Since(time.Unix(0, tr.backlogHeadCreateTime.Load()))
 }
 
 func (tr *taskReader) dispatchBufferedTasks() {
@@ -159,8 +160,8 @@ func (tr *taskReader) getTasksPump() {
 		return
 	}
 
-	updateAckTimer := time.NewTimer(tr.backlogMgr.config.UpdateAckInterval())
-	defer updateAckTimer.Stop()
+	updateAckC, updateAckTimer := tr.backlogMgr.timeSource.NewTimer(tr.backlogMgr.config.UpdateAckInterval())
+	defer func() { updateAckTimer.Stop() }()
 
 	tr.Signal() // prime pump
 Loop:
@@ -204,7 +205,7 @@ Loop:
 			// There maybe more tasks. We yield now, but signal pump to check again later.
 			tr.Signal()
 
-		case <-updateAckTimer.C:
+		case <-updateAckC:
 			err := tr.persistAckBacklogCountLevel(ctx)
 			isConditionFailed := tr.backlogMgr.signalIfFatal(err)
 			if err != nil && !isConditionFailed {
@@ -214,7 +215,7 @@ Loop:
 				// keep going as saving ack is not critical
 			}
 			tr.Signal() // periodically signal pump to check persistence for tasks
-			updateAckTimer = time.NewTimer(tr.backlogMgr.config.UpdateAckInterval())
+			updateAckC, updateAckTimer = tr.backlogMgr.timeSource.NewTimer(tr.backlogMgr.config.UpdateAckInterval())
 		}
 	}
 }
@@ -277,8 +278,9 @@ func (tr *taskReader) addTasksToBuffer(
 	tasks []*persistencespb.AllocatedTaskInfo,
 ) error {
 	for _, t := range tasks {
-		if IsTaskExpired(t) {
+		if IsTaskExpired(t, tr.backlogMgr.timeSource.Now()) {
 			metrics.ExpiredTasksPerTaskQueueCounter.With(tr.taggedMetricsHandler()).Record(1)
+			tr.backlogMgr.pqMgr.DeadLetterTask(t.Data, enumsspb.DEAD_LETTER_REASON_EXPIRED, nil)
 			// Also increment readLevel for expired tasks otherwise it could result in
 			// looping over the same tasks if all tasks read in the batch are expired
 			tr.backlogMgr.taskAckManager.setReadLevel(t.GetTaskId())
@@ -326,7 +328,7 @@ func (tr *taskReader) reEnqueueAfterDelay(duration time.Duration) {
 	defer tr.backoffTimerLock.Unlock()
 
 	if tr.backoffTimer == nil {
-		tr.backoffTimer = time.AfterFunc(duration, func() {
+		tr.backoffTimer = tr.backlogMgr.timeSource.AfterFunc(duration, func() {
 			tr.backoffTimerLock.Lock()
 			defer tr.backoffTimerLock.Unlock()
 
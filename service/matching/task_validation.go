@@ -33,6 +33,7 @@ import (
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/api/historyservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -67,6 +68,7 @@ type (
 		clusterMetadata   cluster.Metadata
 		namespaceRegistry namespace.Registry
 		historyClient     historyservice.HistoryServiceClient
+		timeSource        clock.TimeSource
 
 		lastValidatedTaskInfo taskValidationInfo
 	}
@@ -77,12 +79,14 @@ func newTaskValidator(
 	clusterMetadata cluster.Metadata,
 	namespaceRegistry namespace.Registry,
 	historyClient historyservice.HistoryServiceClient,
+	timeSource clock.TimeSource,
 ) *taskValidatorImpl {
 	return &taskValidatorImpl{
 		tqCtx:             tqCtx,
 		clusterMetadata:   clusterMetadata,
 		namespaceRegistry: namespaceRegistry,
 		historyClient:     historyClient,
+		timeSource:        timeSource,
 	}
 }
 
@@ -90,7 +94,7 @@ func (v *taskValidatorImpl) maybeValidate(
 	task *persistencespb.AllocatedTaskInfo,
 	taskType enumspb.TaskQueueType,
 ) bool {
-	if IsTaskExpired(task) {
+	if IsTaskExpired(task, v.timeSource.Now()) {
 		return false
 	}
 	if !v.preValidate(task) {
@@ -134,14 +138,14 @@ func (v *taskValidatorImpl) preValidateActive(
 		} else {
 			v.lastValidatedTaskInfo = taskValidationInfo{
 				taskID:         task.TaskId,
-				validationTime: time.Now().UTC(), // if no creation time specified, use now
+				validationTime: v.timeSource.Now(), // if no creation time specified, use now
 			}
 		}
 		return false
 	}
 
 	// this task has been validated before
-	return time.Since(v.lastValidatedTaskInfo.validationTime) > taskReaderValidationThreshold
+	return v.timeSource.Since(v.lastValidatedTaskInfo.validationTime) > taskReaderValidationThreshold
 }
 
 // preValidatePassive track a task and return if validation should be done, if namespace is passive
@@ -158,13 +162,13 @@ func (v *taskValidatorImpl) preValidatePassive(
 		} else {
 			v.lastValidatedTaskInfo = taskValidationInfo{
 				taskID:         task.TaskId,
-				validationTime: time.Now().UTC(), // if no creation time specified, use now
+				validationTime: v.timeSource.Now(), // if no creation time specified, use now
 			}
 		}
 	}
 
 	// this task has been validated before
-	return time.Since(v.lastValidatedTaskInfo.validationTime) > taskReaderValidationThreshold
+	return v.timeSource.Since(v.lastValidatedTaskInfo.validationTime) > taskReaderValidationThreshold
 }
 
 // postValidate update tracked task info
@@ -173,7 +177,7 @@ func (v *taskValidatorImpl) postValidate(
 ) {
 	v.lastValidatedTaskInfo = taskValidationInfo{
 		taskID:         task.TaskId,
-		validationTime: time.Now().UTC(),
+		validationTime: v.timeSource.Now(),
 	}
 }
 
@@ -235,7 +239,7 @@ func (v *taskValidatorImpl) isTaskValid(
 //	there should be more validation logic here
 //	1. if task has valid TTL -> TTL reached -> delete
 //	2. if task has 0 TTL / no TTL -> logic need to additionally check if corresponding workflow still exists
-func IsTaskExpired(t *persistencespb.AllocatedTaskInfo) bool {
+func IsTaskExpired(t *persistencespb.AllocatedTaskInfo, now time.Time) bool {
 	expiry := timestamp.TimeValue(t.GetData().GetExpiryTime())
-	return expiry.Unix() > 0 && expiry.Before(time.Now())
+	return expiry.Unix() > 0 && expiry.Before(now)
 }
@@ -81,7 +81,7 @@ func (s *Scavenger) deleteHandler(key *p.TaskQueueKey, state *taskQueueState) ha
 
 		for _, task := range resp.Tasks {
 			nProcessed++
-			if !matching.IsTaskExpired(task) {
+			if !matching.IsTaskExpired(task, time.Now()) {
 				return handlerStatusDone
 			}
 		}
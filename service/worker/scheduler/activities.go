@@ -25,16 +25,21 @@
 package scheduler
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
 	"time"
 
+	"github.com/google/uuid"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	failurepb "go.temporal.io/api/failure/v1"
 	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/activity"
 	"go.temporal.io/sdk/temporal"
@@ -45,8 +50,12 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/common/util"
+	"go.temporal.io/server/components/callbacks"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -61,6 +70,10 @@ type (
 		startWorkflowRateLimiter quotas.RateLimiter
 		maxBlobSize              dynamicconfig.IntPropertyFn
 		localActivitySleepLimit  dynamicconfig.DurationPropertyFn
+		slaWebhookEnabled        dynamicconfig.BoolPropertyFn
+		slaWebhookTimeout        dynamicconfig.DurationPropertyFn
+		// SLA webhooks may only be posted to the namespace's allowed callback addresses.
+		slaWebhookAddresses dynamicconfig.TypedPropertyFn[[]callbacks.AddressMatchRule]
 	}
 
 	errFollow string
@@ -83,6 +96,8 @@ var (
 	errNoAttrs              = errors.New("last event did not have correct attrs")
 	errBlocked              = errors.New("rate limiter doesn't allow any progress")
 	errUnkownWorkflowStatus = errors.New("unknown workflow status")
+
+	errUnknownConcurrencyGroupRequest = errors.New("unknown concurrency group request")
 )
 
 func (e errFollow) Error() string { return string(e) }
@@ -109,6 +124,128 @@ func (a *activities) StartWorkflow(ctx context.Context, req *schedulespb.StartWo
 	}, nil
 }
 
+// NotifySlaViolation posts an SLA violation to the schedule's webhook as JSON.
+func (a *activities) NotifySlaViolation(ctx context.Context, req *schedulespb.NotifySlaViolationRequest) error {
+	if !a.slaWebhookEnabled() {
+		a.Logger.Debug("schedule SLA webhooks are disabled", tag.WorkflowNamespace(a.namespace.String()))
+		return nil
+	}
+
+	body, err := protojson.Marshal(req.Violation)
+	if err != nil {
+		return temporal.NewNonRetryableApplicationError("invalid SLA violation", "", err)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.WebhookUrl, bytes.NewReader(body))
+	if err != nil {
+		return temporal.NewNonRetryableApplicationError("invalid SLA webhook URL", "", err)
+	}
+	rules := a.slaWebhookAddresses()
+	if err := checkSlaWebhookURL(rules, httpReq.URL); err != nil {
+		return temporal.NewNonRetryableApplicationError(err.Error(), "", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	client := &http.Client{
+		Timeout: a.slaWebhookTimeout(),
+		// redirects must stay within the allowed addresses too
+		CheckRedirect: func(r *http.Request, _ []*http.Request) error {
+			return checkSlaWebhookURL(rules, r.URL)
+		},
+	}
+	resp, err := client.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		err := fmt.Errorf("SLA webhook returned status %d", resp.StatusCode)
+		if resp.StatusCode < http.StatusInternalServerError && resp.StatusCode != http.StatusTooManyRequests {
+			return temporal.NewNonRetryableApplicationError(err.Error(), "", err)
+		}
+		return err
+	}
+	return nil
+}
+
+// checkSlaWebhookURL checks a webhook URL against the namespace's allowed callback addresses, the
+// same way the frontend validates completion callback URLs.
+func checkSlaWebhookURL(rules []callbacks.AddressMatchRule, u *url.URL) error {
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("SLA webhook URL has unknown scheme: %v", u.Scheme)
+	}
+	for _, rule := range rules {
+		if rule.Regexp.MatchString(u.Host) {
+			if u.Scheme == "http" && !rule.AllowInsecure {
+				return fmt.Errorf("SLA webhook address does not allow insecure connections: %v", u.Host)
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("SLA webhook URL does not match any allowed callback address: %v", u.Host)
+}
+
+// SignalConcurrencyGroup sends a request to a concurrency group workflow, starting it if
+// it's not running.
+func (a *activities) SignalConcurrencyGroup(ctx context.Context, req *schedulespb.SignalConcurrencyGroupRequest) error {
+	var signalName string
+	var signalArg any
+	switch r := req.Request.(type) {
+	case *schedulespb.SignalConcurrencyGroupRequest_Acquire:
+		signalName, signalArg = SignalNameConcurrencyAcquire, r.Acquire
+	case *schedulespb.SignalConcurrencyGroupRequest_Release:
+		signalName, signalArg = SignalNameConcurrencyRelease, r.Release
+	default:
+		return errUnknownConcurrencyGroupRequest
+	}
+
+	input, err := sdk.PreferProtoDataConverter.ToPayloads(&schedulespb.ConcurrencyGroupState{
+		Namespace: a.namespace.String(),
+		Group:     req.Group,
+	})
+	if err != nil {
+		return err
+	}
+	signalInput, err := sdk.PreferProtoDataConverter.ToPayloads(signalArg)
+	if err != nil {
+		return err
+	}
+
+	_, err = a.FrontendClient.SignalWithStartWorkflowExecution(ctx, &workflowservice.SignalWithStartWorkflowExecutionRequest{
+		Namespace:             a.namespace.String(),
+		WorkflowId:            ConcurrencyGroupWorkflowIDPrefix + req.Group,
+		WorkflowType:          &commonpb.WorkflowType{Name: ConcurrencyGroupWorkflowType},
+		TaskQueue:             &taskqueuepb.TaskQueue{Name: primitives.PerNSWorkerTaskQueue},
+		Input:                 input,
+		Identity:              "temporal-scheduler",
+		RequestId:             uuid.NewString(),
+		WorkflowIdReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+		SignalName:            signalName,
+		SignalInput:           signalInput,
+	})
+	return translateError(err, "SignalWithStartWorkflowExecution")
+}
+
+// FindDeletedSchedules returns the schedules out of the given ones whose workflow is not running
+// anymore, which means the schedule was deleted.
+func (a *activities) FindDeletedSchedules(ctx context.Context, req *schedulespb.FindDeletedSchedulesRequest) (*schedulespb.FindDeletedSchedulesResponse, error) {
+	res := &schedulespb.FindDeletedSchedulesResponse{}
+	for _, id := range req.ScheduleIds {
+		pollRes, err := a.HistoryClient.PollMutableState(ctx, &historyservice.PollMutableStateRequest{
+			NamespaceId: a.namespaceID.String(),
+			Execution:   &commonpb.WorkflowExecution{WorkflowId: WorkflowIDPrefix + id},
+		})
+		if _, ok := err.(*serviceerror.NotFound); ok {
+			res.ScheduleIds = append(res.ScheduleIds, id)
+			continue
+		} else if err != nil {
+			return nil, translateError(err, "PollMutableState")
+		}
+		if pollRes.WorkflowStatus != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+			res.ScheduleIds = append(res.ScheduleIds, id)
+		}
+	}
+	return res, nil
+}
+
 func (a *activities) waitForRateLimiterPermission(req *schedulespb.StartWorkflowRequest) error {
 	if req.CompletedRateLimitSleep {
 		return nil
@@ -0,0 +1,53 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"net/url"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/components/callbacks"
+)
+
+func TestCheckSlaWebhookURL(t *testing.T) {
+	rules := []callbacks.AddressMatchRule{
+		{Regexp: regexp.MustCompile(`^hooks\.example\.com$`)},
+		{Regexp: regexp.MustCompile(`^localhost:.*$`), AllowInsecure: true},
+	}
+	check := func(raw string) error {
+		u, err := url.Parse(raw)
+		require.NoError(t, err)
+		return checkSlaWebhookURL(rules, u)
+	}
+
+	require.NoError(t, check("https://hooks.example.com/sla"))
+	require.NoError(t, check("http://localhost:8080/sla"))
+	require.ErrorContains(t, check("http://hooks.example.com/sla"), "insecure")
+	require.ErrorContains(t, check("https://internal.example.com/sla"), "does not match")
+	require.ErrorContains(t, check("ftp://hooks.example.com/sla"), "scheme")
+	require.Error(t, checkSlaWebhookURL(nil, &url.URL{Scheme: "https", Host: "hooks.example.com"}))
+}
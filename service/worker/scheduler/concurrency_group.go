@@ -0,0 +1,419 @@
+// The MIT License
+//
+// Copyright (c) 2025 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	sdklog "go.temporal.io/sdk/log"
+	"go.temporal.io/sdk/workflow"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
+)
+
+const (
+	ConcurrencyGroupWorkflowType     = "temporal-sys-scheduler-concurrency-group-workflow"
+	ConcurrencyGroupWorkflowIDPrefix = "temporal-sys-scheduler-group:"
+
+	// Signals sent to the concurrency group workflow by schedules
+	SignalNameConcurrencyAcquire = "acquire"
+	SignalNameConcurrencyRelease = "release"
+
+	// Signals sent to schedules
+	SignalNameSetConcurrencyGroup = "set-concurrency-group"
+	SignalNameConcurrencyPermit   = "concurrency-permit"
+
+	// The concurrency group workflow does continue-as-new after this many signals.
+	concurrencyGroupSignalsBeforeContinueAsNew = 500
+	// The concurrency group workflow exits after being idle (no holders or waiters) this long.
+	concurrencyGroupIdleTimeout = time.Hour
+	// How often the concurrency group workflow looks for holders whose schedule was deleted.
+	// Deleting a schedule terminates its workflow, so it can't give back its permits itself.
+	concurrencyGroupHolderCheckInterval = 10 * time.Minute
+
+	// The group name is part of the concurrency group workflow id.
+	maxConcurrencyGroupNameLen = 200
+)
+
+type (
+	// concurrencyGroup hands out permits to start workflows to the schedules in a
+	// namespace-wide concurrency group. Schedules acquire a permit for each workflow they
+	// start and release it when the workflow closes.
+	concurrencyGroup struct {
+		*schedulespb.ConcurrencyGroupState
+
+		ctx    workflow.Context
+		logger sdklog.Logger
+
+		a *activities
+
+		// getLimit reads dynamic config, it must only be called in a side effect.
+		getLimit func(group string) int
+		limit    int
+
+		// When to look for deleted holders next, zero if there are no holders.
+		nextHolderCheck time.Time
+	}
+)
+
+func concurrencyGroupWorkflowWithLimit(
+	ctx workflow.Context,
+	state *schedulespb.ConcurrencyGroupState,
+	getLimit func(group string) int,
+) error {
+	g := &concurrencyGroup{
+		ConcurrencyGroupState: state,
+		ctx:                   ctx,
+		logger:                sdklog.With(workflow.GetLogger(ctx), "wf-namespace", state.Namespace, "concurrency-group", state.Group),
+		getLimit:              getLimit,
+	}
+	return g.run()
+}
+
+func (g *concurrencyGroup) run() error {
+	if g.Holders == nil {
+		g.Holders = make(map[string]int32)
+	}
+
+	acquireCh := workflow.GetSignalChannel(g.ctx, SignalNameConcurrencyAcquire)
+	releaseCh := workflow.GetSignalChannel(g.ctx, SignalNameConcurrencyRelease)
+	signals := 0
+
+	for {
+		g.updateLimit()
+		g.reclaimDeletedHolders()
+		g.grant()
+
+		if signals >= concurrencyGroupSignalsBeforeContinueAsNew || workflow.GetInfo(g.ctx).GetContinueAsNewSuggested() {
+			break
+		}
+
+		sel := workflow.NewSelector(g.ctx)
+		sel.AddReceive(acquireCh, func(ch workflow.ReceiveChannel, _ bool) {
+			var req *schedulespb.ConcurrencyGroupAcquireRequest
+			ch.Receive(g.ctx, &req)
+			g.acquire(req)
+			signals++
+		})
+		sel.AddReceive(releaseCh, func(ch workflow.ReceiveChannel, _ bool) {
+			var req *schedulespb.ConcurrencyGroupReleaseRequest
+			ch.Receive(g.ctx, &req)
+			g.release(req)
+			signals++
+		})
+
+		idleTimedOut := false
+		timerCtx, cancelTimer := workflow.WithCancel(g.ctx)
+		if g.idle() {
+			sel.AddFuture(workflow.NewTimer(timerCtx, concurrencyGroupIdleTimeout), func(f workflow.Future) {
+				idleTimedOut = f.Get(g.ctx, nil) == nil
+			})
+		} else if len(g.Holders) > 0 {
+			if g.nextHolderCheck.IsZero() {
+				g.nextHolderCheck = workflow.Now(g.ctx).Add(concurrencyGroupHolderCheckInterval)
+			}
+			// reclaimDeletedHolders runs on the next iteration
+			sel.AddFuture(workflow.NewTimer(timerCtx, g.nextHolderCheck.Sub(workflow.Now(g.ctx))), func(workflow.Future) {})
+		}
+
+		sel.Select(g.ctx)
+		for sel.HasPending() {
+			sel.Select(g.ctx)
+		}
+		cancelTimer()
+
+		if idleTimedOut && !g.drainSignals(acquireCh, releaseCh) {
+			g.logger.Debug("Concurrency group idle, exiting")
+			return nil
+		}
+	}
+
+	g.drainSignals(acquireCh, releaseCh)
+	g.logger.Debug("Concurrency group doing continue-as-new")
+	return workflow.NewContinueAsNewError(g.ctx, ConcurrencyGroupWorkflowType, g.ConcurrencyGroupState)
+}
+
+// drainSignals processes signals that were received but not handled yet, so they are not lost
+// when the workflow closes. It returns true if there were any.
+func (g *concurrencyGroup) drainSignals(acquireCh, releaseCh workflow.ReceiveChannel) bool {
+	drained := false
+	for {
+		var req *schedulespb.ConcurrencyGroupAcquireRequest
+		if !acquireCh.ReceiveAsync(&req) {
+			break
+		}
+		g.acquire(req)
+		drained = true
+	}
+	for {
+		var req *schedulespb.ConcurrencyGroupReleaseRequest
+		if !releaseCh.ReceiveAsync(&req) {
+			break
+		}
+		g.release(req)
+		drained = true
+	}
+	return drained
+}
+
+func (g *concurrencyGroup) updateLimit() {
+	get := func(ctx workflow.Context) any {
+		return g.getLimit(g.Group)
+	}
+	eq := func(a, b any) bool {
+		return a.(int) == b.(int)
+	}
+	if err := workflow.MutableSideEffect(g.ctx, "limit", get, eq).Get(&g.limit); err != nil {
+		g.logger.Error("error getting concurrency group limit", "error", err)
+	}
+}
+
+// reclaimDeletedHolders releases the permits held by schedules that were deleted, at most once
+// per concurrencyGroupHolderCheckInterval.
+func (g *concurrencyGroup) reclaimDeletedHolders() {
+	if len(g.Holders) == 0 {
+		g.nextHolderCheck = time.Time{}
+		return
+	} else if g.nextHolderCheck.IsZero() || workflow.Now(g.ctx).Before(g.nextHolderCheck) {
+		return
+	}
+	g.nextHolderCheck = time.Time{}
+
+	options := defaultLocalActivityOptions
+	options.ScheduleToCloseTimeout = time.Minute
+	ctx := workflow.WithLocalActivityOptions(g.ctx, options)
+	req := &schedulespb.FindDeletedSchedulesRequest{
+		ScheduleIds: workflow.DeterministicKeys(g.Holders),
+	}
+	var res schedulespb.FindDeletedSchedulesResponse
+	if err := workflow.ExecuteLocalActivity(ctx, g.a.FindDeletedSchedules, req).Get(g.ctx, &res); err != nil {
+		g.logger.Error("error looking for deleted schedules", "error", err)
+		return
+	}
+	for _, id := range res.ScheduleIds {
+		g.logger.Info("Releasing concurrency permits of deleted schedule", "schedule-id", id, "permits", g.Holders[id])
+		delete(g.Holders, id)
+		g.Waiting = slices.DeleteFunc(g.Waiting, func(w *schedulespb.ConcurrencyGroupAcquireRequest) bool {
+			return w.ScheduleId == id
+		})
+	}
+}
+
+func (g *concurrencyGroup) idle() bool {
+	return len(g.Holders) == 0 && len(g.Waiting) == 0
+}
+
+func (g *concurrencyGroup) held() int {
+	total := 0
+	for _, n := range g.Holders {
+		total += int(n)
+	}
+	return total
+}
+
+func (g *concurrencyGroup) acquire(req *schedulespb.ConcurrencyGroupAcquireRequest) {
+	// a schedule asks for one permit at a time, a repeated request only updates the priority
+	matchSchedule := func(w *schedulespb.ConcurrencyGroupAcquireRequest) bool { return w.ScheduleId == req.ScheduleId }
+	if idx := slices.IndexFunc(g.Waiting, matchSchedule); idx >= 0 {
+		g.Waiting[idx].Priority = req.Priority
+		return
+	}
+	g.Waiting = append(g.Waiting, req)
+}
+
+func (g *concurrencyGroup) release(req *schedulespb.ConcurrencyGroupReleaseRequest) {
+	if n := g.Holders[req.ScheduleId] - req.Count; n > 0 {
+		g.Holders[req.ScheduleId] = n
+	} else {
+		delete(g.Holders, req.ScheduleId)
+	}
+}
+
+// nextWaiter picks the waiting schedule to grant the next permit to: the highest priority
+// first, then the schedule holding the fewest permits, then the one that asked first.
+func (g *concurrencyGroup) nextWaiter() int {
+	best := 0
+	for i, w := range g.Waiting[1:] {
+		b := g.Waiting[best]
+		if w.Priority > b.Priority ||
+			(w.Priority == b.Priority && g.Holders[w.ScheduleId] < g.Holders[b.ScheduleId]) {
+			best = i + 1
+		}
+	}
+	return best
+}
+
+func (g *concurrencyGroup) grant() {
+	for len(g.Waiting) > 0 && (g.limit <= 0 || g.held() < g.limit) {
+		idx := g.nextWaiter()
+		w := g.Waiting[idx]
+		g.Waiting = slices.Delete(g.Waiting, idx, idx+1)
+
+		err := workflow.SignalExternalWorkflow(g.ctx, WorkflowIDPrefix+w.ScheduleId, "", SignalNameConcurrencyPermit, g.Group).Get(g.ctx, nil)
+		if err != nil {
+			// most likely the schedule was deleted
+			g.logger.Warn("Failed to grant concurrency permit", "schedule-id", w.ScheduleId, "error", err)
+			continue
+		}
+		g.Holders[w.ScheduleId]++
+	}
+}
+
+// ValidateConcurrencyGroupName checks the name of a concurrency group to join. Empty means no
+// group.
+func ValidateConcurrencyGroupName(name string) error {
+	if len(name) > maxConcurrencyGroupNameLen {
+		return fmt.Errorf("concurrency group name is longer than %d characters", maxConcurrencyGroupNameLen)
+	}
+	if strings.ContainsFunc(name, func(r rune) bool { return r <= ' ' }) {
+		return errors.New("concurrency group name contains whitespace or control characters")
+	}
+	return nil
+}
+
+func (s *scheduler) processSetConcurrencyGroup(req *schedulespb.SetConcurrencyGroupRequest) {
+	if err := s.checkConflict(req.ConflictToken); err != nil {
+		s.logger.Warn("Set concurrency group conflicted with concurrent change")
+		return
+	}
+	if err := ValidateConcurrencyGroupName(req.Group); err != nil {
+		s.logger.Warn("Ignoring invalid concurrency group", "error", err)
+		return
+	}
+
+	s.logger.Debug("Set schedule concurrency group", "group", req.Group, "priority", req.Priority)
+
+	if req.Group != s.State.ConcurrencyGroup {
+		// give back everything we hold in the old group, workflows that are still running are
+		// not limited by the new group
+		held := s.State.ConcurrencyPermits + int32(len(s.State.ConcurrencyPermitWorkflowIds))
+		s.releaseConcurrencyPermits(s.State.ConcurrencyGroup, held)
+		s.State.ConcurrencyPermits = 0
+		s.State.ConcurrencyPermitRequested = false
+		s.State.ConcurrencyPermitWorkflowIds = nil
+	}
+
+	s.State.ConcurrencyGroup = req.Group
+	s.State.ConcurrencyPriority = req.Priority
+	s.incSeqNo()
+}
+
+// processConcurrencyPermit is called for each permit granted by a concurrency group.
+func (s *scheduler) processConcurrencyPermit(group string) {
+	if group != s.State.ConcurrencyGroup {
+		// we left the group after asking for this one
+		s.releaseConcurrencyPermits(group, 1)
+		return
+	}
+	s.State.ConcurrencyPermits++
+	s.State.ConcurrencyPermitRequested = false
+}
+
+// takeConcurrencyPermit returns true if the schedule may start a workflow now. Schedules that
+// aren't in a concurrency group always may.
+func (s *scheduler) takeConcurrencyPermit() bool {
+	if s.State.ConcurrencyGroup == "" {
+		return true
+	}
+	if s.State.ConcurrencyPermits <= 0 {
+		return false
+	}
+	s.State.ConcurrencyPermits--
+	return true
+}
+
+// returnConcurrencyPermit undoes takeConcurrencyPermit for a start that didn't happen.
+func (s *scheduler) returnConcurrencyPermit() {
+	if s.State.ConcurrencyGroup != "" {
+		s.State.ConcurrencyPermits++
+	}
+}
+
+// recordConcurrencyPermitWorkflow records that a started workflow holds a permit until it
+// closes.
+func (s *scheduler) recordConcurrencyPermitWorkflow(id string) {
+	s.State.ConcurrencyPermitWorkflowIds = append(s.State.ConcurrencyPermitWorkflowIds, id)
+}
+
+// releaseConcurrencyPermitWorkflow releases the permit held by a workflow that closed.
+func (s *scheduler) releaseConcurrencyPermitWorkflow(id string) {
+	if idx := slices.Index(s.State.ConcurrencyPermitWorkflowIds, id); idx >= 0 {
+		s.State.ConcurrencyPermitWorkflowIds = slices.Delete(s.State.ConcurrencyPermitWorkflowIds, idx, idx+1)
+		s.releaseConcurrencyPermits(s.State.ConcurrencyGroup, 1)
+	}
+}
+
+// releaseUnusedConcurrencyPermits gives back permits that we don't need anymore, e.g. because
+// the buffered starts were skipped by the overlap policy.
+func (s *scheduler) releaseUnusedConcurrencyPermits() {
+	if s.State.ConcurrencyPermits > 0 && len(s.State.BufferedStarts) == 0 {
+		s.releaseConcurrencyPermits(s.State.ConcurrencyGroup, s.State.ConcurrencyPermits)
+		s.State.ConcurrencyPermits = 0
+	}
+}
+
+func (s *scheduler) requestConcurrencyPermit() {
+	if s.State.ConcurrencyPermitRequested {
+		return
+	}
+	err := s.signalConcurrencyGroup(&schedulespb.SignalConcurrencyGroupRequest{
+		Group: s.State.ConcurrencyGroup,
+		Request: &schedulespb.SignalConcurrencyGroupRequest_Acquire{
+			Acquire: &schedulespb.ConcurrencyGroupAcquireRequest{
+				ScheduleId: s.State.ScheduleId,
+				Priority:   s.State.ConcurrencyPriority,
+			},
+		},
+	})
+	// if this failed, we'll try again on the next wakeup
+	s.State.ConcurrencyPermitRequested = err == nil
+}
+
+func (s *scheduler) releaseConcurrencyPermits(group string, count int32) {
+	if group == "" || count <= 0 {
+		return
+	}
+	_ = s.signalConcurrencyGroup(&schedulespb.SignalConcurrencyGroupRequest{
+		Group: group,
+		Request: &schedulespb.SignalConcurrencyGroupRequest_Release{
+			Release: &schedulespb.ConcurrencyGroupReleaseRequest{
+				ScheduleId: s.State.ScheduleId,
+				Count:      count,
+			},
+		},
+	})
+}
+
+func (s *scheduler) signalConcurrencyGroup(req *schedulespb.SignalConcurrencyGroupRequest) error {
+	options := defaultLocalActivityOptions
+	options.ScheduleToCloseTimeout = time.Minute
+	ctx := workflow.WithLocalActivityOptions(s.ctx, options)
+	err := workflow.ExecuteLocalActivity(ctx, s.a.SignalConcurrencyGroup, req).Get(s.ctx, nil)
+	if err != nil {
+		s.logger.Error("error signaling concurrency group", "group", req.Group, "error", err)
+	}
+	return err
+}
@@ -0,0 +1,159 @@
+// The MIT License
+//
+// Copyright (c) 2025 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
+)
+
+type (
+	concurrencyGroupSuite struct {
+		suite.Suite
+		testsuite.WorkflowTestSuite
+		env *testsuite.TestWorkflowEnvironment
+
+		granted []string
+	}
+)
+
+func TestConcurrencyGroup(t *testing.T) {
+	suite.Run(t, new(concurrencyGroupSuite))
+}
+
+func (s *concurrencyGroupSuite) SetupTest() {
+	s.env = s.NewTestWorkflowEnvironment()
+	s.granted = nil
+	s.env.OnSignalExternalWorkflow(mock.Anything, mock.Anything, "", SignalNameConcurrencyPermit, "mygroup").Return(
+		func(_ string, workflowID, _, _ string, _ any) error {
+			s.granted = append(s.granted, workflowID[len(WorkflowIDPrefix):])
+			return nil
+		})
+}
+
+func (s *concurrencyGroupSuite) AfterTest(suiteName, testName string) {
+	s.env.AssertExpectations(s.T())
+}
+
+func (s *concurrencyGroupSuite) run(limit int) {
+	wf := func(ctx workflow.Context, state *schedulespb.ConcurrencyGroupState) error {
+		return concurrencyGroupWorkflowWithLimit(ctx, state, func(string) int { return limit })
+	}
+	s.env.ExecuteWorkflow(wf, &schedulespb.ConcurrencyGroupState{
+		Namespace: "myns",
+		Group:     "mygroup",
+	})
+}
+
+func (s *concurrencyGroupSuite) acquire(scheduleID string, priority int32, delay time.Duration) {
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalNameConcurrencyAcquire, &schedulespb.ConcurrencyGroupAcquireRequest{
+			ScheduleId: scheduleID,
+			Priority:   priority,
+		})
+	}, delay)
+}
+
+func (s *concurrencyGroupSuite) release(scheduleID string, delay time.Duration) {
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalNameConcurrencyRelease, &schedulespb.ConcurrencyGroupReleaseRequest{
+			ScheduleId: scheduleID,
+			Count:      1,
+		})
+	}, delay)
+}
+
+func (s *concurrencyGroupSuite) TestGrantOrder() {
+	s.acquire("a", 0, 1*time.Minute)
+	s.acquire("a", 0, 2*time.Minute)
+	// limit reached
+	s.acquire("a", 0, 3*time.Minute)
+	s.acquire("b", 0, 4*time.Minute)
+	s.acquire("c", 5, 5*time.Minute)
+	s.acquire("b", 0, 6*time.Minute) // duplicate request
+	s.env.RegisterDelayedCallback(func() {
+		s.Equal([]string{"a", "a"}, s.granted)
+	}, 7*time.Minute)
+	// c has the highest priority
+	s.release("a", 8*time.Minute)
+	// a and b have the same priority, but b holds fewer permits
+	s.release("c", 9*time.Minute)
+	s.release("b", 10*time.Minute)
+	s.release("a", 11*time.Minute)
+	s.release("a", 12*time.Minute)
+
+	s.run(2)
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+	s.Equal([]string{"a", "a", "c", "b", "a"}, s.granted)
+}
+
+func (s *concurrencyGroupSuite) TestUnlimited() {
+	s.acquire("a", 0, 1*time.Minute)
+	s.acquire("b", 0, 1*time.Minute)
+	s.acquire("a", 0, 2*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		s.Equal([]string{"a", "b", "a"}, s.granted)
+	}, 3*time.Minute)
+	s.release("a", 4*time.Minute)
+	s.release("a", 4*time.Minute)
+	s.release("b", 4*time.Minute)
+
+	s.run(0)
+
+	// exits after being idle
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+func (s *concurrencyGroupSuite) TestReclaimDeletedHolder() {
+	s.env.OnActivity(new(activities).FindDeletedSchedules, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, req *schedulespb.FindDeletedSchedulesRequest) (*schedulespb.FindDeletedSchedulesResponse, error) {
+			// a was deleted without releasing its permit
+			s.Equal([]string{"a"}, req.ScheduleIds)
+			return &schedulespb.FindDeletedSchedulesResponse{ScheduleIds: []string{"a"}}, nil
+		}).Once()
+
+	s.acquire("a", 0, 1*time.Minute)
+	s.acquire("b", 0, 2*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		s.Equal([]string{"a"}, s.granted)
+	}, 3*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		s.Equal([]string{"a", "b"}, s.granted)
+	}, concurrencyGroupHolderCheckInterval+2*time.Minute)
+	s.release("b", concurrencyGroupHolderCheckInterval+3*time.Minute)
+
+	s.run(1)
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
@@ -0,0 +1,117 @@
+// The MIT License
+//
+// Copyright (c) 2025 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"slices"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// FilterDependencies returns the dependencies of the given schedule that name an upstream schedule, other than the
+// schedule itself, for the first time. The schedule IDs of the dropped dependencies are returned separately.
+func FilterDependencies(
+	scheduleID string,
+	deps []*schedulespb.ScheduleDependency,
+) (valid []*schedulespb.ScheduleDependency, dropped []string) {
+	for _, dep := range deps {
+		if dep.GetScheduleId() == "" || dep.GetScheduleId() == scheduleID || slices.ContainsFunc(valid, func(d *schedulespb.ScheduleDependency) bool {
+			return d.ScheduleId == dep.ScheduleId
+		}) {
+			dropped = append(dropped, dep.GetScheduleId())
+			continue
+		}
+		valid = append(valid, dep)
+	}
+	return valid, dropped
+}
+
+// SatisfyDependency records a closed action of an upstream schedule in satisfied, and returns the updated map. Once
+// every dependency is satisfied, it also returns true and the nominal time of the dependency-triggered action, which
+// is the latest upstream close time. The returned map is then empty, to wait for the next round of upstream actions.
+// Actions of schedules that are not dependencies, or that closed with a non-matching status, are ignored.
+func SatisfyDependency(
+	deps []*schedulespb.ScheduleDependency,
+	satisfied map[string]*timestamppb.Timestamp,
+	completed *schedulespb.UpstreamActionCompleted,
+	now time.Time,
+) (map[string]*timestamppb.Timestamp, time.Time, bool) {
+	matchDep := func(d *schedulespb.ScheduleDependency) bool { return d.ScheduleId == completed.GetScheduleId() }
+	idx := slices.IndexFunc(deps, matchDep)
+	if idx < 0 || !dependencySatisfiedBy(deps[idx], completed.GetStatus()) {
+		return satisfied, time.Time{}, false
+	}
+
+	closeTime := completed.GetCloseTime()
+	if closeTime == nil {
+		closeTime = timestamppb.New(now)
+	}
+	if satisfied == nil {
+		satisfied = make(map[string]*timestamppb.Timestamp)
+	}
+	satisfied[completed.GetScheduleId()] = closeTime
+
+	var nominalTime time.Time
+	for _, dep := range deps {
+		t, ok := satisfied[dep.ScheduleId]
+		if !ok {
+			return satisfied, time.Time{}, false
+		}
+		if t.AsTime().After(nominalTime) {
+			nominalTime = t.AsTime()
+		}
+	}
+	return nil, nominalTime, true
+}
+
+// RegisterDependent adds or, when unregister is set on the request, removes a dependent schedule ID.
+func RegisterDependent(dependents []string, req *schedulespb.RegisterDependentRequest) []string {
+	idx := slices.Index(dependents, req.GetScheduleId())
+	if req.GetUnregister() {
+		if idx >= 0 {
+			return slices.Delete(dependents, idx, idx+1)
+		}
+	} else if idx < 0 && req.GetScheduleId() != "" {
+		return append(dependents, req.GetScheduleId())
+	}
+	return dependents
+}
+
+// DependencyScheduleIDs returns the upstream schedule IDs of the dependencies.
+func DependencyScheduleIDs(deps []*schedulespb.ScheduleDependency) []string {
+	ids := make([]string, 0, len(deps))
+	for _, dep := range deps {
+		ids = append(ids, dep.ScheduleId)
+	}
+	return ids
+}
+
+func dependencySatisfiedBy(dep *schedulespb.ScheduleDependency, status enumspb.WorkflowExecutionStatus) bool {
+	if len(dep.Statuses) == 0 {
+		return status == enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
+	}
+	return slices.Contains(dep.Statuses, status)
+}
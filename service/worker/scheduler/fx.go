@@ -41,6 +41,7 @@ import (
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/components/callbacks"
 	workercommon "go.temporal.io/server/service/worker/common"
 	"go.uber.org/fx"
 )
@@ -70,6 +71,10 @@ type (
 		globalNSStartWorkflowRPS dynamicconfig.TypedSubscribableWithNamespaceFilter[float64]
 		maxBlobSize              dynamicconfig.IntPropertyFnWithNamespaceFilter
 		localActivitySleepLimit  dynamicconfig.DurationPropertyFnWithNamespaceFilter
+		slaWebhookEnabled        dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		slaWebhookTimeout        dynamicconfig.DurationPropertyFnWithNamespaceFilter
+		slaWebhookAddresses      dynamicconfig.TypedPropertyFnWithNamespaceFilter[[]callbacks.AddressMatchRule]
+		concurrencyGroupLimits   dynamicconfig.TypedPropertyFnWithNamespaceFilter[map[string]int]
 	}
 
 	activityDeps struct {
@@ -88,9 +93,13 @@ type (
 
 var Module = fx.Options(
 	fx.Provide(NewResult),
-	fx.Provide(NewSpecBuilder),
+	fx.Provide(newSpecBuilder),
 )
 
+func newSpecBuilder(registry namespace.Registry) *SpecBuilder {
+	return NewSpecBuilderWithSharedCalendars(NamespaceSharedCalendarLookup(registry))
+}
+
 func NewResult(
 	dc *dynamicconfig.Collection,
 	specBuilder *SpecBuilder,
@@ -104,6 +113,10 @@ func NewResult(
 			globalNSStartWorkflowRPS: dynamicconfig.SchedulerNamespaceStartWorkflowRPS.Subscribe(dc),
 			maxBlobSize:              dynamicconfig.BlobSizeLimitError.Get(dc),
 			localActivitySleepLimit:  dynamicconfig.SchedulerLocalActivitySleepLimit.Get(dc),
+			slaWebhookEnabled:        dynamicconfig.SchedulerSLAWebhookEnabled.Get(dc),
+			slaWebhookTimeout:        dynamicconfig.SchedulerSLAWebhookTimeout.Get(dc),
+			slaWebhookAddresses:      callbacks.AllowedAddresses.Get(dc),
+			concurrencyGroupLimits:   dynamicconfig.SchedulerConcurrencyGroupLimits.Get(dc),
 		},
 	}
 }
@@ -120,6 +133,14 @@ func (s *workerComponent) Register(registry sdkworker.Registry, ns *namespace.Na
 	}
 	registry.RegisterWorkflowWithOptions(wfFunc, workflow.RegisterOptions{Name: WorkflowType})
 
+	groupLimit := func(group string) int {
+		return s.concurrencyGroupLimits(ns.Name().String())[group]
+	}
+	groupFunc := func(ctx workflow.Context, state *schedulespb.ConcurrencyGroupState) error {
+		return concurrencyGroupWorkflowWithLimit(ctx, state, groupLimit)
+	}
+	registry.RegisterWorkflowWithOptions(groupFunc, workflow.RegisterOptions{Name: ConcurrencyGroupWorkflowType})
+
 	activities, cleanup := s.newActivities(ns.Name(), ns.ID(), details)
 	registry.RegisterActivity(activities)
 	return cleanup
@@ -144,5 +165,8 @@ func (s *workerComponent) newActivities(name namespace.Name, id namespace.ID, de
 		startWorkflowRateLimiter: lim,
 		maxBlobSize:              func() int { return s.maxBlobSize(name.String()) },
 		localActivitySleepLimit:  func() time.Duration { return s.localActivitySleepLimit(name.String()) },
+		slaWebhookEnabled:        func() bool { return s.slaWebhookEnabled(name.String()) },
+		slaWebhookTimeout:        func() time.Duration { return s.slaWebhookTimeout(name.String()) },
+		slaWebhookAddresses:      func() []callbacks.AddressMatchRule { return s.slaWebhookAddresses(name.String()) },
 	}, cancel
 }
@@ -0,0 +1,165 @@
+// The MIT License
+//
+// Copyright (c) 2025 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+	"time"
+
+	schedulespb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/namespace"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// Shared calendars are stored in namespace data under this prefix plus the calendar name.
+	SharedCalendarDataKeyPrefix = "temporal-sys-schedule-calendar:"
+
+	maxSharedCalendarNameLen = 200
+)
+
+type (
+	// SharedCalendarLookup returns the encoded shared calendar with the given name in a
+	// namespace, or false if it doesn't exist.
+	SharedCalendarLookup func(namespaceID namespace.ID, name string) (string, bool)
+
+	sharedCalendarKey struct {
+		namespaceID namespace.ID
+		name        string
+		tz          *time.Location
+	}
+
+	compiledSharedCalendar struct {
+		encoded   string
+		calendars []*compiledCalendar
+	}
+)
+
+// NewSpecBuilderWithSharedCalendars returns a SpecBuilder that resolves shared calendar
+// references with the given lookup.
+func NewSpecBuilderWithSharedCalendars(lookup SharedCalendarLookup) *SpecBuilder {
+	b := NewSpecBuilder()
+	b.sharedCalendars = lookup
+	b.sharedCalendarCache = cache.New(1000, nil)
+	return b
+}
+
+// NamespaceSharedCalendarLookup looks up shared calendars in namespace data.
+func NamespaceSharedCalendarLookup(registry namespace.Registry) SharedCalendarLookup {
+	return func(namespaceID namespace.ID, name string) (string, bool) {
+		ns, err := registry.GetNamespaceByID(namespaceID)
+		if err != nil {
+			return "", false
+		}
+		encoded := ns.GetCustomData(SharedCalendarDataKey(name))
+		return encoded, encoded != ""
+	}
+}
+
+func SharedCalendarDataKey(name string) string {
+	return SharedCalendarDataKeyPrefix + name
+}
+
+func ValidateSharedCalendar(cal *schedulespb.SharedCalendar) error {
+	if err := ValidateSharedCalendarName(cal.GetName()); err != nil {
+		return err
+	}
+	for _, structured := range cal.GetCalendars() {
+		if err := validateStructuredCalendar(structured); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func ValidateSharedCalendarName(name string) error {
+	if name == "" {
+		return errors.New("shared calendar name is not set")
+	}
+	if len(name) > maxSharedCalendarNameLen {
+		return fmt.Errorf("shared calendar name is longer than %d characters", maxSharedCalendarNameLen)
+	}
+	if strings.ContainsFunc(name, func(r rune) bool { return r <= ' ' }) {
+		return errors.New("shared calendar name contains whitespace or control characters")
+	}
+	return nil
+}
+
+func EncodeSharedCalendar(cal *schedulespb.SharedCalendar) (string, error) {
+	data, err := protojson.Marshal(cal)
+	return string(data), err
+}
+
+func DecodeSharedCalendar(encoded string) (*schedulespb.SharedCalendar, error) {
+	var cal schedulespb.SharedCalendar
+	if err := protojson.Unmarshal([]byte(encoded), &cal); err != nil {
+		return nil, err
+	}
+	return &cal, nil
+}
+
+// SharedCalendarsVersion returns a fingerprint of the stored shared calendars with the given names, which changes
+// whenever one of them is added, updated or deleted.
+func (b *SpecBuilder) SharedCalendarsVersion(namespaceID namespace.ID, names []string) string {
+	if b.sharedCalendars == nil || namespaceID == "" || len(names) == 0 {
+		return ""
+	}
+	h := fnv.New64a()
+	for _, name := range names {
+		encoded, _ := b.sharedCalendars(namespaceID, name)
+		_, _ = h.Write([]byte(encoded))
+		_, _ = h.Write([]byte{0})
+	}
+	return strconv.FormatUint(h.Sum64(), 16)
+}
+
+// getSharedCalendar returns the compiled calendars of a shared calendar, recompiling them if
+// the stored calendar changed. Missing or invalid calendars don't match any time.
+func (b *SpecBuilder) getSharedCalendar(namespaceID namespace.ID, name string, tz *time.Location) []*compiledCalendar {
+	if b.sharedCalendars == nil || namespaceID == "" {
+		return nil
+	}
+	encoded, ok := b.sharedCalendars(namespaceID, name)
+	if !ok {
+		return nil
+	}
+
+	key := sharedCalendarKey{namespaceID: namespaceID, name: name, tz: tz}
+	if cached, ok := b.sharedCalendarCache.Get(key).(*compiledSharedCalendar); ok && cached.encoded == encoded {
+		return cached.calendars
+	}
+
+	compiled := &compiledSharedCalendar{encoded: encoded}
+	if cal, err := DecodeSharedCalendar(encoded); err == nil {
+		for _, structured := range cal.GetCalendars() {
+			compiled.calendars = append(compiled.calendars, newCompiledCalendar(structured, tz))
+		}
+	}
+	b.sharedCalendarCache.Put(key, compiled)
+	return compiled.calendars
+}
@@ -0,0 +1,283 @@
+// The MIT License
+//
+// Copyright (c) 2025 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	schedulepb "go.temporal.io/api/schedule/v1"
+	"go.temporal.io/sdk/workflow"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payload"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SLA violation types, used in metrics, search attributes and webhook notifications.
+const (
+	SlaViolationLateStart           = "late_start"
+	SlaViolationConsecutiveFailures = "consecutive_failures"
+	SlaViolationMaxDuration         = "max_duration"
+	SlaViolationOverlapSkipped      = "overlap_skipped"
+)
+
+// slaNexusOperationTimeout bounds how long an SLA Nexus operation may take, including retries of
+// its start request.
+const slaNexusOperationTimeout = 10 * time.Minute
+
+// ValidateSla checks the parts of an SLA that don't depend on namespace configuration. A nil SLA
+// is valid and removes the SLA.
+func ValidateSla(sla *schedulespb.ScheduleSla) error {
+	if sla.GetMaxStartDelay().AsDuration() < 0 || sla.GetMaxDuration().AsDuration() < 0 {
+		return errors.New("SLA durations must not be negative")
+	}
+	if sla.GetMaxConsecutiveFailures() < 0 {
+		return errors.New("SLA max consecutive failures must not be negative")
+	}
+	nexusSet := 0
+	for _, f := range []string{sla.GetNexusEndpoint(), sla.GetNexusService(), sla.GetNexusOperation()} {
+		if f != "" {
+			nexusSet++
+		}
+	}
+	if nexusSet != 0 && nexusSet != 3 {
+		return errors.New("SLA Nexus endpoint, service and operation must be set together")
+	}
+	return nil
+}
+
+func (s *scheduler) processSetSla(req *schedulespb.SetSlaRequest) {
+	if err := s.checkConflict(req.ConflictToken); err != nil {
+		s.logger.Warn("Set SLA conflicted with concurrent change")
+		return
+	}
+	if err := ValidateSla(req.Sla); err != nil {
+		s.logger.Warn("Ignoring invalid SLA", "error", err)
+		return
+	}
+
+	s.logger.Debug("Set schedule SLA")
+
+	// clear the search attribute if the schedule stops using it
+	if prev := s.State.Sla.GetSearchAttribute(); prev != "" && prev != req.Sla.GetSearchAttribute() {
+		//nolint:staticcheck // SA1019 The untyped function here is more convenient.
+		if err := workflow.UpsertSearchAttributes(s.ctx, map[string]any{prev: nil}); err != nil {
+			s.logger.Error("error clearing SLA search attribute", "error", err)
+		}
+	}
+
+	s.State.Sla = req.Sla
+	// start evaluating the new SLA from scratch
+	s.State.ActiveSlaViolations = nil
+	s.State.OverDurationWorkflowIds = nil
+	s.incSeqNo()
+}
+
+// slaWatchesWorkflows returns true if the SLA needs to see started workflows close.
+func (s *scheduler) slaWatchesWorkflows() bool {
+	return s.State.Sla.GetMaxDuration().AsDuration() > 0 || s.State.Sla.GetMaxConsecutiveFailures() > 0
+}
+
+func (s *scheduler) recordSlaViolation(violationType, workflowID, message string) {
+	s.logger.Warn("Schedule SLA violated", "type", violationType, "workflow", workflowID, "message", message)
+	s.metrics.WithTags(map[string]string{
+		metrics.ScheduleSlaViolationTypeTag: violationType,
+	}).Counter(metrics.ScheduleSlaViolations.Name()).Inc(1)
+
+	if !slices.Contains(s.State.ActiveSlaViolations, violationType) {
+		s.State.ActiveSlaViolations = append(s.State.ActiveSlaViolations, violationType)
+	}
+
+	violation := &schedulespb.SlaViolation{
+		Namespace:  s.State.Namespace,
+		ScheduleId: s.State.ScheduleId,
+		Type:       violationType,
+		WorkflowId: workflowID,
+		Message:    message,
+		Time:       timestamppb.New(s.now()),
+	}
+
+	if url := s.State.Sla.GetWebhookUrl(); url != "" {
+		options := defaultLocalActivityOptions
+		options.ScheduleToCloseTimeout = time.Minute
+		ctx := workflow.WithLocalActivityOptions(s.ctx, options)
+		req := &schedulespb.NotifySlaViolationRequest{
+			WebhookUrl: url,
+			Violation:  violation,
+		}
+		if err := workflow.ExecuteLocalActivity(ctx, s.a.NotifySlaViolation, req).Get(s.ctx, nil); err != nil {
+			s.logger.Error("error notifying SLA webhook", "error", err)
+		}
+	}
+
+	if endpoint := s.State.Sla.GetNexusEndpoint(); endpoint != "" {
+		s.notifySlaNexusOperation(endpoint, violation)
+	}
+}
+
+// notifySlaNexusOperation starts the SLA's Nexus operation for a violation without blocking the
+// schedule. The notification counts as delivered once the operation started; its result is
+// ignored.
+func (s *scheduler) notifySlaNexusOperation(endpoint string, violation *schedulespb.SlaViolation) {
+	client := workflow.NewNexusClient(endpoint, s.State.Sla.GetNexusService())
+	f := client.ExecuteOperation(s.ctx, s.State.Sla.GetNexusOperation(), violation, workflow.NexusOperationOptions{
+		ScheduleToCloseTimeout: slaNexusOperationTimeout,
+	})
+	s.outstandingSignals++
+	workflow.Go(s.ctx, func(ctx workflow.Context) {
+		defer func() { s.outstandingSignals-- }()
+		if err := f.GetNexusOperationExecution().Get(ctx, nil); err != nil {
+			s.logger.Error("error starting SLA Nexus operation", "endpoint", endpoint, "error", err)
+		}
+	})
+}
+
+func (s *scheduler) clearSlaViolation(violationType string) {
+	if idx := slices.Index(s.State.ActiveSlaViolations, violationType); idx >= 0 {
+		s.State.ActiveSlaViolations = slices.Delete(s.State.ActiveSlaViolations, idx, idx+1)
+	}
+}
+
+// checkStartSla is called after a scheduled (not manual) action started a workflow.
+func (s *scheduler) checkStartSla(start *schedulespb.BufferedStart, result *schedulepb.ScheduleActionResult) {
+	if s.State.Sla == nil {
+		return
+	}
+	// an action was taken, so we're no longer skipping them
+	s.clearSlaViolation(SlaViolationOverlapSkipped)
+
+	maxDelay := s.State.Sla.GetMaxStartDelay().AsDuration()
+	if maxDelay <= 0 {
+		return
+	}
+	delay := result.GetActualTime().AsTime().Sub(start.GetActualTime().AsTime())
+	if delay > maxDelay {
+		s.recordSlaViolation(SlaViolationLateStart, result.GetStartWorkflowResult().GetWorkflowId(),
+			fmt.Sprintf("started %s after its scheduled time", delay))
+	} else {
+		s.clearSlaViolation(SlaViolationLateStart)
+	}
+}
+
+func (s *scheduler) checkOverlapSkipSla(skipped int64) {
+	if !s.State.Sla.GetOverlapSkipIsViolation() {
+		return
+	}
+	s.recordSlaViolation(SlaViolationOverlapSkipped, "", fmt.Sprintf("%d actions skipped due to overlap policy", skipped))
+}
+
+// checkClosedWorkflowSla is called once for each started workflow that closed.
+func (s *scheduler) checkClosedWorkflowSla(id string, status enumspb.WorkflowExecutionStatus, failed bool) {
+	if idx := slices.Index(s.State.OverDurationWorkflowIds, id); idx >= 0 {
+		s.State.OverDurationWorkflowIds = slices.Delete(s.State.OverDurationWorkflowIds, idx, idx+1)
+	}
+	if len(s.State.OverDurationWorkflowIds) == 0 {
+		s.clearSlaViolation(SlaViolationMaxDuration)
+	}
+
+	if failed {
+		s.State.ConsecutiveFailures++
+	} else if status == enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED {
+		s.State.ConsecutiveFailures = 0
+		s.clearSlaViolation(SlaViolationConsecutiveFailures)
+	}
+
+	maxFailures := s.State.Sla.GetMaxConsecutiveFailures()
+	if failed && maxFailures > 0 && s.State.ConsecutiveFailures >= maxFailures {
+		s.recordSlaViolation(SlaViolationConsecutiveFailures, id,
+			fmt.Sprintf("%d consecutive workflows failed", s.State.ConsecutiveFailures))
+	}
+}
+
+// checkDurationSla reports running workflows, including watched overlapping ones, that exceeded the SLA's max duration. It returns the time when the next running workflow would
+// exceed it, or zero if there is none.
+func (s *scheduler) checkDurationSla() time.Time {
+	maxDuration := s.State.Sla.GetMaxDuration().AsDuration()
+	if maxDuration <= 0 {
+		return time.Time{}
+	}
+
+	now := s.now()
+	var next time.Time
+	check := func(id string, started time.Time) {
+		if slices.Contains(s.State.OverDurationWorkflowIds, id) {
+			return
+		}
+		deadline := started.Add(maxDuration)
+		if !now.Before(deadline) {
+			s.State.OverDurationWorkflowIds = append(s.State.OverDurationWorkflowIds, id)
+			s.recordSlaViolation(SlaViolationMaxDuration, id, fmt.Sprintf("running for more than %s", maxDuration))
+		} else if next.IsZero() || deadline.Before(next) {
+			next = deadline
+		}
+	}
+	for _, ex := range s.Info.RunningWorkflows {
+		id := ex.GetWorkflowId()
+		matchRecent := func(a *schedulepb.ScheduleActionResult) bool { return a.GetStartWorkflowResult().GetWorkflowId() == id }
+		if idx := slices.IndexFunc(s.Info.RecentActions, matchRecent); idx >= 0 {
+			check(id, s.Info.RecentActions[idx].GetActualTime().AsTime())
+		}
+	}
+	for _, result := range s.State.WatchedWorkflows {
+		check(result.GetStartWorkflowResult().GetWorkflowId(), result.GetActualTime().AsTime())
+	}
+	return next
+}
+
+// updateSlaSearchAttribute keeps the SLA's search attribute set to the active violations.
+func (s *scheduler) updateSlaSearchAttribute() {
+	name := s.State.Sla.GetSearchAttribute()
+	if name == "" {
+		return
+	}
+
+	active := s.State.ActiveSlaViolations
+	//nolint:staticcheck // SA1019 Use untyped version for backwards compatibility.
+	currentPayload := workflow.GetInfo(s.ctx).SearchAttributes.GetIndexedFields()[name]
+	var current []string
+	if currentPayload == nil && len(active) == 0 {
+		return
+	} else if currentPayload != nil && payload.Decode(currentPayload, &current) == nil && slices.Equal(current, active) {
+		return
+	}
+
+	if active == nil {
+		active = []string{}
+	}
+	//nolint:staticcheck // SA1019 The untyped function here is more convenient.
+	if err := workflow.UpsertSearchAttributes(s.ctx, map[string]any{name: active}); err != nil {
+		s.logger.Error("error updating SLA search attribute", "error", err)
+	}
+}
+
+// earliestWakeup returns the earlier of two wakeup times, where zero means no wakeup.
+func earliestWakeup(a, b time.Time) time.Time {
+	if a.IsZero() || (!b.IsZero() && b.Before(a)) {
+		return b
+	}
+	return a
+}
@@ -35,6 +35,7 @@ import (
 	schedulepb "go.temporal.io/api/schedule/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/util"
 )
@@ -45,6 +46,13 @@ type (
 		tz       *time.Location
 		calendar []*compiledCalendar
 		excludes []*compiledCalendar
+
+		// Names of shared calendars whose times are excluded. These are resolved on each use
+		// (through builder) so that edits to a shared calendar are picked up without
+		// recompiling the spec.
+		builder        *SpecBuilder
+		namespaceID    namespace.ID
+		sharedExcludes []string
 	}
 
 	GetNextTimeResult struct {
@@ -59,6 +67,12 @@ type (
 		// the time zone database is changed while the process is running. To handle that, we
 		// expire entries after a day. Note that we cache negative results also.
 		locationCache cache.Cache
+
+		// sharedCalendars looks up shared calendars referenced by specs. It may be nil, in
+		// which case references don't match any time. sharedCalendarCache caches compiled
+		// shared calendars along with the encoded form they were compiled from.
+		sharedCalendars     SharedCalendarLookup
+		sharedCalendarCache cache.Cache
 	}
 
 	locationAndError struct {
@@ -78,6 +92,16 @@ func NewSpecBuilder() *SpecBuilder {
 }
 
 func (b *SpecBuilder) NewCompiledSpec(spec *schedulepb.ScheduleSpec) (*CompiledSpec, error) {
+	return b.NewCompiledSpecForNamespace("", spec, nil)
+}
+
+// NewCompiledSpecForNamespace compiles a spec that additionally excludes the times of the named
+// shared calendars of the given namespace.
+func (b *SpecBuilder) NewCompiledSpecForNamespace(
+	namespaceID namespace.ID,
+	spec *schedulepb.ScheduleSpec,
+	sharedExcludes []string,
+) (*CompiledSpec, error) {
 	spec, err := canonicalizeSpec(spec)
 	if err != nil {
 		return nil, err
@@ -102,10 +126,13 @@ func (b *SpecBuilder) NewCompiledSpec(spec *schedulepb.ScheduleSpec) (*CompiledS
 	}
 
 	cspec := &CompiledSpec{
-		spec:     spec,
-		tz:       tz,
-		calendar: ccs,
-		excludes: excludes,
+		spec:           spec,
+		tz:             tz,
+		calendar:       ccs,
+		excludes:       excludes,
+		builder:        b,
+		namespaceID:    namespaceID,
+		sharedExcludes: sharedExcludes,
 	}
 
 	return cspec, nil
@@ -369,6 +396,13 @@ func (cs *CompiledSpec) excluded(nominal time.Time) bool {
 			return true
 		}
 	}
+	for _, name := range cs.sharedExcludes {
+		for _, excal := range cs.builder.getSharedCalendar(cs.namespaceID, name, cs.tz) {
+			if excal.matches(nominal) {
+				return true
+			}
+		}
+	}
 	return false
 }
 
@@ -30,6 +30,8 @@ import (
 
 	"github.com/stretchr/testify/suite"
 	schedulepb "go.temporal.io/api/schedule/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/testing/protorequire"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -505,3 +507,53 @@ func (s *specSuite) TestSpecJitterSeed() {
 		time.Date(2022, 3, 24, 0, 39, 16, 922000000, time.UTC),
 	)
 }
+
+func (s *specSuite) TestSpecSharedCalendarExclude() {
+	stored := make(map[string]string)
+	s.specBuilder = NewSpecBuilderWithSharedCalendars(func(namespaceID namespace.ID, name string) (string, bool) {
+		encoded, ok := stored[string(namespaceID)+"/"+name]
+		return encoded, ok
+	})
+	store := func(hour string) {
+		structured, err := parseCalendarToStructured(&schedulepb.CalendarSpec{Hour: hour, Minute: "*", Second: "*"})
+		s.Require().NoError(err)
+		cal := &schedulespb.SharedCalendar{Name: "holidays", Calendars: []*schedulepb.StructuredCalendarSpec{structured}}
+		s.Require().NoError(ValidateSharedCalendar(cal))
+		encoded, err := EncodeSharedCalendar(cal)
+		s.Require().NoError(err)
+		stored["nsid/holidays"] = encoded
+	}
+	spec := &schedulepb.ScheduleSpec{
+		Interval: []*schedulepb.IntervalSpec{{
+			Interval: durationpb.New(time.Hour),
+		}},
+	}
+	cs, err := s.specBuilder.NewCompiledSpecForNamespace("nsid", spec, []string{"holidays"})
+	s.Require().NoError(err)
+	start := time.Date(2022, 3, 23, 1, 0, 0, 0, time.UTC)
+
+	// missing calendar doesn't exclude anything
+	s.Equal(time.Date(2022, 3, 23, 2, 0, 0, 0, time.UTC), cs.GetNextTime("", start).Next)
+
+	store("2")
+	s.Equal(time.Date(2022, 3, 23, 3, 0, 0, 0, time.UTC), cs.GetNextTime("", start).Next)
+
+	// edits are picked up by the same compiled spec
+	store("2-4")
+	s.Equal(time.Date(2022, 3, 23, 5, 0, 0, 0, time.UTC), cs.GetNextTime("", start).Next)
+
+	// references are resolved only within the namespace
+	other, err := s.specBuilder.NewCompiledSpecForNamespace("othernsid", spec, []string{"holidays"})
+	s.Require().NoError(err)
+	s.Equal(time.Date(2022, 3, 23, 2, 0, 0, 0, time.UTC), other.GetNextTime("", start).Next)
+}
+
+func (s *specSuite) TestValidateSharedCalendar() {
+	s.Error(ValidateSharedCalendar(&schedulespb.SharedCalendar{}))
+	s.Error(ValidateSharedCalendar(&schedulespb.SharedCalendar{Name: "two words"}))
+	s.Error(ValidateSharedCalendar(&schedulespb.SharedCalendar{
+		Name:      "badrange",
+		Calendars: []*schedulepb.StructuredCalendarSpec{{Hour: []*schedulepb.Range{{Start: 25}}}},
+	}))
+	s.NoError(ValidateSharedCalendar(&schedulespb.SharedCalendar{Name: "holidays"}))
+}
@@ -45,6 +45,7 @@ import (
 	schedulespb "go.temporal.io/server/api/schedule/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/searchattribute"
@@ -96,10 +97,15 @@ const (
 	// id, used for validation in the frontend.
 	AppendedTimestampForValidation = "-2009-11-10T23:00:00Z"
 
-	SignalNameUpdate   = "update"
-	SignalNamePatch    = "patch"
-	SignalNameRefresh  = "refresh"
-	SignalNameForceCAN = "force-continue-as-new"
+	SignalNameUpdate             = "update"
+	SignalNamePatch              = "patch"
+	SignalNameRefresh            = "refresh"
+	SignalNameForceCAN           = "force-continue-as-new"
+	SignalNameSetDependencies    = "set-dependencies"
+	SignalNameRegisterDependent  = "register-dependent"
+	SignalNameUpstreamCompleted  = "upstream-completed"
+	SignalNameSetSla             = "set-sla"
+	SignalNameSetSharedCalendars = "set-shared-calendars"
 
 	QueryNameDescribe          = "describe"
 	QueryNameListMatchingTimes = "listMatchingTimes"
@@ -145,9 +151,20 @@ type (
 		watchingFuture     workflow.Future
 
 		// Signal requests
-		pendingPatch  *schedulepb.SchedulePatch
-		pendingUpdate *schedulespb.FullUpdateRequest
-		forceCAN      bool
+		pendingPatch               *schedulepb.SchedulePatch
+		pendingUpdate              *schedulespb.FullUpdateRequest
+		pendingDependencies        *schedulespb.SetDependenciesRequest
+		pendingRegistrations       []*schedulespb.RegisterDependentRequest
+		pendingUpstreamCompletions []*schedulespb.UpstreamActionCompleted
+		pendingSla                 *schedulespb.SetSlaRequest
+		pendingConcurrencyGroup    *schedulespb.SetConcurrencyGroupRequest
+		pendingConcurrencyPermits  []string
+		pendingSharedCalendars     *schedulespb.SetSharedExcludeCalendarsRequest
+		forceCAN                   bool
+
+		// Number of signals to other schedules and SLA Nexus operations that were sent but not
+		// delivered or started yet.
+		outstandingSignals int
 
 		uuidBatch []string
 
@@ -175,6 +192,7 @@ type (
 		ReuseTimer                        bool                     // Whether to reuse timer. Used for workflow compatibility.
 		NextTimeCacheV2Size               int                      // Size of next time cache (v2)
 		SpecFieldLengthLimit              int                      // item limit per spec field on the ScheduleInfo memo
+		SharedCalendarRefreshInterval     time.Duration            // How often schedules that exclude shared calendars check them for changes
 		Version                           SchedulerWorkflowVersion // Used to keep track of schedules version to release new features and for backward compatibility
 		// version 0 corresponds to the schedule version that comes before introducing the Version parameter
 
@@ -225,6 +243,7 @@ var (
 		ReuseTimer:                        true,
 		NextTimeCacheV2Size:               14, // see note below
 		SpecFieldLengthLimit:              10,
+		SharedCalendarRefreshInterval:     5 * time.Minute,
 		Version:                           ActionResultIncludesStatus,
 	}
 
@@ -292,10 +311,12 @@ func (s *scheduler) run() error {
 		} else {
 			suggestContinueAsNew = suggestContinueAsNew || info.GetContinueAsNewSuggested() || s.forceCAN
 		}
-		if suggestContinueAsNew && s.pendingUpdate == nil && s.pendingPatch == nil {
+		if suggestContinueAsNew && !s.hasPendingSignals() {
 			break
 		}
 
+		s.checkSharedCalendars()
+
 		t1 := timestamp.TimeValue(s.State.LastProcessedTime)
 		t2 := s.now()
 		if t2.Before(t1) {
@@ -333,17 +354,20 @@ func (s *scheduler) run() error {
 		//nolint:revive
 		for s.processBuffer() {
 		}
+		s.releaseUnusedConcurrencyPermits()
+		s.watchForNotifications()
+		slaWakeup := s.checkDurationSla()
 		s.updateMemoAndSearchAttributes()
 
 		// if schedule is not paused and out of actions or do not have anything scheduled, exit the schedule workflow after retention period has passed
 		if exp := s.getRetentionExpiration(nextWakeup); !exp.IsZero() && !exp.After(s.now()) {
 			return nil
 		}
-		if suggestContinueAsNew && s.pendingUpdate == nil && s.pendingPatch == nil && s.hasMinVersion(CANAfterSignals) {
+		if suggestContinueAsNew && !s.hasPendingSignals() && s.hasMinVersion(CANAfterSignals) {
 			// If suggestContinueAsNew was true but we had a pending update or patch, we would
 			// not break above, but process the update/patch. Now that we're done, we should
-			// break here to do CAN. (pendingUpdate and pendingPatch should always be nil here,
-			// the check above is just being defensive.)
+			// break here to do CAN. (Pending signals should always be empty here, the check
+			// above is just being defensive.)
 			break
 		}
 
@@ -351,12 +375,18 @@ func (s *scheduler) run() error {
 		// 1. requested time elapsed
 		// 2. we got a signal (update, request, refresh)
 		// 3. a workflow that we were watching finished
-		s.sleep(nextWakeup)
+		s.sleep(earliestWakeup(nextWakeup, slaWakeup))
 		s.updateTweakables()
 	}
 
 	// Any watcher activities will get cancelled automatically if running.
 
+	// Signals to other schedules and SLA Nexus operations are lost if they are still outstanding
+	// when the run closes.
+	if err := workflow.Await(s.ctx, func() bool { return s.outstandingSignals == 0 }); err != nil {
+		return err
+	}
+
 	s.logger.Debug("Schedule doing continue-as-new")
 	return workflow.NewContinueAsNewError(s.ctx, WorkflowType, s.StartScheduleArgs)
 }
@@ -395,7 +425,7 @@ func (s *scheduler) compileSpec() {
 	s.nextTimeCacheV1 = nil
 	s.nextTimeCacheV2 = nil
 
-	cspec, err := s.specBuilder.NewCompiledSpec(s.Schedule.Spec)
+	cspec, err := s.specBuilder.NewCompiledSpecForNamespace(namespace.ID(s.State.NamespaceId), s.Schedule.Spec, s.State.SharedExcludeCalendars)
 	if err != nil {
 		if s.logger != nil {
 			s.logger.Error("Invalid schedule", "error", err)
@@ -567,6 +597,12 @@ func (s *scheduler) fillNextTimeCacheV2(start time.Time) {
 			NextTimes:    make([]int64, 0, s.tweakables.NextTimeCacheV2Size),
 			NominalTimes: make([]int64, 0, s.tweakables.NextTimeCacheV2Size),
 		}
+		if len(s.State.SharedExcludeCalendars) > 0 {
+			cache.SharedCalendarsVersion = s.specBuilder.SharedCalendarsVersion(
+				namespace.ID(s.State.NamespaceId),
+				s.State.SharedExcludeCalendars,
+			)
+		}
 		for t := start; len(cache.NextTimes) < s.tweakables.NextTimeCacheV2Size; {
 			next := s.cspec.GetNextTime(s.jitterSeed(), t)
 			if next.Next.IsZero() {
@@ -608,6 +644,28 @@ func (s *scheduler) fillNextTimeCacheV2(start time.Time) {
 	}
 }
 
+// checkSharedCalendars drops the next time cache when one of the shared calendars excluded by the schedule changed
+// since the cache was filled. The cache is filled in a side effect, so edits would otherwise only take effect once the
+// cache runs out. Schedules that exclude no shared calendar don't record the marker.
+func (s *scheduler) checkSharedCalendars() {
+	if len(s.State.SharedExcludeCalendars) == 0 {
+		return
+	}
+	get := func(ctx workflow.Context) interface{} {
+		return s.specBuilder.SharedCalendarsVersion(namespace.ID(s.State.NamespaceId), s.State.SharedExcludeCalendars)
+	}
+	eq := func(a, b interface{}) bool { return a.(string) == b.(string) }
+	var version string
+	if err := workflow.MutableSideEffect(s.ctx, "shared-calendars-version", get, eq).Get(&version); err != nil {
+		s.logger.Error("error getting shared calendars version", "error", err)
+		return
+	}
+	if s.nextTimeCacheV2 != nil && s.nextTimeCacheV2.SharedCalendarsVersion != version {
+		s.logger.Debug("Shared calendars changed, refilling next time cache")
+		s.nextTimeCacheV2 = nil
+	}
+}
+
 func (s *scheduler) getNextTime(after time.Time) GetNextTimeResult {
 	// Implementation using a cache to save markers + computation.
 	if s.hasMinVersion(NewCacheAndJitter) {
@@ -725,6 +783,47 @@ func (s *scheduler) sleep(nextWakeup time.Time) {
 	forceCAN := workflow.GetSignalChannel(s.ctx, SignalNameForceCAN)
 	sel.AddReceive(forceCAN, s.handleForceCANSignal)
 
+	sharedCalendarsCh := workflow.GetSignalChannel(s.ctx, SignalNameSetSharedCalendars)
+	sel.AddReceive(sharedCalendarsCh, func(ch workflow.ReceiveChannel, _ bool) {
+		ch.Receive(s.ctx, &s.pendingSharedCalendars)
+	})
+
+	depCh := workflow.GetSignalChannel(s.ctx, SignalNameSetDependencies)
+	sel.AddReceive(depCh, func(ch workflow.ReceiveChannel, _ bool) {
+		ch.Receive(s.ctx, &s.pendingDependencies)
+	})
+
+	registerCh := workflow.GetSignalChannel(s.ctx, SignalNameRegisterDependent)
+	sel.AddReceive(registerCh, func(ch workflow.ReceiveChannel, _ bool) {
+		var req *schedulespb.RegisterDependentRequest
+		ch.Receive(s.ctx, &req)
+		s.pendingRegistrations = append(s.pendingRegistrations, req)
+	})
+
+	upstreamCh := workflow.GetSignalChannel(s.ctx, SignalNameUpstreamCompleted)
+	sel.AddReceive(upstreamCh, func(ch workflow.ReceiveChannel, _ bool) {
+		var completed *schedulespb.UpstreamActionCompleted
+		ch.Receive(s.ctx, &completed)
+		s.pendingUpstreamCompletions = append(s.pendingUpstreamCompletions, completed)
+	})
+
+	slaCh := workflow.GetSignalChannel(s.ctx, SignalNameSetSla)
+	sel.AddReceive(slaCh, func(ch workflow.ReceiveChannel, _ bool) {
+		ch.Receive(s.ctx, &s.pendingSla)
+	})
+
+	groupCh := workflow.GetSignalChannel(s.ctx, SignalNameSetConcurrencyGroup)
+	sel.AddReceive(groupCh, func(ch workflow.ReceiveChannel, _ bool) {
+		ch.Receive(s.ctx, &s.pendingConcurrencyGroup)
+	})
+
+	permitCh := workflow.GetSignalChannel(s.ctx, SignalNameConcurrencyPermit)
+	sel.AddReceive(permitCh, func(ch workflow.ReceiveChannel, _ bool) {
+		var group string
+		ch.Receive(s.ctx, &group)
+		s.pendingConcurrencyPermits = append(s.pendingConcurrencyPermits, group)
+	})
+
 	if s.hasMoreAllowAllBackfills() {
 		// if we have more allow-all backfills to do, do a short sleep and continue
 		nextWakeup = s.now().Add(1 * time.Second)
@@ -738,6 +837,11 @@ func (s *scheduler) sleep(nextWakeup time.Time) {
 		nextWakeup = exp
 	}
 
+	// wake up periodically to notice changes to shared calendars, see checkSharedCalendars
+	if interval := s.tweakables.SharedCalendarRefreshInterval; !nextWakeup.IsZero() && interval > 0 && len(s.State.SharedExcludeCalendars) > 0 {
+		nextWakeup = util.MinTime(nextWakeup, s.now().Add(interval))
+	}
+
 	if !nextWakeup.IsZero() {
 		sleepTime := nextWakeup.Sub(s.now())
 		// A previous version of this workflow passed around sleep duration instead of wakeup time,
@@ -831,11 +935,13 @@ func (s *scheduler) processWatcherResult(id string, f workflow.Future, long bool
 
 	// now we know it's not running, remove from running workflow list
 	matchRunning := func(ex *commonpb.WorkflowExecution) bool { return ex.GetWorkflowId() == id }
-	if idx := slices.IndexFunc(s.Info.RunningWorkflows, matchRunning); idx >= 0 {
+	idx := slices.IndexFunc(s.Info.RunningWorkflows, matchRunning)
+	watched := s.removeWatchedWorkflow(id)
+	if idx >= 0 {
 		// We could also immediately update visibility, with updateMemoAndSearchAttributes,
 		// but the wakeup here will trigger a write after anyways (after processing the buffer).
 		s.Info.RunningWorkflows = slices.Delete(s.Info.RunningWorkflows, idx, idx+1)
-	} else {
+	} else if !watched {
 		// This could happen if the watcher activity gets interrupted and is retried after the
 		// heartbeat timeout, but in the meantime we have done a refresh through a local activity.
 		// This often happens when the worker is restarted.
@@ -868,6 +974,16 @@ func (s *scheduler) processWatcherResult(id string, f workflow.Future, long bool
 		s.incSeqNo()
 	}
 
+	// Only notify dependents, evaluate SLAs and release permits the first time we see the workflow closed. It
+	// may be reported again by a refresh racing with the long-poll watcher.
+	if idx >= 0 {
+		s.notifyDependents(id, &res)
+	}
+	if idx >= 0 || watched {
+		s.checkClosedWorkflowSla(id, res.Status, failedStatus)
+		s.releaseConcurrencyPermitWorkflow(id)
+	}
+
 	// handle last completion/failure
 	if res.GetResult() != nil {
 		s.State.LastCompletionResult = res.GetResult()
@@ -943,9 +1059,227 @@ func (s *scheduler) processSignals() bool {
 		s.pendingUpdate = nil
 		scheduleChanged = true
 	}
+	if s.pendingSharedCalendars != nil {
+		scheduleChanged = s.processSetSharedCalendars(s.pendingSharedCalendars) || scheduleChanged
+		s.pendingSharedCalendars = nil
+	}
+	if s.pendingDependencies != nil {
+		s.processSetDependencies(s.pendingDependencies)
+		s.pendingDependencies = nil
+	}
+	for _, req := range s.pendingRegistrations {
+		s.processRegisterDependent(req)
+	}
+	s.pendingRegistrations = nil
+	for _, completed := range s.pendingUpstreamCompletions {
+		s.processUpstreamCompleted(completed)
+	}
+	s.pendingUpstreamCompletions = nil
+	if s.pendingSla != nil {
+		s.processSetSla(s.pendingSla)
+		s.pendingSla = nil
+	}
+	if s.pendingConcurrencyGroup != nil {
+		s.processSetConcurrencyGroup(s.pendingConcurrencyGroup)
+		s.pendingConcurrencyGroup = nil
+	}
+	for _, group := range s.pendingConcurrencyPermits {
+		s.processConcurrencyPermit(group)
+	}
+	s.pendingConcurrencyPermits = nil
 	return scheduleChanged
 }
 
+func (s *scheduler) hasPendingSignals() bool {
+	return s.pendingUpdate != nil ||
+		s.pendingPatch != nil ||
+		s.pendingSharedCalendars != nil ||
+		s.pendingDependencies != nil ||
+		len(s.pendingRegistrations) > 0 ||
+		len(s.pendingUpstreamCompletions) > 0 ||
+		s.pendingSla != nil ||
+		s.pendingConcurrencyGroup != nil ||
+		len(s.pendingConcurrencyPermits) > 0
+}
+
+// processSetSharedCalendars replaces the shared calendars excluded by the schedule and
+// returns whether the spec was recompiled.
+func (s *scheduler) processSetSharedCalendars(req *schedulespb.SetSharedExcludeCalendarsRequest) bool {
+	if err := s.checkConflict(req.ConflictToken); err != nil {
+		s.logger.Warn("Set shared calendars conflicted with concurrent change")
+		return false
+	}
+
+	var names []string
+	for _, name := range req.Names {
+		if ValidateSharedCalendarName(name) != nil || slices.Contains(names, name) {
+			s.logger.Warn("Ignoring invalid or duplicate shared calendar", "calendar", name)
+			continue
+		}
+		names = append(names, name)
+	}
+
+	s.logger.Debug("Set shared exclude calendars", "count", len(names))
+	s.State.SharedExcludeCalendars = names
+	s.compileSpec()
+	s.incSeqNo()
+	return true
+}
+
+func (s *scheduler) processSetDependencies(req *schedulespb.SetDependenciesRequest) {
+	if err := s.checkConflict(req.ConflictToken); err != nil {
+		s.logger.Warn("Set dependencies conflicted with concurrent change")
+		return
+	}
+
+	deps, dropped := FilterDependencies(s.State.ScheduleId, req.Dependencies)
+	for _, id := range dropped {
+		s.logger.Warn("Ignoring invalid or duplicate schedule dependency", "upstream-schedule-id", id)
+	}
+
+	s.logger.Debug("Set schedule dependencies", "count", len(deps))
+
+	oldIDs := DependencyScheduleIDs(s.State.Dependencies)
+	newIDs := DependencyScheduleIDs(deps)
+	for _, id := range newIDs {
+		if !slices.Contains(oldIDs, id) {
+			s.signalSchedule(id, SignalNameRegisterDependent, &schedulespb.RegisterDependentRequest{
+				ScheduleId: s.State.ScheduleId,
+			})
+		}
+	}
+	for _, id := range oldIDs {
+		if !slices.Contains(newIDs, id) {
+			s.signalSchedule(id, SignalNameRegisterDependent, &schedulespb.RegisterDependentRequest{
+				ScheduleId: s.State.ScheduleId,
+				Unregister: true,
+			})
+		}
+	}
+
+	s.State.Dependencies = deps
+	// forget progress for upstreams we no longer depend on
+	for _, id := range oldIDs {
+		if !slices.Contains(newIDs, id) {
+			delete(s.State.SatisfiedDependencies, id)
+		}
+	}
+	s.incSeqNo()
+}
+
+func (s *scheduler) processRegisterDependent(req *schedulespb.RegisterDependentRequest) {
+	s.logger.Debug("Register dependent schedule", "dependent-schedule-id", req.GetScheduleId(), "unregister", req.GetUnregister())
+	s.State.DependentScheduleIds = RegisterDependent(s.State.DependentScheduleIds, req)
+}
+
+// processUpstreamCompleted records a closed action of an upstream schedule. Once every
+// dependency is satisfied, an action is buffered with the latest upstream close time as its
+// nominal time, subject to the usual pause, catchup window, and overlap policy handling.
+func (s *scheduler) processUpstreamCompleted(completed *schedulespb.UpstreamActionCompleted) {
+	s.logger.Debug("Upstream action closed",
+		"upstream-schedule-id", completed.GetScheduleId(), "workflow", completed.GetWorkflowId(), "status", completed.GetStatus())
+	var nominalTime time.Time
+	var ready bool
+	s.State.SatisfiedDependencies, nominalTime, ready = SatisfyDependency(
+		s.State.Dependencies,
+		s.State.SatisfiedDependencies,
+		completed,
+		s.now(),
+	)
+	if !ready {
+		return
+	}
+
+	if !s.canTakeScheduledAction(false, false) {
+		s.logger.Debug("Dropping dependency-triggered action while paused or out of actions")
+		return
+	}
+	now := s.now()
+	if now.Sub(nominalTime) > s.getCatchupWindow() {
+		s.logger.Warn("Schedule missed catchup window", "now", now, "time", nominalTime)
+		s.metrics.Counter(metrics.ScheduleMissedCatchupWindow.Name()).Inc(1)
+		s.Info.MissedCatchupWindow++
+		return
+	}
+	s.addStart(nominalTime, now, enumspb.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED, false)
+}
+
+func (s *scheduler) notifyDependents(id string, res *schedulespb.WatchWorkflowResponse) {
+	for _, dependent := range s.State.DependentScheduleIds {
+		s.signalSchedule(dependent, SignalNameUpstreamCompleted, &schedulespb.UpstreamActionCompleted{
+			ScheduleId: s.State.ScheduleId,
+			WorkflowId: id,
+			Status:     res.GetStatus(),
+			CloseTime:  res.GetCloseTime(),
+		})
+	}
+}
+
+// watchForNotifications makes sure that a schedule with dependents, holding concurrency
+// permits, or with an SLA on started workflows, is woken up when a workflow it started closes,
+// even if there is nothing in the buffer waiting for it.
+func (s *scheduler) watchForNotifications() {
+	if s.watchingFuture != nil {
+		return
+	}
+	needWatch := len(s.State.DependentScheduleIds) > 0 ||
+		len(s.State.ConcurrencyPermitWorkflowIds) > 0 ||
+		s.watchesOverlappingWorkflows()
+	if needWatch && len(s.Info.RunningWorkflows) > 0 {
+		s.startLongPollWatcher(s.Info.RunningWorkflows[0])
+	} else if len(s.State.WatchedWorkflows) > 0 {
+		s.startLongPollWatcher(s.State.WatchedWorkflows[0].GetStartWorkflowResult())
+	}
+}
+
+// watchesOverlappingWorkflows returns true if the schedule needs to see started workflows close
+// even when it doesn't track them as running workflows: to evaluate the SLA, or to release the
+// concurrency permits they hold.
+func (s *scheduler) watchesOverlappingWorkflows() bool {
+	return s.slaWatchesWorkflows() || s.State.ConcurrencyGroup != ""
+}
+
+// addWatchedWorkflow watches a started workflow until it closes if it's not tracked as a
+// running workflow of the schedule, and the schedule needs to see it close.
+func (s *scheduler) addWatchedWorkflow(result *schedulepb.ScheduleActionResult) {
+	id := result.GetStartWorkflowResult().GetWorkflowId()
+	matchRunning := func(ex *commonpb.WorkflowExecution) bool { return ex.GetWorkflowId() == id }
+	if !s.watchesOverlappingWorkflows() || id == "" || slices.ContainsFunc(s.Info.RunningWorkflows, matchRunning) {
+		return
+	}
+	s.State.WatchedWorkflows = append(s.State.WatchedWorkflows, result)
+	if excess := len(s.State.WatchedWorkflows) - s.tweakables.MaxBufferSize; excess > 0 {
+		s.logger.Warn("Too many watched workflows, forgetting the oldest", "count", excess)
+		s.State.WatchedWorkflows = s.State.WatchedWorkflows[excess:]
+	}
+}
+
+// removeWatchedWorkflow stops watching a closed workflow. It returns false if the workflow
+// wasn't watched.
+func (s *scheduler) removeWatchedWorkflow(id string) bool {
+	matchID := func(a *schedulepb.ScheduleActionResult) bool { return a.GetStartWorkflowResult().GetWorkflowId() == id }
+	idx := slices.IndexFunc(s.State.WatchedWorkflows, matchID)
+	if idx < 0 {
+		return false
+	}
+	s.State.WatchedWorkflows = slices.Delete(s.State.WatchedWorkflows, idx, idx+1)
+	return true
+}
+
+// signalSchedule sends a signal to another schedule without waiting for it to be delivered, so
+// that a missing or slow schedule doesn't hold up this one. Continue-as-new waits for
+// outstanding signals.
+func (s *scheduler) signalSchedule(scheduleID string, signalName string, arg any) {
+	f := workflow.SignalExternalWorkflow(s.ctx, WorkflowIDPrefix+scheduleID, "", signalName, arg)
+	s.outstandingSignals++
+	workflow.Go(s.ctx, func(ctx workflow.Context) {
+		defer func() { s.outstandingSignals-- }()
+		if err := f.Get(ctx, nil); err != nil {
+			s.logger.Warn("Failed to signal schedule", "target-schedule-id", scheduleID, "signal", signalName, "error", err)
+		}
+	})
+}
+
 // Returns up to `n` future action times.
 //
 // After workflow version `AccurateFutureActionTimes`, No more than the
@@ -1002,9 +1336,15 @@ func (s *scheduler) handleDescribeQuery() (*schedulespb.DescribeResponse, error)
 	infoCopy.BufferSize = int64(len(s.State.BufferedStarts))
 
 	return &schedulespb.DescribeResponse{
-		Schedule:      s.Schedule,
-		Info:          infoCopy,
-		ConflictToken: s.State.ConflictToken,
+		Schedule:               s.Schedule,
+		Info:                   infoCopy,
+		ConflictToken:          s.State.ConflictToken,
+		Dependencies:           s.State.Dependencies,
+		Sla:                    s.State.Sla,
+		ActiveSlaViolations:    s.State.ActiveSlaViolations,
+		ConcurrencyGroup:       s.State.ConcurrencyGroup,
+		ConcurrencyPriority:    s.State.ConcurrencyPriority,
+		SharedExcludeCalendars: s.State.SharedExcludeCalendars,
 	}, nil
 }
 
@@ -1088,6 +1428,10 @@ func (s *scheduler) updateCustomSearchAttributes(searchAttributes *commonpb.Sear
 		if searchattribute.IsReserved(key) {
 			continue
 		}
+		// The SLA search attribute is maintained by the schedule itself.
+		if key == s.State.Sla.GetSearchAttribute() {
+			continue
+		}
 		if newValuePayload, exists := searchAttributes.GetIndexedFields()[key]; !exists {
 			// Key is not set, so needs to be deleted.
 			upsertMap[key] = nil
@@ -1142,6 +1486,8 @@ func (s *scheduler) updateMemoAndSearchAttributes() {
 			s.logger.Error("error updating search attributes", "error", err)
 		}
 	}
+
+	s.updateSlaSearchAttribute()
 }
 
 func (s *scheduler) checkConflict(token int64) error {
@@ -1212,7 +1558,11 @@ func (s *scheduler) processBuffer() bool {
 	// TODO: on the other hand, we don't have to refresh if we have one workflow running and a
 	// long-poll watcher running, because we would have gotten woken up already.
 	if s.State.NeedRefresh {
-		s.refreshWorkflows(slices.Clone(s.Info.RunningWorkflows))
+		executions := slices.Clone(s.Info.RunningWorkflows)
+		for _, result := range s.State.WatchedWorkflows {
+			executions = append(executions, result.GetStartWorkflowResult())
+		}
+		s.refreshWorkflows(executions)
 		s.State.NeedRefresh = false
 	}
 
@@ -1229,14 +1579,24 @@ func (s *scheduler) processBuffer() bool {
 
 	s.State.BufferedStarts = action.NewBuffer
 	s.Info.OverlapSkipped += action.OverlapSkipped
+	if action.OverlapSkipped > 0 {
+		s.checkOverlapSkipSla(action.OverlapSkipped)
+	}
 
 	// Try starting whatever we're supposed to start now
 	allStarts := action.OverlappingStarts
 	if action.NonOverlappingStart != nil {
 		allStarts = append(allStarts, action.NonOverlappingStart)
 	}
+	var waitingForPermit []*schedulespb.BufferedStart
 	for _, start := range allStarts {
+		if !s.takeConcurrencyPermit() {
+			// keep it in the buffer until the concurrency group grants us a permit
+			waitingForPermit = append(waitingForPermit, start)
+			continue
+		}
 		if !s.canTakeScheduledAction(start.Manual, true) {
+			s.returnConcurrencyPermit()
 			// try again to drain the buffer if paused or out of actions
 			tryAgain = true
 			continue
@@ -1250,12 +1610,25 @@ func (s *scheduler) processBuffer() bool {
 			if !isUserScheduleError(err) {
 				metricsWithTag.Counter(metrics.ScheduleActionErrors.Name()).Inc(1)
 			}
+			s.returnConcurrencyPermit()
 			tryAgain = true
 			continue
 		}
 		metricsWithTag.Counter(metrics.ScheduleActionSuccess.Name()).Inc(1)
 		nonOverlapping := start == action.NonOverlappingStart
 		s.recordAction(result, nonOverlapping)
+		if s.State.ConcurrencyGroup != "" {
+			s.recordConcurrencyPermitWorkflow(result.GetStartWorkflowResult().GetWorkflowId())
+		}
+		s.addWatchedWorkflow(result)
+		if !start.Manual {
+			s.checkStartSla(start, result)
+		}
+	}
+
+	if len(waitingForPermit) > 0 {
+		s.State.BufferedStarts = append(waitingForPermit, s.State.BufferedStarts...)
+		s.requestConcurrencyPermit()
 	}
 
 	// Terminate or cancel if required (terminate overrides cancel if both are present)
@@ -1276,7 +1649,7 @@ func (s *scheduler) processBuffer() bool {
 	if len(s.State.BufferedStarts) > 0 && s.watchingFuture == nil {
 		if len(s.Info.RunningWorkflows) > 0 {
 			s.startLongPollWatcher(s.Info.RunningWorkflows[0])
-		} else {
+		} else if len(waitingForPermit) == 0 {
 			s.logger.Error("have buffered workflows but none running")
 		}
 	}
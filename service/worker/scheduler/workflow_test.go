@@ -27,11 +27,13 @@ package scheduler
 import (
 	"context"
 	"errors"
+	"fmt"
 	"math/rand"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/nexus-rpc/sdk-go/nexus"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	commonpb "go.temporal.io/api/common/v1"
@@ -44,6 +46,7 @@ import (
 	"go.temporal.io/sdk/testsuite"
 	"go.temporal.io/sdk/workflow"
 	schedulespb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/searchattribute"
@@ -2281,3 +2284,534 @@ func (s *workflowSuite) TestCANBySignal() {
 	s.True(s.env.IsWorkflowCompleted())
 	s.True(workflow.IsContinueAsNewError(s.env.GetWorkflowError()))
 }
+
+func (s *workflowSuite) TestDependencyTrigger() {
+	// written using low-level mocks so we can send dependency signals directly
+
+	for _, upstream := range []string{"upA", "upB"} {
+		s.env.OnSignalExternalWorkflow(mock.Anything, WorkflowIDPrefix+upstream, "", SignalNameRegisterDependent,
+			&schedulespb.RegisterDependentRequest{ScheduleId: "myschedule"}).Return(nil).Once()
+	}
+	s.expectStart(func(req *schedulespb.StartWorkflowRequest) (*schedulespb.StartWorkflowResponse, error) {
+		s.True(time.Date(2022, 6, 1, 0, 4, 0, 0, time.UTC).Equal(s.now()))
+		s.Equal("myid-2022-06-01T00:04:00Z", req.Request.WorkflowId)
+		return nil, nil
+	})
+
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalNameSetDependencies, &schedulespb.SetDependenciesRequest{
+			Dependencies: []*schedulespb.ScheduleDependency{
+				{ScheduleId: "upA"},
+				{ScheduleId: "upB", Statuses: []enumspb.WorkflowExecutionStatus{
+					enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+					enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+				}},
+				{ScheduleId: "myschedule"}, // ignored
+			},
+		})
+	}, 1*time.Minute)
+	upstreamCompleted := func(id string, status enumspb.WorkflowExecutionStatus) {
+		s.env.SignalWorkflow(SignalNameUpstreamCompleted, &schedulespb.UpstreamActionCompleted{
+			ScheduleId: id,
+			WorkflowId: id + "-wf",
+			Status:     status,
+			CloseTime:  timestamppb.New(s.now()),
+		})
+	}
+	s.env.RegisterDelayedCallback(func() {
+		s.Len(s.describe().Dependencies, 2)
+		// upA only accepts completed
+		upstreamCompleted("upA", enumspb.WORKFLOW_EXECUTION_STATUS_FAILED)
+	}, 2*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		upstreamCompleted("upA", enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)
+	}, 3*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		s.Zero(s.describe().Info.ActionCount)
+		upstreamCompleted("upB", enumspb.WORKFLOW_EXECUTION_STATUS_FAILED)
+	}, 4*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		s.EqualValues(1, s.describe().Info.ActionCount)
+	}, 5*time.Minute)
+
+	s.run(&schedulepb.Schedule{
+		Spec: &schedulepb.ScheduleSpec{},
+	}, 6)
+	s.True(s.env.IsWorkflowCompleted())
+}
+
+func (s *workflowSuite) TestDependentsNotified() {
+	// written using low-level mocks so we can check the notification signal
+
+	s.expectStart(func(req *schedulespb.StartWorkflowRequest) (*schedulespb.StartWorkflowResponse, error) {
+		s.Equal("myid-2022-06-01T00:05:00Z", req.Request.WorkflowId)
+		return nil, nil
+	})
+	// with a dependent registered, we watch the running workflow even with an empty buffer
+	s.expectWatch(func(req *schedulespb.WatchWorkflowRequest) (*schedulespb.WatchWorkflowResponse, error) {
+		s.Equal("myid-2022-06-01T00:05:00Z", req.Execution.WorkflowId)
+		s.True(req.LongPoll)
+		return &schedulespb.WatchWorkflowResponse{
+			Status:    enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			CloseTime: timestamppb.New(s.now()),
+		}, nil
+	})
+	matchCompleted := mock.MatchedBy(func(c *schedulespb.UpstreamActionCompleted) bool {
+		return c.ScheduleId == "myschedule" &&
+			c.WorkflowId == "myid-2022-06-01T00:05:00Z" &&
+			c.Status == enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
+	})
+	s.env.OnSignalExternalWorkflow(mock.Anything, WorkflowIDPrefix+"downstream", "", SignalNameUpstreamCompleted, matchCompleted).
+		Return(nil).Once()
+
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalNameRegisterDependent, &schedulespb.RegisterDependentRequest{ScheduleId: "downstream"})
+	}, 1*time.Minute)
+
+	s.run(&schedulepb.Schedule{
+		Spec: &schedulepb.ScheduleSpec{
+			Interval: []*schedulepb.IntervalSpec{{
+				Interval: durationpb.New(5 * time.Minute),
+			}},
+		},
+	}, 4)
+	s.True(s.env.IsWorkflowCompleted())
+	s.True(workflow.IsContinueAsNewError(s.env.GetWorkflowError()))
+}
+
+func (s *workflowSuite) TestSlaConsecutiveFailures() {
+	// written using low-level mocks so we can return failures
+
+	for _, minute := range []int{5, 10, 15} {
+		s.expectStart(func(req *schedulespb.StartWorkflowRequest) (*schedulespb.StartWorkflowResponse, error) {
+			s.True(time.Date(2022, 6, 1, 0, minute, 0, 0, time.UTC).Equal(s.now()))
+			return nil, nil
+		})
+	}
+	// the SLA watches each workflow, which fails after two minutes
+	for range 3 {
+		s.expectWatch(func(req *schedulespb.WatchWorkflowRequest) (*schedulespb.WatchWorkflowResponse, error) {
+			return &schedulespb.WatchWorkflowResponse{Status: enumspb.WORKFLOW_EXECUTION_STATUS_FAILED}, nil
+		}).After(2 * time.Minute)
+	}
+	matchViolation := mock.MatchedBy(func(req *schedulespb.NotifySlaViolationRequest) bool {
+		return req.WebhookUrl == "http://example.com/sla" &&
+			req.Violation.ScheduleId == "myschedule" &&
+			req.Violation.Type == SlaViolationConsecutiveFailures &&
+			req.Violation.WorkflowId == "myid-2022-06-01T00:10:00Z"
+	})
+	s.env.OnActivity(new(activities).NotifySlaViolation, mock.Anything, matchViolation).Once().Return(nil)
+
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalNameSetSla, &schedulespb.SetSlaRequest{
+			Sla: &schedulespb.ScheduleSla{
+				MaxConsecutiveFailures: 2,
+				SearchAttribute:        "SlaViolations",
+				WebhookUrl:             "http://example.com/sla",
+			},
+		})
+	}, 1*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		s.Empty(s.describe().ActiveSlaViolations)
+	}, 11*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		s.Equal([]string{SlaViolationConsecutiveFailures}, s.describe().ActiveSlaViolations)
+	}, 16*time.Minute)
+
+	s.run(&schedulepb.Schedule{
+		Spec: &schedulepb.ScheduleSpec{
+			Interval: []*schedulepb.IntervalSpec{{
+				Interval: durationpb.New(5 * time.Minute),
+			}},
+		},
+	}, 7)
+	s.True(s.env.IsWorkflowCompleted())
+	s.True(workflow.IsContinueAsNewError(s.env.GetWorkflowError()))
+}
+
+func (s *workflowSuite) TestSlaNexusNotification() {
+	for range 2 {
+		s.expectStart(func(req *schedulespb.StartWorkflowRequest) (*schedulespb.StartWorkflowResponse, error) {
+			return nil, nil
+		})
+	}
+	for range 2 {
+		s.expectWatch(func(req *schedulespb.WatchWorkflowRequest) (*schedulespb.WatchWorkflowResponse, error) {
+			return &schedulespb.WatchWorkflowResponse{Status: enumspb.WORKFLOW_EXECUTION_STATUS_FAILED}, nil
+		}).After(2 * time.Minute)
+	}
+	// the test environment doesn't allow matchers for the input
+	s.env.OnNexusOperation(
+		"sla-service",
+		nexus.NewOperationReference[*schedulespb.SlaViolation, nexus.NoValue]("notify"),
+		mock.Anything,
+		mock.Anything,
+	).Once().Return(&nexus.HandlerStartOperationResultSync[nexus.NoValue]{}, nil)
+
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalNameSetSla, &schedulespb.SetSlaRequest{
+			Sla: &schedulespb.ScheduleSla{
+				MaxConsecutiveFailures: 1,
+				NexusEndpoint:          "sla-endpoint",
+				NexusService:           "sla-service",
+				NexusOperation:         "notify",
+			},
+		})
+	}, 1*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		s.Equal([]string{SlaViolationConsecutiveFailures}, s.describe().ActiveSlaViolations)
+	}, 11*time.Minute)
+
+	s.run(&schedulepb.Schedule{
+		Spec: &schedulepb.ScheduleSpec{
+			Interval: []*schedulepb.IntervalSpec{{
+				Interval: durationpb.New(5 * time.Minute),
+			}},
+		},
+	}, 5)
+	s.True(s.env.IsWorkflowCompleted())
+	s.True(workflow.IsContinueAsNewError(s.env.GetWorkflowError()))
+}
+
+func (s *workflowSuite) TestSlaRejectsPartialNexusTarget() {
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalNameSetSla, &schedulespb.SetSlaRequest{
+			Sla: &schedulespb.ScheduleSla{
+				MaxConsecutiveFailures: 1,
+				NexusEndpoint:          "sla-endpoint",
+			},
+		})
+	}, 1*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		s.Nil(s.describe().Sla)
+	}, 2*time.Minute)
+
+	s.run(&schedulepb.Schedule{
+		Spec: &schedulepb.ScheduleSpec{
+			Interval: []*schedulepb.IntervalSpec{{
+				Interval: durationpb.New(time.Hour),
+			}},
+		},
+	}, 2)
+}
+
+func (s *workflowSuite) TestSlaMaxDuration() {
+	// written using low-level mocks so we can control workflow durations
+
+	for _, minute := range []int{10, 20} {
+		s.expectStart(func(req *schedulespb.StartWorkflowRequest) (*schedulespb.StartWorkflowResponse, error) {
+			s.True(time.Date(2022, 6, 1, 0, minute, 0, 0, time.UTC).Equal(s.now()))
+			return nil, nil
+		})
+	}
+	// the SLA watches each workflow, which completes after five minutes
+	for _, minute := range []int{10, 20} {
+		s.expectWatch(func(req *schedulespb.WatchWorkflowRequest) (*schedulespb.WatchWorkflowResponse, error) {
+			s.Equal(fmt.Sprintf("myid-2022-06-01T00:%d:00Z", minute), req.Execution.WorkflowId)
+			return &schedulespb.WatchWorkflowResponse{Status: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED}, nil
+		}).After(5 * time.Minute)
+	}
+
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalNameSetSla, &schedulespb.SetSlaRequest{
+			Sla: &schedulespb.ScheduleSla{
+				MaxDuration: durationpb.New(3 * time.Minute),
+			},
+		})
+	}, 1*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		s.Empty(s.describe().ActiveSlaViolations)
+	}, 12*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		// woken up by the SLA deadline even though nothing else is scheduled
+		s.Equal([]string{SlaViolationMaxDuration}, s.describe().ActiveSlaViolations)
+	}, 14*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		s.Empty(s.describe().ActiveSlaViolations)
+	}, 21*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		s.Equal([]string{SlaViolationMaxDuration}, s.describe().ActiveSlaViolations)
+	}, 24*time.Minute)
+
+	s.run(&schedulepb.Schedule{
+		Spec: &schedulepb.ScheduleSpec{
+			Interval: []*schedulepb.IntervalSpec{{
+				Interval: durationpb.New(10 * time.Minute),
+			}},
+		},
+	}, 6)
+	s.True(s.env.IsWorkflowCompleted())
+	s.True(workflow.IsContinueAsNewError(s.env.GetWorkflowError()))
+}
+
+func (s *workflowSuite) TestSlaAllowAll() {
+	// workflows that may overlap aren't running workflows of the schedule, but the SLA still
+	// watches them
+
+	for _, minute := range []int{10, 20} {
+		s.expectStart(func(req *schedulespb.StartWorkflowRequest) (*schedulespb.StartWorkflowResponse, error) {
+			s.True(time.Date(2022, 6, 1, 0, minute, 0, 0, time.UTC).Equal(s.now()))
+			return nil, nil
+		})
+	}
+	s.expectWatch(func(req *schedulespb.WatchWorkflowRequest) (*schedulespb.WatchWorkflowResponse, error) {
+		s.Equal("myid-2022-06-01T00:10:00Z", req.Execution.WorkflowId)
+		return &schedulespb.WatchWorkflowResponse{Status: enumspb.WORKFLOW_EXECUTION_STATUS_FAILED}, nil
+	}).After(5 * time.Minute)
+	s.expectWatch(func(req *schedulespb.WatchWorkflowRequest) (*schedulespb.WatchWorkflowResponse, error) {
+		s.Equal("myid-2022-06-01T00:20:00Z", req.Execution.WorkflowId)
+		return &schedulespb.WatchWorkflowResponse{Status: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED}, nil
+	}).After(time.Minute)
+
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalNameSetSla, &schedulespb.SetSlaRequest{
+			Sla: &schedulespb.ScheduleSla{
+				MaxDuration:            durationpb.New(3 * time.Minute),
+				MaxConsecutiveFailures: 1,
+			},
+		})
+	}, 1*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		s.Empty(s.runningWorkflows())
+		s.Empty(s.describe().ActiveSlaViolations)
+	}, 12*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		s.Equal([]string{SlaViolationMaxDuration}, s.describe().ActiveSlaViolations)
+	}, 14*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		s.Contains(s.describe().ActiveSlaViolations, SlaViolationConsecutiveFailures)
+	}, 16*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		// the next workflow completed in time
+		s.Empty(s.describe().ActiveSlaViolations)
+	}, 22*time.Minute)
+
+	s.run(&schedulepb.Schedule{
+		Spec: &schedulepb.ScheduleSpec{
+			Interval: []*schedulepb.IntervalSpec{{
+				Interval: durationpb.New(10 * time.Minute),
+			}},
+		},
+		Policies: &schedulepb.SchedulePolicies{
+			OverlapPolicy: enumspb.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL,
+		},
+	}, 8)
+	s.True(s.env.IsWorkflowCompleted())
+	s.True(workflow.IsContinueAsNewError(s.env.GetWorkflowError()))
+	s.Empty(s.describe().ActiveSlaViolations)
+}
+
+func (s *workflowSuite) TestSharedExcludeCalendars() {
+	structured, err := parseCalendarToStructured(&schedulepb.CalendarSpec{Hour: "0", Minute: "20-39"})
+	s.NoError(err)
+	quiet, err := EncodeSharedCalendar(&schedulespb.SharedCalendar{
+		Name:      "quiet",
+		Calendars: []*schedulepb.StructuredCalendarSpec{structured},
+	})
+	s.NoError(err)
+	specBuilder := NewSpecBuilderWithSharedCalendars(func(namespaceID namespace.ID, name string) (string, bool) {
+		return quiet, namespaceID == "mynsid" && name == "quiet"
+	})
+
+	for _, minute := range []int{10, 40, 50} {
+		s.expectStart(func(req *schedulespb.StartWorkflowRequest) (*schedulespb.StartWorkflowResponse, error) {
+			s.True(time.Date(2022, 6, 1, 0, minute, 0, 0, time.UTC).Equal(s.now()))
+			return nil, nil
+		})
+	}
+
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalNameSetSharedCalendars, &schedulespb.SetSharedExcludeCalendarsRequest{
+			Names: []string{"quiet", "quiet", "not valid"},
+		})
+	}, 5*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		s.Equal([]string{"quiet"}, s.describe().SharedExcludeCalendars)
+	}, 6*time.Minute)
+
+	// schedules excluding shared calendars also wake up every SharedCalendarRefreshInterval
+	CurrentTweakablePolicies.IterationsBeforeContinueAsNew = 11
+	s.env.SetStartTime(baseStartTime)
+	s.env.ExecuteWorkflow(func(ctx workflow.Context, args *schedulespb.StartScheduleArgs) error {
+		return schedulerWorkflowWithSpecBuilder(ctx, args, specBuilder)
+	}, &schedulespb.StartScheduleArgs{
+		Schedule: &schedulepb.Schedule{
+			Spec: &schedulepb.ScheduleSpec{
+				Interval: []*schedulepb.IntervalSpec{{
+					Interval: durationpb.New(10 * time.Minute),
+				}},
+			},
+			Action: s.defaultAction("myid"),
+			Policies: &schedulepb.SchedulePolicies{
+				OverlapPolicy: enumspb.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL,
+			},
+		},
+		State: &schedulespb.InternalState{
+			Namespace:     "myns",
+			NamespaceId:   "mynsid",
+			ScheduleId:    "myschedule",
+			ConflictToken: InitialConflictToken,
+		},
+	})
+	s.True(s.env.IsWorkflowCompleted())
+	s.True(workflow.IsContinueAsNewError(s.env.GetWorkflowError()))
+}
+
+func (s *workflowSuite) TestSharedExcludeCalendarEdited() {
+	encode := func(minutes string) string {
+		structured, err := parseCalendarToStructured(&schedulepb.CalendarSpec{Hour: "0", Minute: minutes})
+		s.NoError(err)
+		encoded, err := EncodeSharedCalendar(&schedulespb.SharedCalendar{
+			Name:      "quiet",
+			Calendars: []*schedulepb.StructuredCalendarSpec{structured},
+		})
+		s.NoError(err)
+		return encoded
+	}
+	quiet := encode("20-39")
+	specBuilder := NewSpecBuilderWithSharedCalendars(func(namespaceID namespace.ID, name string) (string, bool) {
+		return quiet, name == "quiet"
+	})
+
+	// the edit at 00:12 moves the quiet time before the schedule would have woken up for 00:40
+	for _, minute := range []int{10, 20, 30} {
+		s.expectStart(func(req *schedulespb.StartWorkflowRequest) (*schedulespb.StartWorkflowResponse, error) {
+			s.True(time.Date(2022, 6, 1, 0, minute, 0, 0, time.UTC).Equal(s.now()))
+			return nil, nil
+		})
+	}
+	s.env.RegisterDelayedCallback(func() {
+		quiet = encode("40-59")
+	}, 12*time.Minute)
+
+	CurrentTweakablePolicies.IterationsBeforeContinueAsNew = 12
+	s.env.SetStartTime(baseStartTime)
+	s.env.ExecuteWorkflow(func(ctx workflow.Context, args *schedulespb.StartScheduleArgs) error {
+		return schedulerWorkflowWithSpecBuilder(ctx, args, specBuilder)
+	}, &schedulespb.StartScheduleArgs{
+		Schedule: &schedulepb.Schedule{
+			Spec: &schedulepb.ScheduleSpec{
+				Interval: []*schedulepb.IntervalSpec{{
+					Interval: durationpb.New(10 * time.Minute),
+				}},
+			},
+			Action: s.defaultAction("myid"),
+			Policies: &schedulepb.SchedulePolicies{
+				OverlapPolicy: enumspb.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL,
+			},
+		},
+		State: &schedulespb.InternalState{
+			Namespace:              "myns",
+			NamespaceId:            "mynsid",
+			ScheduleId:             "myschedule",
+			ConflictToken:          InitialConflictToken,
+			SharedExcludeCalendars: []string{"quiet"},
+		},
+	})
+	s.True(s.env.IsWorkflowCompleted())
+	s.True(workflow.IsContinueAsNewError(s.env.GetWorkflowError()))
+}
+
+func (s *workflowSuite) TestConcurrencyGroupPermits() {
+	// written using low-level mocks so we can grant permits with signals
+
+	isAcquire := mock.MatchedBy(func(req *schedulespb.SignalConcurrencyGroupRequest) bool {
+		return req.Group == "mygroup" && req.GetAcquire().GetScheduleId() == "myschedule" && req.GetAcquire().GetPriority() == 3
+	})
+	s.env.OnActivity(new(activities).SignalConcurrencyGroup, mock.Anything, isAcquire).Return(nil)
+	isRelease := mock.MatchedBy(func(req *schedulespb.SignalConcurrencyGroupRequest) bool {
+		return req.Group == "mygroup" && req.GetRelease().GetScheduleId() == "myschedule" && req.GetRelease().GetCount() == 1
+	})
+	s.env.OnActivity(new(activities).SignalConcurrencyGroup, mock.Anything, isRelease).Return(nil).Once()
+
+	s.expectStart(func(req *schedulespb.StartWorkflowRequest) (*schedulespb.StartWorkflowResponse, error) {
+		// held in the buffer until the permit arrived
+		s.True(time.Date(2022, 6, 1, 0, 7, 0, 0, time.UTC).Equal(s.now()))
+		s.Equal("myid-2022-06-01T00:05:00Z", req.Request.WorkflowId)
+		return nil, nil
+	})
+	// the workflow holds the permit, so we watch it even with an empty buffer
+	s.expectWatch(func(req *schedulespb.WatchWorkflowRequest) (*schedulespb.WatchWorkflowResponse, error) {
+		s.Equal("myid-2022-06-01T00:05:00Z", req.Execution.WorkflowId)
+		return &schedulespb.WatchWorkflowResponse{
+			Status:    enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			CloseTime: timestamppb.New(s.now()),
+		}, nil
+	})
+
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalNameSetConcurrencyGroup, &schedulespb.SetConcurrencyGroupRequest{
+			Group:    "mygroup",
+			Priority: 3,
+		})
+	}, 1*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.Equal("mygroup", desc.ConcurrencyGroup)
+		s.Zero(desc.Info.ActionCount)
+		s.EqualValues(1, desc.Info.BufferSize)
+		s.env.SignalWorkflow(SignalNameConcurrencyPermit, "mygroup")
+	}, 7*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.EqualValues(1, desc.Info.ActionCount)
+		s.Empty(desc.Info.RunningWorkflows)
+	}, 8*time.Minute)
+
+	s.run(&schedulepb.Schedule{
+		Spec: &schedulepb.ScheduleSpec{
+			Interval: []*schedulepb.IntervalSpec{{
+				Interval: durationpb.New(5 * time.Minute),
+			}},
+		},
+	}, 6)
+	s.True(s.env.IsWorkflowCompleted())
+}
+
+func (s *workflowSuite) TestConcurrencyGroupAllowAll() {
+	// a workflow that may overlap holds a permit, but isn't tracked as running
+
+	s.env.OnActivity(new(activities).SignalConcurrencyGroup, mock.Anything, mock.MatchedBy(
+		func(req *schedulespb.SignalConcurrencyGroupRequest) bool { return req.GetAcquire() != nil },
+	)).Return(nil)
+	isRelease := mock.MatchedBy(func(req *schedulespb.SignalConcurrencyGroupRequest) bool {
+		return req.Group == "mygroup" && req.GetRelease().GetCount() == 1
+	})
+	s.env.OnActivity(new(activities).SignalConcurrencyGroup, mock.Anything, isRelease).Return(nil).Once()
+
+	s.expectStart(func(req *schedulespb.StartWorkflowRequest) (*schedulespb.StartWorkflowResponse, error) {
+		s.True(time.Date(2022, 6, 1, 0, 7, 0, 0, time.UTC).Equal(s.now()))
+		return nil, nil
+	})
+	s.expectWatch(func(req *schedulespb.WatchWorkflowRequest) (*schedulespb.WatchWorkflowResponse, error) {
+		s.Equal("myid-2022-06-01T00:05:00Z", req.Execution.WorkflowId)
+		return &schedulespb.WatchWorkflowResponse{
+			Status:    enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			CloseTime: timestamppb.New(s.now()),
+		}, nil
+	}).After(2 * time.Minute)
+
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalNameSetConcurrencyGroup, &schedulespb.SetConcurrencyGroupRequest{Group: "mygroup"})
+	}, 1*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalNameConcurrencyPermit, "mygroup")
+	}, 7*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.EqualValues(1, desc.Info.ActionCount)
+		s.Empty(desc.Info.RunningWorkflows)
+	}, 8*time.Minute)
+
+	s.run(&schedulepb.Schedule{
+		Spec: &schedulepb.ScheduleSpec{
+			Interval: []*schedulepb.IntervalSpec{{
+				Interval: durationpb.New(5 * time.Minute),
+			}},
+		},
+		Policies: &schedulepb.SchedulePolicies{
+			OverlapPolicy: enumspb.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL,
+		},
+	}, 6)
+	s.True(s.env.IsWorkflowCompleted())
+}
@@ -45,6 +45,7 @@ import (
 	"go.temporal.io/server/client"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
@@ -54,6 +55,7 @@ import (
 	"go.temporal.io/server/common/membership/ringpop"
 	"go.temporal.io/server/common/membership/static"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payloadstore"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/cassandra"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
@@ -83,6 +85,7 @@ var (
 	clusterMetadataInitErr           = errors.New("failed to initialize current cluster metadata")
 	missingCurrentClusterMetadataErr = errors.New("missing current cluster metadata under clusterMetadata.ClusterInformation")
 	missingServiceInStaticHosts      = errors.New("hosts are missing in static hosts for service: ")
+	hostLocalPayloadStoreErr         = errors.New("payload store is host local but static hosts define more than one history host, configure a shared payload store")
 )
 
 type (
@@ -140,6 +143,8 @@ type (
 		EsConfig              *esclient.Config
 		EsClient              esclient.Client
 		MetricsHandler        metrics.Handler
+		PayloadStore          payloadstore.Store
+		TimeSource            clock.TimeSource
 	}
 )
 
@@ -272,6 +277,18 @@ func ServerOptionsProvider(opts []ServerOption) (serverOptionsProvider, error) {
 		}
 	}
 
+	// PayloadStore
+	payloadStore := so.payloadStore
+	if payloadStore == nil && so.config.PayloadStore.Filestore != nil {
+		payloadStore, err = payloadstore.NewFileStore(
+			so.config.PayloadStore.Filestore.Directory,
+			so.config.PayloadStore.Filestore.Shared,
+		)
+		if err != nil {
+			return serverOptionsProvider{}, err
+		}
+	}
+
 	// check that when static hosts are defined, they are defined for all required hosts
 	if len(so.hostsByService) > 0 {
 		for _, service := range DefaultServices {
@@ -282,6 +299,12 @@ func ServerOptionsProvider(opts []ServerOption) (serverOptionsProvider, error) {
 		}
 	}
 
+	// payloads offloaded to a host local store would not be readable by the other history hosts
+	if local, ok := payloadStore.(payloadstore.HostLocal); ok && local.HostLocal() &&
+		len(so.hostsByService[primitives.HistoryService].All) > 1 {
+		return serverOptionsProvider{}, hostLocalPayloadStoreErr
+	}
+
 	return serverOptionsProvider{
 		ServerOptions:              so,
 		StopChan:                   stopChan,
@@ -312,6 +335,8 @@ func ServerOptionsProvider(opts []ServerOption) (serverOptionsProvider, error) {
 		EsConfig:              esConfig,
 		EsClient:              esClient,
 		MetricsHandler:        metricHandler,
+		PayloadStore:          payloadStore,
+		TimeSource:            so.timeSource,
 	}, nil
 }
 
@@ -376,6 +401,8 @@ type (
 		InstanceID                 resource.InstanceID                     `optional:"true"`
 		StaticServiceHosts         map[primitives.ServiceName]static.Hosts `optional:"true"`
 		TaskCategoryRegistry       tasks.TaskCategoryRegistry
+		PayloadStore               payloadstore.Store
+		TimeSource                 clock.TimeSource
 	}
 )
 
@@ -392,6 +419,13 @@ func (params ServiceProviderParamsCommon) GetCommonServiceOptions(serviceName pr
 		membershipModule = static.MembershipModule(params.StaticServiceHosts)
 	}
 
+	timeSourceOption := fx.Options()
+	if params.TimeSource != nil {
+		timeSourceOption = fx.Decorate(func() clock.TimeSource {
+			return params.TimeSource
+		})
+	}
+
 	return fx.Options(
 		fx.Supply(
 			serviceName,
@@ -449,10 +483,14 @@ func (params ServiceProviderParamsCommon) GetCommonServiceOptions(serviceName pr
 			func() tasks.TaskCategoryRegistry {
 				return params.TaskCategoryRegistry
 			},
+			func() payloadstore.Store {
+				return params.PayloadStore
+			},
 		),
 		ServiceTracingModule,
 		resource.DefaultOptions,
 		membershipModule,
+		timeSourceOption,
 		FxLogAdapter,
 	)
 }
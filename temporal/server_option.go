@@ -29,11 +29,13 @@ import (
 
 	"go.temporal.io/server/client"
 	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/membership/static"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payloadstore"
 	persistenceclient "go.temporal.io/server/common/persistence/client"
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/primitives"
@@ -198,6 +200,22 @@ func WithChainedFrontendGrpcInterceptors(
 	})
 }
 
+// WithPayloadStore sets a custom store for payloads offloaded from workflow history.
+// If not set, the filestore payload store from the static config is used when configured.
+func WithPayloadStore(store payloadstore.Store) ServerOption {
+	return applyFunc(func(s *serverOptions) {
+		s.payloadStore = store
+	})
+}
+
+// WithTimeSource sets the time source used by all services of the server instead of the wall clock.
+// NOTE: this option is meant for tests, e.g. to run a server on a virtual clock.
+func WithTimeSource(timeSource clock.TimeSource) ServerOption {
+	return applyFunc(func(s *serverOptions) {
+		s.timeSource = timeSource
+	})
+}
+
 // WithCustomerMetricsProvider sets a custom implementation of the metrics.MetricsHandler interface
 // metrics.MetricsHandler is the base interface for publishing metric events
 func WithCustomMetricsHandler(provider metrics.Handler) ServerOption {
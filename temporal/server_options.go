@@ -31,11 +31,13 @@ import (
 
 	"go.temporal.io/server/client"
 	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/membership/static"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payloadstore"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/primitives"
@@ -77,6 +79,8 @@ type (
 		searchAttributesMapper       searchattribute.Mapper
 		customFrontendInterceptors   []grpc.UnaryServerInterceptor
 		metricHandler                metrics.Handler
+		payloadStore                 payloadstore.Store
+		timeSource                   clock.TimeSource
 	}
 )
 
@@ -31,6 +31,7 @@ import (
 
 	"go.temporal.io/sdk/client"
 	"go.temporal.io/sdk/worker"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/temporal"
 )
 
@@ -76,3 +77,25 @@ func WithBaseServerOptions(options ...temporal.ServerOption) TestServerOption {
 		server.serverOptions = append(server.serverOptions, options...)
 	})
 }
+
+// WithTimeSkipping runs the server on a virtual clock instead of the wall clock. The virtual clock moves forward in
+// real time and can additionally be advanced with TestServer.AdvanceTime, which immediately fires all workflow timers,
+// timeouts and retries that became due.
+func WithTimeSkipping() TestServerOption {
+	return applyFunc(func(server *TestServer) {
+		if server.timeSource == nil {
+			server.timeSource = clock.NewVirtualTimeSource()
+		}
+	})
+}
+
+// WithAutoTimeSkipping enables WithTimeSkipping and automatically advances the virtual clock to the next due timer
+// whenever all running workflows are idle, i.e. they have no pending workflow or activity tasks and are only waiting
+// for timers, retries or timeouts. Workflows of every namespace registered with the server are taken into account,
+// including namespaces registered by the test itself.
+func WithAutoTimeSkipping() TestServerOption {
+	return applyFunc(func(server *TestServer) {
+		WithTimeSkipping().apply(server)
+		server.autoSkipTime = true
+	})
+}
@@ -36,10 +36,12 @@ import (
 
 	"go.temporal.io/sdk/client"
 	"go.temporal.io/sdk/worker"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/internal/temporalite"
 	"go.temporal.io/server/temporal"
+	"google.golang.org/grpc"
 )
 
 // A TestServer is a Temporal server listening on a system-chosen port on the
@@ -56,6 +58,9 @@ type TestServer struct {
 	defaultClientOptions client.Options
 	defaultWorkerOptions worker.Options
 	serverOptions        []temporal.ServerOption
+	timeSource           *clock.VirtualTimeSource
+	autoSkipTime         bool
+	stopAutoSkipTime     context.CancelFunc
 }
 
 func (ts *TestServer) fatal(err error) {
@@ -122,6 +127,12 @@ func (ts *TestServer) NewClientWithOptions(opts client.Options) client.Client {
 	if opts.Logger == nil {
 		opts.Logger = &testLogger{ts.t}
 	}
+	if ts.timeSource != nil {
+		opts.ConnectionOptions.DialOptions = append(
+			opts.ConnectionOptions.DialOptions,
+			grpc.WithChainUnaryInterceptor(ts.virtualTimeClientInterceptor),
+		)
+	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
@@ -138,6 +149,9 @@ func (ts *TestServer) NewClientWithOptions(opts client.Options) client.Client {
 
 // Stop closes test clients and shuts down the server.
 func (ts *TestServer) Stop() {
+	if ts.stopAutoSkipTime != nil {
+		ts.stopAutoSkipTime()
+	}
 	for _, w := range ts.workers {
 		w.Stop()
 	}
@@ -170,6 +184,9 @@ func NewServer(opts ...TestServerOption) *TestServer {
 	if ts.t != nil {
 		ts.t.Cleanup(ts.Stop)
 	}
+	if ts.timeSource != nil {
+		ts.serverOptions = append(ts.serverOptions, temporal.WithTimeSource(ts.timeSource))
+	}
 
 	s, err := temporalite.NewLiteServer(&temporalite.LiteServerConfig{
 		Namespaces: []string{ts.defaultTestNamespace},
@@ -194,5 +211,9 @@ func NewServer(opts ...TestServerOption) *TestServer {
 	// This sleep helps avoid a panic in github.com/temporalio/ringpop-go@v0.0.0-20230606200434-b5c079f412d3/swim/labels.go:175
 	time.Sleep(100 * time.Millisecond)
 
+	if ts.autoSkipTime {
+		ts.startAutoTimeSkipping()
+	}
+
 	return &ts
 }
@@ -49,6 +49,7 @@ import (
 	"go.temporal.io/server/temporal"
 	"go.temporal.io/server/temporaltest"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 // to be used in example code
@@ -359,6 +360,114 @@ func TestSearchAttributeRegistration(t *testing.T) {
 	}, 30*time.Second, 100*time.Millisecond)
 }
 
+func TestAutoTimeSkipping(t *testing.T) {
+	ts := temporaltest.NewServer(temporaltest.WithT(t), temporaltest.WithAutoTimeSkipping())
+	ts.NewWorker("hello_world", func(registry worker.Registry) {
+		RegisterWorkflowsAndActivities(registry)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	wfr, err := ts.GetDefaultClient().ExecuteWorkflow(
+		ctx,
+		client.StartWorkflowOptions{TaskQueue: "hello_world"},
+		SleepThenGreet,
+		24*time.Hour,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var elapsed time.Duration
+	if err := wfr.Get(ctx, &elapsed); err != nil {
+		t.Fatal(err)
+	}
+	assert.GreaterOrEqual(t, elapsed, 24*time.Hour)
+	assert.GreaterOrEqual(t, ts.Now().Sub(time.Now()), 23*time.Hour)
+}
+
+func TestAutoTimeSkippingInRegisteredNamespace(t *testing.T) {
+	ts := temporaltest.NewServer(temporaltest.WithT(t), temporaltest.WithAutoTimeSkipping())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	namespace := fmt.Sprintf("%s-registered", ts.GetDefaultNamespace())
+	_, err := ts.GetDefaultClient().WorkflowService().RegisterNamespace(ctx, &workflowservice.RegisterNamespaceRequest{
+		Namespace:                        namespace,
+		WorkflowExecutionRetentionPeriod: durationpb.New(24 * time.Hour),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := ts.NewClientWithOptions(client.Options{Namespace: namespace})
+	w := worker.New(c, "hello_world", worker.Options{})
+	RegisterWorkflowsAndActivities(w)
+	if err := w.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer w.Stop()
+
+	// The namespace becomes usable once the namespace registry picked it up.
+	var wfr client.WorkflowRun
+	assert.EventuallyWithT(t, func(t *assert.CollectT) {
+		wfr, err = c.ExecuteWorkflow(
+			ctx,
+			client.StartWorkflowOptions{TaskQueue: "hello_world"},
+			SleepThenGreet,
+			24*time.Hour,
+		)
+		assert.NoError(t, err)
+	}, 20*time.Second, 100*time.Millisecond)
+
+	var elapsed time.Duration
+	if err := wfr.Get(ctx, &elapsed); err != nil {
+		t.Fatal(err)
+	}
+	assert.GreaterOrEqual(t, elapsed, 24*time.Hour)
+}
+
+func TestAdvanceTime(t *testing.T) {
+	ts := temporaltest.NewServer(temporaltest.WithT(t), temporaltest.WithTimeSkipping())
+	ts.NewWorker("hello_world", func(registry worker.Registry) {
+		RegisterWorkflowsAndActivities(registry)
+	})
+	c := ts.GetDefaultClient()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	wfr, err := c.ExecuteWorkflow(
+		ctx,
+		client.StartWorkflowOptions{TaskQueue: "hello_world"},
+		SleepThenGreet,
+		time.Hour,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Wait for the workflow to start its timer before skipping over it.
+	assert.EventuallyWithT(t, func(t *assert.CollectT) {
+		resp, err := c.DescribeWorkflowExecution(ctx, wfr.GetID(), wfr.GetRunID())
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Nil(t, resp.GetPendingWorkflowTask())
+		assert.Greater(t, resp.GetWorkflowExecutionInfo().GetHistoryLength(), int64(4))
+	}, 10*time.Second, 100*time.Millisecond)
+
+	ts.AdvanceTime(time.Hour)
+
+	var elapsed time.Duration
+	if err := wfr.Get(ctx, &elapsed); err != nil {
+		t.Fatal(err)
+	}
+	assert.GreaterOrEqual(t, elapsed, time.Hour)
+}
+
 func BenchmarkRunWorkflow(b *testing.B) {
 	ts := temporaltest.NewServer()
 	defer ts.Stop()
@@ -396,6 +505,21 @@ func SearchAttrWorkflow(ctx workflow.Context, searchAttr string) error {
 	})
 }
 
+// SleepThenGreet sleeps for the given duration before running an activity and returns the workflow time it took.
+func SleepThenGreet(ctx workflow.Context, d time.Duration) (time.Duration, error) {
+	start := workflow.Now(ctx)
+	if err := workflow.Sleep(ctx, d); err != nil {
+		return 0, err
+	}
+	if err := workflow.ExecuteActivity(
+		workflow.WithActivityOptions(ctx, workflow.ActivityOptions{ScheduleToCloseTimeout: time.Second}),
+		PickGreeting,
+	).Get(ctx, nil); err != nil {
+		return 0, err
+	}
+	return workflow.Now(ctx).Sub(start), nil
+}
+
 // Example workflow/activity
 
 // Greet implements a Temporal workflow that returns a salutation for a given subject.
@@ -422,6 +546,7 @@ func HandleIntercept(ctx context.Context) (string, error) {
 
 func RegisterWorkflowsAndActivities(r worker.Registry) {
 	r.RegisterWorkflow(Greet)
+	r.RegisterWorkflow(SleepThenGreet)
 	r.RegisterActivity(PickGreeting)
 	r.RegisterActivityWithOptions(HandleIntercept, activity.RegisterOptions{Name: "HandleIntercept"})
 }
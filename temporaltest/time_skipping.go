@@ -0,0 +1,267 @@
+// The MIT License
+//
+// Copyright (c) 2025 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package temporaltest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/primitives"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	autoTimeSkippingInterval = 100 * time.Millisecond
+	autoTimeSkippingTimeout  = 5 * time.Second
+)
+
+// AdvanceTime moves the virtual clock of the server forward by d. Workflow timers, timeouts and retries that become
+// due are fired right away. It requires the WithTimeSkipping option.
+func (ts *TestServer) AdvanceTime(d time.Duration) {
+	if ts.timeSource == nil {
+		ts.fatal(fmt.Errorf("time skipping is not enabled, use the WithTimeSkipping option"))
+		return
+	}
+	ts.timeSource.Advance(d)
+}
+
+// Now returns the current time of the server, which is ahead of the wall clock if time was skipped.
+func (ts *TestServer) Now() time.Time {
+	if ts.timeSource == nil {
+		return time.Now().UTC()
+	}
+	return ts.timeSource.Now()
+}
+
+// virtualTimeClientInterceptor moves the times of activity tasks back onto the wall clock. SDK workers compute the
+// deadline of an activity from those times using the local clock, so without this every activity started after time
+// was skipped would time out right away on the worker.
+func (ts *TestServer) virtualTimeClientInterceptor(
+	ctx context.Context,
+	method string,
+	req, reply any,
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	if err := invoker(ctx, method, req, reply, cc, opts...); err != nil {
+		return err
+	}
+	task, ok := reply.(*workflowservice.PollActivityTaskQueueResponse)
+	if !ok {
+		return nil
+	}
+	offset := ts.timeSource.Offset()
+	task.ScheduledTime = shiftTimestamp(task.ScheduledTime, -offset)
+	task.StartedTime = shiftTimestamp(task.StartedTime, -offset)
+	task.CurrentAttemptScheduledTime = shiftTimestamp(task.CurrentAttemptScheduledTime, -offset)
+	return nil
+}
+
+func shiftTimestamp(t *timestamppb.Timestamp, d time.Duration) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(t.AsTime().Add(d))
+}
+
+func (ts *TestServer) startAutoTimeSkipping() {
+	conn, err := grpc.NewClient(ts.GetFrontendHostPort(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		ts.fatal(fmt.Errorf("error creating admin client: %w", err))
+		return
+	}
+	adminClient := adminservice.NewAdminServiceClient(conn)
+	workflowClient := workflowservice.NewWorkflowServiceClient(conn)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ts.stopAutoSkipTime = func() {
+		cancel()
+		_ = conn.Close()
+	}
+
+	go func() {
+		ticker := time.NewTicker(autoTimeSkippingInterval)
+		defer ticker.Stop()
+
+		// Only skip once the same idle state was observed twice in a row, this gives visibility a chance to catch up
+		// with workflows that were just started.
+		var lastDeadline time.Time
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			deadline, idle := ts.nextTimerDeadline(ctx, workflowClient, adminClient)
+			if !idle || deadline.IsZero() || !deadline.Equal(lastDeadline) {
+				lastDeadline = deadline
+				continue
+			}
+			lastDeadline = time.Time{}
+			ts.timeSource.Advance(deadline.Sub(ts.timeSource.Now()))
+		}
+	}()
+}
+
+// nextTimerDeadline returns the earliest time any running workflow is waiting for, and whether all of them are idle.
+// It covers every namespace registered with the server, except the system namespace whose background workflows don't
+// depend on test time.
+func (ts *TestServer) nextTimerDeadline(
+	ctx context.Context,
+	workflowClient workflowservice.WorkflowServiceClient,
+	adminClient adminservice.AdminServiceClient,
+) (time.Time, bool) {
+	ctx, cancel := context.WithTimeout(ctx, autoTimeSkippingTimeout)
+	defer cancel()
+
+	var deadline time.Time
+	var nextPageToken []byte
+	for {
+		resp, err := workflowClient.ListNamespaces(ctx, &workflowservice.ListNamespacesRequest{
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return time.Time{}, false
+		}
+		for _, ns := range resp.GetNamespaces() {
+			name := ns.GetNamespaceInfo().GetName()
+			if name == primitives.SystemLocalNamespace ||
+				ns.GetNamespaceInfo().GetState() != enumspb.NAMESPACE_STATE_REGISTERED {
+				continue
+			}
+			namespaceDeadline, idle := ts.namespaceTimerDeadline(ctx, name, workflowClient, adminClient)
+			if !idle {
+				return time.Time{}, false
+			}
+			if !namespaceDeadline.IsZero() && (deadline.IsZero() || namespaceDeadline.Before(deadline)) {
+				deadline = namespaceDeadline
+			}
+		}
+		nextPageToken = resp.GetNextPageToken()
+		if len(nextPageToken) == 0 {
+			return deadline, true
+		}
+	}
+}
+
+// namespaceTimerDeadline returns the earliest time any running workflow in the namespace is waiting for, and whether
+// all of them are idle.
+func (ts *TestServer) namespaceTimerDeadline(
+	ctx context.Context,
+	namespace string,
+	workflowClient workflowservice.WorkflowServiceClient,
+	adminClient adminservice.AdminServiceClient,
+) (time.Time, bool) {
+	var deadline time.Time
+	var nextPageToken []byte
+	for {
+		resp, err := workflowClient.ListWorkflowExecutions(ctx, &workflowservice.ListWorkflowExecutionsRequest{
+			Namespace:     namespace,
+			Query:         "ExecutionStatus = 'Running'",
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return time.Time{}, false
+		}
+		for _, execution := range resp.GetExecutions() {
+			msResp, err := adminClient.DescribeMutableState(ctx, &adminservice.DescribeMutableStateRequest{
+				Namespace: namespace,
+				Execution: execution.GetExecution(),
+			})
+			if err != nil {
+				return time.Time{}, false
+			}
+			workflowDeadline, idle := idleUntil(msResp.GetDatabaseMutableState(), ts.timeSource.Now())
+			if !idle {
+				return time.Time{}, false
+			}
+			if !workflowDeadline.IsZero() && (deadline.IsZero() || workflowDeadline.Before(deadline)) {
+				deadline = workflowDeadline
+			}
+		}
+		nextPageToken = resp.GetNextPageToken()
+		if len(nextPageToken) == 0 {
+			return deadline, true
+		}
+	}
+}
+
+// idleUntil returns whether the workflow is only waiting for time to pass, and the earliest time it is waiting for.
+func idleUntil(ms *persistencespb.WorkflowMutableState, now time.Time) (time.Time, bool) {
+	executionInfo := ms.GetExecutionInfo()
+	if executionInfo.GetPauseInfo() != nil {
+		// paused workflows don't make progress regardless of time
+		return time.Time{}, true
+	}
+	if executionInfo.GetWorkflowTaskScheduledEventId() != common.EmptyEventID ||
+		len(ms.GetBufferedEvents()) > 0 ||
+		len(ms.GetRequestCancelInfos()) > 0 ||
+		len(ms.GetSignalInfos()) > 0 {
+		return time.Time{}, false
+	}
+	for _, ci := range ms.GetChildExecutionInfos() {
+		if ci.GetStartedEventId() == common.EmptyEventID {
+			return time.Time{}, false
+		}
+	}
+
+	var deadline time.Time
+	addDeadline := func(t *timestamppb.Timestamp) {
+		if t == nil || t.AsTime().IsZero() || t.AsTime().Unix() == 0 {
+			return
+		}
+		if deadline.IsZero() || t.AsTime().Before(deadline) {
+			deadline = t.AsTime()
+		}
+	}
+
+	for _, ai := range ms.GetActivityInfos() {
+		if ai.GetPaused() {
+			continue
+		}
+		// A scheduled activity is only idle while it waits for its next retry attempt.
+		if ai.GetStartedEventId() != common.EmptyEventID || !ai.GetScheduledTime().AsTime().After(now) {
+			return time.Time{}, false
+		}
+		addDeadline(ai.GetScheduledTime())
+	}
+	for _, ti := range ms.GetTimerInfos() {
+		addDeadline(ti.GetExpiryTime())
+	}
+	if executionInfo.GetExecutionTime().AsTime().After(now) {
+		addDeadline(executionInfo.GetExecutionTime())
+	}
+	addDeadline(executionInfo.GetWorkflowRunExpirationTime())
+	addDeadline(executionInfo.GetWorkflowExecutionExpirationTime())
+	return deadline, true
+}
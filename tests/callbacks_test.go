@@ -45,6 +45,7 @@ import (
 	"go.temporal.io/sdk/client"
 	"go.temporal.io/sdk/worker"
 	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/testing/protoassert"
 	"go.temporal.io/server/components/callbacks"
@@ -370,6 +371,21 @@ func (s *CallbacksSuite) TestWorkflowNexusCallbacks_CarriedOver() {
 						assert.Nil(col, callbackInfo.LastAttemptFailure)
 					}
 				}, 2*time.Second, 100*time.Millisecond)
+
+				// The attempt history is only in the history service's describe response.
+				historyDescription, err := s.GetTestCluster().HistoryClient().DescribeWorkflowExecution(
+					ctx,
+					&historyservice.DescribeWorkflowExecutionRequest{
+						NamespaceId: s.NamespaceID().String(),
+						Request: &workflowservice.DescribeWorkflowExecutionRequest{
+							Namespace: s.Namespace().String(),
+							Execution: &commonpb.WorkflowExecution{WorkflowId: request.WorkflowId},
+						},
+					},
+				)
+				s.NoError(err)
+				s.Len(historyDescription.CallbackRecentAttempts, 1)
+				s.Len(historyDescription.CallbackRecentAttempts[0].Attempts, attempt)
 			}
 		})
 	}
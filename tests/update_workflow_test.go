@@ -246,6 +246,89 @@ func (s *UpdateWorkflowSuite) TestUpdateWorkflow_EmptySpeculativeWorkflowTask_Ac
 	}
 }
 
+func (s *UpdateWorkflowSuite) TestUpdateWorkflow_PausedWorkflow_HeldUntilUnpause() {
+	tv := testvars.New(s.T())
+	s.startWorkflow(tv)
+
+	wtHandlerCalls := 0
+	wtHandler := func(task *workflowservice.PollWorkflowTaskQueueResponse) ([]*commandpb.Command, error) {
+		wtHandlerCalls++
+		switch wtHandlerCalls {
+		case 1:
+			return nil, nil
+		case 2:
+			return s.UpdateAcceptCompleteCommands(tv), nil
+		default:
+			s.Failf("wtHandler called too many times", "wtHandler shouldn't be called %d times", wtHandlerCalls)
+			return nil, nil
+		}
+	}
+	msgHandlerCalls := 0
+	msgHandler := func(task *workflowservice.PollWorkflowTaskQueueResponse) ([]*protocolpb.Message, error) {
+		msgHandlerCalls++
+		switch msgHandlerCalls {
+		case 1:
+			return nil, nil
+		case 2:
+			s.Len(task.Messages, 1)
+			return s.UpdateAcceptCompleteMessages(tv, task.Messages[0]), nil
+		default:
+			s.Failf("msgHandler called too many times", "msgHandler shouldn't be called %d times", msgHandlerCalls)
+			return nil, nil
+		}
+	}
+	poller := &testcore.TaskPoller{
+		Client:              s.FrontendClient(),
+		Namespace:           s.Namespace().String(),
+		TaskQueue:           tv.TaskQueue(),
+		WorkflowTaskHandler: wtHandler,
+		MessageHandler:      msgHandler,
+		Logger:              s.Logger,
+		T:                   s.T(),
+	}
+
+	// Drain first WT.
+	_, err := poller.PollAndProcessWorkflowTask()
+	s.NoError(err)
+
+	_, err = s.AdminClient().PauseWorkflowExecution(testcore.NewContext(), &adminservice.PauseWorkflowExecutionRequest{
+		Namespace: s.Namespace().String(),
+		Execution: tv.WorkflowExecution(),
+		Identity:  tv.WorkerIdentity(),
+		Reason:    "test",
+	})
+	s.NoError(err)
+
+	updateResultCh := s.sendUpdateNoError(tv)
+	s.Eventually(func() bool {
+		resp, err := s.pollUpdate(tv, &updatepb.WaitPolicy{LifecycleStage: enumspb.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_ADMITTED})
+		return err == nil && resp.GetStage() == enumspb.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_ADMITTED
+	}, 5*time.Second, 100*time.Millisecond)
+
+	// The update is held in the registry, no workflow task is created for it while the workflow is paused.
+	descResp, err := s.FrontendClient().DescribeWorkflowExecution(testcore.NewContext(), &workflowservice.DescribeWorkflowExecutionRequest{
+		Namespace: s.Namespace().String(),
+		Execution: tv.WorkflowExecution(),
+	})
+	s.NoError(err)
+	s.Nil(descResp.GetPendingWorkflowTask())
+
+	_, err = s.AdminClient().UnpauseWorkflowExecution(testcore.NewContext(), &adminservice.UnpauseWorkflowExecutionRequest{
+		Namespace: s.Namespace().String(),
+		Execution: tv.WorkflowExecution(),
+		Identity:  tv.WorkerIdentity(),
+	})
+	s.NoError(err)
+
+	// Unpausing schedules a workflow task that delivers the update.
+	_, err = poller.PollAndProcessWorkflowTask()
+	s.NoError(err)
+	updateResult := <-updateResultCh
+	s.EqualValues("success-result-of-"+tv.UpdateID(), testcore.DecodeString(s.T(), updateResult.GetOutcome().GetSuccess()))
+	s.Equal(2, wtHandlerCalls)
+	s.Equal(2, msgHandlerCalls)
+}
+
 func (s *UpdateWorkflowSuite) TestUpdateWorkflow_NotEmptySpeculativeWorkflowTask_AcceptComplete() {
 	testCases := []struct {
 		name     string
@@ -25,6 +25,7 @@
 package tdbg
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -326,6 +327,97 @@ func describeMutableState(c *cli.Context, clientFactory ClientFactory) (*adminse
 	return resp, nil
 }
 
+// AdminDiffWorkflowHistories compares the histories of two workflow executions, or two branches of one execution
+func AdminDiffWorkflowHistories(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return err
+	}
+	otherWid := c.String(FlagOtherWorkflowID)
+	if otherWid == "" {
+		otherWid = wid
+	}
+	execution := &commonpb.WorkflowExecution{WorkflowId: wid, RunId: c.String(FlagRunID)}
+	otherExecution := &commonpb.WorkflowExecution{WorkflowId: otherWid, RunId: c.String(FlagOtherRunID)}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	branchToken, err := getBranchToken(ctx, adminClient, namespace, execution, c.Int(FlagBranchIndex))
+	if err != nil {
+		return err
+	}
+	otherBranchToken, err := getBranchToken(ctx, adminClient, namespace, otherExecution, c.Int(FlagOtherBranchIndex))
+	if err != nil {
+		return err
+	}
+
+	resp, err := adminClient.DiffWorkflowExecutionHistories(ctx, &adminservice.DiffWorkflowExecutionHistoriesRequest{
+		Namespace:         namespace,
+		Execution:         execution,
+		OtherExecution:    otherExecution,
+		BranchToken:       branchToken,
+		OtherBranchToken:  otherBranchToken,
+		MaximumEventDiffs: int32(c.Int(FlagMaxDiffs)),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to diff workflow histories: %s", err)
+	}
+
+	if resp.GetDivergenceEventId() == 0 {
+		fmt.Fprintln(c.App.Writer, color.Green(c, "Histories are the same."))
+		return nil
+	}
+	if resp.GetSharedLastEventId() > 0 {
+		fmt.Fprintf(c.App.Writer, "Shared branch up to event: %d\n", resp.GetSharedLastEventId())
+	}
+	fmt.Fprintf(c.App.Writer, "%s %d\n", color.Red(c, "Histories diverge at event:"), resp.GetDivergenceEventId())
+	fmt.Fprintf(c.App.Writer, "Commands: %v\n", resp.GetCommands())
+	fmt.Fprintf(c.App.Writer, "Other commands: %v\n", resp.GetOtherCommands())
+	fmt.Fprintln(c.App.Writer, color.Green(c, "Differing events:"))
+	for _, diff := range resp.GetEventDiffs() {
+		prettyPrintJSONObject(c, diff)
+	}
+	if resp.GetTruncated() {
+		fmt.Fprintf(c.App.Writer, "More events differ, increase --%s to see them.\n", FlagMaxDiffs)
+	}
+	return nil
+}
+
+// getBranchToken returns the branch token of the version history at index, or nil for the current branch.
+func getBranchToken(
+	ctx context.Context,
+	adminClient adminservice.AdminServiceClient,
+	namespace string,
+	execution *commonpb.WorkflowExecution,
+	index int,
+) ([]byte, error) {
+	if index < 0 {
+		return nil, nil
+	}
+	resp, err := adminClient.DescribeMutableState(ctx, &adminservice.DescribeMutableStateRequest{
+		Namespace: namespace,
+		Execution: execution,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get Workflow Mutable State: %s", err)
+	}
+	versionHistory, err := versionhistory.GetVersionHistory(
+		resp.GetDatabaseMutableState().GetExecutionInfo().GetVersionHistories(),
+		int32(index),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return versionHistory.GetBranchToken(), nil
+}
+
 // AdminDeleteWorkflow force deletes a workflow's mutable state (both concrete and current), history, and visibility
 // records as long as it's possible.
 // It should only be used as a troubleshooting tool since no additional check will be done before the deletion.
@@ -517,6 +609,24 @@ func AdminDescribeShard(c *cli.Context, clientFactory ClientFactory) error {
 	return nil
 }
 
+// AdminDescribeMutableStateCache lists the largest mutable states of a shard in the history cache
+func AdminDescribeMutableStateCache(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	response, err := adminClient.DescribeMutableStateCache(ctx, &adminservice.DescribeMutableStateCacheRequest{
+		ShardId:        int32(c.Int(FlagShardID)),
+		MaximumEntries: int32(c.Int(FlagPageSize)),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to describe mutable state cache: %s", err)
+	}
+
+	prettyPrintJSONObject(c, response)
+	return nil
+}
+
 // AdminShardManagement describes history host
 func AdminShardManagement(c *cli.Context, clientFactory ClientFactory) error {
 	adminClient := clientFactory.AdminClient(c)
@@ -91,4 +91,9 @@ var (
 	FlagBuildIDs                   = "select-build-id"
 	FlagUnversioned                = "select-unversioned"
 	FlagAllActive                  = "select-all-active"
+	FlagOtherWorkflowID            = "other-workflow-id"
+	FlagOtherRunID                 = "other-run-id"
+	FlagBranchIndex                = "branch-index"
+	FlagOtherBranchIndex           = "other-branch-index"
+	FlagMaxDiffs                   = "max-diffs"
 )
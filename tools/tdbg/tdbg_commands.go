@@ -158,6 +158,48 @@ func newAdminWorkflowCommands(clientFactory ClientFactory, prompterFactory Promp
 				return AdminShowWorkflow(c, clientFactory)
 			},
 		},
+		{
+			Name:  "diff",
+			Usage: "Compare the histories of two workflow executions, or two branches of one execution",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Workflow ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID",
+				},
+				&cli.StringFlag{
+					Name:  FlagOtherWorkflowID,
+					Usage: "Workflow ID of the execution to compare with, defaults to the first workflow ID",
+				},
+				&cli.StringFlag{
+					Name:  FlagOtherRunID,
+					Usage: "Run ID of the execution to compare with",
+				},
+				&cli.IntFlag{
+					Name:  FlagBranchIndex,
+					Usage: "Index of the version history of the first execution to compare, defaults to the current branch",
+					Value: -1,
+				},
+				&cli.IntFlag{
+					Name:  FlagOtherBranchIndex,
+					Usage: "Index of the version history of the other execution to compare, defaults to the current branch",
+					Value: -1,
+				},
+				&cli.IntFlag{
+					Name:  FlagMaxDiffs,
+					Usage: "Maximum number of differing events to show",
+					Value: 100,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDiffWorkflowHistories(c, clientFactory)
+			},
+		},
 		{
 			Name:    "describe",
 			Aliases: []string{"d"},
@@ -282,6 +324,25 @@ func newAdminShardManagementCommands(clientFactory ClientFactory, taskCategoryRe
 				return AdminDescribeShard(c, clientFactory)
 			},
 		},
+		{
+			Name:  "describe-cache",
+			Usage: "List the largest mutable states of a shard in the history cache",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:     FlagShardID,
+					Usage:    "The ID of the shard",
+					Required: true,
+				},
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Value: defaultPageSize,
+					Usage: "Maximum number of entries to list",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDescribeMutableStateCache(c, clientFactory)
+			},
+		},
 		{
 			Name:  "list-tasks",
 			Usage: "List tasks for given shard ID and task category",